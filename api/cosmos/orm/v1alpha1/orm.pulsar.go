@@ -639,6 +639,7 @@ var (
 	fd_PrimaryKeyDescriptor_fields         protoreflect.FieldDescriptor
 	fd_PrimaryKeyDescriptor_auto_increment protoreflect.FieldDescriptor
 	fd_PrimaryKeyDescriptor_references     protoreflect.FieldDescriptor
+	fd_PrimaryKeyDescriptor_on_delete      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -647,6 +648,7 @@ func init() {
 	fd_PrimaryKeyDescriptor_fields = md_PrimaryKeyDescriptor.Fields().ByName("fields")
 	fd_PrimaryKeyDescriptor_auto_increment = md_PrimaryKeyDescriptor.Fields().ByName("auto_increment")
 	fd_PrimaryKeyDescriptor_references = md_PrimaryKeyDescriptor.Fields().ByName("references")
+	fd_PrimaryKeyDescriptor_on_delete = md_PrimaryKeyDescriptor.Fields().ByName("on_delete")
 }
 
 var _ protoreflect.Message = (*fastReflection_PrimaryKeyDescriptor)(nil)
@@ -732,6 +734,12 @@ func (x *fastReflection_PrimaryKeyDescriptor) Range(f func(protoreflect.FieldDes
 			return
 		}
 	}
+	if x.OnDelete != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.OnDelete))
+		if !f(fd_PrimaryKeyDescriptor_on_delete, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -753,6 +761,8 @@ func (x *fastReflection_PrimaryKeyDescriptor) Has(fd protoreflect.FieldDescripto
 		return x.AutoIncrement != false
 	case "cosmos.orm.v1alpha1.PrimaryKeyDescriptor.references":
 		return x.References != ""
+	case "cosmos.orm.v1alpha1.PrimaryKeyDescriptor.on_delete":
+		return x.OnDelete != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.orm.v1alpha1.PrimaryKeyDescriptor"))
@@ -775,6 +785,8 @@ func (x *fastReflection_PrimaryKeyDescriptor) Clear(fd protoreflect.FieldDescrip
 		x.AutoIncrement = false
 	case "cosmos.orm.v1alpha1.PrimaryKeyDescriptor.references":
 		x.References = ""
+	case "cosmos.orm.v1alpha1.PrimaryKeyDescriptor.on_delete":
+		x.OnDelete = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.orm.v1alpha1.PrimaryKeyDescriptor"))
@@ -800,6 +812,9 @@ func (x *fastReflection_PrimaryKeyDescriptor) Get(descriptor protoreflect.FieldD
 	case "cosmos.orm.v1alpha1.PrimaryKeyDescriptor.references":
 		value := x.References
 		return protoreflect.ValueOfString(value)
+	case "cosmos.orm.v1alpha1.PrimaryKeyDescriptor.on_delete":
+		value := x.OnDelete
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.orm.v1alpha1.PrimaryKeyDescriptor"))
@@ -826,6 +841,8 @@ func (x *fastReflection_PrimaryKeyDescriptor) Set(fd protoreflect.FieldDescripto
 		x.AutoIncrement = value.Bool()
 	case "cosmos.orm.v1alpha1.PrimaryKeyDescriptor.references":
 		x.References = value.Interface().(string)
+	case "cosmos.orm.v1alpha1.PrimaryKeyDescriptor.on_delete":
+		x.OnDelete = (ReferenceDeleteMode)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.orm.v1alpha1.PrimaryKeyDescriptor"))
@@ -852,6 +869,8 @@ func (x *fastReflection_PrimaryKeyDescriptor) Mutable(fd protoreflect.FieldDescr
 		panic(fmt.Errorf("field auto_increment of message cosmos.orm.v1alpha1.PrimaryKeyDescriptor is not mutable"))
 	case "cosmos.orm.v1alpha1.PrimaryKeyDescriptor.references":
 		panic(fmt.Errorf("field references of message cosmos.orm.v1alpha1.PrimaryKeyDescriptor is not mutable"))
+	case "cosmos.orm.v1alpha1.PrimaryKeyDescriptor.on_delete":
+		panic(fmt.Errorf("field on_delete of message cosmos.orm.v1alpha1.PrimaryKeyDescriptor is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.orm.v1alpha1.PrimaryKeyDescriptor"))
@@ -871,6 +890,8 @@ func (x *fastReflection_PrimaryKeyDescriptor) NewField(fd protoreflect.FieldDesc
 		return protoreflect.ValueOfBool(false)
 	case "cosmos.orm.v1alpha1.PrimaryKeyDescriptor.references":
 		return protoreflect.ValueOfString("")
+	case "cosmos.orm.v1alpha1.PrimaryKeyDescriptor.on_delete":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.orm.v1alpha1.PrimaryKeyDescriptor"))
@@ -951,6 +972,9 @@ func (x *fastReflection_PrimaryKeyDescriptor) ProtoMethods() *protoiface.Methods
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.OnDelete != 0 {
+			n += 1 + runtime.Sov(uint64(x.OnDelete))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -980,6 +1004,11 @@ func (x *fastReflection_PrimaryKeyDescriptor) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.OnDelete != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OnDelete))
+			i--
+			dAtA[i] = 0x20
+		}
 		if len(x.References) > 0 {
 			i -= len(x.References)
 			copy(dAtA[i:], x.References)
@@ -1137,6 +1166,25 @@ func (x *fastReflection_PrimaryKeyDescriptor) ProtoMethods() *protoiface.Methods
 				}
 				x.References = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OnDelete", wireType)
+				}
+				x.OnDelete = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OnDelete |= ReferenceDeleteMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1178,6 +1226,7 @@ var (
 	fd_SecondaryIndexDescriptor_id         protoreflect.FieldDescriptor
 	fd_SecondaryIndexDescriptor_unique     protoreflect.FieldDescriptor
 	fd_SecondaryIndexDescriptor_references protoreflect.FieldDescriptor
+	fd_SecondaryIndexDescriptor_on_delete  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1187,6 +1236,7 @@ func init() {
 	fd_SecondaryIndexDescriptor_id = md_SecondaryIndexDescriptor.Fields().ByName("id")
 	fd_SecondaryIndexDescriptor_unique = md_SecondaryIndexDescriptor.Fields().ByName("unique")
 	fd_SecondaryIndexDescriptor_references = md_SecondaryIndexDescriptor.Fields().ByName("references")
+	fd_SecondaryIndexDescriptor_on_delete = md_SecondaryIndexDescriptor.Fields().ByName("on_delete")
 }
 
 var _ protoreflect.Message = (*fastReflection_SecondaryIndexDescriptor)(nil)
@@ -1278,6 +1328,12 @@ func (x *fastReflection_SecondaryIndexDescriptor) Range(f func(protoreflect.Fiel
 			return
 		}
 	}
+	if x.OnDelete != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.OnDelete))
+		if !f(fd_SecondaryIndexDescriptor_on_delete, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1301,6 +1357,8 @@ func (x *fastReflection_SecondaryIndexDescriptor) Has(fd protoreflect.FieldDescr
 		return x.Unique != false
 	case "cosmos.orm.v1alpha1.SecondaryIndexDescriptor.references":
 		return x.References != ""
+	case "cosmos.orm.v1alpha1.SecondaryIndexDescriptor.on_delete":
+		return x.OnDelete != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.orm.v1alpha1.SecondaryIndexDescriptor"))
@@ -1325,6 +1383,8 @@ func (x *fastReflection_SecondaryIndexDescriptor) Clear(fd protoreflect.FieldDes
 		x.Unique = false
 	case "cosmos.orm.v1alpha1.SecondaryIndexDescriptor.references":
 		x.References = ""
+	case "cosmos.orm.v1alpha1.SecondaryIndexDescriptor.on_delete":
+		x.OnDelete = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.orm.v1alpha1.SecondaryIndexDescriptor"))
@@ -1353,6 +1413,9 @@ func (x *fastReflection_SecondaryIndexDescriptor) Get(descriptor protoreflect.Fi
 	case "cosmos.orm.v1alpha1.SecondaryIndexDescriptor.references":
 		value := x.References
 		return protoreflect.ValueOfString(value)
+	case "cosmos.orm.v1alpha1.SecondaryIndexDescriptor.on_delete":
+		value := x.OnDelete
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.orm.v1alpha1.SecondaryIndexDescriptor"))
@@ -1381,6 +1444,8 @@ func (x *fastReflection_SecondaryIndexDescriptor) Set(fd protoreflect.FieldDescr
 		x.Unique = value.Bool()
 	case "cosmos.orm.v1alpha1.SecondaryIndexDescriptor.references":
 		x.References = value.Interface().(string)
+	case "cosmos.orm.v1alpha1.SecondaryIndexDescriptor.on_delete":
+		x.OnDelete = (ReferenceDeleteMode)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.orm.v1alpha1.SecondaryIndexDescriptor"))
@@ -1409,6 +1474,8 @@ func (x *fastReflection_SecondaryIndexDescriptor) Mutable(fd protoreflect.FieldD
 		panic(fmt.Errorf("field unique of message cosmos.orm.v1alpha1.SecondaryIndexDescriptor is not mutable"))
 	case "cosmos.orm.v1alpha1.SecondaryIndexDescriptor.references":
 		panic(fmt.Errorf("field references of message cosmos.orm.v1alpha1.SecondaryIndexDescriptor is not mutable"))
+	case "cosmos.orm.v1alpha1.SecondaryIndexDescriptor.on_delete":
+		panic(fmt.Errorf("field on_delete of message cosmos.orm.v1alpha1.SecondaryIndexDescriptor is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.orm.v1alpha1.SecondaryIndexDescriptor"))
@@ -1430,6 +1497,8 @@ func (x *fastReflection_SecondaryIndexDescriptor) NewField(fd protoreflect.Field
 		return protoreflect.ValueOfBool(false)
 	case "cosmos.orm.v1alpha1.SecondaryIndexDescriptor.references":
 		return protoreflect.ValueOfString("")
+	case "cosmos.orm.v1alpha1.SecondaryIndexDescriptor.on_delete":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.orm.v1alpha1.SecondaryIndexDescriptor"))
@@ -1513,6 +1582,9 @@ func (x *fastReflection_SecondaryIndexDescriptor) ProtoMethods() *protoiface.Met
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.OnDelete != 0 {
+			n += 1 + runtime.Sov(uint64(x.OnDelete))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1542,6 +1614,11 @@ func (x *fastReflection_SecondaryIndexDescriptor) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.OnDelete != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OnDelete))
+			i--
+			dAtA[i] = 0x28
+		}
 		if len(x.References) > 0 {
 			i -= len(x.References)
 			copy(dAtA[i:], x.References)
@@ -1723,6 +1800,25 @@ func (x *fastReflection_SecondaryIndexDescriptor) ProtoMethods() *protoiface.Met
 				}
 				x.References = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OnDelete", wireType)
+				}
+				x.OnDelete = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OnDelete |= ReferenceDeleteMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2175,6 +2271,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ReferenceDeleteMode specifies how the deletion of a referenced row is
+// handled.
+type ReferenceDeleteMode int32
+
+const (
+	// REFERENCE_DELETE_MODE_RESTRICT specifies that a row cannot be deleted
+	// while other rows reference it.
+	ReferenceDeleteMode_REFERENCE_DELETE_MODE_RESTRICT ReferenceDeleteMode = 0
+	// REFERENCE_DELETE_MODE_CASCADE specifies that the rows referencing a row
+	// are deleted together with it.
+	ReferenceDeleteMode_REFERENCE_DELETE_MODE_CASCADE ReferenceDeleteMode = 1
+)
+
+// Enum value maps for ReferenceDeleteMode.
+var (
+	ReferenceDeleteMode_name = map[int32]string{
+		0: "REFERENCE_DELETE_MODE_RESTRICT",
+		1: "REFERENCE_DELETE_MODE_CASCADE",
+	}
+	ReferenceDeleteMode_value = map[string]int32{
+		"REFERENCE_DELETE_MODE_RESTRICT": 0,
+		"REFERENCE_DELETE_MODE_CASCADE":  1,
+	}
+)
+
+func (x ReferenceDeleteMode) Enum() *ReferenceDeleteMode {
+	p := new(ReferenceDeleteMode)
+	*p = x
+	return p
+}
+
+func (x ReferenceDeleteMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReferenceDeleteMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_cosmos_orm_v1alpha1_orm_proto_enumTypes[0].Descriptor()
+}
+
+func (ReferenceDeleteMode) Type() protoreflect.EnumType {
+	return &file_cosmos_orm_v1alpha1_orm_proto_enumTypes[0]
+}
+
+func (x ReferenceDeleteMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReferenceDeleteMode.Descriptor instead.
+func (ReferenceDeleteMode) EnumDescriptor() ([]byte, []int) {
+	return file_cosmos_orm_v1alpha1_orm_proto_rawDescGZIP(), []int{0}
+}
+
 // TableDescriptor describes an ORM table.
 type TableDescriptor struct {
 	state         protoimpl.MessageState
@@ -2246,6 +2394,10 @@ type PrimaryKeyDescriptor struct {
 	//   - string's are encoded as raw bytes in terminal key segments and null-terminated
 	//   in non-terminal segments. Null characters are thus forbidden in strings.
 	//   string fields support sorted iteration.
+	//   - string fields annotated with the cosmos_proto.scalar option "cosmos.Int"
+	//   or "cosmos.Dec" are encoded with a self-delimiting encoding which supports
+	//   sorted iteration in numerical order. Keys store these values in their
+	//   canonical form, without leading or trailing zeros.
 	//   - bytes are encoded as raw bytes in terminal segments and length-prefixed
 	//   with a single byte in non-terminal segments. Because of this byte arrays
 	//   longer than 255 bytes are unsupported and bytes fields should not
@@ -2274,6 +2426,9 @@ type PrimaryKeyDescriptor struct {
 	// field for more details. An additional constraint placed on primary keys
 	// which reference another table is that those references cannot be circular.
 	References string `protobuf:"bytes,3,opt,name=references,proto3" json:"references,omitempty"`
+	// on_delete specifies what happens to the rows of this table when the row
+	// they reference is deleted. It is only used when references is set.
+	OnDelete ReferenceDeleteMode `protobuf:"varint,4,opt,name=on_delete,json=onDelete,proto3,enum=cosmos.orm.v1alpha1.ReferenceDeleteMode" json:"on_delete,omitempty"`
 }
 
 func (x *PrimaryKeyDescriptor) Reset() {
@@ -2317,6 +2472,13 @@ func (x *PrimaryKeyDescriptor) GetReferences() string {
 	return ""
 }
 
+func (x *PrimaryKeyDescriptor) GetOnDelete() ReferenceDeleteMode {
+	if x != nil {
+		return x.OnDelete
+	}
+	return ReferenceDeleteMode_REFERENCE_DELETE_MODE_RESTRICT
+}
+
 // PrimaryKeyDescriptor describes a table secondary index.
 type SecondaryIndexDescriptor struct {
 	state         protoimpl.MessageState
@@ -2325,6 +2487,13 @@ type SecondaryIndexDescriptor struct {
 
 	// fields is a comma-separated list of fields in the index. The supported
 	// field types are the same as those for PrimaryKeyDescriptor.fields.
+	// Fields of nested messages can be referred to by dot-separated paths,
+	// for instance "coin.denom".
+	//
+	// A single field of a non-unique index can be a repeated field, or a field
+	// of a repeated message field such as "coins.denom", in which case the
+	// index is a multi-entry index with one entry for each distinct element of
+	// that field. Rows whose repeated field is empty have no index entries.
 	// Index keys are prefixed by the varint encoded table id and the varint
 	// encoded index id plus any additional prefix specified by the schema.
 	//
@@ -2340,14 +2509,21 @@ type SecondaryIndexDescriptor struct {
 	// unique specifies that this an unique index.
 	Unique bool `protobuf:"varint,3,opt,name=unique,proto3" json:"unique,omitempty"`
 	// references specifies that this index references another table defined in the same
-	// proto file. Currently references are not support to tables with composite
-	// primary keys, therefore fields must reference one field and its type must
-	// be the same type as the primary key field of the referenced table.
+	// proto file. The value is the name of the referenced message, either
+	// unqualified or fully-qualified. The leading fields of the index must
+	// match in number and type the primary key fields of the referenced table.
 	// References to tables in defined by different proto files are not supported
-	// to avoid tight coupling of dependencies. Beyond validating that the reference
-	// is valid key constraints are currently not enforced, but references should
-	// be used by clients to perform automatic joins.
+	// to avoid tight coupling of dependencies.
+	//
+	// References are enforced as foreign key constraints: a row cannot be
+	// inserted or updated with a reference to a row that does not exist, unless
+	// all the referencing fields have their default value in which case the
+	// reference is considered to be unset. Deleting a referenced row is handled
+	// as specified by on_delete.
 	References string `protobuf:"bytes,4,opt,name=references,proto3" json:"references,omitempty"`
+	// on_delete specifies what happens to the rows of this table when the row
+	// they reference is deleted. It is only used when references is set.
+	OnDelete ReferenceDeleteMode `protobuf:"varint,5,opt,name=on_delete,json=onDelete,proto3,enum=cosmos.orm.v1alpha1.ReferenceDeleteMode" json:"on_delete,omitempty"`
 }
 
 func (x *SecondaryIndexDescriptor) Reset() {
@@ -2398,6 +2574,13 @@ func (x *SecondaryIndexDescriptor) GetReferences() string {
 	return ""
 }
 
+func (x *SecondaryIndexDescriptor) GetOnDelete() ReferenceDeleteMode {
+	if x != nil {
+		return x.OnDelete
+	}
+	return ReferenceDeleteMode_REFERENCE_DELETE_MODE_RESTRICT
+}
+
 // TableDescriptor describes an ORM singleton table which has at most one instance.
 type SingletonDescriptor struct {
 	state         protoimpl.MessageState
@@ -2489,51 +2672,66 @@ var file_cosmos_orm_v1alpha1_orm_proto_rawDesc = []byte{
 	0x72, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x6f,
 	0x6e, 0x64, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
 	0x70, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x75, 0x0a, 0x14, 0x50,
-	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x61,
-	0x75, 0x74, 0x6f, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x73, 0x22, 0x7a, 0x0a, 0x18, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x16,
-	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x25,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0xbc, 0x01, 0x0a, 0x14,
+	0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x61, 0x75, 0x74, 0x6f, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x09, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x08, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x18, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x09, 0x6f, 0x6e, 0x5f, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x25,
 	0x0a, 0x13, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72,
 	0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x5e, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0xee, 0xb3, 0xea, 0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x05,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x3a, 0x6a, 0x0a, 0x09, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x74,
-	0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0xef, 0xb3, 0xea, 0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x6f,
-	0x6e, 0x42, 0xd3, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x08, 0x4f,
-	0x72, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x6f, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b,
-	0x6f, 0x72, 0x6d, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x4f,
-	0x58, 0xaa, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4f, 0x72, 0x6d, 0x2e, 0x56,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x5c, 0x4f, 0x72, 0x6d, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x1f,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4f, 0x72, 0x6d, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x4f, 0x72, 0x6d, 0x3a, 0x3a, 0x56,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0d, 0x52, 0x02, 0x69, 0x64, 0x2a, 0x5c, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x1e,
+	0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x10, 0x00,
+	0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x44, 0x45,
+	0x4c, 0x45, 0x54, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x41, 0x53, 0x43, 0x41, 0x44,
+	0x45, 0x10, 0x01, 0x3a, 0x5e, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xee, 0xb3,
+	0xea, 0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x3a, 0x6a, 0x0a, 0x09, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x6f, 0x6e,
+	0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0xef, 0xb3, 0xea, 0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x74, 0x6f, 0x6e, 0x42,
+	0xd3, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6f,
+	0x72, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x08, 0x4f, 0x72, 0x6d,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x6f, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x6f, 0x72,
+	0x6d, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x4f, 0x58, 0xaa,
+	0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4f, 0x72, 0x6d, 0x2e, 0x56, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4f,
+	0x72, 0x6d, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4f, 0x72, 0x6d, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x4f, 0x72, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2548,26 +2746,30 @@ func file_cosmos_orm_v1alpha1_orm_proto_rawDescGZIP() []byte {
 	return file_cosmos_orm_v1alpha1_orm_proto_rawDescData
 }
 
+var file_cosmos_orm_v1alpha1_orm_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_cosmos_orm_v1alpha1_orm_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_cosmos_orm_v1alpha1_orm_proto_goTypes = []interface{}{
-	(*TableDescriptor)(nil),             // 0: cosmos.orm.v1alpha1.TableDescriptor
-	(*PrimaryKeyDescriptor)(nil),        // 1: cosmos.orm.v1alpha1.PrimaryKeyDescriptor
-	(*SecondaryIndexDescriptor)(nil),    // 2: cosmos.orm.v1alpha1.SecondaryIndexDescriptor
-	(*SingletonDescriptor)(nil),         // 3: cosmos.orm.v1alpha1.SingletonDescriptor
-	(*descriptorpb.MessageOptions)(nil), // 4: google.protobuf.MessageOptions
+	(ReferenceDeleteMode)(0),            // 0: cosmos.orm.v1alpha1.ReferenceDeleteMode
+	(*TableDescriptor)(nil),             // 1: cosmos.orm.v1alpha1.TableDescriptor
+	(*PrimaryKeyDescriptor)(nil),        // 2: cosmos.orm.v1alpha1.PrimaryKeyDescriptor
+	(*SecondaryIndexDescriptor)(nil),    // 3: cosmos.orm.v1alpha1.SecondaryIndexDescriptor
+	(*SingletonDescriptor)(nil),         // 4: cosmos.orm.v1alpha1.SingletonDescriptor
+	(*descriptorpb.MessageOptions)(nil), // 5: google.protobuf.MessageOptions
 }
 var file_cosmos_orm_v1alpha1_orm_proto_depIdxs = []int32{
-	1, // 0: cosmos.orm.v1alpha1.TableDescriptor.primary_key:type_name -> cosmos.orm.v1alpha1.PrimaryKeyDescriptor
-	2, // 1: cosmos.orm.v1alpha1.TableDescriptor.index:type_name -> cosmos.orm.v1alpha1.SecondaryIndexDescriptor
-	4, // 2: cosmos.orm.v1alpha1.table:extendee -> google.protobuf.MessageOptions
-	4, // 3: cosmos.orm.v1alpha1.singleton:extendee -> google.protobuf.MessageOptions
-	0, // 4: cosmos.orm.v1alpha1.table:type_name -> cosmos.orm.v1alpha1.TableDescriptor
-	3, // 5: cosmos.orm.v1alpha1.singleton:type_name -> cosmos.orm.v1alpha1.SingletonDescriptor
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	4, // [4:6] is the sub-list for extension type_name
-	2, // [2:4] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 0: cosmos.orm.v1alpha1.TableDescriptor.primary_key:type_name -> cosmos.orm.v1alpha1.PrimaryKeyDescriptor
+	3, // 1: cosmos.orm.v1alpha1.TableDescriptor.index:type_name -> cosmos.orm.v1alpha1.SecondaryIndexDescriptor
+	0, // 2: cosmos.orm.v1alpha1.PrimaryKeyDescriptor.on_delete:type_name -> cosmos.orm.v1alpha1.ReferenceDeleteMode
+	0, // 3: cosmos.orm.v1alpha1.SecondaryIndexDescriptor.on_delete:type_name -> cosmos.orm.v1alpha1.ReferenceDeleteMode
+	5, // 4: cosmos.orm.v1alpha1.table:extendee -> google.protobuf.MessageOptions
+	5, // 5: cosmos.orm.v1alpha1.singleton:extendee -> google.protobuf.MessageOptions
+	1, // 6: cosmos.orm.v1alpha1.table:type_name -> cosmos.orm.v1alpha1.TableDescriptor
+	4, // 7: cosmos.orm.v1alpha1.singleton:type_name -> cosmos.orm.v1alpha1.SingletonDescriptor
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	6, // [6:8] is the sub-list for extension type_name
+	4, // [4:6] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_orm_v1alpha1_orm_proto_init() }
@@ -2630,13 +2832,14 @@ func file_cosmos_orm_v1alpha1_orm_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_orm_v1alpha1_orm_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   4,
 			NumExtensions: 2,
 			NumServices:   0,
 		},
 		GoTypes:           file_cosmos_orm_v1alpha1_orm_proto_goTypes,
 		DependencyIndexes: file_cosmos_orm_v1alpha1_orm_proto_depIdxs,
+		EnumInfos:         file_cosmos_orm_v1alpha1_orm_proto_enumTypes,
 		MessageInfos:      file_cosmos_orm_v1alpha1_orm_proto_msgTypes,
 		ExtensionInfos:    file_cosmos_orm_v1alpha1_orm_proto_extTypes,
 	}.Build()
@@ -36,7 +36,13 @@ func ValuesOf(values ...interface{}) []protoreflect.Value {
 	n := len(values)
 	res := make([]protoreflect.Value, n)
 	for i := 0; i < n; i++ {
-		res[i] = protoreflect.ValueOf(values[i])
+		// protoreflect.ValueOf doesn't handle the generated message types,
+		// such as *timestamppb.Timestamp, only their protoreflect.Message.
+		if msg, ok := values[i].(protoreflect.ProtoMessage); ok {
+			res[i] = protoreflect.ValueOfMessage(msg.ProtoReflect())
+		} else {
+			res[i] = protoreflect.ValueOf(values[i])
+		}
 	}
 	return res
 }
@@ -3,6 +3,9 @@ package ormfield
 import (
 	"io"
 
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"google.golang.org/protobuf/proto"
+
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -61,6 +64,28 @@ func GetCodec(field protoreflect.FieldDescriptor, nonTerminal bool) (Codec, erro
 		return nil, ormerrors.UnsupportedKeyField.Wrapf("repeated field %s", field.FullName())
 	}
 
+	return getCodec(field, nonTerminal)
+}
+
+// GetRepeatedElementCodec returns the Codec for the elements of the provided
+// repeated field if one is defined. It is used by indexes which store one
+// entry per element of a repeated field.
+func GetRepeatedElementCodec(field protoreflect.FieldDescriptor, nonTerminal bool) (Codec, error) {
+	if field == nil {
+		return nil, ormerrors.UnsupportedKeyField.Wrap("nil field")
+	}
+	if !field.IsList() {
+		return nil, ormerrors.UnsupportedKeyField.Wrapf("%s is not a repeated field", field.FullName())
+	}
+
+	return getCodec(field, nonTerminal)
+}
+
+func getCodec(field protoreflect.FieldDescriptor, nonTerminal bool) (Codec, error) {
+	if field.IsMap() {
+		return nil, ormerrors.UnsupportedKeyField.Wrapf("map field %s", field.FullName())
+	}
+
 	if field.ContainingOneof() != nil {
 		return nil, ormerrors.UnsupportedKeyField.Wrapf("oneof field %s", field.FullName())
 	}
@@ -73,6 +98,12 @@ func GetCodec(field protoreflect.FieldDescriptor, nonTerminal bool) (Codec, erro
 			return BytesCodec{}, nil
 		}
 	case protoreflect.StringKind:
+		switch getScalar(field) {
+		case ScalarInt:
+			return IntStringCodec{}, nil
+		case ScalarDec:
+			return DecStringCodec{}, nil
+		}
 		if nonTerminal {
 			return NonTerminalStringCodec{}, nil
 		} else {
@@ -104,3 +135,12 @@ func GetCodec(field protoreflect.FieldDescriptor, nonTerminal bool) (Codec, erro
 		return nil, ormerrors.UnsupportedKeyField.Wrapf("%s of kind %s", field.FullName(), field.Kind())
 	}
 }
+
+func getScalar(field protoreflect.FieldDescriptor) string {
+	opts := field.Options()
+	if opts == nil || !proto.HasExtension(opts, cosmos_proto.E_Scalar) {
+		return ""
+	}
+	scalar, _ := proto.GetExtension(opts, cosmos_proto.E_Scalar).(string)
+	return scalar
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 
+	"github.com/cosmos/cosmos-sdk/orm/internal/testpb"
 	"github.com/cosmos/cosmos-sdk/orm/internal/testutil"
 )
 
@@ -85,3 +86,83 @@ func TestNTBytesTooLong(t *testing.T) {
 	_, err = cdc.ComputeBufferSize(bz)
 	assert.ErrorContains(t, err, ormerrors.BytesFieldTooLong.Error())
 }
+
+func TestDecimalCodecs(t *testing.T) {
+	desc := (&testpb.Order{}).ProtoReflect().Descriptor()
+	cdc, err := ormfield.GetCodec(desc.Fields().ByName("price"), true)
+	assert.NilError(t, err)
+	assert.Equal(t, ormfield.DecStringCodec{}, cdc)
+	amountCdc, err := ormfield.GetCodec(desc.Fields().ByName("amount").Message().Fields().ByName("amount"), false)
+	assert.NilError(t, err)
+	assert.Equal(t, ormfield.IntStringCodec{}, amountCdc)
+
+	// values in numerical order, the empty string comes first
+	ordered := []string{"", "-1000", "-10.5", "-10.25", "-10", "-2", "-0.5", "-0.05", "0", "0.001", "0.01", "0.1", "1", "1.5", "1.55", "2", "9.99", "10", "99", "100.5", "1000"}
+	var prev []byte
+	for i, x := range ordered {
+		buf := &bytes.Buffer{}
+		assert.NilError(t, cdc.Encode(protoreflect.ValueOfString(x), buf))
+		bz := buf.Bytes()
+		if i > 0 {
+			assert.Assert(t, bytes.Compare(prev, bz) < 0, "%s should be less than %s", ordered[i-1], x)
+			assert.Assert(t, cdc.Compare(protoreflect.ValueOfString(ordered[i-1]), protoreflect.ValueOfString(x)) < 0)
+		}
+		prev = bz
+
+		y, err := cdc.Decode(bytes.NewReader(bz))
+		assert.NilError(t, err)
+		assert.Equal(t, x, y.String())
+	}
+
+	// values are decoded in their canonical form
+	for x, expected := range map[string]string{"007": "7", "1.500": "1.5", "-0.0": "0", "0.000000000000000000": "0", "-012.340": "-12.34"} {
+		buf := &bytes.Buffer{}
+		assert.NilError(t, cdc.Encode(protoreflect.ValueOfString(x), buf))
+		y, err := cdc.Decode(bytes.NewReader(buf.Bytes()))
+		assert.NilError(t, err)
+		assert.Equal(t, expected, y.String())
+		assert.Equal(t, 0, cdc.Compare(protoreflect.ValueOfString(x), y))
+	}
+
+	for _, x := range []string{"abc", "1.", ".5", "-", "1e5", "+1", "1.2.3"} {
+		assert.Assert(t, cdc.Encode(protoreflect.ValueOfString(x), &bytes.Buffer{}) != nil, x)
+	}
+	assert.Assert(t, amountCdc.Encode(protoreflect.ValueOfString("1.5"), &bytes.Buffer{}) != nil)
+}
+
+func TestDecimalCodecNonTerminal(t *testing.T) {
+	cdc := ormfield.DecStringCodec{}
+	rapid.Check(t, func(t *rapid.T) {
+		x := rapid.StringMatching(`-?[0-9]{1,20}(\.[0-9]{1,18})?`).Draw(t, "x").(string)
+		y := rapid.StringMatching(`-?[0-9]{1,20}(\.[0-9]{1,18})?`).Draw(t, "y").(string)
+		buf := &bytes.Buffer{}
+		assert.NilError(t, cdc.Encode(protoreflect.ValueOfString(x), buf))
+		size, err := cdc.ComputeBufferSize(protoreflect.ValueOfString(x))
+		assert.NilError(t, err)
+		assert.Assert(t, size >= buf.Len())
+		bz1 := buf.Bytes()
+		buf2 := &bytes.Buffer{}
+		assert.NilError(t, cdc.Encode(protoreflect.ValueOfString(y), buf2))
+		bz2 := buf2.Bytes()
+		assert.Equal(t, cdc.Compare(protoreflect.ValueOfString(x), protoreflect.ValueOfString(y)), bytes.Compare(bz1, bz2))
+
+		// the encoding is self-delimiting so values can be followed by other
+		// key segments
+		r := bytes.NewReader(append(append([]byte{}, bz1...), bz2...))
+		x2, err := cdc.Decode(r)
+		assert.NilError(t, err)
+		y2, err := cdc.Decode(r)
+		assert.NilError(t, err)
+		assert.Equal(t, 0, cdc.Compare(protoreflect.ValueOfString(x), x2))
+		assert.Equal(t, 0, cdc.Compare(protoreflect.ValueOfString(y), y2))
+		assert.Equal(t, 0, r.Len())
+	})
+}
+
+func TestRepeatedElementCodec(t *testing.T) {
+	cdc, err := ormfield.GetRepeatedElementCodec(testutil.GetTestField("repeated"), false)
+	assert.NilError(t, err)
+	assert.Equal(t, ormfield.Uint32Codec{}, cdc)
+	_, err = ormfield.GetRepeatedElementCodec(testutil.GetTestField("u32"), false)
+	assert.ErrorContains(t, err, ormerrors.UnsupportedKeyField.Error())
+}
@@ -0,0 +1,262 @@
+package ormfield
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// ScalarInt is the cosmos_proto.scalar annotation of string fields
+	// containing arbitrary precision integers.
+	ScalarInt = "cosmos.Int"
+
+	// ScalarDec is the cosmos_proto.scalar annotation of string fields
+	// containing arbitrary precision decimals.
+	ScalarDec = "cosmos.Dec"
+)
+
+const (
+	decimalEmpty    byte = 0x0
+	decimalNegative byte = 0x1
+	decimalZero     byte = 0x2
+	decimalPositive byte = 0x3
+)
+
+// IntStringCodec encodes strings annotated as cosmos.Int so that they are
+// ordered numerically. See DecStringCodec for the encoding format. The
+// decoded value is the canonical representation of the integer.
+type IntStringCodec struct{}
+
+func (c IntStringCodec) Decode(r Reader) (protoreflect.Value, error) {
+	return decodeDecimal(r)
+}
+
+func (c IntStringCodec) Encode(value protoreflect.Value, w io.Writer) error {
+	bz, err := encodeDecimal(value.Interface().(string), false)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bz)
+	return err
+}
+
+func (c IntStringCodec) Compare(v1, v2 protoreflect.Value) int {
+	return compareDecimals(v1, v2, false)
+}
+
+func (c IntStringCodec) IsOrdered() bool {
+	return true
+}
+
+func (c IntStringCodec) FixedBufferSize() int {
+	return -1
+}
+
+func (c IntStringCodec) ComputeBufferSize(value protoreflect.Value) (int, error) {
+	return decimalBufferSize(value), nil
+}
+
+// DecStringCodec encodes strings annotated as cosmos.Dec so that they are
+// ordered numerically. Values are encoded as a marker byte for empty,
+// negative, zero and positive values followed, for non-zero values, by the
+// big-endian uint16 number of integer digits, the integer digits without
+// leading zeros, the fractional digits without trailing zeros and a null
+// terminator. The bytes following the marker of negative values are inverted.
+// The encoding is self-delimiting and can be used in any key segment. The
+// decoded value is the canonical representation of the decimal, so for
+// instance "1.500" is decoded as "1.5".
+type DecStringCodec struct{}
+
+func (c DecStringCodec) Decode(r Reader) (protoreflect.Value, error) {
+	return decodeDecimal(r)
+}
+
+func (c DecStringCodec) Encode(value protoreflect.Value, w io.Writer) error {
+	bz, err := encodeDecimal(value.Interface().(string), true)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bz)
+	return err
+}
+
+func (c DecStringCodec) Compare(v1, v2 protoreflect.Value) int {
+	return compareDecimals(v1, v2, true)
+}
+
+func (c DecStringCodec) IsOrdered() bool {
+	return true
+}
+
+func (c DecStringCodec) FixedBufferSize() int {
+	return -1
+}
+
+func (c DecStringCodec) ComputeBufferSize(value protoreflect.Value) (int, error) {
+	return decimalBufferSize(value), nil
+}
+
+func decimalBufferSize(value protoreflect.Value) int {
+	// marker, length prefix and terminator
+	return len(value.Interface().(string)) + 4
+}
+
+func compareDecimals(v1, v2 protoreflect.Value, allowFraction bool) int {
+	s1, s2 := v1.Interface().(string), v2.Interface().(string)
+	bz1, err1 := encodeDecimal(s1, allowFraction)
+	bz2, err2 := encodeDecimal(s2, allowFraction)
+	if err1 != nil || err2 != nil {
+		return strings.Compare(s1, s2)
+	}
+	return bytes.Compare(bz1, bz2)
+}
+
+func encodeDecimal(str string, allowFraction bool) ([]byte, error) {
+	if str == "" {
+		return []byte{decimalEmpty}, nil
+	}
+
+	negative, intDigits, fracDigits, err := parseDecimal(str, allowFraction)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(intDigits) == 0 && len(fracDigits) == 0 {
+		return []byte{decimalZero}, nil
+	}
+
+	if len(intDigits) > math.MaxUint16 {
+		return nil, fmt.Errorf("too many integer digits in %q", str)
+	}
+
+	bz := make([]byte, 3, len(intDigits)+len(fracDigits)+4)
+	binary.BigEndian.PutUint16(bz[1:], uint16(len(intDigits)))
+	bz = append(bz, intDigits...)
+	bz = append(bz, fracDigits...)
+	bz = append(bz, 0)
+
+	if negative {
+		bz[0] = decimalNegative
+		for i := 1; i < len(bz); i++ {
+			bz[i] = ^bz[i]
+		}
+	} else {
+		bz[0] = decimalPositive
+	}
+
+	return bz, nil
+}
+
+// parseDecimal splits str into its sign, integer digits without leading
+// zeros and fractional digits without trailing zeros.
+func parseDecimal(str string, allowFraction bool) (negative bool, intDigits, fracDigits string, err error) {
+	s := str
+	if strings.HasPrefix(s, "-") {
+		negative = true
+		s = s[1:]
+	}
+
+	intDigits, fracDigits = s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		if !allowFraction {
+			return false, "", "", fmt.Errorf("invalid integer %q", str)
+		}
+		intDigits, fracDigits = s[:i], s[i+1:]
+		if len(fracDigits) == 0 {
+			return false, "", "", fmt.Errorf("invalid decimal %q", str)
+		}
+	}
+
+	if len(intDigits) == 0 || !isDigits(intDigits) || !isDigits(fracDigits) {
+		return false, "", "", fmt.Errorf("invalid number %q", str)
+	}
+
+	return negative, strings.TrimLeft(intDigits, "0"), strings.TrimRight(fracDigits, "0"), nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func decodeDecimal(r Reader) (protoreflect.Value, error) {
+	marker, err := r.ReadByte()
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	var negative bool
+	switch marker {
+	case decimalEmpty:
+		return protoreflect.ValueOfString(""), nil
+	case decimalZero:
+		return protoreflect.ValueOfString("0"), nil
+	case decimalNegative:
+		negative = true
+	case decimalPositive:
+	default:
+		return protoreflect.Value{}, fmt.Errorf("invalid decimal marker %x", marker)
+	}
+
+	var invert byte
+	if negative {
+		invert = 0xFF
+	}
+
+	var lenBz [2]byte
+	_, err = io.ReadFull(r, lenBz[:])
+	if err != nil {
+		return protoreflect.Value{}, unexpectedEOF(err)
+	}
+	intLen := int(binary.BigEndian.Uint16([]byte{lenBz[0] ^ invert, lenBz[1] ^ invert}))
+
+	var digits []byte
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return protoreflect.Value{}, unexpectedEOF(err)
+		}
+		b ^= invert
+		if b == 0 {
+			break
+		}
+		digits = append(digits, b)
+	}
+
+	if len(digits) < intLen {
+		return protoreflect.Value{}, fmt.Errorf("invalid decimal encoding")
+	}
+
+	var sb strings.Builder
+	if negative {
+		sb.WriteByte('-')
+	}
+	if intLen == 0 {
+		sb.WriteByte('0')
+	} else {
+		sb.Write(digits[:intLen])
+	}
+	if len(digits) > intLen {
+		sb.WriteByte('.')
+		sb.Write(digits[intLen:])
+	}
+
+	return protoreflect.ValueOfString(sb.String()), nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
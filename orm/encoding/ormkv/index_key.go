@@ -9,7 +9,9 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
-// IndexKeyCodec is the codec for (non-unique) index keys.
+// IndexKeyCodec is the codec for (non-unique) index keys. One index field can
+// refer to a repeated field, or to a field within a repeated message field, in
+// which case a message has one index entry per distinct element of this field.
 type IndexKeyCodec struct {
 	*KeyCodec
 	pkFieldOrder []int
@@ -50,7 +52,7 @@ func NewIndexKeyCodec(prefix []byte, messageType protoreflect.MessageType, index
 		k++
 	}
 
-	cdc, err := NewKeyCodec(prefix, messageType, keyFields)
+	cdc, err := newKeyCodec(prefix, messageType, keyFields, true)
 	if err != nil {
 		return nil, err
 	}
@@ -118,7 +120,31 @@ func (cdc IndexKeyCodec) EncodeEntry(entry Entry) (k, v []byte, err error) {
 	return bz, []byte{}, nil
 }
 
+// EncodeKVFromMessage encodes the single kv-pair for the message and returns
+// an error for multi-entry indexes, see EncodeKeysFromMessage.
 func (cdc IndexKeyCodec) EncodeKVFromMessage(message protoreflect.Message) (k, v []byte, err error) {
 	_, k, err = cdc.EncodeKeyFromMessage(message)
 	return k, []byte{}, err
 }
+
+// EncodeKeysFromMessage encodes all the distinct keys of the message in this
+// index, which can be none or more than one for multi-entry indexes. Index
+// entry values are always empty.
+func (cdc IndexKeyCodec) EncodeKeysFromMessage(message protoreflect.Message) (keyValues [][]protoreflect.Value, keys [][]byte, err error) {
+	seen := map[string]bool{}
+	for _, values := range cdc.GetMultiKeyValues(message) {
+		key, err := cdc.EncodeKey(values)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if seen[string(key)] {
+			continue
+		}
+		seen[string(key)] = true
+
+		keyValues = append(keyValues, values)
+		keys = append(keys, key)
+	}
+	return keyValues, keys, nil
+}
@@ -5,12 +5,15 @@ import (
 	"fmt"
 	"testing"
 
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"gotest.tools/v3/assert"
 	"pgregory.net/rapid"
 
 	"github.com/cosmos/cosmos-sdk/orm/encoding/ormkv"
 	"github.com/cosmos/cosmos-sdk/orm/internal/testpb"
 	"github.com/cosmos/cosmos-sdk/orm/internal/testutil"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 )
 
 func TestIndexKeyCodec(t *testing.T) {
@@ -61,3 +64,86 @@ func TestIndexKeyCodec(t *testing.T) {
 		}
 	})
 }
+
+func TestMultiEntryIndexKeyCodec(t *testing.T) {
+	messageType := (&testpb.Order{}).ProtoReflect().Type()
+	order := &testpb.Order{
+		Id:     3,
+		Amount: &testpb.Amount{Denom: "foo", Amount: "10"},
+		Tags:   []string{"b", "a", "b"},
+		Fees:   []*testpb.Amount{{Denom: "bar"}, {Denom: "foo"}},
+	}
+
+	tagsCdc, err := ormkv.NewIndexKeyCodec(nil, messageType, []protoreflect.Name{"tags"}, []protoreflect.Name{"id"})
+	assert.NilError(t, err)
+	idx, ok := tagsCdc.MultiEntryField()
+	assert.Assert(t, ok)
+	assert.Equal(t, 0, idx)
+	values, keys, err := tagsCdc.EncodeKeysFromMessage(order.ProtoReflect())
+	assert.NilError(t, err)
+	// duplicate elements only produce one key
+	assert.Equal(t, 2, len(keys))
+	assert.Equal(t, "b", values[0][0].String())
+	assert.Equal(t, "a", values[1][0].String())
+	for i, key := range keys {
+		idxValues, pk, err := tagsCdc.DecodeIndexKey(key, nil)
+		assert.NilError(t, err)
+		assert.Equal(t, 0, tagsCdc.CompareKeys(values[i], idxValues))
+		assert.Equal(t, uint64(3), pk[0].Uint())
+	}
+	_, _, err = tagsCdc.EncodeKVFromMessage(order.ProtoReflect())
+	assert.ErrorContains(t, err, ormerrors.UnsupportedOperation.Error())
+
+	feesCdc, err := ormkv.NewIndexKeyCodec(nil, messageType, []protoreflect.Name{"fees.denom", "amount.denom"}, []protoreflect.Name{"id"})
+	assert.NilError(t, err)
+	values, _, err = feesCdc.EncodeKeysFromMessage(order.ProtoReflect())
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(values))
+	assert.Equal(t, "bar", values[0][0].String())
+	assert.Equal(t, "foo", values[1][0].String())
+	assert.Equal(t, "foo", values[1][1].String())
+
+	// no keys for an empty repeated field
+	_, keys, err = feesCdc.EncodeKeysFromMessage((&testpb.Order{Id: 1}).ProtoReflect())
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(keys))
+
+	_, err = ormkv.NewIndexKeyCodec(nil, messageType, []protoreflect.Name{"tags", "fees.denom"}, []protoreflect.Name{"id"})
+	assert.ErrorContains(t, err, ormerrors.InvalidKeyFieldsDefinition.Error())
+	_, err = ormkv.NewUniqueKeyCodec(nil, messageType, []protoreflect.Name{"tags"}, []protoreflect.Name{"id"})
+	assert.ErrorContains(t, err, ormerrors.UnsupportedKeyField.Error())
+}
+
+func TestNestedKeyCodec(t *testing.T) {
+	messageType := (&testpb.Order{}).ProtoReflect().Type()
+	cdc, err := ormkv.NewKeyCodec(nil, messageType, []protoreflect.Name{"amount.denom", "amount.amount"})
+	assert.NilError(t, err)
+	_, ok := cdc.MultiEntryField()
+	assert.Assert(t, !ok)
+
+	// unset nested messages have default values
+	empty := (&testpb.Order{}).ProtoReflect()
+	values := cdc.GetKeyValues(empty)
+	assert.Equal(t, "", values[0].String())
+	assert.Assert(t, !cdc.HasKeyValues(empty, 2))
+
+	order := &testpb.Order{Amount: &testpb.Amount{Denom: "foo", Amount: "10"}}
+	values, bz, err := cdc.EncodeKeyFromMessage(order.ProtoReflect())
+	assert.NilError(t, err)
+	assert.Assert(t, cdc.HasKeyValues(order.ProtoReflect(), 1))
+	decoded, err := cdc.DecodeKey(bytes.NewReader(bz))
+	assert.NilError(t, err)
+	assert.Equal(t, 0, cdc.CompareKeys(values, decoded))
+
+	var order2 testpb.Order
+	cdc.SetKeyValues(order2.ProtoReflect(), decoded)
+	assert.Equal(t, "foo", order2.Amount.Denom)
+	assert.Equal(t, "10", order2.Amount.Amount)
+
+	_, err = ormkv.NewKeyCodec(nil, messageType, []protoreflect.Name{"amount.foo"})
+	assert.ErrorContains(t, err, ormerrors.FieldNotFound.Error())
+	_, err = ormkv.NewKeyCodec(nil, messageType, []protoreflect.Name{"owner.foo"})
+	assert.ErrorContains(t, err, ormerrors.InvalidKeyFieldsDefinition.Error())
+	_, err = ormkv.NewPrimaryKeyCodec(nil, messageType, []protoreflect.Name{"amount.denom"}, proto.UnmarshalOptions{})
+	assert.ErrorContains(t, err, ormerrors.InvalidKeyFieldsDefinition.Error())
+}
@@ -3,6 +3,7 @@ package ormkv
 import (
 	"bytes"
 	"io"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 
@@ -21,31 +22,71 @@ type KeyCodec struct {
 
 	prefix           []byte
 	fieldDescriptors []protoreflect.FieldDescriptor
+	fieldPaths       [][]protoreflect.FieldDescriptor
 	fieldNames       []protoreflect.Name
 	fieldCodecs      []ormfield.Codec
 	messageType      protoreflect.MessageType
+
+	// multiEntryField is the index of the field whose path contains a
+	// repeated field or -1 if there is none.
+	multiEntryField int
 }
 
 // NewKeyCodec returns a new KeyCodec with an optional prefix for the provided
-// message descriptor and fields.
+// message descriptor and fields. Fields of nested messages can be referred
+// to with dot-separated paths, for instance "coin.denom".
 func NewKeyCodec(prefix []byte, messageType protoreflect.MessageType, fieldNames []protoreflect.Name) (*KeyCodec, error) {
+	return newKeyCodec(prefix, messageType, fieldNames, false)
+}
+
+// newKeyCodec returns a new KeyCodec. If allowMultiEntry is true, the path of
+// at most one field can contain a repeated field in which case the codec
+// produces one key for each element of this field.
+func newKeyCodec(prefix []byte, messageType protoreflect.MessageType, fieldNames []protoreflect.Name, allowMultiEntry bool) (*KeyCodec, error) {
 	n := len(fieldNames)
 	fieldCodecs := make([]ormfield.Codec, n)
 	fieldDescriptors := make([]protoreflect.FieldDescriptor, n)
+	fieldPaths := make([][]protoreflect.FieldDescriptor, n)
 	var variableSizers []struct {
 		cdc ormfield.Codec
 		i   int
 	}
 	fixedSize := 0
-	messageFields := messageType.Descriptor().Fields()
+	multiEntryField := -1
 
 	for i := 0; i < n; i++ {
 		nonTerminal := i != n-1
-		field := messageFields.ByName(fieldNames[i])
-		if field == nil {
-			return nil, ormerrors.FieldNotFound.Wrapf("field %s on %s", fieldNames[i], messageType.Descriptor().FullName())
+		path, err := getFieldPath(messageType.Descriptor(), fieldNames[i])
+		if err != nil {
+			return nil, err
+		}
+
+		numRepeated := 0
+		for _, f := range path {
+			if f.IsList() {
+				numRepeated++
+			}
+		}
+		if numRepeated > 0 {
+			if !allowMultiEntry {
+				return nil, ormerrors.UnsupportedKeyField.Wrapf("repeated field %s", fieldNames[i])
+			}
+			if numRepeated > 1 || multiEntryField >= 0 {
+				return nil, ormerrors.InvalidKeyFieldsDefinition.Wrapf(
+					"at most one repeated field is supported in a key, got %s on %s",
+					fieldNames[i], messageType.Descriptor().FullName(),
+				)
+			}
+			multiEntryField = i
+		}
+
+		field := path[len(path)-1]
+		var cdc ormfield.Codec
+		if field.IsList() {
+			cdc, err = ormfield.GetRepeatedElementCodec(field, nonTerminal)
+		} else {
+			cdc, err = ormfield.GetCodec(field, nonTerminal)
 		}
-		cdc, err := ormfield.GetCodec(field, nonTerminal)
 		if err != nil {
 			return nil, err
 		}
@@ -59,19 +100,114 @@ func NewKeyCodec(prefix []byte, messageType protoreflect.MessageType, fieldNames
 		}
 		fieldCodecs[i] = cdc
 		fieldDescriptors[i] = field
+		fieldPaths[i] = path
 	}
 
 	return &KeyCodec{
 		fieldCodecs:      fieldCodecs,
 		fieldDescriptors: fieldDescriptors,
+		fieldPaths:       fieldPaths,
 		fieldNames:       fieldNames,
 		prefix:           prefix,
 		fixedSize:        fixedSize,
 		variableSizers:   variableSizers,
 		messageType:      messageType,
+		multiEntryField:  multiEntryField,
 	}, nil
 }
 
+// getFieldPath resolves a possibly dot-separated field name to the
+// descriptors of the fields along its path.
+func getFieldPath(desc protoreflect.MessageDescriptor, name protoreflect.Name) ([]protoreflect.FieldDescriptor, error) {
+	parts := strings.Split(string(name), ".")
+	path := make([]protoreflect.FieldDescriptor, len(parts))
+	for i, part := range parts {
+		if desc == nil {
+			return nil, ormerrors.InvalidKeyFieldsDefinition.Wrapf(
+				"field %s in %s is not a message field", path[i-1].FullName(), name,
+			)
+		}
+
+		field := desc.Fields().ByName(protoreflect.Name(part))
+		if field == nil {
+			return nil, ormerrors.FieldNotFound.Wrapf("field %s on %s", name, desc.FullName())
+		}
+
+		path[i] = field
+		desc = nil
+		if field.Kind() == protoreflect.MessageKind && !field.IsMap() {
+			desc = field.Message()
+		}
+	}
+	return path, nil
+}
+
+// getPathValue returns the value of the field at the end of path, or the
+// value of the first repeated field along path.
+func getPathValue(message protoreflect.Message, path []protoreflect.FieldDescriptor) protoreflect.Value {
+	last := len(path) - 1
+	for _, f := range path[:last] {
+		if f.IsList() {
+			return message.Get(f)
+		}
+		message = getNestedMessage(message, f)
+	}
+	return message.Get(path[last])
+}
+
+// getPathValues returns the values of the field at the end of path, which
+// are the elements of the repeated field along path if there is one.
+func getPathValues(message protoreflect.Message, path []protoreflect.FieldDescriptor) []protoreflect.Value {
+	last := len(path) - 1
+	for i, f := range path {
+		if f.IsList() {
+			list := message.Get(f).List()
+			values := make([]protoreflect.Value, list.Len())
+			for j := range values {
+				values[j] = list.Get(j)
+				if i != last {
+					values[j] = getPathValue(values[j].Message(), path[i+1:])
+				}
+			}
+			return values
+		}
+
+		if i == last {
+			break
+		}
+		message = getNestedMessage(message, f)
+	}
+	return []protoreflect.Value{message.Get(path[last])}
+}
+
+// getNestedMessage returns the value of a singular message field, which is an
+// empty message if the field is unset.
+func getNestedMessage(message protoreflect.Message, field protoreflect.FieldDescriptor) protoreflect.Message {
+	if !message.Has(field) {
+		return message.NewField(field).Message()
+	}
+	return message.Get(field).Message()
+}
+
+func hasPathValue(message protoreflect.Message, path []protoreflect.FieldDescriptor) bool {
+	last := len(path) - 1
+	for _, f := range path[:last] {
+		if !message.Has(f) {
+			return false
+		}
+		message = message.Get(f).Message()
+	}
+	return message.Has(path[last])
+}
+
+func setPathValue(message protoreflect.Message, path []protoreflect.FieldDescriptor, value protoreflect.Value) {
+	last := len(path) - 1
+	for _, f := range path[:last] {
+		message = message.Mutable(f).Message()
+	}
+	message.Set(path[last], value)
+}
+
 // EncodeKey encodes the values assuming that they correspond to the fields
 // specified for the key. If the array of values is shorter than the
 // number of fields in the key, a partial "prefix" key will be encoded
@@ -101,14 +237,54 @@ func (cdc *KeyCodec) EncodeKey(values []protoreflect.Value) ([]byte, error) {
 }
 
 // GetKeyValues extracts the values specified by the key fields from the message.
+// For multi-entry keys, the value of the multi-entry field is the list value of
+// its repeated field, use GetMultiKeyValues to get the values of each key.
 func (cdc *KeyCodec) GetKeyValues(message protoreflect.Message) []protoreflect.Value {
-	res := make([]protoreflect.Value, len(cdc.fieldDescriptors))
-	for i, f := range cdc.fieldDescriptors {
-		res[i] = message.Get(f)
+	res := make([]protoreflect.Value, len(cdc.fieldPaths))
+	for i, path := range cdc.fieldPaths {
+		res[i] = getPathValue(message, path)
+	}
+	return res
+}
+
+// GetMultiKeyValues extracts the values of each key of the message, one for
+// each element of the repeated field of multi-entry keys. Messages with an
+// empty repeated field have no keys. For other keys, this returns the result
+// of GetKeyValues.
+func (cdc *KeyCodec) GetMultiKeyValues(message protoreflect.Message) [][]protoreflect.Value {
+	values := cdc.GetKeyValues(message)
+	if cdc.multiEntryField < 0 {
+		return [][]protoreflect.Value{values}
+	}
+
+	elems := getPathValues(message, cdc.fieldPaths[cdc.multiEntryField])
+	res := make([][]protoreflect.Value, len(elems))
+	for i, elem := range elems {
+		keyValues := make([]protoreflect.Value, len(values))
+		copy(keyValues, values)
+		keyValues[cdc.multiEntryField] = elem
+		res[i] = keyValues
 	}
 	return res
 }
 
+// HasKeyValues returns true if any of the first n key fields is set on the
+// message.
+func (cdc *KeyCodec) HasKeyValues(message protoreflect.Message, n int) bool {
+	for _, path := range cdc.fieldPaths[:n] {
+		if hasPathValue(message, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// MultiEntryField returns the index of the key field whose path contains a
+// repeated field, if there is one.
+func (cdc *KeyCodec) MultiEntryField() (index int, ok bool) {
+	return cdc.multiEntryField, cdc.multiEntryField >= 0
+}
+
 // DecodeKey decodes the values in the key specified by the reader. If the
 // provided key is a prefix key, the values that could be decoded will
 // be returned with io.EOF as the error.
@@ -131,8 +307,15 @@ func (cdc *KeyCodec) DecodeKey(r *bytes.Reader) ([]protoreflect.Value, error) {
 	return values, nil
 }
 
-// EncodeKeyFromMessage combines GetKeyValues and EncodeKey.
+// EncodeKeyFromMessage combines GetKeyValues and EncodeKey. It returns an
+// error for multi-entry keys.
 func (cdc *KeyCodec) EncodeKeyFromMessage(message protoreflect.Message) ([]protoreflect.Value, []byte, error) {
+	if cdc.multiEntryField >= 0 {
+		return nil, nil, ormerrors.UnsupportedOperation.Wrapf(
+			"can't encode a single key for the multi-entry field %s", cdc.fieldNames[cdc.multiEntryField],
+		)
+	}
+
 	values := cdc.GetKeyValues(message)
 	bz, err := cdc.EncodeKey(values)
 	return values, bz, err
@@ -206,10 +389,10 @@ func (cdc KeyCodec) ComputeKeyBufferSize(values []protoreflect.Value) (int, erro
 
 // SetKeyValues sets the provided values on the message which must correspond
 // exactly to the field descriptors for this key. Prefix keys aren't
-// supported.
+// supported, nor are multi-entry keys.
 func (cdc *KeyCodec) SetKeyValues(message protoreflect.Message, values []protoreflect.Value) {
-	for i, f := range cdc.fieldDescriptors {
-		message.Set(f, values[i])
+	for i, path := range cdc.fieldPaths {
+		setPathValue(message, path, values[i])
 	}
 }
 
@@ -280,7 +463,8 @@ func (cdc KeyCodec) CheckValidRangeIterationKeys(start, end []protoreflect.Value
 	return nil
 }
 
-// GetFieldDescriptors returns the field descriptors for this codec.
+// GetFieldDescriptors returns the field descriptors for this codec. For
+// nested fields, these are the descriptors of the innermost fields.
 func (cdc *KeyCodec) GetFieldDescriptors() []protoreflect.FieldDescriptor {
 	return cdc.fieldDescriptors
 }
@@ -20,13 +20,20 @@ type PrimaryKeyCodec struct {
 var _ IndexCodec = &PrimaryKeyCodec{}
 
 // NewPrimaryKeyCodec creates a new PrimaryKeyCodec for the provided msg and
-// fields, with an optional prefix and unmarshal options.
+// fields, with an optional prefix and unmarshal options. Primary keys can't
+// contain nested fields.
 func NewPrimaryKeyCodec(prefix []byte, msgType protoreflect.MessageType, fieldNames []protoreflect.Name, unmarshalOptions proto.UnmarshalOptions) (*PrimaryKeyCodec, error) {
 	keyCodec, err := NewKeyCodec(prefix, msgType, fieldNames)
 	if err != nil {
 		return nil, err
 	}
 
+	for i, path := range keyCodec.fieldPaths {
+		if len(path) > 1 {
+			return nil, ormerrors.InvalidKeyFieldsDefinition.Wrapf("nested field %s can't be part of a primary key", fieldNames[i])
+		}
+	}
+
 	return &PrimaryKeyCodec{
 		KeyCodec:         keyCodec,
 		unmarshalOptions: unmarshalOptions,
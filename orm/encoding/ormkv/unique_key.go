@@ -38,8 +38,8 @@ func NewUniqueKeyCodec(prefix []byte, messageType protoreflect.MessageType, inde
 	}
 
 	haveFields := map[protoreflect.Name]int{}
-	for i, descriptor := range keyCodec.fieldDescriptors {
-		haveFields[descriptor.Name()] = i
+	for i, name := range keyCodec.fieldNames {
+		haveFields[name] = i
 	}
 
 	var valueFields []protoreflect.Name
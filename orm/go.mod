@@ -4,7 +4,7 @@ go 1.17
 
 require (
 	github.com/cosmos/cosmos-proto v1.0.0-alpha7
-	github.com/cosmos/cosmos-sdk/api v0.1.0-alpha4
+	github.com/cosmos/cosmos-sdk/api v0.1.0-alpha4.0.20261017094718-7ac7df9647b6
 	github.com/cosmos/cosmos-sdk/errors v1.0.0-beta.2
 	github.com/iancoleman/strcase v0.2.0
 	github.com/stretchr/testify v1.7.0
@@ -45,3 +45,5 @@ require (
 	gopkg.in/yaml.v2 v2.4.0 // indirect
 	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
 )
+
+replace github.com/cosmos/cosmos-sdk/api => ../api
@@ -132,7 +132,11 @@ func (t tableGen) genWithMethods(indexStructName string, parts []string) {
 	funcName := "With" + strings.Join(camelParts, "")
 
 	t.P(funcPrefix, funcName, "(", t.fieldArgsFromStringSlice(parts), ") ", indexStructName, "{")
-	t.P("this.vs = []interface{}{", strings.Join(parts, ","), "}")
+	params := make([]string, len(parts))
+	for i, part := range parts {
+		params[i] = t.fieldParam(protoreflect.Name(part))
+	}
+	t.P("this.vs = []interface{}{", strings.Join(params, ","), "}")
 	t.P("return this")
 	t.P("}")
 	t.P()
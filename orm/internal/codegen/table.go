@@ -145,11 +145,38 @@ func (t tableGen) fieldsArgs(names []protoreflect.Name) string {
 }
 
 func (t tableGen) fieldArg(name protoreflect.Name) string {
-	typ, pointer := t.GeneratedFile.FieldGoType(t.fields[name])
+	field := t.keyField(name)
+	typ, pointer := t.GeneratedFile.FieldGoType(field)
+	if field.Desc.IsList() {
+		// multi-entry index keys take a single element of a repeated field
+		typ = strings.TrimPrefix(typ, "[]")
+	}
 	if pointer {
 		typ = "*" + typ
 	}
-	return string(name) + " " + typ
+	return t.fieldParam(name) + " " + typ
+}
+
+// keyField returns the field referred to by a possibly dot-separated key field
+// name.
+func (t tableGen) keyField(name protoreflect.Name) *protogen.Field {
+	parts := strings.Split(string(name), ".")
+	field := t.fields[protoreflect.Name(parts[0])]
+	for _, part := range parts[1:] {
+		for _, f := range field.Message.Fields {
+			if string(f.Desc.Name()) == part {
+				field = f
+				break
+			}
+		}
+	}
+	return field
+}
+
+// fieldParam returns the parameter name of a possibly dot-separated key field
+// name.
+func (t tableGen) fieldParam(name protoreflect.Name) string {
+	return strings.ReplaceAll(string(name), ".", "_")
 }
 
 func (t tableGen) genStruct() {
@@ -214,7 +241,7 @@ func (t tableGen) genStoreImpl() {
 		t.P("return ", receiverVar, ".table.GetIndexByID(", idx.Id, ").(",
 			ormTablePkg.Ident("UniqueIndex"), ").Has(ctx,")
 		for _, field := range fields {
-			t.P(field, ",")
+			t.P(t.fieldParam(protoreflect.Name(field)), ",")
 		}
 		t.P(")")
 		t.P("}")
@@ -228,7 +255,7 @@ func (t tableGen) genStoreImpl() {
 		t.P("found, err := ", receiverVar, ".table.GetIndexByID(", idx.Id, ").(",
 			ormTablePkg.Ident("UniqueIndex"), ").Get(ctx, &", varName, ",")
 		for _, field := range fields {
-			t.P(field, ",")
+			t.P(t.fieldParam(protoreflect.Name(field)), ",")
 		}
 		t.P(")")
 		t.P("if err != nil {")
@@ -0,0 +1,259 @@
+// Code generated by protoc-gen-go-cosmos-orm. DO NOT EDIT.
+
+package testpb
+
+import (
+	context "context"
+
+	ormlist "github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	ormtable "github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+	ormerrors "github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+)
+
+type OrderStore interface {
+	Insert(ctx context.Context, order *Order) error
+	InsertReturningID(ctx context.Context, order *Order) (uint64, error)
+	Update(ctx context.Context, order *Order) error
+	Save(ctx context.Context, order *Order) error
+	Delete(ctx context.Context, order *Order) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*Order, error)
+	HasByOwnerAmountDenom(ctx context.Context, owner string, amount_denom string) (found bool, err error)
+	// GetByOwnerAmountDenom returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	GetByOwnerAmountDenom(ctx context.Context, owner string, amount_denom string) (*Order, error)
+	List(ctx context.Context, prefixKey OrderIndexKey, opts ...ormlist.Option) (OrderIterator, error)
+	ListRange(ctx context.Context, from, to OrderIndexKey, opts ...ormlist.Option) (OrderIterator, error)
+	DeleteBy(ctx context.Context, prefixKey OrderIndexKey) error
+	DeleteRange(ctx context.Context, from, to OrderIndexKey) error
+
+	doNotImplement()
+}
+
+type OrderIterator struct {
+	ormtable.Iterator
+}
+
+func (i OrderIterator) Value() (*Order, error) {
+	var order Order
+	err := i.UnmarshalMessage(&order)
+	return &order, err
+}
+
+type OrderIndexKey interface {
+	id() uint32
+	values() []interface{}
+	orderIndexKey()
+}
+
+// primary key starting index..
+type OrderPrimaryKey = OrderIdIndexKey
+
+type OrderIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x OrderIdIndexKey) id() uint32            { return 0 }
+func (x OrderIdIndexKey) values() []interface{} { return x.vs }
+func (x OrderIdIndexKey) orderIndexKey()        {}
+
+func (this OrderIdIndexKey) WithId(id uint64) OrderIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type OrderPriceIndexKey struct {
+	vs []interface{}
+}
+
+func (x OrderPriceIndexKey) id() uint32            { return 1 }
+func (x OrderPriceIndexKey) values() []interface{} { return x.vs }
+func (x OrderPriceIndexKey) orderIndexKey()        {}
+
+func (this OrderPriceIndexKey) WithPrice(price string) OrderPriceIndexKey {
+	this.vs = []interface{}{price}
+	return this
+}
+
+type OrderAmountDenomAmountAmountIndexKey struct {
+	vs []interface{}
+}
+
+func (x OrderAmountDenomAmountAmountIndexKey) id() uint32            { return 2 }
+func (x OrderAmountDenomAmountAmountIndexKey) values() []interface{} { return x.vs }
+func (x OrderAmountDenomAmountAmountIndexKey) orderIndexKey()        {}
+
+func (this OrderAmountDenomAmountAmountIndexKey) WithAmountDenom(amount_denom string) OrderAmountDenomAmountAmountIndexKey {
+	this.vs = []interface{}{amount_denom}
+	return this
+}
+
+func (this OrderAmountDenomAmountAmountIndexKey) WithAmountDenomAmountAmount(amount_denom string, amount_amount string) OrderAmountDenomAmountAmountIndexKey {
+	this.vs = []interface{}{amount_denom, amount_amount}
+	return this
+}
+
+type OrderTagsIndexKey struct {
+	vs []interface{}
+}
+
+func (x OrderTagsIndexKey) id() uint32            { return 3 }
+func (x OrderTagsIndexKey) values() []interface{} { return x.vs }
+func (x OrderTagsIndexKey) orderIndexKey()        {}
+
+func (this OrderTagsIndexKey) WithTags(tags string) OrderTagsIndexKey {
+	this.vs = []interface{}{tags}
+	return this
+}
+
+type OrderFeesDenomIndexKey struct {
+	vs []interface{}
+}
+
+func (x OrderFeesDenomIndexKey) id() uint32            { return 4 }
+func (x OrderFeesDenomIndexKey) values() []interface{} { return x.vs }
+func (x OrderFeesDenomIndexKey) orderIndexKey()        {}
+
+func (this OrderFeesDenomIndexKey) WithFeesDenom(fees_denom string) OrderFeesDenomIndexKey {
+	this.vs = []interface{}{fees_denom}
+	return this
+}
+
+type OrderOwnerAmountDenomIndexKey struct {
+	vs []interface{}
+}
+
+func (x OrderOwnerAmountDenomIndexKey) id() uint32            { return 5 }
+func (x OrderOwnerAmountDenomIndexKey) values() []interface{} { return x.vs }
+func (x OrderOwnerAmountDenomIndexKey) orderIndexKey()        {}
+
+func (this OrderOwnerAmountDenomIndexKey) WithOwner(owner string) OrderOwnerAmountDenomIndexKey {
+	this.vs = []interface{}{owner}
+	return this
+}
+
+func (this OrderOwnerAmountDenomIndexKey) WithOwnerAmountDenom(owner string, amount_denom string) OrderOwnerAmountDenomIndexKey {
+	this.vs = []interface{}{owner, amount_denom}
+	return this
+}
+
+type orderStore struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this orderStore) Insert(ctx context.Context, order *Order) error {
+	return this.table.Insert(ctx, order)
+}
+
+func (this orderStore) Update(ctx context.Context, order *Order) error {
+	return this.table.Update(ctx, order)
+}
+
+func (this orderStore) Save(ctx context.Context, order *Order) error {
+	return this.table.Save(ctx, order)
+}
+
+func (this orderStore) Delete(ctx context.Context, order *Order) error {
+	return this.table.Delete(ctx, order)
+}
+
+func (this orderStore) InsertReturningID(ctx context.Context, order *Order) (uint64, error) {
+	return this.table.InsertReturningID(ctx, order)
+}
+
+func (this orderStore) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this orderStore) Get(ctx context.Context, id uint64) (*Order, error) {
+	var order Order
+	found, err := this.table.PrimaryKey().Get(ctx, &order, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &order, nil
+}
+
+func (this orderStore) HasByOwnerAmountDenom(ctx context.Context, owner string, amount_denom string) (found bool, err error) {
+	return this.table.GetIndexByID(5).(ormtable.UniqueIndex).Has(ctx,
+		owner,
+		amount_denom,
+	)
+}
+
+func (this orderStore) GetByOwnerAmountDenom(ctx context.Context, owner string, amount_denom string) (*Order, error) {
+	var order Order
+	found, err := this.table.GetIndexByID(5).(ormtable.UniqueIndex).Get(ctx, &order,
+		owner,
+		amount_denom,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &order, nil
+}
+
+func (this orderStore) List(ctx context.Context, prefixKey OrderIndexKey, opts ...ormlist.Option) (OrderIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return OrderIterator{it}, err
+}
+
+func (this orderStore) ListRange(ctx context.Context, from, to OrderIndexKey, opts ...ormlist.Option) (OrderIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return OrderIterator{it}, err
+}
+
+func (this orderStore) DeleteBy(ctx context.Context, prefixKey OrderIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this orderStore) DeleteRange(ctx context.Context, from, to OrderIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this orderStore) doNotImplement() {}
+
+var _ OrderStore = orderStore{}
+
+func NewOrderStore(db ormtable.Schema) (OrderStore, error) {
+	table := db.GetTable(&Order{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Order{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return orderStore{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type IndexSchemaStore interface {
+	OrderStore() OrderStore
+
+	doNotImplement()
+}
+
+type indexSchemaStore struct {
+	order OrderStore
+}
+
+func (x indexSchemaStore) OrderStore() OrderStore {
+	return x.order
+}
+
+func (indexSchemaStore) doNotImplement() {}
+
+var _ IndexSchemaStore = indexSchemaStore{}
+
+func NewIndexSchemaStore(db ormtable.Schema) (IndexSchemaStore, error) {
+	orderStore, err := NewOrderStore(db)
+	if err != nil {
+		return nil, err
+	}
+
+	return indexSchemaStore{
+		orderStore,
+	}, nil
+}
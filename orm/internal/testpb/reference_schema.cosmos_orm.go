@@ -0,0 +1,586 @@
+// Code generated by protoc-gen-go-cosmos-orm. DO NOT EDIT.
+
+package testpb
+
+import (
+	context "context"
+
+	ormlist "github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	ormtable "github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+	ormerrors "github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+)
+
+type GroupStore interface {
+	Insert(ctx context.Context, group *Group) error
+	InsertReturningID(ctx context.Context, group *Group) (uint64, error)
+	Update(ctx context.Context, group *Group) error
+	Save(ctx context.Context, group *Group) error
+	Delete(ctx context.Context, group *Group) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*Group, error)
+	List(ctx context.Context, prefixKey GroupIndexKey, opts ...ormlist.Option) (GroupIterator, error)
+	ListRange(ctx context.Context, from, to GroupIndexKey, opts ...ormlist.Option) (GroupIterator, error)
+	DeleteBy(ctx context.Context, prefixKey GroupIndexKey) error
+	DeleteRange(ctx context.Context, from, to GroupIndexKey) error
+
+	doNotImplement()
+}
+
+type GroupIterator struct {
+	ormtable.Iterator
+}
+
+func (i GroupIterator) Value() (*Group, error) {
+	var group Group
+	err := i.UnmarshalMessage(&group)
+	return &group, err
+}
+
+type GroupIndexKey interface {
+	id() uint32
+	values() []interface{}
+	groupIndexKey()
+}
+
+// primary key starting index..
+type GroupPrimaryKey = GroupIdIndexKey
+
+type GroupIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x GroupIdIndexKey) id() uint32            { return 0 }
+func (x GroupIdIndexKey) values() []interface{} { return x.vs }
+func (x GroupIdIndexKey) groupIndexKey()        {}
+
+func (this GroupIdIndexKey) WithId(id uint64) GroupIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type groupStore struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this groupStore) Insert(ctx context.Context, group *Group) error {
+	return this.table.Insert(ctx, group)
+}
+
+func (this groupStore) Update(ctx context.Context, group *Group) error {
+	return this.table.Update(ctx, group)
+}
+
+func (this groupStore) Save(ctx context.Context, group *Group) error {
+	return this.table.Save(ctx, group)
+}
+
+func (this groupStore) Delete(ctx context.Context, group *Group) error {
+	return this.table.Delete(ctx, group)
+}
+
+func (this groupStore) InsertReturningID(ctx context.Context, group *Group) (uint64, error) {
+	return this.table.InsertReturningID(ctx, group)
+}
+
+func (this groupStore) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this groupStore) Get(ctx context.Context, id uint64) (*Group, error) {
+	var group Group
+	found, err := this.table.PrimaryKey().Get(ctx, &group, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &group, nil
+}
+
+func (this groupStore) List(ctx context.Context, prefixKey GroupIndexKey, opts ...ormlist.Option) (GroupIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return GroupIterator{it}, err
+}
+
+func (this groupStore) ListRange(ctx context.Context, from, to GroupIndexKey, opts ...ormlist.Option) (GroupIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return GroupIterator{it}, err
+}
+
+func (this groupStore) DeleteBy(ctx context.Context, prefixKey GroupIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this groupStore) DeleteRange(ctx context.Context, from, to GroupIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this groupStore) doNotImplement() {}
+
+var _ GroupStore = groupStore{}
+
+func NewGroupStore(db ormtable.Schema) (GroupStore, error) {
+	table := db.GetTable(&Group{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Group{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return groupStore{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type GroupMemberStore interface {
+	Insert(ctx context.Context, groupMember *GroupMember) error
+	Update(ctx context.Context, groupMember *GroupMember) error
+	Save(ctx context.Context, groupMember *GroupMember) error
+	Delete(ctx context.Context, groupMember *GroupMember) error
+	Has(ctx context.Context, group_id uint64, address string) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, group_id uint64, address string) (*GroupMember, error)
+	List(ctx context.Context, prefixKey GroupMemberIndexKey, opts ...ormlist.Option) (GroupMemberIterator, error)
+	ListRange(ctx context.Context, from, to GroupMemberIndexKey, opts ...ormlist.Option) (GroupMemberIterator, error)
+	DeleteBy(ctx context.Context, prefixKey GroupMemberIndexKey) error
+	DeleteRange(ctx context.Context, from, to GroupMemberIndexKey) error
+
+	doNotImplement()
+}
+
+type GroupMemberIterator struct {
+	ormtable.Iterator
+}
+
+func (i GroupMemberIterator) Value() (*GroupMember, error) {
+	var groupMember GroupMember
+	err := i.UnmarshalMessage(&groupMember)
+	return &groupMember, err
+}
+
+type GroupMemberIndexKey interface {
+	id() uint32
+	values() []interface{}
+	groupMemberIndexKey()
+}
+
+// primary key starting index..
+type GroupMemberPrimaryKey = GroupMemberGroupIdAddressIndexKey
+
+type GroupMemberGroupIdAddressIndexKey struct {
+	vs []interface{}
+}
+
+func (x GroupMemberGroupIdAddressIndexKey) id() uint32            { return 0 }
+func (x GroupMemberGroupIdAddressIndexKey) values() []interface{} { return x.vs }
+func (x GroupMemberGroupIdAddressIndexKey) groupMemberIndexKey()  {}
+
+func (this GroupMemberGroupIdAddressIndexKey) WithGroupId(group_id uint64) GroupMemberGroupIdAddressIndexKey {
+	this.vs = []interface{}{group_id}
+	return this
+}
+
+func (this GroupMemberGroupIdAddressIndexKey) WithGroupIdAddress(group_id uint64, address string) GroupMemberGroupIdAddressIndexKey {
+	this.vs = []interface{}{group_id, address}
+	return this
+}
+
+type GroupMemberAddressIndexKey struct {
+	vs []interface{}
+}
+
+func (x GroupMemberAddressIndexKey) id() uint32            { return 1 }
+func (x GroupMemberAddressIndexKey) values() []interface{} { return x.vs }
+func (x GroupMemberAddressIndexKey) groupMemberIndexKey()  {}
+
+func (this GroupMemberAddressIndexKey) WithAddress(address string) GroupMemberAddressIndexKey {
+	this.vs = []interface{}{address}
+	return this
+}
+
+type groupMemberStore struct {
+	table ormtable.Table
+}
+
+func (this groupMemberStore) Insert(ctx context.Context, groupMember *GroupMember) error {
+	return this.table.Insert(ctx, groupMember)
+}
+
+func (this groupMemberStore) Update(ctx context.Context, groupMember *GroupMember) error {
+	return this.table.Update(ctx, groupMember)
+}
+
+func (this groupMemberStore) Save(ctx context.Context, groupMember *GroupMember) error {
+	return this.table.Save(ctx, groupMember)
+}
+
+func (this groupMemberStore) Delete(ctx context.Context, groupMember *GroupMember) error {
+	return this.table.Delete(ctx, groupMember)
+}
+
+func (this groupMemberStore) Has(ctx context.Context, group_id uint64, address string) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, group_id, address)
+}
+
+func (this groupMemberStore) Get(ctx context.Context, group_id uint64, address string) (*GroupMember, error) {
+	var groupMember GroupMember
+	found, err := this.table.PrimaryKey().Get(ctx, &groupMember, group_id, address)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &groupMember, nil
+}
+
+func (this groupMemberStore) List(ctx context.Context, prefixKey GroupMemberIndexKey, opts ...ormlist.Option) (GroupMemberIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return GroupMemberIterator{it}, err
+}
+
+func (this groupMemberStore) ListRange(ctx context.Context, from, to GroupMemberIndexKey, opts ...ormlist.Option) (GroupMemberIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return GroupMemberIterator{it}, err
+}
+
+func (this groupMemberStore) DeleteBy(ctx context.Context, prefixKey GroupMemberIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this groupMemberStore) DeleteRange(ctx context.Context, from, to GroupMemberIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this groupMemberStore) doNotImplement() {}
+
+var _ GroupMemberStore = groupMemberStore{}
+
+func NewGroupMemberStore(db ormtable.Schema) (GroupMemberStore, error) {
+	table := db.GetTable(&GroupMember{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&GroupMember{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return groupMemberStore{table}, nil
+}
+
+type GroupPolicyStore interface {
+	Insert(ctx context.Context, groupPolicy *GroupPolicy) error
+	Update(ctx context.Context, groupPolicy *GroupPolicy) error
+	Save(ctx context.Context, groupPolicy *GroupPolicy) error
+	Delete(ctx context.Context, groupPolicy *GroupPolicy) error
+	Has(ctx context.Context, address string) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, address string) (*GroupPolicy, error)
+	List(ctx context.Context, prefixKey GroupPolicyIndexKey, opts ...ormlist.Option) (GroupPolicyIterator, error)
+	ListRange(ctx context.Context, from, to GroupPolicyIndexKey, opts ...ormlist.Option) (GroupPolicyIterator, error)
+	DeleteBy(ctx context.Context, prefixKey GroupPolicyIndexKey) error
+	DeleteRange(ctx context.Context, from, to GroupPolicyIndexKey) error
+
+	doNotImplement()
+}
+
+type GroupPolicyIterator struct {
+	ormtable.Iterator
+}
+
+func (i GroupPolicyIterator) Value() (*GroupPolicy, error) {
+	var groupPolicy GroupPolicy
+	err := i.UnmarshalMessage(&groupPolicy)
+	return &groupPolicy, err
+}
+
+type GroupPolicyIndexKey interface {
+	id() uint32
+	values() []interface{}
+	groupPolicyIndexKey()
+}
+
+// primary key starting index..
+type GroupPolicyPrimaryKey = GroupPolicyAddressIndexKey
+
+type GroupPolicyAddressIndexKey struct {
+	vs []interface{}
+}
+
+func (x GroupPolicyAddressIndexKey) id() uint32            { return 0 }
+func (x GroupPolicyAddressIndexKey) values() []interface{} { return x.vs }
+func (x GroupPolicyAddressIndexKey) groupPolicyIndexKey()  {}
+
+func (this GroupPolicyAddressIndexKey) WithAddress(address string) GroupPolicyAddressIndexKey {
+	this.vs = []interface{}{address}
+	return this
+}
+
+type GroupPolicyGroupIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x GroupPolicyGroupIdIndexKey) id() uint32            { return 1 }
+func (x GroupPolicyGroupIdIndexKey) values() []interface{} { return x.vs }
+func (x GroupPolicyGroupIdIndexKey) groupPolicyIndexKey()  {}
+
+func (this GroupPolicyGroupIdIndexKey) WithGroupId(group_id uint64) GroupPolicyGroupIdIndexKey {
+	this.vs = []interface{}{group_id}
+	return this
+}
+
+type groupPolicyStore struct {
+	table ormtable.Table
+}
+
+func (this groupPolicyStore) Insert(ctx context.Context, groupPolicy *GroupPolicy) error {
+	return this.table.Insert(ctx, groupPolicy)
+}
+
+func (this groupPolicyStore) Update(ctx context.Context, groupPolicy *GroupPolicy) error {
+	return this.table.Update(ctx, groupPolicy)
+}
+
+func (this groupPolicyStore) Save(ctx context.Context, groupPolicy *GroupPolicy) error {
+	return this.table.Save(ctx, groupPolicy)
+}
+
+func (this groupPolicyStore) Delete(ctx context.Context, groupPolicy *GroupPolicy) error {
+	return this.table.Delete(ctx, groupPolicy)
+}
+
+func (this groupPolicyStore) Has(ctx context.Context, address string) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, address)
+}
+
+func (this groupPolicyStore) Get(ctx context.Context, address string) (*GroupPolicy, error) {
+	var groupPolicy GroupPolicy
+	found, err := this.table.PrimaryKey().Get(ctx, &groupPolicy, address)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &groupPolicy, nil
+}
+
+func (this groupPolicyStore) List(ctx context.Context, prefixKey GroupPolicyIndexKey, opts ...ormlist.Option) (GroupPolicyIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return GroupPolicyIterator{it}, err
+}
+
+func (this groupPolicyStore) ListRange(ctx context.Context, from, to GroupPolicyIndexKey, opts ...ormlist.Option) (GroupPolicyIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return GroupPolicyIterator{it}, err
+}
+
+func (this groupPolicyStore) DeleteBy(ctx context.Context, prefixKey GroupPolicyIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this groupPolicyStore) DeleteRange(ctx context.Context, from, to GroupPolicyIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this groupPolicyStore) doNotImplement() {}
+
+var _ GroupPolicyStore = groupPolicyStore{}
+
+func NewGroupPolicyStore(db ormtable.Schema) (GroupPolicyStore, error) {
+	table := db.GetTable(&GroupPolicy{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&GroupPolicy{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return groupPolicyStore{table}, nil
+}
+
+type ProposalStore interface {
+	Insert(ctx context.Context, proposal *Proposal) error
+	InsertReturningID(ctx context.Context, proposal *Proposal) (uint64, error)
+	Update(ctx context.Context, proposal *Proposal) error
+	Save(ctx context.Context, proposal *Proposal) error
+	Delete(ctx context.Context, proposal *Proposal) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*Proposal, error)
+	List(ctx context.Context, prefixKey ProposalIndexKey, opts ...ormlist.Option) (ProposalIterator, error)
+	ListRange(ctx context.Context, from, to ProposalIndexKey, opts ...ormlist.Option) (ProposalIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ProposalIndexKey) error
+	DeleteRange(ctx context.Context, from, to ProposalIndexKey) error
+
+	doNotImplement()
+}
+
+type ProposalIterator struct {
+	ormtable.Iterator
+}
+
+func (i ProposalIterator) Value() (*Proposal, error) {
+	var proposal Proposal
+	err := i.UnmarshalMessage(&proposal)
+	return &proposal, err
+}
+
+type ProposalIndexKey interface {
+	id() uint32
+	values() []interface{}
+	proposalIndexKey()
+}
+
+// primary key starting index..
+type ProposalPrimaryKey = ProposalIdIndexKey
+
+type ProposalIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProposalIdIndexKey) id() uint32            { return 0 }
+func (x ProposalIdIndexKey) values() []interface{} { return x.vs }
+func (x ProposalIdIndexKey) proposalIndexKey()     {}
+
+func (this ProposalIdIndexKey) WithId(id uint64) ProposalIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type ProposalGroupPolicyAddressIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProposalGroupPolicyAddressIndexKey) id() uint32            { return 1 }
+func (x ProposalGroupPolicyAddressIndexKey) values() []interface{} { return x.vs }
+func (x ProposalGroupPolicyAddressIndexKey) proposalIndexKey()     {}
+
+func (this ProposalGroupPolicyAddressIndexKey) WithGroupPolicyAddress(group_policy_address string) ProposalGroupPolicyAddressIndexKey {
+	this.vs = []interface{}{group_policy_address}
+	return this
+}
+
+type proposalStore struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this proposalStore) Insert(ctx context.Context, proposal *Proposal) error {
+	return this.table.Insert(ctx, proposal)
+}
+
+func (this proposalStore) Update(ctx context.Context, proposal *Proposal) error {
+	return this.table.Update(ctx, proposal)
+}
+
+func (this proposalStore) Save(ctx context.Context, proposal *Proposal) error {
+	return this.table.Save(ctx, proposal)
+}
+
+func (this proposalStore) Delete(ctx context.Context, proposal *Proposal) error {
+	return this.table.Delete(ctx, proposal)
+}
+
+func (this proposalStore) InsertReturningID(ctx context.Context, proposal *Proposal) (uint64, error) {
+	return this.table.InsertReturningID(ctx, proposal)
+}
+
+func (this proposalStore) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this proposalStore) Get(ctx context.Context, id uint64) (*Proposal, error) {
+	var proposal Proposal
+	found, err := this.table.PrimaryKey().Get(ctx, &proposal, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &proposal, nil
+}
+
+func (this proposalStore) List(ctx context.Context, prefixKey ProposalIndexKey, opts ...ormlist.Option) (ProposalIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ProposalIterator{it}, err
+}
+
+func (this proposalStore) ListRange(ctx context.Context, from, to ProposalIndexKey, opts ...ormlist.Option) (ProposalIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ProposalIterator{it}, err
+}
+
+func (this proposalStore) DeleteBy(ctx context.Context, prefixKey ProposalIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this proposalStore) DeleteRange(ctx context.Context, from, to ProposalIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this proposalStore) doNotImplement() {}
+
+var _ ProposalStore = proposalStore{}
+
+func NewProposalStore(db ormtable.Schema) (ProposalStore, error) {
+	table := db.GetTable(&Proposal{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Proposal{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return proposalStore{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type ReferenceSchemaStore interface {
+	GroupStore() GroupStore
+	GroupMemberStore() GroupMemberStore
+	GroupPolicyStore() GroupPolicyStore
+	ProposalStore() ProposalStore
+
+	doNotImplement()
+}
+
+type referenceSchemaStore struct {
+	group       GroupStore
+	groupMember GroupMemberStore
+	groupPolicy GroupPolicyStore
+	proposal    ProposalStore
+}
+
+func (x referenceSchemaStore) GroupStore() GroupStore {
+	return x.group
+}
+
+func (x referenceSchemaStore) GroupMemberStore() GroupMemberStore {
+	return x.groupMember
+}
+
+func (x referenceSchemaStore) GroupPolicyStore() GroupPolicyStore {
+	return x.groupPolicy
+}
+
+func (x referenceSchemaStore) ProposalStore() ProposalStore {
+	return x.proposal
+}
+
+func (referenceSchemaStore) doNotImplement() {}
+
+var _ ReferenceSchemaStore = referenceSchemaStore{}
+
+func NewReferenceSchemaStore(db ormtable.Schema) (ReferenceSchemaStore, error) {
+	groupStore, err := NewGroupStore(db)
+	if err != nil {
+		return nil, err
+	}
+
+	groupMemberStore, err := NewGroupMemberStore(db)
+	if err != nil {
+		return nil, err
+	}
+
+	groupPolicyStore, err := NewGroupPolicyStore(db)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalStore, err := NewProposalStore(db)
+	if err != nil {
+		return nil, err
+	}
+
+	return referenceSchemaStore{
+		groupStore,
+		groupMemberStore,
+		groupPolicyStore,
+		proposalStore,
+	}, nil
+}
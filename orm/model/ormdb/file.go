@@ -5,9 +5,12 @@ import (
 	"context"
 	"encoding/binary"
 	"math"
+	"sort"
 
 	"google.golang.org/protobuf/reflect/protoregistry"
 
+	ormv1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1alpha1"
+
 	"github.com/cosmos/cosmos-sdk/orm/encoding/encodeutil"
 
 	"github.com/cosmos/cosmos-sdk/orm/encoding/ormkv"
@@ -56,19 +59,30 @@ func newFileDescriptorDB(fileDescriptor protoreflect.FileDescriptor, options fil
 	n := messages.Len()
 	for i := 0; i < n; i++ {
 		messageDescriptor := messages.Get(i)
+		if !isTableOrSingleton(messageDescriptor) {
+			// messages which aren't tables can be used as nested key fields
+			continue
+		}
+
 		tableName := messageDescriptor.FullName()
 		messageType, err := resolver.FindMessageByName(tableName)
 		if err != nil {
 			return nil, err
 		}
 
+		// table options are read from the schema file descriptor which can
+		// differ from the one of the message type, for instance when building
+		// a previous version of the schema for migrations
+		opts := messageDescriptor.Options()
 		table, err := ormtable.Build(ormtable.Options{
-			Prefix:         prefix,
-			MessageType:    messageType,
-			TypeResolver:   resolver,
-			JSONValidator:  options.JSONValidator,
-			GetReadBackend: options.GetReadBackend,
-			GetBackend:     options.GetBackend,
+			Prefix:              prefix,
+			MessageType:         messageType,
+			TableDescriptor:     proto.GetExtension(opts, ormv1alpha1.E_Table).(*ormv1alpha1.TableDescriptor),
+			SingletonDescriptor: proto.GetExtension(opts, ormv1alpha1.E_Singleton).(*ormv1alpha1.SingletonDescriptor),
+			TypeResolver:        resolver,
+			JSONValidator:       options.JSONValidator,
+			GetReadBackend:      options.GetReadBackend,
+			GetBackend:          options.GetBackend,
 		})
 		if err != nil {
 			return nil, err
@@ -86,9 +100,27 @@ func newFileDescriptorDB(fileDescriptor protoreflect.FileDescriptor, options fil
 		schema.tablesByName[tableName] = table
 	}
 
+	tables := make([]ormtable.Table, 0, len(schema.tablesById))
+	for _, table := range schema.tablesById {
+		tables = append(tables, table)
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		return tables[i].ID() < tables[j].ID()
+	})
+
+	err := ormtable.ResolveReferences(tables)
+	if err != nil {
+		return nil, err
+	}
+
 	return schema, nil
 }
 
+func isTableOrSingleton(descriptor protoreflect.MessageDescriptor) bool {
+	opts := descriptor.Options()
+	return proto.HasExtension(opts, ormv1alpha1.E_Table) || proto.HasExtension(opts, ormv1alpha1.E_Singleton)
+}
+
 func (f fileDescriptorDB) DecodeEntry(k, v []byte) (ormkv.Entry, error) {
 	r := bytes.NewReader(k)
 	err := encodeutil.SkipPrefix(r, f.prefix)
@@ -0,0 +1,130 @@
+package ormdb_test
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/internal/testpb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+	"github.com/cosmos/cosmos-sdk/orm/testing/ormtest"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+)
+
+var TestIndexSchema = ormdb.ModuleSchema{
+	FileDescriptors: map[uint32]protoreflect.FileDescriptor{
+		1: testpb.File_testpb_index_schema_proto,
+	},
+}
+
+func newIndexStore(t *testing.T) (testpb.OrderStore, context.Context) {
+	db, err := ormdb.NewModuleDB(TestIndexSchema, ormdb.ModuleDBOptions{})
+	assert.NilError(t, err)
+	store, err := testpb.NewIndexSchemaStore(db)
+	assert.NilError(t, err)
+	return store.OrderStore(), ormtable.WrapContextDefault(ormtest.NewMemoryBackend())
+}
+
+// listOrderIDs returns a function collecting the ids of the orders of an
+// iterator.
+func listOrderIDs(t *testing.T) func(testpb.OrderIterator, error) []uint64 {
+	return func(it testpb.OrderIterator, err error) []uint64 {
+		assert.NilError(t, err)
+		defer it.Close()
+		var ids []uint64
+		for it.Next() {
+			order, err := it.Value()
+			assert.NilError(t, err)
+			ids = append(ids, order.Id)
+		}
+		return ids
+	}
+}
+
+func TestDecimalIndex(t *testing.T) {
+	store, ctx := newIndexStore(t)
+
+	for _, price := range []string{"10", "9.5", "-1", "100", "0.25", "1.000"} {
+		_, err := store.InsertReturningID(ctx, &testpb.Order{Owner: price, Price: price})
+		assert.NilError(t, err)
+	}
+
+	// orders are listed in numerical order of their price
+	ids := listOrderIDs(t)(store.List(ctx, testpb.OrderPriceIndexKey{}))
+	assert.DeepEqual(t, []uint64{3, 5, 6, 2, 1, 4}, ids)
+
+	ids = listOrderIDs(t)(store.ListRange(ctx,
+		testpb.OrderPriceIndexKey{}.WithPrice("1"),
+		testpb.OrderPriceIndexKey{}.WithPrice("10"),
+	))
+	assert.DeepEqual(t, []uint64{6, 2, 1}, ids)
+
+	// values equal to a stored decimal match it
+	ids = listOrderIDs(t)(store.List(ctx, testpb.OrderPriceIndexKey{}.WithPrice("1.0")))
+	assert.DeepEqual(t, []uint64{6}, ids)
+
+	_, err := store.InsertReturningID(ctx, &testpb.Order{Owner: "abc", Price: "abc"})
+	assert.ErrorContains(t, err, "invalid number")
+}
+
+func TestNestedIndex(t *testing.T) {
+	store, ctx := newIndexStore(t)
+
+	orders := []*testpb.Order{
+		{Owner: "alice", Amount: &testpb.Amount{Denom: "foo", Amount: "100"}},
+		{Owner: "bob", Amount: &testpb.Amount{Denom: "foo", Amount: "20"}},
+		{Owner: "alice", Amount: &testpb.Amount{Denom: "bar", Amount: "5"}},
+		{Owner: "carol"},
+	}
+	for _, order := range orders {
+		_, err := store.InsertReturningID(ctx, order)
+		assert.NilError(t, err)
+	}
+
+	ids := listOrderIDs(t)(store.List(ctx, testpb.OrderAmountDenomAmountAmountIndexKey{}.WithAmountDenom("foo")))
+	assert.DeepEqual(t, []uint64{2, 1}, ids)
+
+	order, err := store.GetByOwnerAmountDenom(ctx, "alice", "bar")
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(3), order.Id)
+
+	// an unset nested message has the default values of its fields
+	order, err = store.GetByOwnerAmountDenom(ctx, "carol", "")
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(4), order.Id)
+
+	err = store.Insert(ctx, &testpb.Order{Owner: "alice", Amount: &testpb.Amount{Denom: "foo", Amount: "1"}})
+	assert.ErrorIs(t, err, ormerrors.UniqueKeyViolation)
+}
+
+func TestMultiEntryIndex(t *testing.T) {
+	store, ctx := newIndexStore(t)
+
+	order1 := &testpb.Order{Owner: "alice", Tags: []string{"a", "b", "a"}, Fees: []*testpb.Amount{{Denom: "foo"}}}
+	order2 := &testpb.Order{Owner: "bob", Tags: []string{"b", "c"}, Fees: []*testpb.Amount{{Denom: "bar"}, {Denom: "foo"}}}
+	order3 := &testpb.Order{Owner: "carol"}
+	for _, order := range []*testpb.Order{order1, order2, order3} {
+		_, err := store.InsertReturningID(ctx, order)
+		assert.NilError(t, err)
+	}
+
+	assert.DeepEqual(t, []uint64{1}, listOrderIDs(t)(store.List(ctx, testpb.OrderTagsIndexKey{}.WithTags("a"))))
+	assert.DeepEqual(t, []uint64{1, 2}, listOrderIDs(t)(store.List(ctx, testpb.OrderTagsIndexKey{}.WithTags("b"))))
+	assert.DeepEqual(t, []uint64{1, 1, 2, 2}, listOrderIDs(t)(store.List(ctx, testpb.OrderTagsIndexKey{})))
+	assert.DeepEqual(t, []uint64{1, 2}, listOrderIDs(t)(store.List(ctx, testpb.OrderFeesDenomIndexKey{}.WithFeesDenom("foo"))))
+
+	// updating the repeated field only keeps the entries of its new elements
+	order1.Tags = []string{"b", "d"}
+	assert.NilError(t, store.Update(ctx, order1))
+	assert.Assert(t, listOrderIDs(t)(store.List(ctx, testpb.OrderTagsIndexKey{}.WithTags("a"))) == nil)
+	assert.DeepEqual(t, []uint64{1, 2}, listOrderIDs(t)(store.List(ctx, testpb.OrderTagsIndexKey{}.WithTags("b"))))
+	assert.DeepEqual(t, []uint64{1}, listOrderIDs(t)(store.List(ctx, testpb.OrderTagsIndexKey{}.WithTags("d"))))
+
+	// deleting by a multi-entry index deletes each row once
+	assert.NilError(t, store.DeleteBy(ctx, testpb.OrderTagsIndexKey{}))
+	assert.Assert(t, listOrderIDs(t)(store.List(ctx, testpb.OrderTagsIndexKey{})) == nil)
+	assert.DeepEqual(t, []uint64{3}, listOrderIDs(t)(store.List(ctx, testpb.OrderIdIndexKey{})))
+}
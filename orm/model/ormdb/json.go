@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 
 	"github.com/cosmos/cosmos-sdk/orm/types/ormjson"
@@ -65,14 +66,7 @@ func (m moduleDB) ValidateJSON(source ormjson.ReadSource) error {
 }
 
 func (m moduleDB) ImportJSON(ctx context.Context, source ormjson.ReadSource) error {
-	var names []string
-	for name := range m.tablesByName {
-		names = append(names, string(name))
-	}
-	sort.Strings(names)
-
-	for _, name := range names {
-		fullName := protoreflect.FullName(name)
+	for _, fullName := range m.importOrder() {
 		table := m.tablesByName[fullName]
 
 		r, err := source.OpenReader(fullName)
@@ -117,3 +111,41 @@ func (m moduleDB) ExportJSON(ctx context.Context, sink ormjson.WriteTarget) erro
 	}
 	return nil
 }
+
+// importOrder returns the names of the tables sorted by name, except that
+// tables are always imported after the tables they reference so that
+// reference constraints are satisfied while importing.
+func (m moduleDB) importOrder() []protoreflect.FullName {
+	var names []string
+	for name := range m.tablesByName {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+
+	var order []protoreflect.FullName
+	visited := map[protoreflect.FullName]bool{}
+	var visit func(name protoreflect.FullName)
+	visit = func(name protoreflect.FullName) {
+		if visited[name] {
+			return
+		}
+		visited[name] = true
+
+		table, ok := m.tablesByName[name]
+		if !ok {
+			return
+		}
+
+		for _, referenced := range ormtable.ReferencedTables(table) {
+			visit(referenced)
+		}
+
+		order = append(order, name)
+	}
+
+	for _, name := range names {
+		visit(protoreflect.FullName(name))
+	}
+
+	return order
+}
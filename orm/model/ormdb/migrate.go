@@ -0,0 +1,275 @@
+package ormdb
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	ormv1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1alpha1"
+	"github.com/cosmos/cosmos-sdk/orm/encoding/encodeutil"
+	"github.com/cosmos/cosmos-sdk/orm/internal/fieldnames"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+)
+
+// TableMigration describes how a table changes between two versions of a
+// module schema.
+type TableMigration struct {
+	// Name is the full name of the table message.
+	Name protoreflect.FullName
+
+	// From is the table in the previous schema or nil if the table was added.
+	From ormtable.Table
+
+	// To is the table in the new schema or nil if the table was removed.
+	To ormtable.Table
+
+	// MoveRows is true if the rows of the table need to be written again
+	// because the key prefix, ID or primary key of the table changed. The
+	// indexes of the new table are then written along with its rows.
+	MoveRows bool
+
+	// RebuildIndexes are the IDs of the indexes which were added to the table
+	// or whose definition changed and need to be rebuilt from the rows of the
+	// table.
+	RebuildIndexes []uint32
+
+	// RemovedIndexes are the IDs of the indexes which were removed from the
+	// table and whose entries need to be deleted.
+	RemovedIndexes []uint32
+}
+
+// DiffSchemas returns the migrations of the tables which changed between the
+// from and to versions of a module schema, sorted so that referenced tables
+// come before the tables referencing them. Added tables don't need to be
+// migrated and are not returned. Tables are built from the table and singleton
+// options of the schema file descriptors, so the from schema should use the
+// file descriptors of the previous version of the module. The FileResolver
+// option is ignored.
+func DiffSchemas(from, to ModuleSchema, options ModuleDBOptions) ([]TableMigration, error) {
+	options.FileResolver = nil
+	fromDB, err := NewModuleDB(from, options)
+	if err != nil {
+		return nil, err
+	}
+
+	toDB, err := NewModuleDB(to, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffModuleDBs(fromDB.(*moduleDB), toDB.(*moduleDB)), nil
+}
+
+// Migrate migrates the state of a module from the from version of its schema
+// to the to version:
+//   - rows of tables whose key prefix, ID or primary key changed are moved
+//     to the new table definition, which is done in memory,
+//   - the entries of removed tables and indexes are deleted, and
+//   - added indexes and indexes whose definition changed are rebuilt.
+//
+// Rows are moved with ormtable.ImportRows, so the backend hooks are called
+// and references are enforced for the moved rows only.
+//
+// Migrate isn't atomic and is meant to be run in the context of a larger
+// transaction, such as a module.MigrationHandler.
+func Migrate(ctx context.Context, from, to ModuleSchema, options ModuleDBOptions) error {
+	migrations, err := DiffSchemas(from, to, options)
+	if err != nil {
+		return err
+	}
+
+	return RunMigrations(ctx, migrations)
+}
+
+// RunMigrations runs the table migrations returned by DiffSchemas. See
+// Migrate for more details.
+func RunMigrations(ctx context.Context, migrations []TableMigration) error {
+	type exported struct {
+		rows    []proto.Message
+		lastSeq uint64
+	}
+
+	// rows are exported before any table is cleared because the new prefix
+	// of a table can be the previous prefix of another one
+	exports := make([]exported, len(migrations))
+	for i, migration := range migrations {
+		if !migration.MoveRows {
+			continue
+		}
+
+		rows, lastSeq, err := ormtable.ExportRows(ctx, migration.From)
+		if err != nil {
+			return err
+		}
+		exports[i] = exported{rows: rows, lastSeq: lastSeq}
+	}
+
+	for _, migration := range migrations {
+		err := clearEntries(ctx, migration)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, migration := range migrations {
+		err := writeEntries(ctx, migration, exports[i].rows, exports[i].lastSeq)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// clearEntries deletes the entries of removed tables and indexes as well as
+// the entries of the tables whose rows are moved.
+func clearEntries(ctx context.Context, migration TableMigration) error {
+	if migration.To == nil || migration.MoveRows {
+		return ormtable.ClearTable(ctx, migration.From)
+	}
+
+	for _, id := range migration.RemovedIndexes {
+		err := ormtable.ClearIndex(ctx, migration.To, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeEntries imports the moved rows of a table or rebuilds its changed
+// indexes.
+func writeEntries(ctx context.Context, migration TableMigration, rows []proto.Message, lastSeq uint64) error {
+	if migration.To == nil {
+		return nil
+	}
+
+	if migration.MoveRows {
+		return ormtable.ImportRows(ctx, migration.To, rows, lastSeq)
+	}
+
+	for _, id := range migration.RebuildIndexes {
+		err := ormtable.RebuildIndex(ctx, migration.To, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tableInfo is the definition of a table in a schema.
+type tableInfo struct {
+	table     ormtable.Table
+	prefix    []byte
+	tableDesc *ormv1alpha1.TableDescriptor
+	singleton bool
+}
+
+func (m moduleDB) tableInfos() map[protoreflect.FullName]tableInfo {
+	infos := map[protoreflect.FullName]tableInfo{}
+	for _, file := range m.filesById {
+		for name, table := range file.tablesByName {
+			opts := file.fileDescriptor.Messages().ByName(name.Name()).Options()
+			tableDesc := proto.GetExtension(opts, ormv1alpha1.E_Table).(*ormv1alpha1.TableDescriptor)
+			infos[name] = tableInfo{
+				table:     table,
+				prefix:    encodeutil.AppendVarUInt32(append([]byte{}, file.prefix...), table.ID()),
+				tableDesc: tableDesc,
+				singleton: tableDesc == nil,
+			}
+		}
+	}
+	return infos
+}
+
+func diffModuleDBs(from, to *moduleDB) []TableMigration {
+	fromInfos := from.tableInfos()
+	toInfos := to.tableInfos()
+
+	var migrations []TableMigration
+	for _, name := range to.importOrder() {
+		toInfo := toInfos[name]
+		fromInfo, ok := fromInfos[name]
+		if !ok {
+			continue
+		}
+
+		migration := TableMigration{
+			Name: name,
+			From: fromInfo.table,
+			To:   toInfo.table,
+		}
+
+		if !bytes.Equal(fromInfo.prefix, toInfo.prefix) || fromInfo.singleton != toInfo.singleton ||
+			(!toInfo.singleton && !samePrimaryKey(fromInfo.tableDesc.PrimaryKey, toInfo.tableDesc.PrimaryKey)) {
+			migration.MoveRows = true
+			migrations = append(migrations, migration)
+			continue
+		}
+
+		if toInfo.singleton {
+			continue
+		}
+
+		fromIndexes := indexesByID(fromInfo.tableDesc)
+		toIndexes := indexesByID(toInfo.tableDesc)
+		for id, idx := range toIndexes {
+			if fromIdx, ok := fromIndexes[id]; !ok || !sameIndex(fromIdx, idx) {
+				migration.RebuildIndexes = append(migration.RebuildIndexes, id)
+			}
+		}
+		for id := range fromIndexes {
+			if _, ok := toIndexes[id]; !ok {
+				migration.RemovedIndexes = append(migration.RemovedIndexes, id)
+			}
+		}
+
+		if len(migration.RebuildIndexes) == 0 && len(migration.RemovedIndexes) == 0 {
+			continue
+		}
+
+		sortIDs(migration.RebuildIndexes)
+		sortIDs(migration.RemovedIndexes)
+		migrations = append(migrations, migration)
+	}
+
+	for _, name := range from.importOrder() {
+		if _, ok := toInfos[name]; !ok {
+			migrations = append(migrations, TableMigration{
+				Name: name,
+				From: fromInfos[name].table,
+			})
+		}
+	}
+
+	return migrations
+}
+
+func samePrimaryKey(a, b *ormv1alpha1.PrimaryKeyDescriptor) bool {
+	return fieldnames.CommaSeparatedFieldNames(a.Fields) == fieldnames.CommaSeparatedFieldNames(b.Fields) &&
+		a.AutoIncrement == b.AutoIncrement
+}
+
+func sameIndex(a, b *ormv1alpha1.SecondaryIndexDescriptor) bool {
+	return fieldnames.CommaSeparatedFieldNames(a.Fields) == fieldnames.CommaSeparatedFieldNames(b.Fields) &&
+		a.Unique == b.Unique
+}
+
+func indexesByID(desc *ormv1alpha1.TableDescriptor) map[uint32]*ormv1alpha1.SecondaryIndexDescriptor {
+	indexes := map[uint32]*ormv1alpha1.SecondaryIndexDescriptor{}
+	for _, idx := range desc.Index {
+		indexes[idx.Id] = idx
+	}
+	return indexes
+}
+
+func sortIDs(ids []uint32) {
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+}
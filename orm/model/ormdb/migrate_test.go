@@ -0,0 +1,207 @@
+package ormdb_test
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"gotest.tools/v3/assert"
+
+	ormv1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1alpha1"
+	"github.com/cosmos/cosmos-sdk/orm/internal/testkv"
+	"github.com/cosmos/cosmos-sdk/orm/internal/testpb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+)
+
+// previousSchemaFile returns a copy of file in which the table descriptor of
+// the message with the provided name is modified by edit. It simulates the
+// file descriptor of a previous version of a module schema.
+func previousSchemaFile(t *testing.T, file protoreflect.FileDescriptor, name protoreflect.Name, edit func(*ormv1alpha1.TableDescriptor)) protoreflect.FileDescriptor {
+	fdProto := protodesc.ToFileDescriptorProto(file)
+	for _, msg := range fdProto.MessageType {
+		if protoreflect.Name(msg.GetName()) != name {
+			continue
+		}
+
+		tableDesc := proto.Clone(proto.GetExtension(msg.Options, ormv1alpha1.E_Table).(*ormv1alpha1.TableDescriptor)).(*ormv1alpha1.TableDescriptor)
+		edit(tableDesc)
+		proto.SetExtension(msg.Options, ormv1alpha1.E_Table, tableDesc)
+	}
+
+	fd, err := protodesc.NewFile(fdProto, protoregistry.GlobalFiles)
+	assert.NilError(t, err)
+	return fd
+}
+
+var testOrders = []*testpb.Order{
+	{Owner: "alice", Price: "1.5", Amount: &testpb.Amount{Denom: "foo", Amount: "10"}, Tags: []string{"a", "b"}},
+	{Owner: "bob", Price: "0.5", Amount: &testpb.Amount{Denom: "bar", Amount: "5"}, Fees: []*testpb.Amount{{Denom: "foo", Amount: "1"}}},
+	{Owner: "carol", Price: "-2", Amount: &testpb.Amount{Denom: "foo", Amount: "7"}, Tags: []string{"b"}},
+	{Owner: "dave", Price: "3"},
+}
+
+var testBalances = []*testpb.Balance{
+	{Address: "alice", Denom: "foo", Amount: 10},
+	{Address: "bob", Denom: "bar", Amount: 5},
+	{Address: "bob", Denom: "foo", Amount: 3},
+}
+
+// populate inserts the test orders and balances in the tables of db which
+// exist and deletes the last order so that the order sequence is ahead of
+// the last order id.
+func populate(t *testing.T, ctx context.Context, db ormdb.ModuleDB) {
+	orderTable := db.GetTable(&testpb.Order{})
+	if orderTable != nil {
+		var last proto.Message
+		for _, order := range testOrders {
+			last = proto.Clone(order)
+			assert.NilError(t, orderTable.Insert(ctx, last))
+		}
+		assert.NilError(t, orderTable.Delete(ctx, last))
+	}
+
+	balanceTable := db.GetTable(&testpb.Balance{})
+	if balanceTable != nil {
+		for _, balance := range testBalances {
+			assert.NilError(t, balanceTable.Insert(ctx, proto.Clone(balance)))
+		}
+	}
+}
+
+// assertMigrated populates a backend with the from schema, migrates it to the
+// to schema and checks that it is equal to a backend populated with the to
+// schema directly.
+func assertMigrated(t *testing.T, backend func() ormtable.Backend, from, to ormdb.ModuleSchema) {
+	fromDB, err := ormdb.NewModuleDB(from, ormdb.ModuleDBOptions{})
+	assert.NilError(t, err)
+	toDB, err := ormdb.NewModuleDB(to, ormdb.ModuleDBOptions{})
+	assert.NilError(t, err)
+
+	migrated := backend()
+	ctx := ormtable.WrapContextDefault(migrated)
+	populate(t, ctx, fromDB)
+	assert.NilError(t, ormdb.Migrate(ctx, from, to, ormdb.ModuleDBOptions{}))
+
+	expected := backend()
+	populate(t, ormtable.WrapContextDefault(expected), toDB)
+
+	testkv.AssertBackendsEqual(t, migrated, expected)
+
+	// the tables of the new schema can be used after the migration
+	store, err := testpb.NewIndexSchemaStore(toDB)
+	assert.NilError(t, err)
+	id, err := store.OrderStore().InsertReturningID(ctx, &testpb.Order{Owner: "erin"})
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(len(testOrders)+1), id)
+	ids := listOrderIDs(t)(store.OrderStore().List(ctx, testpb.OrderTagsIndexKey{}.WithTags("b")))
+	assert.DeepEqual(t, []uint64{1, 3}, ids)
+}
+
+func TestMigrate(t *testing.T) {
+	changedIndexes := previousSchemaFile(t, testpb.File_testpb_index_schema_proto, "Order", func(desc *ormv1alpha1.TableDescriptor) {
+		desc.Index = []*ormv1alpha1.SecondaryIndexDescriptor{
+			{Id: 1, Fields: "owner"},
+			{Id: 3, Fields: "tags"},
+			{Id: 6, Fields: "price"},
+		}
+	})
+	changedTableID := previousSchemaFile(t, testpb.File_testpb_index_schema_proto, "Order", func(desc *ormv1alpha1.TableDescriptor) {
+		desc.Id = 7
+		desc.Index = desc.Index[:2]
+	})
+	changedPrimaryKey := previousSchemaFile(t, testpb.File_testpb_bank_proto, "Balance", func(desc *ormv1alpha1.TableDescriptor) {
+		desc.PrimaryKey.Fields = "denom,address"
+		desc.Index = []*ormv1alpha1.SecondaryIndexDescriptor{{Id: 1, Fields: "address"}}
+	})
+
+	tests := []struct {
+		name       string
+		from, to   ormdb.ModuleSchema
+		migrations []ormdb.TableMigration
+	}{
+		{
+			name: "changed indexes",
+			from: ormdb.ModuleSchema{FileDescriptors: map[uint32]protoreflect.FileDescriptor{1: changedIndexes}},
+			to:   TestIndexSchema,
+			migrations: []ormdb.TableMigration{
+				{Name: "testpb.Order", RebuildIndexes: []uint32{1, 2, 4, 5}, RemovedIndexes: []uint32{6}},
+			},
+		},
+		{
+			name: "changed table id",
+			from: ormdb.ModuleSchema{FileDescriptors: map[uint32]protoreflect.FileDescriptor{1: changedTableID}},
+			to:   TestIndexSchema,
+			migrations: []ormdb.TableMigration{
+				{Name: "testpb.Order", MoveRows: true},
+			},
+		},
+		{
+			name: "changed file id",
+			from: ormdb.ModuleSchema{FileDescriptors: map[uint32]protoreflect.FileDescriptor{3: testpb.File_testpb_index_schema_proto}},
+			to:   TestIndexSchema,
+			migrations: []ormdb.TableMigration{
+				{Name: "testpb.Order", MoveRows: true},
+			},
+		},
+		{
+			name: "changed primary key",
+			from: ormdb.ModuleSchema{FileDescriptors: map[uint32]protoreflect.FileDescriptor{
+				1: testpb.File_testpb_index_schema_proto,
+				2: changedPrimaryKey,
+			}},
+			to: ormdb.ModuleSchema{FileDescriptors: map[uint32]protoreflect.FileDescriptor{
+				1: testpb.File_testpb_index_schema_proto,
+				2: testpb.File_testpb_bank_proto,
+			}},
+			migrations: []ormdb.TableMigration{
+				{Name: "testpb.Balance", MoveRows: true},
+			},
+		},
+		{
+			name: "removed tables",
+			from: ormdb.ModuleSchema{FileDescriptors: map[uint32]protoreflect.FileDescriptor{
+				1: testpb.File_testpb_index_schema_proto,
+				2: testpb.File_testpb_bank_proto,
+			}},
+			to: TestIndexSchema,
+			migrations: []ormdb.TableMigration{
+				{Name: "testpb.Balance"},
+				{Name: "testpb.Supply"},
+			},
+		},
+		{
+			name:       "unchanged schema",
+			from:       TestIndexSchema,
+			to:         TestIndexSchema,
+			migrations: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			migrations, err := ormdb.DiffSchemas(tc.from, tc.to, ormdb.ModuleDBOptions{})
+			assert.NilError(t, err)
+			assert.Equal(t, len(tc.migrations), len(migrations))
+			for i, migration := range migrations {
+				expected := tc.migrations[i]
+				assert.Equal(t, expected.Name, migration.Name)
+				assert.Equal(t, expected.MoveRows, migration.MoveRows)
+				assert.DeepEqual(t, expected.RebuildIndexes, migration.RebuildIndexes)
+				assert.DeepEqual(t, expected.RemovedIndexes, migration.RemovedIndexes)
+				assert.Assert(t, migration.From != nil)
+				assert.Equal(t, tc.to.FileDescriptors[2] == nil && migration.Name != "testpb.Order", migration.To == nil)
+			}
+
+			t.Run("split", func(t *testing.T) {
+				assertMigrated(t, testkv.NewSplitMemBackend, tc.from, tc.to)
+			})
+			t.Run("shared", func(t *testing.T) {
+				assertMigrated(t, testkv.NewSharedMemBackend, tc.from, tc.to)
+			})
+		})
+	}
+}
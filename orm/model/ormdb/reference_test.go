@@ -0,0 +1,107 @@
+package ormdb_test
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/internal/testpb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+	"github.com/cosmos/cosmos-sdk/orm/testing/ormtest"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormjson"
+)
+
+var TestReferenceSchema = ormdb.ModuleSchema{
+	FileDescriptors: map[uint32]protoreflect.FileDescriptor{
+		1: testpb.File_testpb_reference_schema_proto,
+	},
+}
+
+func newReferenceStore(t *testing.T) (ormdb.ModuleDB, testpb.ReferenceSchemaStore, context.Context) {
+	db, err := ormdb.NewModuleDB(TestReferenceSchema, ormdb.ModuleDBOptions{})
+	assert.NilError(t, err)
+	store, err := testpb.NewReferenceSchemaStore(db)
+	assert.NilError(t, err)
+	ctx := ormtable.WrapContextDefault(ormtest.NewMemoryBackend())
+	return db, store, ctx
+}
+
+func TestReferences(t *testing.T) {
+	_, store, ctx := newReferenceStore(t)
+
+	// references to missing rows are rejected
+	err := store.GroupMemberStore().Insert(ctx, &testpb.GroupMember{GroupId: 1, Address: "alice", Weight: 1})
+	assert.ErrorIs(t, err, ormerrors.ReferenceConstraintViolation)
+	err = store.GroupPolicyStore().Insert(ctx, &testpb.GroupPolicy{Address: "policy1", GroupId: 1})
+	assert.ErrorIs(t, err, ormerrors.ReferenceConstraintViolation)
+
+	// unset references are allowed
+	assert.NilError(t, store.GroupPolicyStore().Insert(ctx, &testpb.GroupPolicy{Address: "policy0"}))
+
+	groupID, err := store.GroupStore().InsertReturningID(ctx, &testpb.Group{Admin: "admin"})
+	assert.NilError(t, err)
+	assert.NilError(t, store.GroupMemberStore().Insert(ctx, &testpb.GroupMember{GroupId: groupID, Address: "alice", Weight: 1}))
+	assert.NilError(t, store.GroupMemberStore().Insert(ctx, &testpb.GroupMember{GroupId: groupID, Address: "bob", Weight: 2}))
+	assert.NilError(t, store.GroupPolicyStore().Insert(ctx, &testpb.GroupPolicy{Address: "policy1", GroupId: groupID}))
+	proposalID, err := store.ProposalStore().InsertReturningID(ctx, &testpb.Proposal{GroupPolicyAddress: "policy1"})
+	assert.NilError(t, err)
+
+	// updates can't dangle a reference
+	err = store.GroupPolicyStore().Update(ctx, &testpb.GroupPolicy{Address: "policy1", GroupId: groupID + 1})
+	assert.ErrorIs(t, err, ormerrors.ReferenceConstraintViolation)
+	err = store.ProposalStore().Update(ctx, &testpb.Proposal{Id: proposalID, GroupPolicyAddress: "policy2"})
+	assert.ErrorIs(t, err, ormerrors.ReferenceConstraintViolation)
+
+	// the group can't be deleted while a group policy references it
+	group, err := store.GroupStore().Get(ctx, groupID)
+	assert.NilError(t, err)
+	err = store.GroupStore().Delete(ctx, group)
+	assert.ErrorIs(t, err, ormerrors.ReferenceConstraintViolation)
+	found, err := store.GroupMemberStore().Has(ctx, groupID, "alice")
+	assert.NilError(t, err)
+	assert.Assert(t, found)
+
+	// deleting the group policy cascades to its proposals
+	assert.NilError(t, store.GroupPolicyStore().Delete(ctx, &testpb.GroupPolicy{Address: "policy1"}))
+	found, err = store.ProposalStore().Has(ctx, proposalID)
+	assert.NilError(t, err)
+	assert.Assert(t, !found)
+
+	// deleting the group cascades to its members
+	assert.NilError(t, store.GroupStore().Delete(ctx, group))
+	it, err := store.GroupMemberStore().List(ctx, testpb.GroupMemberGroupIdAddressIndexKey{}.WithGroupId(groupID))
+	assert.NilError(t, err)
+	assert.Assert(t, !it.Next())
+	it.Close()
+}
+
+func TestReferencesImportJSON(t *testing.T) {
+	db, store, ctx := newReferenceStore(t)
+
+	groupID, err := store.GroupStore().InsertReturningID(ctx, &testpb.Group{Admin: "admin"})
+	assert.NilError(t, err)
+	assert.NilError(t, store.GroupMemberStore().Insert(ctx, &testpb.GroupMember{GroupId: groupID, Address: "alice", Weight: 1}))
+	assert.NilError(t, store.GroupPolicyStore().Insert(ctx, &testpb.GroupPolicy{Address: "policy1", GroupId: groupID}))
+	_, err = store.ProposalStore().InsertReturningID(ctx, &testpb.Proposal{GroupPolicyAddress: "policy1"})
+	assert.NilError(t, err)
+
+	target := ormjson.NewRawMessageTarget()
+	assert.NilError(t, db.ExportJSON(ctx, target))
+	rawJson, err := target.JSON()
+	assert.NilError(t, err)
+
+	// tables are imported after the tables they reference even though
+	// testpb.GroupMember sorts before testpb.Group
+	backend := ormtest.NewMemoryBackend()
+	source, err := ormjson.NewRawMessageSource(rawJson)
+	assert.NilError(t, err)
+	assert.NilError(t, db.ImportJSON(ormtable.WrapContextDefault(backend), source))
+
+	source, err = ormjson.NewRawMessageSource([]byte(`{"testpb.GroupMember":[{"group_id":"2","address":"bob","weight":"1"}]}`))
+	assert.NilError(t, err)
+	assert.ErrorIs(t, db.ImportJSON(ormtable.WrapContextDefault(ormtest.NewMemoryBackend()), source), ormerrors.ReferenceConstraintViolation)
+}
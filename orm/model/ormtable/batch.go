@@ -8,6 +8,11 @@ type batchIndexCommitmentWriter struct {
 	Backend
 	commitmentWriter *batchStoreWriter
 	indexWriter      *batchStoreWriter
+
+	// deleted tracks the primary keys deleted with this writer so that rows
+	// are deleted only once when cascading deletes to referencing rows or
+	// iterating over multi-entry indexes.
+	deleted map[string]bool
 }
 
 func newBatchIndexCommitmentWriter(store Backend) *batchIndexCommitmentWriter {
@@ -77,6 +82,17 @@ func flushBuf(store kv.Store, writes []*batchWriterEntry) error {
 	return nil
 }
 
+func (w *batchIndexCommitmentWriter) markDeleted(primaryKey []byte) {
+	if w.deleted == nil {
+		w.deleted = map[string]bool{}
+	}
+	w.deleted[string(primaryKey)] = true
+}
+
+func (w *batchIndexCommitmentWriter) isDeleted(primaryKey []byte) bool {
+	return w.deleted[string(primaryKey)]
+}
+
 // Close discards any pending writes and should generally be called using
 // a defer statement.
 func (w *batchIndexCommitmentWriter) Close() {
@@ -84,6 +100,7 @@ func (w *batchIndexCommitmentWriter) Close() {
 	w.commitmentWriter.curBuf = nil
 	w.indexWriter.prevBufs = nil
 	w.indexWriter.curBuf = nil
+	w.deleted = nil
 }
 
 type batchWriterEntry struct {
@@ -182,6 +182,12 @@ func Build(options Options) (Table, error) {
 	table.indexesById[primaryKeyId] = pkIndex
 	table.indexes = append(table.indexes, pkIndex)
 
+	if tableDesc.PrimaryKey.References != "" {
+		table.references = append(table.references, newReference(
+			table, pkIndex, pkCodec.KeyCodec, tableDesc.PrimaryKey.References, tableDesc.PrimaryKey.OnDelete,
+		))
+	}
+
 	for _, idxDesc := range tableDesc.Index {
 		id := idxDesc.Id
 		if id == 0 || id >= indexIdLimit {
@@ -195,6 +201,7 @@ func Build(options Options) (Table, error) {
 		idxFields := fieldnames.CommaSeparatedFieldNames(idxDesc.Fields)
 		idxPrefix := encodeutil.AppendVarUInt32(prefix, id)
 		var index concreteIndex
+		var keyCodec *ormkv.KeyCodec
 
 		// altNames contains all the alternative "names" of this index
 		altNames := map[fieldnames.FieldNames]bool{idxFields: true}
@@ -217,6 +224,7 @@ func Build(options Options) (Table, error) {
 			}
 			table.uniqueIndexesByFields[idxFields] = uniqIdx
 			index = uniqIdx
+			keyCodec = uniqCdc.GetKeyCodec()
 		} else {
 			idxCdc, err := ormkv.NewIndexKeyCodec(
 				idxPrefix,
@@ -233,6 +241,7 @@ func Build(options Options) (Table, error) {
 				primaryKey:     pkIndex,
 				getReadBackend: getReadBackend,
 			}
+			keyCodec = idxCdc.KeyCodec
 
 			// non-unique indexes can sometimes be named by several sub-lists of
 			// fields and we need to handle all of them. For example consider,
@@ -275,6 +284,12 @@ func Build(options Options) (Table, error) {
 			table.indexesByFields[name] = index
 		}
 
+		if idxDesc.References != "" {
+			table.references = append(table.references, newReference(
+				table, index, keyCodec, idxDesc.References, idxDesc.OnDelete,
+			))
+		}
+
 		table.entryCodecsById[id] = index
 		table.indexesById[id] = index
 		table.indexes = append(table.indexes, index)
@@ -67,42 +67,75 @@ var _ Index = &indexKeyIndex{}
 func (i indexKeyIndex) doNotImplement() {}
 
 func (i indexKeyIndex) onInsert(store kv.Store, message protoreflect.Message) error {
-	k, v, err := i.EncodeKVFromMessage(message)
+	_, keys, err := i.EncodeKeysFromMessage(message)
 	if err != nil {
 		return err
 	}
-	return store.Set(k, v)
-}
 
-func (i indexKeyIndex) onUpdate(store kv.Store, new, existing protoreflect.Message) error {
-	newValues := i.GetKeyValues(new)
-	existingValues := i.GetKeyValues(existing)
-	if i.CompareKeys(newValues, existingValues) == 0 {
-		return nil
+	for _, key := range keys {
+		err = store.Set(key, []byte{})
+		if err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	existingKey, err := i.EncodeKey(existingValues)
+func (i indexKeyIndex) onUpdate(store kv.Store, new, existing protoreflect.Message) error {
+	_, newKeys, err := i.EncodeKeysFromMessage(new)
 	if err != nil {
 		return err
 	}
-	err = store.Delete(existingKey)
+
+	_, existingKeys, err := i.EncodeKeysFromMessage(existing)
 	if err != nil {
 		return err
 	}
 
-	newKey, err := i.EncodeKey(newValues)
-	if err != nil {
-		return err
+	newKeySet := make(map[string]bool, len(newKeys))
+	for _, key := range newKeys {
+		newKeySet[string(key)] = true
 	}
-	return store.Set(newKey, []byte{})
+
+	existingKeySet := make(map[string]bool, len(existingKeys))
+	for _, key := range existingKeys {
+		existingKeySet[string(key)] = true
+		if newKeySet[string(key)] {
+			continue
+		}
+
+		err = store.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, key := range newKeys {
+		if existingKeySet[string(key)] {
+			continue
+		}
+
+		err = store.Set(key, []byte{})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (i indexKeyIndex) onDelete(store kv.Store, message protoreflect.Message) error {
-	_, key, err := i.EncodeKeyFromMessage(message)
+	_, keys, err := i.EncodeKeysFromMessage(message)
 	if err != nil {
 		return err
 	}
-	return store.Delete(key)
+
+	for _, key := range keys {
+		err = store.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (i indexKeyIndex) readValueFromIndexKey(backend ReadBackend, primaryKey []protoreflect.Value, _ []byte, message proto.Message) error {
@@ -0,0 +1,223 @@
+package ormtable
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/orm/encoding/encodeutil"
+	"github.com/cosmos/cosmos-sdk/orm/types/kv"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+)
+
+// ExportRows returns all the rows of the table ordered by primary key and,
+// for auto-increment tables, the last value of the table sequence. It is
+// intended for schema migrations which need to move rows to a different
+// table definition and loads all the rows of the table in memory.
+func ExportRows(ctx context.Context, table Table) (rows []proto.Message, lastSeq uint64, err error) {
+	impl := getTableImpl(table)
+	if impl == nil {
+		return nil, 0, ormerrors.UnsupportedOperation.Wrapf("can't export rows of %T", table)
+	}
+
+	backend, err := impl.getReadBackend(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if autoInc, ok := table.(*autoIncrementTable); ok {
+		lastSeq, err = autoInc.curSeqValue(backend.IndexStoreReader())
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	it, err := prefixIterator(backend.CommitmentStoreReader(), backend, impl.primaryKeyIndex, impl.KeyCodec, nil, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer it.Close()
+
+	for it.Next() {
+		msg, err := it.GetMessage()
+		if err != nil {
+			return nil, 0, err
+		}
+		rows = append(rows, msg)
+	}
+
+	return rows, lastSeq, nil
+}
+
+// ImportRows inserts rows exported with ExportRows into the table. Rows of
+// auto-increment tables keep their IDs, unless they are unset in which case
+// new IDs are generated, and the table sequence is set to at least lastSeq.
+// Like ImportJSON, ImportRows isn't atomic and calls the backend hooks.
+func ImportRows(ctx context.Context, table Table, rows []proto.Message, lastSeq uint64) error {
+	impl := getTableImpl(table)
+	if impl == nil {
+		return ormerrors.UnsupportedOperation.Wrapf("can't import rows into %T", table)
+	}
+
+	backend, err := impl.getBackend(ctx)
+	if err != nil {
+		return err
+	}
+
+	autoInc, ok := table.(*autoIncrementTable)
+	if !ok {
+		for _, row := range rows {
+			err = impl.save(backend, row, saveModeInsert)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// the sequence must be updated before generating new IDs
+	seq := lastSeq
+	for _, row := range rows {
+		if id := row.ProtoReflect().Get(autoInc.autoIncField).Uint(); id > seq {
+			seq = id
+		}
+	}
+
+	err = autoInc.setSeqValue(backend.IndexStore(), seq)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		if row.ProtoReflect().Get(autoInc.autoIncField).Uint() == 0 {
+			_, err = autoInc.save(backend, row, saveModeInsert)
+		} else {
+			err = impl.save(backend, row, saveModeInsert)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ClearTable deletes all the rows of the table as well as their index entries
+// and the table sequence without calling hooks or enforcing references.
+func ClearTable(ctx context.Context, table Table) error {
+	impl := getTableImpl(table)
+	if impl == nil {
+		return ormerrors.UnsupportedOperation.Wrapf("can't clear %T", table)
+	}
+
+	backend, err := impl.getBackend(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = deletePrefix(backend.CommitmentStore(), impl.tablePrefix)
+	if err != nil {
+		return err
+	}
+
+	return deletePrefix(backend.IndexStore(), impl.tablePrefix)
+}
+
+// ClearIndex deletes all the entries stored for the index with the provided
+// ID in the table. The index doesn't need to be defined by the table so that
+// the entries of indexes removed from a schema can be deleted.
+func ClearIndex(ctx context.Context, table Table, id uint32) error {
+	impl := getTableImpl(table)
+	if impl == nil {
+		return ormerrors.UnsupportedOperation.Wrapf("can't clear index of %T", table)
+	}
+
+	if id == primaryKeyId || id >= indexIdLimit {
+		return ormerrors.InvalidIndexId.Wrapf("can't clear index %d of %s", id, table.MessageType().Descriptor().FullName())
+	}
+
+	backend, err := impl.getBackend(ctx)
+	if err != nil {
+		return err
+	}
+
+	return deletePrefix(backend.IndexStore(), encodeutil.AppendVarUInt32(impl.tablePrefix, id))
+}
+
+// RebuildIndex deletes all the entries of the index with the provided ID and
+// writes them again from the rows of the table. It is used when an index is
+// added to a table or when its definition changes.
+func RebuildIndex(ctx context.Context, table Table, id uint32) error {
+	impl := getTableImpl(table)
+	if impl == nil {
+		return ormerrors.UnsupportedOperation.Wrapf("can't rebuild index of %T", table)
+	}
+
+	idx, ok := impl.indexesById[id].(indexer)
+	if !ok || id == primaryKeyId {
+		return ormerrors.CantFindIndex.Wrapf("id %d on table %s", id, table.MessageType().Descriptor().FullName())
+	}
+
+	err := ClearIndex(ctx, table, id)
+	if err != nil {
+		return err
+	}
+
+	backend, err := impl.getBackend(ctx)
+	if err != nil {
+		return err
+	}
+
+	// we batch writes while the iterator is still open
+	writer := newBatchIndexCommitmentWriter(backend)
+	defer writer.Close()
+
+	it, err := prefixIterator(backend.CommitmentStoreReader(), backend, impl.primaryKeyIndex, impl.KeyCodec, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	for it.Next() {
+		msg, err := it.GetMessage()
+		if err != nil {
+			it.Close()
+			return err
+		}
+
+		err = idx.onInsert(writer.IndexStore(), msg.ProtoReflect())
+		if err != nil {
+			it.Close()
+			return err
+		}
+	}
+
+	it.Close()
+	return writer.Write()
+}
+
+// deletePrefix deletes all the keys of the store starting with prefix.
+func deletePrefix(store kv.Store, prefix []byte) error {
+	it, err := store.Iterator(prefix, prefixEndBytes(prefix))
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+
+	err = it.Close()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		err = store.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -22,6 +22,9 @@ type primaryKeyIndex struct {
 	indexers       []indexer
 	getBackend     func(context.Context) (Backend, error)
 	getReadBackend func(context.Context) (ReadBackend, error)
+
+	// referencedBy are the references of other tables to this primary key.
+	referencedBy []*reference
 }
 
 func (p primaryKeyIndex) List(ctx context.Context, prefixKey []interface{}, options ...ormlist.Option) (Iterator, error) {
@@ -143,6 +146,18 @@ func (p primaryKeyIndex) doDeleteWithWriteBatch(backend Backend, writer *batchIn
 		}
 	}
 
+	mref := message.ProtoReflect()
+	writer.markDeleted(primaryKeyBz)
+	if len(p.referencedBy) != 0 {
+		pkValues := p.GetKeyValues(mref)
+		for _, ref := range p.referencedBy {
+			err := ref.onReferencedDelete(backend, writer, pkValues)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	// delete object
 	err := writer.CommitmentStore().Delete(primaryKeyBz)
 	if err != nil {
@@ -150,7 +165,6 @@ func (p primaryKeyIndex) doDeleteWithWriteBatch(backend Backend, writer *batchIn
 	}
 
 	// clear indexes
-	mref := message.ProtoReflect()
 	indexStoreWriter := writer.IndexStore()
 	for _, idx := range p.indexers {
 		err := idx.onDelete(indexStoreWriter, mref)
@@ -209,6 +223,12 @@ func (p primaryKeyIndex) deleteByIterator(ctx context.Context, it Iterator) erro
 			return err
 		}
 
+		// rows can be listed more than once by multi-entry indexes or be
+		// deleted already by a cascading delete
+		if writer.isDeleted(pkBz) {
+			continue
+		}
+
 		err = p.doDeleteWithWriteBatch(backend, writer, pkBz, msg)
 		if err != nil {
 			return err
@@ -0,0 +1,297 @@
+package ormtable
+
+import (
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	ormv1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1alpha1"
+	"github.com/cosmos/cosmos-sdk/orm/encoding/ormkv"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+)
+
+// reference is a foreign key constraint between the leading fields of a key
+// of the referencing table and the primary key of the referenced table.
+type reference struct {
+	// tableName is the full name of the referenced table.
+	tableName protoreflect.FullName
+
+	// table is the referencing table.
+	table *tableImpl
+
+	// index is the referencing index, which can be the primary key.
+	index concreteIndex
+
+	// codec is the key codec of the referencing index.
+	codec *ormkv.KeyCodec
+
+	onDelete ormv1alpha1.ReferenceDeleteMode
+
+	// referenced is the primary key of the referenced table. It is set by
+	// ResolveReferences.
+	referenced *primaryKeyIndex
+}
+
+func newReference(table *tableImpl, index concreteIndex, codec *ormkv.KeyCodec, name string, onDelete ormv1alpha1.ReferenceDeleteMode) *reference {
+	tableName := protoreflect.FullName(name)
+	if !strings.Contains(name, ".") {
+		// unqualified names are resolved within the package of the referencing table
+		tableName = table.MessageType().Descriptor().ParentFile().Package().Append(protoreflect.Name(name))
+	}
+
+	return &reference{
+		tableName: tableName,
+		table:     table,
+		index:     index,
+		codec:     codec,
+		onDelete:  onDelete,
+	}
+}
+
+// referenceValues returns the values of the referencing fields of message
+// and whether the reference is set, meaning that at least one of these fields
+// is populated.
+func (r *reference) referenceValues(message protoreflect.Message) (values []protoreflect.Value, isSet bool) {
+	n := len(r.referenced.GetFieldNames())
+	return r.codec.GetKeyValues(message)[:n], r.codec.HasKeyValues(message, n)
+}
+
+// checkOnSave checks that the row referenced by the new message exists. The
+// existing message is nil on insert.
+func (r *reference) checkOnSave(backend ReadBackend, message, existing protoreflect.Message) error {
+	values, isSet := r.referenceValues(message)
+	if !isSet {
+		return nil
+	}
+
+	if existing != nil {
+		existingValues, existingSet := r.referenceValues(existing)
+		if existingSet && r.referenced.CompareKeys(values, existingValues) == 0 {
+			return nil
+		}
+	}
+
+	found, err := r.referenced.has(backend, values)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return ormerrors.ReferenceConstraintViolation.Wrapf(
+			"%s references a %s which does not exist: %+v",
+			message.Descriptor().FullName(), r.tableName, values,
+		)
+	}
+
+	return nil
+}
+
+// onReferencedDelete is called before the row with the primary key values
+// of the referenced table is deleted and applies the delete mode to the rows
+// referencing it.
+func (r *reference) onReferencedDelete(backend Backend, writer *batchIndexCommitmentWriter, values []protoreflect.Value) error {
+	store := backend.IndexStoreReader()
+	if _, ok := r.index.(*primaryKeyIndex); ok {
+		store = backend.CommitmentStoreReader()
+	}
+
+	prefix := make([]interface{}, len(values))
+	for i, value := range values {
+		prefix[i] = value.Interface()
+	}
+
+	it, err := prefixIterator(store, backend, r.index, r.codec, prefix, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for it.Next() {
+		_, pk, err := it.Keys()
+		if err != nil {
+			return err
+		}
+
+		pkBz, err := r.table.EncodeKey(pk)
+		if err != nil {
+			return err
+		}
+
+		// skip rows which are already being deleted, such as rows which
+		// reference themselves or each other
+		if writer.isDeleted(pkBz) {
+			continue
+		}
+
+		if r.onDelete != ormv1alpha1.ReferenceDeleteMode_REFERENCE_DELETE_MODE_CASCADE {
+			return ormerrors.ReferenceConstraintViolation.Wrapf(
+				"%s is referenced by %s: %+v",
+				r.tableName, r.table.MessageType().Descriptor().FullName(), values,
+			)
+		}
+
+		msg, err := it.GetMessage()
+		if err != nil {
+			return err
+		}
+
+		err = r.table.primaryKeyIndex.doDeleteWithWriteBatch(backend, writer, pkBz, msg)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResolveReferences validates the references declared by the primary keys
+// and indexes of the provided tables, and links them so that the reference
+// constraints are enforced when inserting, updating and deleting rows. The
+// referenced tables must be among the provided tables and defined in the
+// same file as the referencing tables.
+func ResolveReferences(tables []Table) error {
+	tablesByName := map[protoreflect.FullName]*tableImpl{}
+	for _, table := range tables {
+		if impl := getTableImpl(table); impl != nil {
+			tablesByName[table.MessageType().Descriptor().FullName()] = impl
+		}
+	}
+
+	// pkReferences is used to detect circular primary key references
+	pkReferences := map[protoreflect.FullName]protoreflect.FullName{}
+
+	for _, table := range tables {
+		if _, ok := table.(*singleton); ok {
+			continue
+		}
+
+		impl := getTableImpl(table)
+		if impl == nil {
+			continue
+		}
+
+		for _, ref := range impl.references {
+			desc := impl.MessageType().Descriptor()
+			referenced, ok := tablesByName[ref.tableName]
+			if !ok {
+				return ormerrors.InvalidReference.Wrapf("%s references unknown table %s", desc.FullName(), ref.tableName)
+			}
+
+			if referenced.MessageType().Descriptor().ParentFile().Path() != desc.ParentFile().Path() {
+				return ormerrors.InvalidReference.Wrapf("%s references %s which is defined in a different file", desc.FullName(), ref.tableName)
+			}
+
+			pkFields := referenced.primaryKeyIndex.GetFieldDescriptors()
+			if len(pkFields) == 0 {
+				return ormerrors.InvalidReference.Wrapf("%s references %s which is not a table", desc.FullName(), ref.tableName)
+			}
+
+			fields := ref.codec.GetFieldDescriptors()
+			if len(fields) < len(pkFields) {
+				return ormerrors.InvalidReference.Wrapf(
+					"%s has %d fields referencing %s, expected at least %d",
+					desc.FullName(), len(fields), ref.tableName, len(pkFields),
+				)
+			}
+
+			if i, ok := ref.codec.MultiEntryField(); ok && i < len(pkFields) {
+				return ormerrors.InvalidReference.Wrapf(
+					"%s references %s with the repeated field %s",
+					desc.FullName(), ref.tableName, ref.codec.GetFieldNames()[i],
+				)
+			}
+
+			for i, pkField := range pkFields {
+				if !sameFieldType(fields[i], pkField) {
+					return ormerrors.InvalidReference.Wrapf(
+						"field %s of %s does not have the same type as field %s of the primary key of %s",
+						fields[i].Name(), desc.FullName(), pkField.Name(), ref.tableName,
+					)
+				}
+			}
+
+			if _, ok := ref.index.(*primaryKeyIndex); ok {
+				pkReferences[desc.FullName()] = ref.tableName
+			}
+
+			ref.referenced = referenced.primaryKeyIndex
+		}
+	}
+
+	for name := range pkReferences {
+		visited := map[protoreflect.FullName]bool{name: true}
+		for next, ok := pkReferences[name]; ok; next, ok = pkReferences[next] {
+			if visited[next] {
+				return ormerrors.InvalidReference.Wrapf("circular primary key references from %s", name)
+			}
+			visited[next] = true
+		}
+	}
+
+	// link the references to the referenced tables once they are all valid
+	for _, table := range tables {
+		impl := getTableImpl(table)
+		if impl == nil {
+			continue
+		}
+
+		for _, ref := range impl.references {
+			ref.referenced.referencedBy = append(ref.referenced.referencedBy, ref)
+		}
+	}
+
+	return nil
+}
+
+// ReferencedTables returns the sorted names of the tables referenced by the
+// primary key and indexes of the provided table.
+func ReferencedTables(table Table) []protoreflect.FullName {
+	impl := getTableImpl(table)
+	if impl == nil {
+		return nil
+	}
+
+	seen := map[protoreflect.FullName]bool{}
+	var names []protoreflect.FullName
+	for _, ref := range impl.references {
+		if !seen[ref.tableName] {
+			seen[ref.tableName] = true
+			names = append(names, ref.tableName)
+		}
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		return names[i] < names[j]
+	})
+
+	return names
+}
+
+func getTableImpl(table Table) *tableImpl {
+	switch table := table.(type) {
+	case *tableImpl:
+		return table
+	case *autoIncrementTable:
+		return table.tableImpl
+	case *singleton:
+		return table.tableImpl
+	default:
+		return nil
+	}
+}
+
+func sameFieldType(a, b protoreflect.FieldDescriptor) bool {
+	if a.Kind() != b.Kind() {
+		return false
+	}
+
+	switch a.Kind() {
+	case protoreflect.MessageKind:
+		return a.Message().FullName() == b.Message().FullName()
+	case protoreflect.EnumKind:
+		return a.Enum().FullName() == b.Enum().FullName()
+	default:
+		return true
+	}
+}
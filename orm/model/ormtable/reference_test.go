@@ -0,0 +1,141 @@
+package ormtable_test
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"gotest.tools/v3/assert"
+
+	ormv1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1alpha1"
+	"github.com/cosmos/cosmos-sdk/orm/internal/testkv"
+	"github.com/cosmos/cosmos-sdk/orm/internal/testpb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+)
+
+func buildTable(t *testing.T, message proto.Message, desc *ormv1alpha1.TableDescriptor) ormtable.Table {
+	table, err := ormtable.Build(ormtable.Options{
+		MessageType:     message.ProtoReflect().Type(),
+		TableDescriptor: desc,
+	})
+	assert.NilError(t, err)
+	return table
+}
+
+func TestResolveReferences(t *testing.T) {
+	supply := buildTable(t, &testpb.Supply{}, &ormv1alpha1.TableDescriptor{
+		Id:         2,
+		PrimaryKey: &ormv1alpha1.PrimaryKeyDescriptor{Fields: "denom"},
+	})
+	singleton, err := ormtable.Build(ormtable.Options{
+		MessageType: (&testpb.ExampleSingleton{}).ProtoReflect().Type(),
+	})
+	assert.NilError(t, err)
+
+	testCases := []struct {
+		name   string
+		desc   *ormv1alpha1.TableDescriptor
+		tables []ormtable.Table
+		expErr string
+	}{
+		{
+			name: "valid index reference",
+			desc: &ormv1alpha1.TableDescriptor{
+				Id:         1,
+				PrimaryKey: &ormv1alpha1.PrimaryKeyDescriptor{Fields: "address,denom"},
+				Index:      []*ormv1alpha1.SecondaryIndexDescriptor{{Id: 1, Fields: "denom", References: "Supply"}},
+			},
+			tables: []ormtable.Table{supply},
+		},
+		{
+			name: "unknown table",
+			desc: &ormv1alpha1.TableDescriptor{
+				Id:         1,
+				PrimaryKey: &ormv1alpha1.PrimaryKeyDescriptor{Fields: "address,denom"},
+				Index:      []*ormv1alpha1.SecondaryIndexDescriptor{{Id: 1, Fields: "denom", References: "Supply"}},
+			},
+			expErr: "unknown table testpb.Supply",
+		},
+		{
+			name: "different file",
+			desc: &ormv1alpha1.TableDescriptor{
+				Id:         1,
+				PrimaryKey: &ormv1alpha1.PrimaryKeyDescriptor{Fields: "address,denom"},
+				Index:      []*ormv1alpha1.SecondaryIndexDescriptor{{Id: 1, Fields: "denom", References: "ExampleSingleton"}},
+			},
+			tables: []ormtable.Table{singleton},
+			expErr: "different file",
+		},
+		{
+			name: "type mismatch",
+			desc: &ormv1alpha1.TableDescriptor{
+				Id:         1,
+				PrimaryKey: &ormv1alpha1.PrimaryKeyDescriptor{Fields: "address,denom"},
+				Index:      []*ormv1alpha1.SecondaryIndexDescriptor{{Id: 1, Fields: "amount", References: "Supply"}},
+			},
+			tables: []ormtable.Table{supply},
+			expErr: "does not have the same type",
+		},
+		{
+			name: "circular primary key reference",
+			desc: &ormv1alpha1.TableDescriptor{
+				Id:         1,
+				PrimaryKey: &ormv1alpha1.PrimaryKeyDescriptor{Fields: "address,denom", References: "Balance"},
+			},
+			expErr: "circular",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			balance := buildTable(t, &testpb.Balance{}, tc.desc)
+			err := ormtable.ResolveReferences(append([]ormtable.Table{balance}, tc.tables...))
+			if tc.expErr != "" {
+				assert.ErrorIs(t, err, ormerrors.InvalidReference)
+				assert.ErrorContains(t, err, tc.expErr)
+			} else {
+				assert.NilError(t, err)
+			}
+		})
+	}
+}
+
+func TestReferenceDeleteModes(t *testing.T) {
+	for _, mode := range []ormv1alpha1.ReferenceDeleteMode{
+		ormv1alpha1.ReferenceDeleteMode_REFERENCE_DELETE_MODE_RESTRICT,
+		ormv1alpha1.ReferenceDeleteMode_REFERENCE_DELETE_MODE_CASCADE,
+	} {
+		t.Run(mode.String(), func(t *testing.T) {
+			supply := buildTable(t, &testpb.Supply{}, &ormv1alpha1.TableDescriptor{
+				Id:         2,
+				PrimaryKey: &ormv1alpha1.PrimaryKeyDescriptor{Fields: "denom"},
+			})
+			balance := buildTable(t, &testpb.Balance{}, &ormv1alpha1.TableDescriptor{
+				Id:         1,
+				PrimaryKey: &ormv1alpha1.PrimaryKeyDescriptor{Fields: "address,denom"},
+				Index: []*ormv1alpha1.SecondaryIndexDescriptor{
+					{Id: 1, Fields: "denom", References: "testpb.Supply", OnDelete: mode},
+				},
+			})
+			assert.NilError(t, ormtable.ResolveReferences([]ormtable.Table{balance, supply}))
+
+			ctx := ormtable.WrapContextDefault(testkv.NewSplitMemBackend())
+			err := balance.Insert(ctx, &testpb.Balance{Address: "bob", Denom: "foo", Amount: 10})
+			assert.ErrorIs(t, err, ormerrors.ReferenceConstraintViolation)
+
+			assert.NilError(t, supply.Insert(ctx, &testpb.Supply{Denom: "foo", Amount: 10}))
+			assert.NilError(t, balance.Insert(ctx, &testpb.Balance{Address: "bob", Denom: "foo", Amount: 10}))
+
+			err = supply.Delete(ctx, &testpb.Supply{Denom: "foo"})
+			found, hasErr := balance.Has(ctx, &testpb.Balance{Address: "bob", Denom: "foo"})
+			assert.NilError(t, hasErr)
+			if mode == ormv1alpha1.ReferenceDeleteMode_REFERENCE_DELETE_MODE_CASCADE {
+				assert.NilError(t, err)
+				assert.Assert(t, !found)
+			} else {
+				assert.ErrorIs(t, err, ormerrors.ReferenceConstraintViolation)
+				assert.Assert(t, found)
+			}
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/cosmos/cosmos-sdk/orm/encoding/ormkv"
 )
 
 // singleton implements a Table instance for singletons.
@@ -100,3 +102,13 @@ func (t *singleton) GetTable(message proto.Message) Table {
 	}
 	return nil
 }
+
+// DecodeEntry decodes the singleton entry, whose key is just the table prefix
+// without any index ID.
+func (t singleton) DecodeEntry(k, v []byte) (ormkv.Entry, error) {
+	return t.PrimaryKeyCodec.DecodeEntry(k, v)
+}
+
+func (t singleton) EncodeEntry(entry ormkv.Entry) (k, v []byte, err error) {
+	return t.PrimaryKeyCodec.EncodeEntry(entry)
+}
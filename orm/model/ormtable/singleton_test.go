@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/orm/encoding/ormkv"
 	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
 
 	"google.golang.org/protobuf/testing/protocmp"
@@ -37,6 +38,20 @@ func TestSingleton(t *testing.T) {
 	assert.NilError(t, err)
 	assert.DeepEqual(t, val, val2, protocmp.Transform())
 
+	backend := testkv.NewSplitMemBackend()
+	assert.NilError(t, store.Save(ormtable.WrapContextDefault(backend), val))
+	it, err := backend.CommitmentStore().Iterator(nil, nil)
+	assert.NilError(t, err)
+	assert.Assert(t, it.Valid())
+	entry, err := table.DecodeEntry(it.Key(), it.Value())
+	assert.NilError(t, err)
+	assert.DeepEqual(t, val, entry.(*ormkv.PrimaryKeyEntry).Value, protocmp.Transform())
+	k, v, err := table.EncodeEntry(entry)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, it.Key(), k)
+	assert.DeepEqual(t, it.Value(), v)
+	assert.NilError(t, it.Close())
+
 	buf := &bytes.Buffer{}
 	assert.NilError(t, table.ExportJSON(ctx, buf))
 	assert.NilError(t, table.ValidateJSON(bytes.NewReader(buf.Bytes())))
@@ -63,6 +63,9 @@ type Table interface {
 	// If store implement the Hooks interface, the appropriate OnInsert or
 	// OnUpdate hook method will be called.
 	//
+	// If the primary key or an index of the table references another table,
+	// Save fails if the referenced row does not exist.
+	//
 	// Save attempts to be atomic with respect to the underlying store,
 	// meaning that either the full save operation is written or the store is
 	// left unchanged, unless there is an error with the underlying store.
@@ -84,6 +87,10 @@ type Table interface {
 	// If store implement the Hooks interface, the OnDelete hook method will
 	// be called.
 	//
+	// If other tables reference the entry, Delete either fails or also
+	// deletes the referencing entries depending on the on_delete mode of
+	// the reference.
+	//
 	// Delete attempts to be atomic with respect to the underlying store,
 	// meaning that either the full save operation is written or the store is
 	// left unchanged, unless there is an error with the underlying store.
@@ -12,6 +12,7 @@ import (
 
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/cosmos/cosmos-sdk/orm/encoding/encodeutil"
 	"github.com/cosmos/cosmos-sdk/orm/encoding/ormkv"
@@ -30,6 +31,7 @@ type tableImpl struct {
 	tableId               uint32
 	typeResolver          TypeResolver
 	customJSONValidator   func(message proto.Message) error
+	references            []*reference
 }
 
 func (t *tableImpl) GetTable(message proto.Message) Table {
@@ -117,6 +119,23 @@ func (t tableImpl) doSave(writer *batchIndexCommitmentWriter, message proto.Mess
 		}
 	}
 
+	var existingMref protoreflect.Message
+	if haveExisting {
+		existingMref = existing.ProtoReflect()
+	}
+
+	for _, ref := range t.references {
+		// references are only enforced once resolved
+		if ref.referenced == nil {
+			continue
+		}
+
+		err = ref.checkOnSave(writer, mref, existingMref)
+		if err != nil {
+			return err
+		}
+	}
+
 	// temporarily clear primary key
 	t.ClearValues(mref)
 
@@ -141,7 +160,6 @@ func (t tableImpl) doSave(writer *batchIndexCommitmentWriter, message proto.Mess
 
 		}
 	} else {
-		existingMref := existing.ProtoReflect()
 		for _, idx := range t.indexers {
 			err = idx.onUpdate(indexStoreWriter, mref, existingMref)
 			if err != nil {
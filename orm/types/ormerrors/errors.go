@@ -39,4 +39,6 @@ var (
 	TableNotFound                 = errors.New(codespace, 27, "table not found")
 	JSONValidationError           = errors.New(codespace, 28, "invalid JSON")
 	NotFound                      = errors.New(codespace, 29, "not found")
+	InvalidReference              = errors.New(codespace, 30, "invalid table reference")
+	ReferenceConstraintViolation  = errors.New(codespace, 31, "reference constraint violation")
 )
@@ -7,9 +7,14 @@ set -eo pipefail
 protoc_gen_gopulsar() {
   go install github.com/cosmos/cosmos-proto/cmd/protoc-gen-go-pulsar@latest 2>/dev/null
   go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@latest 2>/dev/null
+  go install google.golang.org/protobuf/cmd/protoc-gen-go@latest 2>/dev/null
+  (cd orm; go install ./cmd/protoc-gen-go-cosmos-orm)
 }
 
 protoc_gen_gopulsar
 
 echo "Generating API module"
-(cd proto; buf generate --template buf.gen.pulsar.yaml)
+(cd proto; buf generate --template buf.gen.pulsar.yaml --exclude-path cosmos/group/state)
+
+echo "Generating x/group ORM state"
+(cd proto; buf generate --template buf.gen.orm.yaml --path cosmos/group/state)
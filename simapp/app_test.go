@@ -24,8 +24,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/capability"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
+	epochingmodule "github.com/cosmos/cosmos-sdk/x/epoching/module"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	feemarketmodule "github.com/cosmos/cosmos-sdk/x/feemarket/module"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	group "github.com/cosmos/cosmos-sdk/x/group/module"
@@ -33,6 +35,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	tokenfactorymodule "github.com/cosmos/cosmos-sdk/x/tokenfactory/module"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 )
 
@@ -129,8 +132,13 @@ func TestRunMigrations(t *testing.T) {
 			false, "", true, "no migration found for module bank from version 2 to version 3: not found", 0,
 		},
 		{
-			"can register 2->3 migration handler for x/bank, can run migration",
+			"can register 2->3 migration handler for x/bank, cannot run migration",
 			"bank", 2,
+			false, "", true, "no migration found for module bank from version 3 to version 4: not found", 0,
+		},
+		{
+			"can register 3->4 migration handler for x/bank, can run migration",
+			"bank", 3,
 			false, "", false, "", 1,
 		},
 		{
@@ -189,6 +197,9 @@ func TestRunMigrations(t *testing.T) {
 					"crisis":       crisis.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
 					"capability":   capability.AppModule{}.ConsensusVersion(),
+					"epoching":     epochingmodule.AppModule{}.ConsensusVersion(),
+					"feemarket":    feemarketmodule.AppModule{}.ConsensusVersion(),
+					"tokenfactory": tokenfactorymodule.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
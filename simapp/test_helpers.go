@@ -200,7 +200,7 @@ func genesisStateWithValSet(t *testing.T,
 	})
 
 	// update total supply
-	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, balances, totalSupply, []banktypes.Metadata{})
+	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, balances, totalSupply, []banktypes.Metadata{}, []banktypes.SendEnabled{})
 	genesisState[banktypes.ModuleName] = app.AppCodec().MustMarshalJSON(bankGenesis)
 
 	return genesisState
@@ -455,6 +455,20 @@ func SignCheckDeliver(
 	return gInfo, res, err
 }
 
+// EndEpoch commits empty blocks until the end of the current epoch, so that
+// the staking messages buffered by x/epoching are executed.
+func EndEpoch(app *SimApp) {
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+	epochLength := app.EpochingKeeper.EpochLength(ctx)
+
+	for app.LastBlockHeight()%epochLength != 0 {
+		header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		app.Commit()
+	}
+}
+
 // GenSequenceOfTxs generates a set of signed transactions of messages, such
 // that they differ only by having the sequence numbers incremented between
 // every transaction.
@@ -43,6 +43,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -574,6 +575,27 @@ func (n *Network) WaitForNextBlock() error {
 	return err
 }
 
+// WaitForEpochEnd waits for the last block of the current x/epoching epoch to
+// be committed, so that the staking messages buffered until then have been
+// executed. It returns the height of that block.
+func (n *Network) WaitForEpochEnd() (int64, error) {
+	if len(n.Validators) == 0 {
+		return 0, errors.New("no validators available")
+	}
+
+	queryClient := epoching.NewQueryClient(n.Validators[0].ClientCtx)
+	res, err := queryClient.CurrentEpoch(context.Background(), &epoching.QueryCurrentEpochRequest{})
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := n.WaitForHeightWithTimeout(res.EpochEndHeight, time.Minute); err != nil {
+		return 0, err
+	}
+
+	return res.EpochEndHeight, nil
+}
+
 // Cleanup removes the root testing (temporary) directory and stops both the
 // Tendermint and API services. It allows other callers to create and start
 // test networks. This method must be called when a test is finished, typically
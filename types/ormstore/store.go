@@ -0,0 +1,85 @@
+// Package ormstore connects ORM module databases (see orm/model/ormdb) to the
+// KV stores of a module and to the module migration framework.
+package ormstore
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+	"github.com/cosmos/cosmos-sdk/orm/types/kv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// kvStore adapts a storetypes.KVStore to the kv.Store interface used by ORM
+// tables.
+type kvStore struct {
+	store storetypes.KVStore
+}
+
+var _ kv.Store = kvStore{}
+
+// NewKVStore returns a kv.Store backed by the provided KVStore.
+func NewKVStore(store storetypes.KVStore) kv.Store {
+	return kvStore{store: store}
+}
+
+func (s kvStore) Get(key []byte) ([]byte, error) {
+	return s.store.Get(key), nil
+}
+
+func (s kvStore) Has(key []byte) (bool, error) {
+	return s.store.Has(key), nil
+}
+
+func (s kvStore) Iterator(start, end []byte) (kv.Iterator, error) {
+	return s.store.Iterator(start, end), nil
+}
+
+func (s kvStore) ReverseIterator(start, end []byte) (kv.Iterator, error) {
+	return s.store.ReverseIterator(start, end), nil
+}
+
+func (s kvStore) Set(key, value []byte) error {
+	s.store.Set(key, value)
+	return nil
+}
+
+func (s kvStore) Delete(key []byte) error {
+	s.store.Delete(key)
+	return nil
+}
+
+// NewBackend returns an ORM table backend which uses the KVStore of the
+// provided key both as its commitment and index store.
+func NewBackend(ctx sdk.Context, key storetypes.StoreKey) ormtable.Backend {
+	return ormtable.NewBackend(ormtable.BackendOptions{
+		CommitmentStore: NewKVStore(ctx.KVStore(key)),
+	})
+}
+
+// ModuleDBOptions returns options for ormdb.NewModuleDB which retrieve the
+// backend of the tables from the KVStore of the provided key. The context
+// passed to the tables must wrap an sdk.Context (see sdk.WrapSDKContext).
+func ModuleDBOptions(key storetypes.StoreKey) ormdb.ModuleDBOptions {
+	return ormdb.ModuleDBOptions{
+		GetBackend: func(ctx context.Context) (ormtable.Backend, error) {
+			return NewBackend(sdk.UnwrapSDKContext(ctx), key), nil
+		},
+		GetReadBackend: func(ctx context.Context) (ormtable.ReadBackend, error) {
+			return NewBackend(sdk.UnwrapSDKContext(ctx), key), nil
+		},
+	}
+}
+
+// NewMigrationHandler returns a module.MigrationHandler which migrates the
+// ORM state of a module stored under key from the from version of its schema
+// to the to version with ormdb.Migrate. It is meant to be registered with
+// module.Configurator.RegisterMigration.
+func NewMigrationHandler(key storetypes.StoreKey, from, to ormdb.ModuleSchema) module.MigrationHandler {
+	return func(ctx sdk.Context) error {
+		return ormdb.Migrate(sdk.WrapSDKContext(ctx), from, to, ModuleDBOptions(key))
+	}
+}
@@ -0,0 +1,98 @@
+package ormstore_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	ormv1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1alpha1"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/ormstore"
+)
+
+// itemFile builds a schema file with an Item table with the provided
+// secondary indexes.
+func itemFile(t *testing.T, indexes ...*ormv1alpha1.SecondaryIndexDescriptor) protoreflect.FileDescriptor {
+	opts := &descriptorpb.MessageOptions{}
+	proto.SetExtension(opts, ormv1alpha1.E_Table, &ormv1alpha1.TableDescriptor{
+		Id:         1,
+		PrimaryKey: &ormv1alpha1.PrimaryKeyDescriptor{Fields: "id"},
+		Index:      indexes,
+	})
+
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:       proto.String("cosmos/ormstore/testing/item.proto"),
+		Package:    proto.String("cosmos.ormstore.testing"),
+		Dependency: []string{"cosmos/orm/v1alpha1/orm.proto"},
+		Syntax:     proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Item"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:   proto.String("id"),
+					Number: proto.Int32(1),
+					Type:   descriptorpb.FieldDescriptorProto_TYPE_UINT64.Enum(),
+					Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				},
+				{
+					Name:   proto.String("name"),
+					Number: proto.Int32(2),
+					Type:   descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+					Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				},
+			},
+			Options: opts,
+		}},
+	}, protoregistry.GlobalFiles)
+	require.NoError(t, err)
+	return fd
+}
+
+func TestMigrationHandler(t *testing.T) {
+	key := sdk.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_test"))
+
+	fromFile := itemFile(t)
+	toFile := itemFile(t, &ormv1alpha1.SecondaryIndexDescriptor{Id: 1, Fields: "name"})
+	itemType := dynamicpb.NewMessageType(toFile.Messages().ByName("Item"))
+	require.NoError(t, protoregistry.GlobalTypes.RegisterMessage(itemType))
+
+	from := ormdb.ModuleSchema{FileDescriptors: map[uint32]protoreflect.FileDescriptor{1: fromFile}}
+	to := ormdb.ModuleSchema{FileDescriptors: map[uint32]protoreflect.FileDescriptor{1: toFile}}
+
+	fromDB, err := ormdb.NewModuleDB(from, ormstore.ModuleDBOptions(key))
+	require.NoError(t, err)
+	table := fromDB.GetTable(itemType.New().Interface())
+	require.NotNil(t, table)
+	for i, name := range []string{"b", "a", "b"} {
+		item := itemType.New()
+		item.Set(item.Descriptor().Fields().ByName("id"), protoreflect.ValueOfUint64(uint64(i+1)))
+		item.Set(item.Descriptor().Fields().ByName("name"), protoreflect.ValueOfString(name))
+		require.NoError(t, table.Insert(sdk.WrapSDKContext(ctx), item.Interface()))
+	}
+
+	handler := ormstore.NewMigrationHandler(key, from, to)
+	require.NoError(t, handler(ctx))
+
+	toDB, err := ormdb.NewModuleDB(to, ormstore.ModuleDBOptions(key))
+	require.NoError(t, err)
+	it, err := toDB.GetTable(itemType.New().Interface()).GetIndex("name").List(sdk.WrapSDKContext(ctx), []interface{}{"b"})
+	require.NoError(t, err)
+	defer it.Close()
+
+	var ids []uint64
+	for it.Next() {
+		item := itemType.New().Interface()
+		require.NoError(t, it.UnmarshalMessage(item))
+		ids = append(ids, item.ProtoReflect().Get(item.ProtoReflect().Descriptor().Fields().ByName("id")).Uint())
+	}
+	require.Equal(t, []uint64{1, 3}, ids)
+}
@@ -13,6 +13,10 @@ import (
 func InitGenesis(ctx sdk.Context, ak keeper.AccountKeeper, data types.GenesisState) {
 	ak.SetParams(ctx, data.Params)
 
+	for _, discount := range data.MsgFeeDiscounts {
+		ak.SetMsgFeeDiscount(ctx, discount)
+	}
+
 	accounts, err := types.UnpackAccounts(data.Accounts)
 	if err != nil {
 		panic(err)
@@ -38,5 +42,5 @@ func ExportGenesis(ctx sdk.Context, ak keeper.AccountKeeper) *types.GenesisState
 		return false
 	})
 
-	return types.NewGenesisState(params, genAccounts)
+	return types.NewGenesisState(params, genAccounts, ak.GetAllMsgFeeDiscounts(ctx)...)
 }
@@ -62,8 +62,17 @@ type AccountKeeper struct {
 	// The prototypical AccountI constructor.
 	proto      func() types.AccountI
 	addressCdc address.Codec
+
+	// the address capable of executing a MsgSetMsgFeeDiscounts message, the
+	// gov module account
+	authority string
 }
 
+// govModuleName is the name of the gov module account, which is the authority
+// of the Msg fee discounts. It is not imported from x/gov, which depends on
+// x/auth.
+const govModuleName = "gov"
+
 var _ AccountKeeperI = &AccountKeeper{}
 
 // NewAccountKeeper returns a new AccountKeeperI that uses go-amino to
@@ -96,9 +105,15 @@ func NewAccountKeeper(
 		paramSubspace: paramstore,
 		permAddrs:     permAddrs,
 		addressCdc:    bech32Codec,
+		authority:     sdk.MustBech32ifyAddressBytes(bech32Prefix, types.NewModuleAddress(govModuleName)),
 	}
 }
 
+// GetAuthority returns the address capable of setting the Msg fee discounts
+func (ak AccountKeeper) GetAuthority() string {
+	return ak.authority
+}
+
 // Logger returns a module-specific logger.
 func (ak AccountKeeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
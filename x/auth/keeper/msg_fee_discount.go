@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GetMsgFeeDiscount returns the fee discount of a Msg type URL, and false if
+// the Msg type is not discounted.
+func (ak AccountKeeper) GetMsgFeeDiscount(ctx sdk.Context, msgTypeURL string) (types.MsgFeeDiscount, bool) {
+	store := ctx.KVStore(ak.key)
+	bz := store.Get(types.MsgFeeDiscountKey(msgTypeURL))
+	if bz == nil {
+		return types.MsgFeeDiscount{}, false
+	}
+
+	var discount types.MsgFeeDiscount
+	ak.cdc.MustUnmarshal(bz, &discount)
+
+	return discount, true
+}
+
+// SetMsgFeeDiscount sets the fee discount of its Msg type URL.
+func (ak AccountKeeper) SetMsgFeeDiscount(ctx sdk.Context, discount types.MsgFeeDiscount) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.MsgFeeDiscountKey(discount.MsgTypeUrl), ak.cdc.MustMarshal(&discount))
+}
+
+// DeleteMsgFeeDiscounts deletes the fee discounts of one or more Msg type URLs.
+// If a Msg type URL is provided that isn't discounted, it is ignored.
+func (ak AccountKeeper) DeleteMsgFeeDiscounts(ctx sdk.Context, msgTypeURLs ...string) {
+	store := ctx.KVStore(ak.key)
+	for _, msgTypeURL := range msgTypeURLs {
+		store.Delete(types.MsgFeeDiscountKey(msgTypeURL))
+	}
+}
+
+// IterateMsgFeeDiscounts iterates over all the stored fee discounts, in the
+// order of their Msg type URLs, and performs a callback function. Stops
+// iteration when callback returns true.
+func (ak AccountKeeper) IterateMsgFeeDiscounts(ctx sdk.Context, cb func(discount types.MsgFeeDiscount) (stop bool)) {
+	store := ctx.KVStore(ak.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.MsgFeeDiscountPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var discount types.MsgFeeDiscount
+		ak.cdc.MustUnmarshal(iterator.Value(), &discount)
+
+		if cb(discount) {
+			break
+		}
+	}
+}
+
+// GetAllMsgFeeDiscounts returns all the stored fee discounts.
+func (ak AccountKeeper) GetAllMsgFeeDiscounts(ctx sdk.Context) []types.MsgFeeDiscount {
+	var discounts []types.MsgFeeDiscount
+	ak.IterateMsgFeeDiscounts(ctx, func(discount types.MsgFeeDiscount) bool {
+		discounts = append(discounts, discount)
+		return false
+	})
+
+	return discounts
+}
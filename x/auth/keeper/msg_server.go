@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type msgServer struct {
+	AccountKeeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/auth MsgServer interface
+// for the provided AccountKeeper.
+func NewMsgServerImpl(ak AccountKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: ak}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (ms msgServer) SetMsgFeeDiscounts(goCtx context.Context, msg *types.MsgSetMsgFeeDiscounts) (*types.MsgSetMsgFeeDiscountsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if ms.authority != msg.Authority {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("expected %s got %s", ms.authority, msg.Authority)
+	}
+
+	for _, discount := range msg.MsgFeeDiscounts {
+		ms.SetMsgFeeDiscount(ctx, discount)
+	}
+
+	if len(msg.RemoveMsgTypeUrls) > 0 {
+		ms.DeleteMsgFeeDiscounts(ctx, msg.RemoveMsgTypeUrls...)
+	}
+
+	return &types.MsgSetMsgFeeDiscountsResponse{}, nil
+}
@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestSetMsgFeeDiscounts() {
+	msgServer := keeper.NewMsgServerImpl(suite.app.AccountKeeper)
+	authority := suite.app.AccountKeeper.GetAuthority()
+
+	voteDiscount := types.NewMsgFeeDiscount("/cosmos.gov.v1beta1.MsgVote", sdk.NewDecWithPrec(5, 1), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	unjailDiscount := types.NewMsgFeeDiscount("/cosmos.slashing.v1beta1.MsgUnjail", sdk.ZeroDec(), nil)
+
+	ctx, _ := suite.ctx.CacheContext()
+	suite.app.AccountKeeper.SetMsgFeeDiscount(ctx, unjailDiscount)
+
+	_, err := msgServer.SetMsgFeeDiscounts(sdk.WrapSDKContext(ctx), types.NewMsgSetMsgFeeDiscounts(
+		sdk.AccAddress("invalid").String(), []types.MsgFeeDiscount{voteDiscount}, nil,
+	))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().Equal([]types.MsgFeeDiscount{unjailDiscount}, suite.app.AccountKeeper.GetAllMsgFeeDiscounts(ctx))
+
+	_, err = msgServer.SetMsgFeeDiscounts(sdk.WrapSDKContext(ctx), types.NewMsgSetMsgFeeDiscounts(
+		authority, []types.MsgFeeDiscount{voteDiscount}, nil,
+	))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.MsgFeeDiscount{voteDiscount, unjailDiscount}, suite.app.AccountKeeper.GetAllMsgFeeDiscounts(ctx))
+
+	_, err = msgServer.SetMsgFeeDiscounts(sdk.WrapSDKContext(ctx), types.NewMsgSetMsgFeeDiscounts(
+		authority, nil, []string{unjailDiscount.MsgTypeUrl, "/cosmos.bank.v1beta1.MsgSend"},
+	))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.MsgFeeDiscount{voteDiscount}, suite.app.AccountKeeper.GetAllMsgFeeDiscounts(ctx))
+
+	discount, found := suite.app.AccountKeeper.GetMsgFeeDiscount(ctx, voteDiscount.MsgTypeUrl)
+	suite.Require().True(found)
+	suite.Require().Equal(voteDiscount, discount)
+	_, found = suite.app.AccountKeeper.GetMsgFeeDiscount(ctx, unjailDiscount.MsgTypeUrl)
+	suite.Require().False(found)
+}
@@ -9,6 +9,7 @@ import (
 // Interface provides support to use non-sdk AccountKeeper for TxHandler's middlewares.
 type AccountKeeper interface {
 	GetParams(ctx sdk.Context) (params types.Params)
+	GetMsgFeeDiscount(ctx sdk.Context, msgTypeURL string) (types.MsgFeeDiscount, bool)
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) types.AccountI
 	SetAccount(ctx sdk.Context, acc types.AccountI)
 	GetModuleAddress(moduleName string) sdk.AccAddress
@@ -18,3 +19,9 @@ type AccountKeeper interface {
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// FeeMarketKeeper defines the expected fee market keeper.
+type FeeMarketKeeper interface {
+	GetBaseGasPriceCoin(ctx sdk.Context) sdk.DecCoin
+	CollectBaseFee(ctx sdk.Context, baseFee sdk.Coins) error
+}
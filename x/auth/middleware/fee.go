@@ -34,6 +34,8 @@ func MempoolFeeMiddleware(txh tx.Handler) tx.Handler {
 // and the transaction does not meet the minimum, the transaction is rejected.
 //
 // Recall, a transaction's fee is determined by ceil(minGasPrice * gasLimit).
+// The minimum fee of the transactions discounted by MsgFeeDiscountMiddleware
+// is overridden or computed on a fraction of the gas limit instead.
 func (txh mempoolFeeTxHandler) CheckTx(ctx context.Context, req tx.Request, checkReq tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -45,6 +47,18 @@ func (txh mempoolFeeTxHandler) CheckTx(ctx context.Context, req tx.Request, chec
 	feeCoins := feeTx.GetFee()
 	gas := feeTx.GetGas()
 
+	// If the tx is discounted by MsgFeeDiscountMiddleware, its minimum fee
+	// either overrides the validator's one, or is computed on a fraction of the
+	// gas limit.
+	discount, discounted := getTxFeeDiscount(sdkCtx)
+	if discounted && discount.minFee != nil {
+		if !feeCoins.IsAnyGTE(discount.minFee) {
+			return tx.Response{}, tx.ResponseCheckTx{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, discount.minFee)
+		}
+
+		return txh.next.CheckTx(ctx, req, checkReq)
+	}
+
 	// Ensure that the provided fees meet a minimum threshold for the validator,
 	// if this is a CheckTx. This is only for local mempool purposes, and thus
 	// is only ran on check tx.
@@ -55,6 +69,9 @@ func (txh mempoolFeeTxHandler) CheckTx(ctx context.Context, req tx.Request, chec
 		// Determine the required fees by multiplying each required minimum gas
 		// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
 		glDec := sdk.NewDec(int64(gas))
+		if discounted {
+			glDec = glDec.Mul(discount.gasMultiplier)
+		}
 		for i, gp := range minGasPrices {
 			fee := gp.Amount.Mul(glDec)
 			requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
@@ -164,8 +181,14 @@ func (dfd deductFeeTxHandler) CheckTx(ctx context.Context, req tx.Request, check
 	return dfd.next.CheckTx(ctx, req, checkReq)
 }
 
-// DeliverTx implements tx.Handler.DeliverTx.
+// DeliverTx implements tx.Handler.DeliverTx. The minimum fee overrides set by
+// MsgFeeDiscountMiddleware are enforced here, as they are part of the consensus
+// rules, unlike the validators' minimum gas prices.
 func (dfd deductFeeTxHandler) DeliverTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	if err := checkMinFee(sdk.UnwrapSDKContext(ctx), req.Tx); err != nil {
+		return tx.Response{}, err
+	}
+
 	if err := dfd.checkDeductFee(ctx, req.Tx); err != nil {
 		return tx.Response{}, err
 	}
@@ -181,6 +204,26 @@ func (dfd deductFeeTxHandler) SimulateTx(ctx context.Context, req tx.Request) (t
 	return dfd.next.SimulateTx(ctx, req)
 }
 
+// checkMinFee checks that the tx pays the minimum fee overriding the validators'
+// one set by MsgFeeDiscountMiddleware, if any.
+func checkMinFee(ctx sdk.Context, sdkTx sdk.Tx) error {
+	discount, discounted := getTxFeeDiscount(ctx)
+	if !discounted || discount.minFee == nil {
+		return nil
+	}
+
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	if !ok {
+		return sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if fee := feeTx.GetFee(); !fee.IsAnyGTE(discount.minFee) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", fee, discount.minFee)
+	}
+
+	return nil
+}
+
 // Deprecated: DeductFees deducts fees from the given account.
 // This function will be private in the next release.
 func DeductFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc types.AccountI, fees sdk.Coins) error {
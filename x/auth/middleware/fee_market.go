@@ -0,0 +1,116 @@
+package middleware
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ tx.Handler = feeMarketTxHandler{}
+
+type feeMarketTxHandler struct {
+	feeMarketKeeper FeeMarketKeeper
+	next            tx.Handler
+}
+
+// FeeMarketMiddleware checks that the transaction's fee pays at least the
+// on-chain base gas price for its gas limit, and collects this base fee out of
+// the fee collector: it is burned or sent to another module account. The fee
+// above the base fee is the tip, which TxPriorityMiddleware prioritizes the
+// transaction by, instead of its whole fee. The base fee of the transactions
+// discounted by MsgFeeDiscountMiddleware is discounted too.
+// If the fee market keeper is nil, the middleware does nothing.
+// CONTRACT: Tx must implement FeeTx, and the middleware must be placed after
+// MsgFeeDiscountMiddleware and DeductFeeMiddleware, and before
+// TxPriorityMiddleware.
+func FeeMarketMiddleware(fmk FeeMarketKeeper) tx.Middleware {
+	return func(txh tx.Handler) tx.Handler {
+		if fmk == nil {
+			return txh
+		}
+
+		return feeMarketTxHandler{
+			feeMarketKeeper: fmk,
+			next:            txh,
+		}
+	}
+}
+
+// txTipKey is the sdk.Context key of the tip paid by the transaction above
+// the base fee.
+type txTipKey struct{}
+
+// collectBaseFee checks the transaction pays the base fee, collects it and
+// returns the tip paid on top of it.
+func (fmh feeMarketTxHandler) collectBaseFee(ctx context.Context, sdkTx sdk.Tx) (sdk.Coin, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	if !ok {
+		return sdk.Coin{}, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// Determine the base fee, where baseFee = ceil(baseGasPrice * gasLimit).
+	// If the tx is discounted by MsgFeeDiscountMiddleware, its minimum fee
+	// either overrides the base fee, or the base fee is computed on a fraction
+	// of the gas limit.
+	baseGasPrice := fmh.feeMarketKeeper.GetBaseGasPriceCoin(sdkCtx)
+	glDec := sdk.NewDec(int64(feeTx.GetGas()))
+	discount, discounted := getTxFeeDiscount(sdkCtx)
+	if discounted {
+		glDec = glDec.Mul(discount.gasMultiplier)
+	}
+	baseFee := sdk.NewCoin(baseGasPrice.Denom, baseGasPrice.Amount.Mul(glDec).Ceil().RoundInt())
+	if discounted && discount.minFee != nil {
+		baseFee = sdk.NewCoin(baseGasPrice.Denom, discount.minFee.AmountOf(baseGasPrice.Denom))
+	}
+
+	paid := feeTx.GetFee().AmountOf(baseFee.Denom)
+	if paid.LT(baseFee.Amount) {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeTx.GetFee(), baseFee)
+	}
+
+	if err := fmh.feeMarketKeeper.CollectBaseFee(sdkCtx, sdk.NewCoins(baseFee)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(baseFee.Denom, paid.Sub(baseFee.Amount)), nil
+}
+
+// CheckTx implements tx.Handler.CheckTx. It rejects transactions not paying
+// the base fee, and passes the tip of the transaction to TxPriorityMiddleware.
+func (fmh feeMarketTxHandler) CheckTx(ctx context.Context, req tx.Request, checkReq tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
+	tip, err := fmh.collectBaseFee(ctx, req.Tx)
+	if err != nil {
+		return tx.Response{}, tx.ResponseCheckTx{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	ctx = sdk.WrapSDKContext(sdkCtx.WithValue(txTipKey{}, sdk.NewCoins(tip)))
+
+	return fmh.next.CheckTx(ctx, req, checkReq)
+}
+
+// DeliverTx implements tx.Handler.DeliverTx. It rejects transactions not
+// paying the base fee.
+func (fmh feeMarketTxHandler) DeliverTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	if _, err := fmh.collectBaseFee(ctx, req.Tx); err != nil {
+		return tx.Response{}, err
+	}
+
+	return fmh.next.DeliverTx(ctx, req)
+}
+
+// SimulateTx implements tx.Handler.SimulateTx. The base fee is not checked, so
+// that transactions can be simulated before their fee is known.
+func (fmh feeMarketTxHandler) SimulateTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	return fmh.next.SimulateTx(ctx, req)
+}
+
+// getTxTip returns the tip paid by the transaction above the base fee set by
+// FeeMarketMiddleware, and false if the fee market is disabled.
+func getTxTip(ctx sdk.Context) (sdk.Coins, bool) {
+	tip, ok := ctx.Value(txTipKey{}).(sdk.Coins)
+	return tip, ok
+}
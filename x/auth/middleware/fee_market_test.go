@@ -0,0 +1,182 @@
+package middleware_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+)
+
+func (s *MWTestSuite) TestFeeMarket() {
+	ctx := s.SetupTest(false) // setup
+	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+	txHandler := middleware.ComposeMiddlewares(
+		noopTxHandler,
+		middleware.DeductFeeMiddleware(
+			s.app.AccountKeeper,
+			s.app.BankKeeper,
+			s.app.FeeGrantKeeper,
+		),
+		middleware.FeeMarketMiddleware(s.app.FeeMarketKeeper),
+		middleware.TxPriorityMiddleware(middleware.GasPriceTxPriority(map[string]sdk.Dec{"atom": sdk.OneDec()})),
+	)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	acc := s.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	s.app.AccountKeeper.SetAccount(ctx, acc)
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))))
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	s.Require().NoError(txBuilder.SetMsgs(msg))
+	txBuilder.SetFeeAmount(feeAmount)
+	txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	testTx, _, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+	s.Require().NoError(err)
+
+	// the base fee is 100atom for the 200000 gas limit, out of the 150atom fee
+	params := s.app.FeeMarketKeeper.GetParams(ctx)
+	params.FeeDenom = "atom"
+	s.app.FeeMarketKeeper.SetParams(ctx, params)
+	s.app.FeeMarketKeeper.SetBaseGasPrice(ctx, sdk.NewDecWithPrec(5, 4))
+	supply := s.app.BankKeeper.GetSupply(ctx, "atom")
+
+	_, checkTxRes, err := txHandler.CheckTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx}, tx.RequestCheckTx{})
+	s.Require().NoError(err, "Middleware should not have errored on fee higher than base fee")
+	s.Require().Equal(int64(250), checkTxRes.Priority, "priority should be the tip per unit of gas")
+
+	// a tx paying the same tip for twice the gas has a lower priority
+	largeTxBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+	s.Require().NoError(largeTxBuilder.SetMsgs(msg))
+	largeTxBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 250)))
+	largeTxBuilder.SetGasLimit(2 * gasLimit)
+	largeTx, _, err := s.createTestTx(largeTxBuilder, privs, accNums, accSeqs, ctx.ChainID())
+	s.Require().NoError(err)
+	cacheCtx, _ := ctx.CacheContext()
+	_, checkTxRes, err = txHandler.CheckTx(sdk.WrapSDKContext(cacheCtx), tx.Request{Tx: largeTx}, tx.RequestCheckTx{})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(125), checkTxRes.Priority, "priority should be the tip per unit of gas")
+
+	// the tip is given to the configured TxPriorityFn
+	customTxHandler := middleware.ComposeMiddlewares(
+		noopTxHandler,
+		middleware.DeductFeeMiddleware(
+			s.app.AccountKeeper,
+			s.app.BankKeeper,
+			s.app.FeeGrantKeeper,
+		),
+		middleware.FeeMarketMiddleware(s.app.FeeMarketKeeper),
+		middleware.TxPriorityMiddleware(middleware.GasPriceTxPriority(map[string]sdk.Dec{"atom": sdk.NewDec(2)})),
+	)
+	cacheCtx, _ = ctx.CacheContext()
+	_, checkTxRes, err = customTxHandler.CheckTx(sdk.WrapSDKContext(cacheCtx), tx.Request{Tx: largeTx}, tx.RequestCheckTx{})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(250), checkTxRes.Priority, "priority should be set by the TxPriorityFn from the tip")
+
+	_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx})
+	s.Require().NoError(err, "Middleware should not have errored on fee higher than base fee")
+
+	// the base fees are burned, and the tips are kept in the fee collector
+	feeCollector := s.app.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+	s.Require().Equal(supply.SubAmount(sdk.NewInt(200)), s.app.BankKeeper.GetSupply(ctx, "atom"))
+	s.Require().Equal(sdk.NewInt64Coin("atom", 100), s.app.BankKeeper.GetBalance(ctx, feeCollector, "atom"))
+
+	// the base fee is now 200atom
+	s.app.FeeMarketKeeper.SetBaseGasPrice(ctx, sdk.NewDecWithPrec(1, 3))
+
+	_, _, err = txHandler.CheckTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx}, tx.RequestCheckTx{})
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee, "Middleware should have errored on fee lower than base fee")
+
+	_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx})
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee, "Middleware should have errored on fee lower than base fee")
+
+	_, err = txHandler.SimulateTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx})
+	s.Require().NoError(err, "Middleware should not check the base fee in SimulateTx")
+}
+
+func (s *MWTestSuite) TestFeeMarketMsgFeeDiscount() {
+	ctx := s.SetupTest(false) // setup
+	txHandler := middleware.ComposeMiddlewares(
+		noopTxHandler,
+		middleware.MsgFeeDiscountMiddleware(s.app.AccountKeeper),
+		middleware.DeductFeeMiddleware(
+			s.app.AccountKeeper,
+			s.app.BankKeeper,
+			s.app.FeeGrantKeeper,
+		),
+		middleware.FeeMarketMiddleware(s.app.FeeMarketKeeper),
+		middleware.TxPriorityMiddleware(middleware.GasPriceTxPriority(map[string]sdk.Dec{"atom": sdk.OneDec()})),
+	)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	acc := s.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	s.app.AccountKeeper.SetAccount(ctx, acc)
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))))
+
+	// a tx paying the standard test fee of 150atom for 200000 gas
+	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{acc.GetAccountNumber()}, []uint64{0}
+	testTx, _, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+	s.Require().NoError(err)
+
+	// the base fee is 200atom, above the 150atom fee
+	params := s.app.FeeMarketKeeper.GetParams(ctx)
+	params.FeeDenom = "atom"
+	s.app.FeeMarketKeeper.SetParams(ctx, params)
+	s.app.FeeMarketKeeper.SetBaseGasPrice(ctx, sdk.NewDecWithPrec(1, 3))
+
+	cacheCtx, _ := ctx.CacheContext()
+	_, _, err = txHandler.CheckTx(sdk.WrapSDKContext(cacheCtx), tx.Request{Tx: testTx}, tx.RequestCheckTx{})
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	testMsgTypeURL := sdk.MsgTypeURL(&testdata.TestMsg{})
+	feeCollector := s.app.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+
+	testCases := []struct {
+		name        string
+		discount    types.MsgFeeDiscount
+		expPriority int64
+		expBurned   int64
+	}{
+		{
+			"the gas multiplier discounts the base fee",
+			types.NewMsgFeeDiscount(testMsgTypeURL, sdk.NewDecWithPrec(5, 1), nil),
+			250, 100,
+		},
+		{
+			"the min fee overrides the base fee",
+			types.NewMsgFeeDiscount(testMsgTypeURL, sdk.OneDec(), sdk.NewCoins(sdk.NewInt64Coin("atom", 120))),
+			150, 120,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cacheCtx, _ := ctx.CacheContext()
+			s.app.AccountKeeper.SetMsgFeeDiscount(cacheCtx, tc.discount)
+			supply := s.app.BankKeeper.GetSupply(cacheCtx, "atom")
+
+			_, checkTxRes, err := txHandler.CheckTx(sdk.WrapSDKContext(cacheCtx), tx.Request{Tx: testTx}, tx.RequestCheckTx{})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expPriority, checkTxRes.Priority, "priority should be the tip above the discounted base fee per unit of gas")
+
+			_, err = txHandler.DeliverTx(sdk.WrapSDKContext(cacheCtx), tx.Request{Tx: testTx})
+			s.Require().NoError(err)
+			s.Require().Equal(supply.SubAmount(sdk.NewInt(2*tc.expBurned)), s.app.BankKeeper.GetSupply(cacheCtx, "atom"))
+			s.Require().Equal(sdk.NewInt64Coin("atom", 2*(150-tc.expBurned)), s.app.BankKeeper.GetBalance(cacheCtx, feeCollector, "atom"))
+		})
+	}
+}
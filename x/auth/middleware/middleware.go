@@ -48,6 +48,13 @@ type TxHandlerOptions struct {
 	FeegrantKeeper  FeegrantKeeper
 	SignModeHandler authsigning.SignModeHandler
 	SigGasConsumer  func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
+
+	// FeeMarketKeeper is optional, the fee market is enabled if it is set.
+	FeeMarketKeeper FeeMarketKeeper
+
+	// TxPriorityFn defines the priority of transactions in the mempool. If nil,
+	// DefaultTxPriority is used.
+	TxPriorityFn TxPriorityFn
 }
 
 // NewDefaultTxHandler defines a TxHandler middleware stacks that should work
@@ -92,6 +99,10 @@ func NewDefaultTxHandler(options TxHandlerOptions) (tx.Handler, error) {
 		// Reject all extension options which can optionally be included in the
 		// tx.
 		RejectExtensionOptionsMiddleware,
+		// `MsgFeeDiscountMiddleware` must be put before `MempoolFeeMiddleware`,
+		// `DeductFeeMiddleware` and `FeeMarketMiddleware`, which honor the fee
+		// discount it computes.
+		MsgFeeDiscountMiddleware(options.AccountKeeper),
 		MempoolFeeMiddleware,
 		ValidateBasicMiddleware,
 		TxTimeoutHeightMiddleware,
@@ -102,7 +113,10 @@ func NewDefaultTxHandler(options TxHandlerOptions) (tx.Handler, error) {
 		// `DeductFeeMiddleware` and `IncrementSequenceMiddleware` should be put outside of `WithBranchedStore` middleware,
 		// so their storage writes are not discarded when tx fails.
 		DeductFeeMiddleware(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
-		TxPriorityMiddleware,
+		// `FeeMarketMiddleware` must be put after `DeductFeeMiddleware` to collect the base fee
+		// out of the deducted fees, and before `TxPriorityMiddleware` to pass it the tip.
+		FeeMarketMiddleware(options.FeeMarketKeeper),
+		TxPriorityMiddleware(options.TxPriorityFn),
 		SetPubKeyMiddleware(options.AccountKeeper),
 		ValidateSigCountMiddleware(options.AccountKeeper),
 		SigGasConsumeMiddleware(options.AccountKeeper, sigGasConsumer),
@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ tx.Handler = msgFeeDiscountTxHandler{}
+
+type msgFeeDiscountTxHandler struct {
+	accountKeeper AccountKeeper
+	next          tx.Handler
+}
+
+// txFeeDiscountKey is the sdk.Context key of the fee discount of the
+// transaction.
+type txFeeDiscountKey struct{}
+
+// txFeeDiscount defines the fee discount of a transaction, computed from the
+// MsgFeeDiscounts of its Msgs.
+type txFeeDiscount struct {
+	// gasMultiplier scales the gas limit the minimum fee is computed from.
+	gasMultiplier sdk.Dec
+	// minFee, if not nil, overrides the minimum fee of the transaction.
+	minFee sdk.Coins
+}
+
+// MsgFeeDiscountMiddleware computes the fee discount of the transaction from
+// the MsgFeeDiscounts in the auth store, to be honored by MempoolFeeMiddleware,
+// DeductFeeMiddleware and FeeMarketMiddleware. It must thus be put before them.
+//
+// A transaction is only discounted if all of its Msgs are. Its gas multiplier
+// is then the highest gas multiplier of its Msgs, and its minimum fee, if all
+// of its Msgs set one, the sum of the minimum fees of its Msgs.
+func MsgFeeDiscountMiddleware(ak AccountKeeper) tx.Middleware {
+	return func(txh tx.Handler) tx.Handler {
+		return msgFeeDiscountTxHandler{
+			accountKeeper: ak,
+			next:          txh,
+		}
+	}
+}
+
+// withTxFeeDiscount returns the context holding the fee discount of the
+// transaction, if any.
+func (h msgFeeDiscountTxHandler) withTxFeeDiscount(ctx context.Context, sdkTx sdk.Tx) context.Context {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// The discounts are read before the tx is known to pay its fees, so no gas
+	// is consumed.
+	infiniteGasCtx := sdkCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	getDiscount := func(msgTypeURL string) (types.MsgFeeDiscount, bool) {
+		return h.accountKeeper.GetMsgFeeDiscount(infiniteGasCtx, msgTypeURL)
+	}
+
+	discount, found := computeTxFeeDiscount(getDiscount, sdkTx.GetMsgs())
+	if !found {
+		return ctx
+	}
+
+	return sdk.WrapSDKContext(sdkCtx.WithValue(txFeeDiscountKey{}, discount))
+}
+
+// CheckTx implements tx.Handler.CheckTx.
+func (h msgFeeDiscountTxHandler) CheckTx(ctx context.Context, req tx.Request, checkReq tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
+	return h.next.CheckTx(h.withTxFeeDiscount(ctx, req.Tx), req, checkReq)
+}
+
+// DeliverTx implements tx.Handler.DeliverTx.
+func (h msgFeeDiscountTxHandler) DeliverTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	return h.next.DeliverTx(h.withTxFeeDiscount(ctx, req.Tx), req)
+}
+
+// SimulateTx implements tx.Handler.SimulateTx.
+func (h msgFeeDiscountTxHandler) SimulateTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	return h.next.SimulateTx(h.withTxFeeDiscount(ctx, req.Tx), req)
+}
+
+// computeTxFeeDiscount returns the fee discount of a transaction executing the
+// given msgs, and false if the transaction is not discounted. getDiscount
+// returns the fee discount of a Msg type URL.
+func computeTxFeeDiscount(getDiscount func(msgTypeURL string) (types.MsgFeeDiscount, bool), msgs []sdk.Msg) (txFeeDiscount, bool) {
+	if len(msgs) == 0 {
+		return txFeeDiscount{}, false
+	}
+
+	txDiscount := txFeeDiscount{gasMultiplier: sdk.ZeroDec(), minFee: sdk.Coins{}}
+	for _, msg := range msgs {
+		d, found := getDiscount(sdk.MsgTypeURL(msg))
+		if !found {
+			return txFeeDiscount{}, false
+		}
+
+		txDiscount.gasMultiplier = sdk.MaxDec(txDiscount.gasMultiplier, d.GasMultiplier)
+
+		switch {
+		case d.MinFee.Empty():
+			txDiscount.minFee = nil
+		case txDiscount.minFee != nil:
+			txDiscount.minFee = txDiscount.minFee.Add(d.MinFee...)
+		}
+	}
+
+	return txDiscount, true
+}
+
+// getTxFeeDiscount returns the fee discount of the transaction set by
+// MsgFeeDiscountMiddleware, and false if the transaction is not discounted.
+func getTxFeeDiscount(ctx sdk.Context) (txFeeDiscount, bool) {
+	discount, ok := ctx.Value(txFeeDiscountKey{}).(txFeeDiscount)
+	return discount, ok
+}
@@ -0,0 +1,88 @@
+package middleware_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (s *MWTestSuite) TestMsgFeeDiscount() {
+	ctx := s.SetupTest(false) // setup
+
+	txHandler := middleware.ComposeMiddlewares(
+		noopTxHandler,
+		middleware.MsgFeeDiscountMiddleware(s.app.AccountKeeper),
+		middleware.MempoolFeeMiddleware,
+		middleware.DeductFeeMiddleware(s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper),
+	)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	acc := s.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	s.app.AccountKeeper.SetAccount(ctx, acc)
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))))
+
+	// newTestTx returns a tx executing the given msgs and paying the standard test fee
+	newTestTx := func(msgs ...sdk.Msg) sdk.Tx {
+		txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+		s.Require().NoError(txBuilder.SetMsgs(msgs...))
+		txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{acc.GetAccountNumber()}, []uint64{0}
+		testTx, _, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+		s.Require().NoError(err)
+		return testTx
+	}
+
+	// setDiscount sets the fee discount of its msg type
+	setDiscount := func(discount types.MsgFeeDiscount) {
+		s.app.AccountKeeper.SetMsgFeeDiscount(ctx, discount)
+	}
+
+	testMsgTypeURL := sdk.MsgTypeURL(&testdata.TestMsg{})
+	discountedTx := newTestTx(testdata.NewTestMsg(addr1))
+	mixedTx := newTestTx(testdata.NewTestMsg(addr1), banktypes.NewMsgSend(addr1, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 1))))
+
+	// The standard test fee of 150atom for 200000 gas is too low for a gas price of 0.0015atom.
+	highGasPriceCtx := ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(15, 4))))
+	_, _, err := txHandler.CheckTx(sdk.WrapSDKContext(highGasPriceCtx), tx.Request{Tx: discountedTx}, tx.RequestCheckTx{})
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	s.T().Log("verify the gas multiplier discounts the minimum fee")
+	setDiscount(types.NewMsgFeeDiscount(testMsgTypeURL, sdk.NewDecWithPrec(5, 1), nil))
+	_, _, err = txHandler.CheckTx(sdk.WrapSDKContext(highGasPriceCtx), tx.Request{Tx: discountedTx}, tx.RequestCheckTx{})
+	s.Require().NoError(err)
+
+	s.T().Log("verify a tx is not discounted if one of its msgs is not")
+	_, _, err = txHandler.CheckTx(sdk.WrapSDKContext(highGasPriceCtx), tx.Request{Tx: mixedTx}, tx.RequestCheckTx{})
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	s.T().Log("verify the min fee overrides the validator's minimum gas prices")
+	setDiscount(types.NewMsgFeeDiscount(testMsgTypeURL, sdk.OneDec(), sdk.NewCoins(sdk.NewInt64Coin("atom", 100))))
+	_, _, err = txHandler.CheckTx(sdk.WrapSDKContext(highGasPriceCtx), tx.Request{Tx: discountedTx}, tx.RequestCheckTx{})
+	s.Require().NoError(err)
+
+	s.T().Log("verify the min fee is enforced in CheckTx and DeliverTx")
+	setDiscount(types.NewMsgFeeDiscount(testMsgTypeURL, sdk.OneDec(), sdk.NewCoins(sdk.NewInt64Coin("atom", 200))))
+	balance := s.app.BankKeeper.GetBalance(ctx, addr1, "atom")
+	_, _, err = txHandler.CheckTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: discountedTx}, tx.RequestCheckTx{})
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+	_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: discountedTx})
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+	s.Require().Equal(balance, s.app.BankKeeper.GetBalance(ctx, addr1, "atom"), "no fee should be deducted")
+
+	s.T().Log("verify the min fee applies to every msg of the tx")
+	setDiscount(types.NewMsgFeeDiscount(testMsgTypeURL, sdk.OneDec(), sdk.NewCoins(sdk.NewInt64Coin("atom", 100))))
+	_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: discountedTx})
+	s.Require().NoError(err)
+	s.Require().Equal(balance.SubAmount(sdk.NewInt(150)), s.app.BankKeeper.GetBalance(ctx, addr1, "atom"))
+	_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: newTestTx(testdata.NewTestMsg(addr1), testdata.NewTestMsg(addr1))})
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+}
@@ -2,40 +2,65 @@ package middleware
 
 import (
 	"context"
+	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
+// GasPricePriorityPrecision is the number of units of priority per unit of
+// gas price: a gas price of 0.025 gives a priority of 25000.
+const GasPricePriorityPrecision = 1_000_000
+
+// TxPriorityFn returns the priority of a transaction in the mempool.
+type TxPriorityFn func(ctx sdk.Context, tx sdk.FeeTx) int64
+
 var _ tx.Handler = txPriorityHandler{}
 
 type txPriorityHandler struct {
-	next tx.Handler
+	priorityFn TxPriorityFn
+	next       tx.Handler
 }
 
 // TxPriorityMiddleware implements tx handling middleware that determines a
-// transaction's priority via a naive mechanism -- the total sum of fees provided.
+// transaction's priority with the given TxPriorityFn. If priorityFn is nil,
+// DefaultTxPriority is used.
+// If FeeMarketMiddleware is placed before it, the fee of the transaction given
+// to priorityFn is its tip above the base fee.
 // It sets the Priority in ResponseCheckTx only.
-func TxPriorityMiddleware(txh tx.Handler) tx.Handler {
-	return txPriorityHandler{next: txh}
+func TxPriorityMiddleware(priorityFn TxPriorityFn) tx.Middleware {
+	if priorityFn == nil {
+		priorityFn = DefaultTxPriority
+	}
+
+	return func(txh tx.Handler) tx.Handler {
+		return txPriorityHandler{
+			priorityFn: priorityFn,
+			next:       txh,
+		}
+	}
 }
 
 // CheckTx implements tx.Handler.CheckTx. We set the Priority of the transaction
-// to be ordered in the Tendermint mempool based naively on the total sum of all
-// fees included. Applications that need more sophisticated mempool ordering
-// should look to implement their own fee handling middleware instead of using
-// TxPriorityHandler.
+// to be ordered in the Tendermint mempool based on the TxPriorityFn.
+// Applications that need more sophisticated mempool ordering should provide
+// their own TxPriorityFn, or implement their own fee handling middleware.
 func (h txPriorityHandler) CheckTx(ctx context.Context, req tx.Request, checkReq tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
 	feeTx, ok := req.Tx.(sdk.FeeTx)
 	if !ok {
 		return tx.Response{}, tx.ResponseCheckTx{}, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
 	}
 
-	feeCoins := feeTx.GetFee()
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if tip, ok := getTxTip(sdkCtx); ok {
+		feeTx = tipFeeTx{FeeTx: feeTx, tip: tip}
+	}
+
+	priority := h.priorityFn(sdkCtx, feeTx)
 
 	res, checkRes, err := h.next.CheckTx(ctx, req, checkReq)
-	checkRes.Priority = GetTxPriority(feeCoins)
+	checkRes.Priority = priority
 
 	return res, checkRes, err
 }
@@ -48,16 +73,83 @@ func (h txPriorityHandler) SimulateTx(ctx context.Context, req tx.Request) (tx.R
 	return h.next.SimulateTx(ctx, req)
 }
 
-// GetTxPriority returns a naive tx priority based on the amount of the smallest denomination of the fee
-// provided in a transaction.
+// DefaultTxPriority is the TxPriorityFn used by TxPriorityMiddleware if none
+// is provided. It prioritizes transactions by the gas price of the fee they pay
+// in the bond denom (sdk.DefaultBondDenom), the other denoms being ignored.
+func DefaultTxPriority(ctx sdk.Context, tx sdk.FeeTx) int64 {
+	return GasPriceTxPriority(map[string]sdk.Dec{sdk.DefaultBondDenom: sdk.OneDec()})(ctx, tx)
+}
+
+// GetTxPriority returns the priority DefaultTxPriority gives to a transaction
+// paying the provided fee with a gas limit of 1.
+//
+// Deprecated: use DefaultTxPriority, which takes the gas limit of the
+// transaction into account, or GasPriceTxPriority.
 func GetTxPriority(fee sdk.Coins) int64 {
-	var priority int64
-	for _, c := range fee {
-		p := c.Amount.Int64()
-		if priority == 0 || p < priority {
-			priority = p
+	return DefaultTxPriority(sdk.Context{}, gasFeeTx{fee: fee, gas: 1})
+}
+
+// GasPriceTxPriority returns a TxPriorityFn prioritizing transactions by gas
+// price, i.e. the fee divided by the gas limit, in GasPricePriorityPrecision
+// units.
+//
+// The fee is first converted to a common reference denom with denomRates, the
+// amount of the reference denom one unit of each denom is worth, ignoring the
+// denoms not in the table. It panics if denomRates is empty.
+func GasPriceTxPriority(denomRates map[string]sdk.Dec) TxPriorityFn {
+	if len(denomRates) == 0 {
+		panic("gas price tx priority requires a denom conversion table")
+	}
+
+	return func(_ sdk.Context, tx sdk.FeeTx) int64 {
+		gas := tx.GetGas()
+		if gas == 0 {
+			return 0
 		}
+
+		fee := sdk.ZeroDec()
+		for _, c := range tx.GetFee() {
+			rate, found := denomRates[c.Denom]
+			if !found {
+				continue
+			}
+			fee = fee.Add(rate.MulInt(c.Amount))
+		}
+
+		priority := fee.MulInt64(GasPricePriorityPrecision).QuoInt(sdk.NewIntFromUint64(gas)).TruncateInt()
+		if !priority.IsInt64() {
+			return math.MaxInt64
+		}
+
+		return priority.Int64()
 	}
+}
+
+// gasFeeTx is a FeeTx which only provides a fee and a gas limit.
+type gasFeeTx struct {
+	sdk.FeeTx
+	fee sdk.Coins
+	gas uint64
+}
+
+// GetFee returns the fee of the transaction.
+func (t gasFeeTx) GetFee() sdk.Coins {
+	return t.fee
+}
+
+// GetGas returns the gas limit of the transaction.
+func (t gasFeeTx) GetGas() uint64 {
+	return t.gas
+}
+
+// tipFeeTx is a FeeTx whose fee is the tip it pays above the base fee of the
+// fee market.
+type tipFeeTx struct {
+	sdk.FeeTx
+	tip sdk.Coins
+}
 
-	return priority
+// GetFee returns the tip of the transaction.
+func (t tipFeeTx) GetFee() sdk.Coins {
+	return t.tip
 }
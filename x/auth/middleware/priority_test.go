@@ -10,29 +10,65 @@ import (
 
 func (s *MWTestSuite) TestPriority() {
 	ctx := s.SetupTest(true) // setup
-	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
-
-	txHandler := middleware.ComposeMiddlewares(noopTxHandler, middleware.TxPriorityMiddleware)
 
 	// keys and addresses
 	priv1, _, addr1 := testdata.KeyTestPubAddr()
 
-	// msg and signatures
-	msg := testdata.NewTestMsg(addr1)
-	atomCoin := sdk.NewCoin("atom", sdk.NewInt(150))
-	apeCoin := sdk.NewInt64Coin("ape", 1500000)
-	feeAmount := sdk.NewCoins(apeCoin, atomCoin)
-	gasLimit := testdata.NewTestGasLimit()
-	s.Require().NoError(txBuilder.SetMsgs(msg))
-	txBuilder.SetFeeAmount(feeAmount)
-	txBuilder.SetGasLimit(gasLimit)
-
-	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
-	testTx, _, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
-	s.Require().NoError(err)
-
-	// txHandler errors with insufficient fees
-	_, checkTxRes, err := txHandler.CheckTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx}, tx.RequestCheckTx{})
-	s.Require().NoError(err, "Middleware should not have errored on too low fee for local gasPrice")
-	s.Require().Equal(atomCoin.Amount.Int64(), checkTxRes.Priority, "priority should be atom amount")
+	// newTestTx returns a tx with the given fee and gas limit
+	newTestTx := func(feeAmount sdk.Coins, gasLimit uint64) sdk.Tx {
+		txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+		s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+		txBuilder.SetFeeAmount(feeAmount)
+		txBuilder.SetGasLimit(gasLimit)
+
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+		testTx, _, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+		s.Require().NoError(err)
+		return testTx
+	}
+
+	// priority returns the priority of the tx set by the txHandler
+	priority := func(txHandler tx.Handler, testTx sdk.Tx) int64 {
+		_, checkTxRes, err := txHandler.CheckTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx}, tx.RequestCheckTx{})
+		s.Require().NoError(err)
+		return checkTxRes.Priority
+	}
+
+	// a large tx paying a large fee at a low gas price
+	largeTx := newTestTx(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5000)), 1000000)
+	// a small tx paying a small fee at a high gas price
+	smallTx := newTestTx(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), 100000)
+	// a small tx paying its fee in a less valuable denom
+	apeTx := newTestTx(sdk.NewCoins(sdk.NewInt64Coin("ape", 5000)), 100000)
+
+	s.T().Log("verify txs are prioritized by the gas price in the bond denom by default")
+	txHandler := middleware.ComposeMiddlewares(noopTxHandler, middleware.TxPriorityMiddleware(nil))
+	s.Require().Equal(int64(5000), priority(txHandler, largeTx), "priority should be the gas price")
+	s.Require().Equal(int64(10000), priority(txHandler, smallTx), "priority should be the gas price")
+	s.Require().Equal(int64(0), priority(txHandler, apeTx), "denoms other than the bond denom should be ignored by default")
+	s.Require().Equal(int64(5000000), middleware.GetTxPriority(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))))
+
+	s.T().Log("verify a denom conversion table is required")
+	s.Require().Panics(func() { middleware.GasPriceTxPriority(nil) })
+
+	s.T().Log("verify fee denoms are normalized with the conversion table")
+	txHandler = middleware.ComposeMiddlewares(noopTxHandler, middleware.TxPriorityMiddleware(
+		middleware.GasPriceTxPriority(map[string]sdk.Dec{
+			sdk.DefaultBondDenom: sdk.OneDec(),
+			"ape":                sdk.NewDecWithPrec(1, 1),
+		}),
+	))
+	s.Require().Equal(int64(5000), priority(txHandler, largeTx))
+	s.Require().Equal(int64(10000), priority(txHandler, smallTx))
+	s.Require().Equal(int64(5000), priority(txHandler, apeTx))
+
+	s.T().Log("verify denoms missing from the conversion table are ignored")
+	multiDenomTx := newTestTx(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), sdk.NewInt64Coin("bear", 1000000)), 100000)
+	s.Require().Equal(int64(10000), priority(txHandler, multiDenomTx))
+
+	s.T().Log("verify a custom priority function can be used")
+	txHandler = middleware.ComposeMiddlewares(noopTxHandler, middleware.TxPriorityMiddleware(
+		func(_ sdk.Context, tx sdk.FeeTx) int64 { return int64(tx.GetGas()) },
+	))
+	s.Require().Equal(int64(1000000), priority(txHandler, largeTx))
 }
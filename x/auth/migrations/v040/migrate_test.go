@@ -232,6 +232,7 @@ func TestMigrate(t *testing.T) {
       "sequence": "0"
     }
   ],
+  "msg_fee_discounts": [],
   "params": {
     "max_memo_characters": "10",
     "sig_verify_cost_ed25519": "40",
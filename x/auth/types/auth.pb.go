@@ -7,6 +7,8 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -182,55 +184,129 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+// MsgFeeDiscount defines the discount on the minimum fee of the transactions
+// executing a given Msg type. A transaction is only discounted if all of its
+// Msgs are. The discounts are kept in the auth store, apart from Params, and
+// only read by the fee discount middleware.
+//
+// Since: cosmos-sdk 0.46
+type MsgFeeDiscount struct {
+	// msg_type_url is the type URL of the discounted Msg, e.g.
+	// "/cosmos.gov.v1beta1.MsgVote".
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// gas_multiplier scales the gas limit the minimum fee of the transaction is
+	// computed from, e.g. 0.5 halves the minimum fee.
+	GasMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=gas_multiplier,json=gasMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"gas_multiplier"`
+	// min_fee, if set, overrides the minimum fee computed from the validators'
+	// minimum gas prices, and is enforced in DeliverTx.
+	MinFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=min_fee,json=minFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_fee"`
+}
+
+func (m *MsgFeeDiscount) Reset()         { *m = MsgFeeDiscount{} }
+func (m *MsgFeeDiscount) String() string { return proto.CompactTextString(m) }
+func (*MsgFeeDiscount) ProtoMessage()    {}
+func (*MsgFeeDiscount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{3}
+}
+func (m *MsgFeeDiscount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFeeDiscount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFeeDiscount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFeeDiscount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeeDiscount.Merge(m, src)
+}
+func (m *MsgFeeDiscount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFeeDiscount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeeDiscount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFeeDiscount proto.InternalMessageInfo
+
+func (m *MsgFeeDiscount) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgFeeDiscount) GetMinFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinFee
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*MsgFeeDiscount)(nil), "cosmos.auth.v1beta1.MsgFeeDiscount")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 609 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0x8e, 0xdb, 0xfc, 0xfa, 0xe7, 0xd2, 0x56, 0xea, 0x35, 0xbf, 0xe2, 0x66, 0xb0, 0xad, 0x4a,
-	0x48, 0x41, 0x22, 0x0e, 0x09, 0x2a, 0x12, 0xdd, 0xea, 0x82, 0x50, 0x05, 0x85, 0xca, 0x11, 0x0c,
-	0x2c, 0xd6, 0xd9, 0x79, 0xeb, 0x9e, 0x9a, 0xf3, 0x19, 0xdf, 0xb9, 0x8a, 0xfb, 0x09, 0x18, 0x19,
-	0x19, 0xfb, 0x01, 0x18, 0x3b, 0x33, 0xa3, 0x4e, 0x15, 0x13, 0x53, 0x84, 0xd2, 0x01, 0xc4, 0xa7,
-	0x40, 0xb9, 0x73, 0xaa, 0x16, 0x75, 0xf2, 0xbd, 0xcf, 0xf3, 0xdc, 0xf3, 0xfe, 0xf3, 0x21, 0x2b,
-	0xe2, 0x82, 0x71, 0xd1, 0x26, 0xb9, 0x3c, 0x6a, 0x9f, 0x74, 0x42, 0x90, 0xa4, 0xa3, 0x02, 0x37,
-	0xcd, 0xb8, 0xe4, 0x78, 0x4d, 0xf3, 0xae, 0x82, 0x4a, 0xbe, 0xb1, 0xa1, 0xc1, 0x40, 0x49, 0xda,
-	0xa5, 0x42, 0x05, 0x8d, 0x7a, 0xcc, 0x63, 0xae, 0xf1, 0xc9, 0xa9, 0x44, 0x37, 0x62, 0xce, 0xe3,
-	0x01, 0xb4, 0x55, 0x14, 0xe6, 0x87, 0x6d, 0x92, 0x14, 0x9a, 0xda, 0xfc, 0x65, 0xa0, 0x9a, 0x47,
-	0x04, 0xec, 0x44, 0x11, 0xcf, 0x13, 0x89, 0xbb, 0x68, 0x9e, 0xf4, 0xfb, 0x19, 0x08, 0x61, 0x1a,
-	0x8e, 0xd1, 0x5c, 0xf4, 0xcc, 0xef, 0xe7, 0xad, 0x7a, 0x99, 0x63, 0x47, 0x33, 0x3d, 0x99, 0xd1,
-	0x24, 0xf6, 0xa7, 0x42, 0xfc, 0x02, 0xcd, 0xa7, 0x79, 0x18, 0x1c, 0x43, 0x61, 0xce, 0x38, 0x46,
-	0xb3, 0xd6, 0xad, 0xbb, 0x3a, 0xa1, 0x3b, 0x4d, 0xe8, 0xee, 0x24, 0x85, 0x67, 0xfe, 0x19, 0xd9,
-	0xf5, 0x34, 0x0f, 0x07, 0x34, 0x9a, 0x68, 0x1f, 0x72, 0x46, 0x25, 0xb0, 0x54, 0x16, 0xfe, 0x5c,
-	0x9a, 0x87, 0x2f, 0xa1, 0xc0, 0xf7, 0xd1, 0x0a, 0xd1, 0x75, 0x04, 0x49, 0xce, 0x42, 0xc8, 0xcc,
-	0x59, 0xc7, 0x68, 0x56, 0xfd, 0xe5, 0x12, 0x7d, 0xad, 0x40, 0xdc, 0x40, 0x0b, 0x02, 0x3e, 0xe4,
-	0x90, 0x44, 0x60, 0x56, 0x95, 0xe0, 0x3a, 0xde, 0x36, 0x3f, 0x9e, 0xd9, 0x95, 0xcf, 0x67, 0x76,
-	0xe5, 0xf7, 0x99, 0x5d, 0xb9, 0x38, 0x6f, 0x2d, 0x94, 0x8d, 0xed, 0x6d, 0x7e, 0x31, 0xd0, 0xf2,
-	0x3e, 0xef, 0xe7, 0x83, 0xeb, 0x5e, 0xf7, 0xd0, 0x52, 0x48, 0x04, 0x04, 0xa5, 0xbb, 0x6a, 0xb8,
-	0xd6, 0x75, 0xdc, 0x3b, 0x66, 0xee, 0xde, 0x98, 0x91, 0x57, 0xbd, 0x1c, 0xd9, 0x86, 0x5f, 0x0b,
-	0x6f, 0x8c, 0x0d, 0xa3, 0x6a, 0x42, 0x18, 0xa8, 0xfe, 0x17, 0x7d, 0x75, 0xc6, 0x0e, 0xaa, 0xa5,
-	0x90, 0x31, 0x2a, 0x04, 0xe5, 0x89, 0x30, 0x67, 0x9d, 0xd9, 0xe6, 0xa2, 0x7f, 0x13, 0xda, 0x6e,
-	0x4c, 0x8b, 0xbd, 0x38, 0x6f, 0xad, 0xdc, 0xaa, 0x6d, 0x6f, 0xf3, 0xeb, 0x0c, 0x9a, 0x3b, 0x20,
-	0x19, 0x61, 0x02, 0xbb, 0x68, 0x8d, 0x91, 0x61, 0xc0, 0x80, 0xf1, 0x20, 0x3a, 0x22, 0x19, 0x89,
-	0x24, 0x64, 0x7a, 0x3f, 0x55, 0x7f, 0x95, 0x91, 0xe1, 0x3e, 0x30, 0xbe, 0x7b, 0x4d, 0x60, 0x07,
-	0x2d, 0xc9, 0x61, 0x20, 0x68, 0x1c, 0x0c, 0x28, 0xa3, 0x52, 0x15, 0x55, 0xf5, 0x91, 0x1c, 0xf6,
-	0x68, 0xfc, 0x6a, 0x82, 0xe0, 0x47, 0xe8, 0x7f, 0xa5, 0x38, 0x85, 0x20, 0xe2, 0x42, 0x06, 0x29,
-	0x64, 0x41, 0x58, 0x48, 0x28, 0xe7, 0xbd, 0x3a, 0x91, 0x9e, 0xc2, 0x2e, 0x17, 0xf2, 0x00, 0x32,
-	0xaf, 0x90, 0x80, 0xdf, 0xa0, 0x7b, 0x13, 0xc3, 0x13, 0xc8, 0xe8, 0x61, 0xa1, 0x2f, 0x41, 0xbf,
-	0xbb, 0xb5, 0xd5, 0x79, 0xaa, 0x57, 0xe0, 0x99, 0xe3, 0x91, 0x5d, 0xef, 0xd1, 0xf8, 0x9d, 0x52,
-	0x4c, 0xae, 0x3e, 0x7f, 0xa6, 0x78, 0xbf, 0x2e, 0x6e, 0xa1, 0xfa, 0x16, 0x7e, 0x8b, 0x36, 0xfe,
-	0x35, 0x14, 0x10, 0xa5, 0xdd, 0xad, 0x27, 0xc7, 0x1d, 0xf3, 0x3f, 0x65, 0xd9, 0x18, 0x8f, 0xec,
-	0xf5, 0x5b, 0x96, 0xbd, 0xa9, 0xc2, 0x5f, 0x17, 0x77, 0xe2, 0xdb, 0x0b, 0xe5, 0xee, 0x0d, 0x6f,
-	0xf7, 0xdb, 0xd8, 0x32, 0x2e, 0xc7, 0x96, 0xf1, 0x73, 0x6c, 0x19, 0x9f, 0xae, 0xac, 0xca, 0xe5,
-	0x95, 0x55, 0xf9, 0x71, 0x65, 0x55, 0xde, 0x3f, 0x88, 0xa9, 0x3c, 0xca, 0x43, 0x37, 0xe2, 0xac,
-	0x7c, 0x3d, 0xe5, 0xa7, 0x25, 0xfa, 0xc7, 0xed, 0xa1, 0x7e, 0x8c, 0xb2, 0x48, 0x41, 0x84, 0x73,
-	0xea, 0x0f, 0x7e, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0x35, 0x25, 0xef, 0x58, 0xa8, 0x03, 0x00,
-	0x00,
+	// 752 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xdb, 0x90, 0xb6, 0x93, 0x36, 0xd2, 0x7a, 0xc3, 0xe2, 0xe6, 0x10, 0x5b, 0x91, 0x40,
+	0x41, 0x22, 0xce, 0x26, 0xa8, 0x48, 0x44, 0x5c, 0xea, 0x84, 0x45, 0x15, 0x04, 0x56, 0x0e, 0xcb,
+	0x81, 0x8b, 0x35, 0x76, 0x5e, 0xdd, 0x51, 0x3d, 0x1e, 0xe3, 0x19, 0xaf, 0xe2, 0xbd, 0x72, 0xe1,
+	0xc8, 0x91, 0x63, 0xcf, 0x88, 0x63, 0xce, 0x9c, 0x57, 0x7b, 0xaa, 0xf6, 0x84, 0x38, 0x04, 0x94,
+	0x1e, 0x40, 0xfc, 0x15, 0xc8, 0x33, 0x4e, 0xd4, 0xa2, 0x0a, 0xed, 0x29, 0x9e, 0xef, 0x7b, 0xdf,
+	0xf7, 0xe6, 0xfd, 0x98, 0xa0, 0x76, 0xc0, 0x38, 0x65, 0xbc, 0x8f, 0x33, 0x71, 0xd1, 0x7f, 0x3e,
+	0xf0, 0x41, 0xe0, 0x81, 0x3c, 0xd8, 0x49, 0xca, 0x04, 0xd3, 0x1f, 0x2a, 0xde, 0x96, 0x50, 0xc9,
+	0xb7, 0x36, 0x22, 0x1f, 0x73, 0xd8, 0x8a, 0x02, 0x46, 0x62, 0x25, 0x6a, 0x1d, 0x2b, 0xde, 0x93,
+	0xa7, 0x7e, 0xe9, 0xa0, 0xa8, 0x66, 0xc8, 0x42, 0xa6, 0xf0, 0xe2, 0x6b, 0x23, 0x08, 0x19, 0x0b,
+	0x23, 0xe8, 0xcb, 0x93, 0x9f, 0x9d, 0xf7, 0x71, 0x9c, 0x2b, 0xaa, 0xf3, 0x97, 0x86, 0xea, 0x0e,
+	0xe6, 0x70, 0x1a, 0x04, 0x2c, 0x8b, 0x85, 0x3e, 0x44, 0x7b, 0x78, 0x3e, 0x4f, 0x81, 0x73, 0x43,
+	0xb3, 0xb4, 0xee, 0x81, 0x63, 0xbc, 0x5e, 0xf6, 0x9a, 0x65, 0x8e, 0x53, 0xc5, 0xcc, 0x44, 0x4a,
+	0xe2, 0xd0, 0xdd, 0x04, 0xea, 0x9f, 0xa1, 0xbd, 0x24, 0xf3, 0xbd, 0x4b, 0xc8, 0x8d, 0x1d, 0x4b,
+	0xeb, 0xd6, 0x87, 0x4d, 0x5b, 0x25, 0xb4, 0x37, 0x09, 0xed, 0xd3, 0x38, 0x77, 0x8c, 0x7f, 0x56,
+	0x66, 0x33, 0xc9, 0xfc, 0x88, 0x04, 0x45, 0xec, 0x07, 0x8c, 0x12, 0x01, 0x34, 0x11, 0xb9, 0x5b,
+	0x4b, 0x32, 0xff, 0x73, 0xc8, 0xf5, 0x77, 0x51, 0x03, 0xab, 0x7b, 0x78, 0x71, 0x46, 0x7d, 0x48,
+	0x8d, 0x5d, 0x4b, 0xeb, 0x56, 0xdd, 0xa3, 0x12, 0xfd, 0x52, 0x82, 0x7a, 0x0b, 0xed, 0x73, 0xf8,
+	0x2e, 0x83, 0x38, 0x00, 0xa3, 0x2a, 0x03, 0xb6, 0xe7, 0x91, 0xf1, 0xc3, 0x95, 0x59, 0xf9, 0xe9,
+	0xca, 0xac, 0xfc, 0x7d, 0x65, 0x56, 0x5e, 0x2d, 0x7b, 0xfb, 0x65, 0x61, 0x67, 0x9d, 0x5f, 0x34,
+	0x74, 0x34, 0x65, 0xf3, 0x2c, 0xda, 0xd6, 0x7a, 0x86, 0x0e, 0x8b, 0x16, 0x7b, 0xa5, 0xbb, 0x2c,
+	0xb8, 0x3e, 0xb4, 0xec, 0x7b, 0x66, 0x62, 0xdf, 0xea, 0x91, 0x53, 0xbd, 0x5e, 0x99, 0x9a, 0x5b,
+	0xf7, 0x6f, 0xb5, 0x4d, 0x47, 0xd5, 0x18, 0x53, 0x90, 0xf5, 0x1f, 0xb8, 0xf2, 0x5b, 0xb7, 0x50,
+	0x3d, 0x81, 0x94, 0x12, 0xce, 0x09, 0x8b, 0xb9, 0xb1, 0x6b, 0xed, 0x76, 0x0f, 0xdc, 0xdb, 0xd0,
+	0xa8, 0xb5, 0xb9, 0xec, 0xab, 0x65, 0xaf, 0x71, 0xe7, 0x6e, 0x67, 0x9d, 0x5f, 0x77, 0x50, 0xed,
+	0x29, 0x4e, 0x31, 0xe5, 0xba, 0x8d, 0x1e, 0x52, 0xbc, 0xf0, 0x28, 0x50, 0xe6, 0x05, 0x17, 0x38,
+	0xc5, 0x81, 0x80, 0x54, 0xcd, 0xa7, 0xea, 0x3e, 0xa0, 0x78, 0x31, 0x05, 0xca, 0xc6, 0x5b, 0x42,
+	0xb7, 0xd0, 0xa1, 0x58, 0x78, 0x9c, 0x84, 0x5e, 0x44, 0x28, 0x11, 0xf2, 0x52, 0x55, 0x17, 0x89,
+	0xc5, 0x8c, 0x84, 0x5f, 0x14, 0x88, 0xfe, 0x18, 0xbd, 0x2d, 0x23, 0x5e, 0x80, 0x17, 0x30, 0x2e,
+	0xbc, 0x04, 0x52, 0xcf, 0xcf, 0x05, 0x94, 0xfd, 0x7e, 0x50, 0x84, 0xbe, 0x80, 0x31, 0xe3, 0xe2,
+	0x29, 0xa4, 0x4e, 0x2e, 0x40, 0xff, 0x0a, 0xbd, 0x53, 0x18, 0x3e, 0x87, 0x94, 0x9c, 0xe7, 0x4a,
+	0x04, 0xf3, 0xe1, 0xc9, 0xc9, 0xe0, 0x63, 0x35, 0x02, 0xc7, 0x58, 0xaf, 0xcc, 0xe6, 0x8c, 0x84,
+	0xdf, 0xc8, 0x88, 0x42, 0xfa, 0xe9, 0x44, 0xf2, 0x6e, 0x93, 0xdf, 0x41, 0x95, 0x4a, 0x7f, 0x86,
+	0x8e, 0xff, 0x6b, 0xc8, 0x21, 0x48, 0x86, 0x27, 0x1f, 0x5d, 0x0e, 0x8c, 0xb7, 0xa4, 0x65, 0x6b,
+	0xbd, 0x32, 0x1f, 0xdd, 0xb1, 0x9c, 0x6d, 0x22, 0xdc, 0x47, 0xfc, 0x5e, 0x7c, 0xb4, 0x5f, 0xce,
+	0x5e, 0xeb, 0x7c, 0xbf, 0x83, 0x1a, 0x53, 0x1e, 0x3e, 0x01, 0x98, 0x10, 0xae, 0xa6, 0x64, 0xa1,
+	0x43, 0xca, 0x43, 0x4f, 0xe4, 0x09, 0x78, 0x59, 0x1a, 0xa9, 0x0d, 0x77, 0x11, 0xe5, 0xe1, 0xd7,
+	0x79, 0x02, 0xcf, 0xd2, 0x48, 0x0f, 0x50, 0x23, 0xc4, 0xdc, 0xa3, 0x59, 0x24, 0x48, 0x12, 0x11,
+	0x48, 0xd5, 0x44, 0x9d, 0x4f, 0x5e, 0xae, 0xcc, 0xca, 0xef, 0x2b, 0xf3, 0xbd, 0x90, 0x88, 0x8b,
+	0xcc, 0xb7, 0x03, 0x46, 0xcb, 0x87, 0x57, 0xfe, 0xf4, 0xf8, 0xfc, 0xb2, 0x5f, 0x18, 0x73, 0x7b,
+	0x02, 0xc1, 0xeb, 0x65, 0x0f, 0x95, 0x5b, 0x34, 0x81, 0xc0, 0x3d, 0x0a, 0x31, 0x9f, 0x6e, 0x2d,
+	0xf5, 0x39, 0xda, 0xa3, 0x24, 0xf6, 0xce, 0x01, 0xe4, 0x52, 0xd4, 0x87, 0xc7, 0x9b, 0x95, 0x2b,
+	0x56, 0x6a, 0xbb, 0x72, 0x63, 0x46, 0x62, 0xe7, 0x71, 0x91, 0xf8, 0xe7, 0x3f, 0xcc, 0xee, 0x1b,
+	0x24, 0x2e, 0x04, 0xdc, 0xad, 0x51, 0x12, 0x3f, 0x01, 0x18, 0x55, 0x8b, 0x2e, 0x38, 0xe3, 0x97,
+	0xeb, 0xb6, 0x76, 0xbd, 0x6e, 0x6b, 0x7f, 0xae, 0xdb, 0xda, 0x8f, 0x37, 0xed, 0xca, 0xf5, 0x4d,
+	0xbb, 0xf2, 0xdb, 0x4d, 0xbb, 0xf2, 0xed, 0xfb, 0xff, 0xeb, 0xb8, 0x50, 0x7f, 0x59, 0xd2, 0xd8,
+	0xaf, 0xc9, 0x77, 0xfc, 0xe1, 0xbf, 0x03, 0x00, 0x15, 0x7a, 0xe9, 0x93, 0xce, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -269,6 +345,41 @@ func (this *Params) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgFeeDiscount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgFeeDiscount)
+	if !ok {
+		that2, ok := that.(MsgFeeDiscount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
+		return false
+	}
+	if !this.GasMultiplier.Equal(that1.GasMultiplier) {
+		return false
+	}
+	if len(this.MinFee) != len(that1.MinFee) {
+		return false
+	}
+	for i := range this.MinFee {
+		if !this.MinFee[i].Equal(&that1.MinFee[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -420,6 +531,60 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFeeDiscount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeeDiscount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeeDiscount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinFee) > 0 {
+		for iNdEx := len(m.MinFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.GasMultiplier.Size()
+		i -= size
+		if _, err := m.GasMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -501,6 +666,27 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *MsgFeeDiscount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = m.GasMultiplier.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	if len(m.MinFee) > 0 {
+		for _, e := range m.MinFee {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovAuth(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -958,6 +1144,156 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgFeeDiscount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFeeDiscount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFeeDiscount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFee = append(m.MinFee, types1.Coin{})
+			if err := m.MinFee[len(m.MinFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAuth(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,14 +18,15 @@ var _ types.UnpackInterfacesMessage = GenesisState{}
 type RandomGenesisAccountsFn func(simState *module.SimulationState) GenesisAccounts
 
 // NewGenesisState - Create a new genesis state
-func NewGenesisState(params Params, accounts GenesisAccounts) *GenesisState {
+func NewGenesisState(params Params, accounts GenesisAccounts, msgFeeDiscounts ...MsgFeeDiscount) *GenesisState {
 	genAccounts, err := PackAccounts(accounts)
 	if err != nil {
 		panic(err)
 	}
 	return &GenesisState{
-		Params:   params,
-		Accounts: genAccounts,
+		Params:          params,
+		Accounts:        genAccounts,
+		MsgFeeDiscounts: msgFeeDiscounts,
 	}
 }
 
@@ -65,6 +66,10 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if err := ValidateMsgFeeDiscounts(data.MsgFeeDiscounts); err != nil {
+		return err
+	}
+
 	genAccs, err := UnpackAccounts(data.Accounts)
 	if err != nil {
 		return err
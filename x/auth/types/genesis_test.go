@@ -50,6 +50,17 @@ func TestValidateGenesisDuplicateAccounts(t *testing.T) {
 	require.Error(t, types.ValidateGenAccounts(genAccs))
 }
 
+// require duplicate msg fee discounts fail validation
+func TestValidateGenesisDuplicateMsgFeeDiscounts(t *testing.T) {
+	discount := types.NewMsgFeeDiscount("/cosmos.gov.v1beta1.MsgVote", sdk.OneDec(), nil)
+
+	genState := types.NewGenesisState(types.DefaultParams(), types.GenesisAccounts{}, discount)
+	require.NoError(t, types.ValidateGenesis(*genState))
+
+	genState = types.NewGenesisState(types.DefaultParams(), types.GenesisAccounts{}, discount, discount)
+	require.Error(t, types.ValidateGenesis(*genState))
+}
+
 func TestGenesisAccountIterator(t *testing.T) {
 	acc1 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr1))
 	acc2 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr2))
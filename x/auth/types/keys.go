@@ -24,9 +24,18 @@ var (
 
 	// param key for global account number
 	GlobalAccountNumberKey = []byte("globalAccountNumber")
+
+	// MsgFeeDiscountPrefix prefix for msg-fee-discount-by-msg-type-url store
+	MsgFeeDiscountPrefix = []byte{0x02}
 )
 
 // AddressStoreKey turn an address to key used to get it from the account store
 func AddressStoreKey(addr sdk.AccAddress) []byte {
 	return append(AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// MsgFeeDiscountKey turns a Msg type URL to the key used to get its fee
+// discount from the account store
+func MsgFeeDiscountKey(msgTypeURL string) []byte {
+	return append(append([]byte{}, MsgFeeDiscountPrefix...), msgTypeURL...)
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMsgFeeDiscount creates a new MsgFeeDiscount instance
+func NewMsgFeeDiscount(msgTypeURL string, gasMultiplier sdk.Dec, minFee sdk.Coins) MsgFeeDiscount {
+	return MsgFeeDiscount{
+		MsgTypeUrl:    msgTypeURL,
+		GasMultiplier: gasMultiplier,
+		MinFee:        minFee,
+	}
+}
+
+// Validate checks that the discount has valid values.
+func (d MsgFeeDiscount) Validate() error {
+	if !strings.HasPrefix(d.MsgTypeUrl, "/") {
+		return fmt.Errorf("invalid msg type url: %q", d.MsgTypeUrl)
+	}
+
+	if d.GasMultiplier.IsNil() || d.GasMultiplier.IsNegative() {
+		return fmt.Errorf("invalid gas multiplier for %s: %s", d.MsgTypeUrl, d.GasMultiplier)
+	}
+
+	if err := d.MinFee.Validate(); err != nil {
+		return fmt.Errorf("invalid min fee for %s: %w", d.MsgTypeUrl, err)
+	}
+
+	return nil
+}
+
+// ValidateMsgFeeDiscounts checks that the discounts have valid values and that
+// a Msg type URL has at most one discount.
+func ValidateMsgFeeDiscounts(discounts []MsgFeeDiscount) error {
+	seen := make(map[string]bool, len(discounts))
+	for _, discount := range discounts {
+		if seen[discount.MsgTypeUrl] {
+			return fmt.Errorf("duplicate msg fee discount for %s", discount.MsgTypeUrl)
+		}
+		seen[discount.MsgTypeUrl] = true
+
+		if err := discount.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestValidateMsgFeeDiscounts(t *testing.T) {
+	tests := []struct {
+		name      string
+		discounts []types.MsgFeeDiscount
+		wantErr   error
+	}{
+		{"no msg fee discounts", nil, nil},
+		{"msg fee discounts", []types.MsgFeeDiscount{
+			types.NewMsgFeeDiscount("/cosmos.gov.v1beta1.MsgVote", sdk.NewDecWithPrec(5, 1), nil),
+			types.NewMsgFeeDiscount("/cosmos.slashing.v1beta1.MsgUnjail", sdk.ZeroDec(), sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+		}, nil},
+		{"invalid msg fee discount type url", []types.MsgFeeDiscount{
+			types.NewMsgFeeDiscount("cosmos.gov.v1beta1.MsgVote", sdk.OneDec(), nil),
+		}, fmt.Errorf("invalid msg type url: \"cosmos.gov.v1beta1.MsgVote\"")},
+		{"negative msg fee discount gas multiplier", []types.MsgFeeDiscount{
+			types.NewMsgFeeDiscount("/cosmos.gov.v1beta1.MsgVote", sdk.NewDec(-1), nil),
+		}, fmt.Errorf("invalid gas multiplier for /cosmos.gov.v1beta1.MsgVote: -1.000000000000000000")},
+		{"duplicate msg fee discount", []types.MsgFeeDiscount{
+			types.NewMsgFeeDiscount("/cosmos.gov.v1beta1.MsgVote", sdk.OneDec(), nil),
+			types.NewMsgFeeDiscount("/cosmos.gov.v1beta1.MsgVote", sdk.ZeroDec(), nil),
+		}, fmt.Errorf("duplicate msg fee discount for /cosmos.gov.v1beta1.MsgVote")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got := types.ValidateMsgFeeDiscounts(tt.discounts)
+			if tt.wantErr == nil {
+				require.NoError(t, got)
+				return
+			}
+			require.Equal(t, tt.wantErr, got)
+		})
+	}
+}
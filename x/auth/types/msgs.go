@@ -0,0 +1,65 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ sdk.Msg            = &MsgSetMsgFeeDiscounts{}
+	_ legacytx.LegacyMsg = &MsgSetMsgFeeDiscounts{}
+)
+
+// NewMsgSetMsgFeeDiscounts creates a new MsgSetMsgFeeDiscounts instance
+func NewMsgSetMsgFeeDiscounts(authority string, discounts []MsgFeeDiscount, removeMsgTypeURLs []string) *MsgSetMsgFeeDiscounts {
+	return &MsgSetMsgFeeDiscounts{
+		Authority:         authority,
+		MsgFeeDiscounts:   discounts,
+		RemoveMsgTypeUrls: removeMsgTypeURLs,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgSetMsgFeeDiscounts) Route() string { return ModuleName }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgSetMsgFeeDiscounts) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface. A Msg type URL can only
+// appear once in the fee discounts and the removed Msg type URLs.
+func (msg MsgSetMsgFeeDiscounts) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if err := ValidateMsgFeeDiscounts(msg.MsgFeeDiscounts); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	seen := make(map[string]bool, len(msg.MsgFeeDiscounts)+len(msg.RemoveMsgTypeUrls))
+	for _, discount := range msg.MsgFeeDiscounts {
+		seen[discount.MsgTypeUrl] = true
+	}
+
+	for _, msgTypeURL := range msg.RemoveMsgTypeUrls {
+		if seen[msgTypeURL] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate msg type url entries found for %q", msgTypeURL)
+		}
+
+		seen[msgTypeURL] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetMsgFeeDiscounts) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetMsgFeeDiscounts) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
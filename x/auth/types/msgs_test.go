@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMsgSetMsgFeeDiscountsValidateBasic(t *testing.T) {
+	authority := types.NewModuleAddress("gov").String()
+	voteDiscount := types.NewMsgFeeDiscount("/cosmos.gov.v1beta1.MsgVote", sdk.OneDec(), nil)
+
+	tests := []struct {
+		name      string
+		msg       *types.MsgSetMsgFeeDiscounts
+		expErrMsg string
+	}{
+		{
+			"valid",
+			types.NewMsgSetMsgFeeDiscounts(authority, []types.MsgFeeDiscount{voteDiscount}, []string{"/cosmos.bank.v1beta1.MsgSend"}),
+			"",
+		},
+		{
+			"invalid authority",
+			types.NewMsgSetMsgFeeDiscounts("invalid", []types.MsgFeeDiscount{voteDiscount}, nil),
+			"invalid authority address",
+		},
+		{
+			"invalid discount",
+			types.NewMsgSetMsgFeeDiscounts(authority, []types.MsgFeeDiscount{types.NewMsgFeeDiscount("cosmos.gov.v1beta1.MsgVote", sdk.OneDec(), nil)}, nil),
+			"invalid msg type url",
+		},
+		{
+			"discount set and removed",
+			types.NewMsgSetMsgFeeDiscounts(authority, []types.MsgFeeDiscount{voteDiscount}, []string{voteDiscount.MsgTypeUrl}),
+			"duplicate msg type url entries found",
+		},
+		{
+			"discount removed twice",
+			types.NewMsgSetMsgFeeDiscounts(authority, nil, []string{voteDiscount.MsgTypeUrl, voteDiscount.MsgTypeUrl}),
+			"duplicate msg type url entries found",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.expErrMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.expErrMsg)
+		})
+	}
+}
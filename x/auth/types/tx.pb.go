@@ -0,0 +1,676 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/auth/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSetMsgFeeDiscounts is the Msg/SetMsgFeeDiscounts request type.
+//
+// Only entries to add or update should be included. Existing MsgFeeDiscount
+// entries that are not included in this message are left unchanged.
+//
+// Since: cosmos-sdk 0.46
+type MsgSetMsgFeeDiscounts struct {
+	// authority is the address allowed to set the fee discounts, the gov module
+	// account by default.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// msg_fee_discounts are the fee discounts to add or update.
+	MsgFeeDiscounts []MsgFeeDiscount `protobuf:"bytes,2,rep,name=msg_fee_discounts,json=msgFeeDiscounts,proto3" json:"msg_fee_discounts"`
+	// remove_msg_type_urls are the Msg type URLs whose fee discounts are
+	// deleted. Type URLs without a fee discount are ignored.
+	RemoveMsgTypeUrls []string `protobuf:"bytes,3,rep,name=remove_msg_type_urls,json=removeMsgTypeUrls,proto3" json:"remove_msg_type_urls,omitempty"`
+}
+
+func (m *MsgSetMsgFeeDiscounts) Reset()         { *m = MsgSetMsgFeeDiscounts{} }
+func (m *MsgSetMsgFeeDiscounts) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMsgFeeDiscounts) ProtoMessage()    {}
+func (*MsgSetMsgFeeDiscounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{0}
+}
+func (m *MsgSetMsgFeeDiscounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMsgFeeDiscounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMsgFeeDiscounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMsgFeeDiscounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMsgFeeDiscounts.Merge(m, src)
+}
+func (m *MsgSetMsgFeeDiscounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMsgFeeDiscounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMsgFeeDiscounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMsgFeeDiscounts proto.InternalMessageInfo
+
+func (m *MsgSetMsgFeeDiscounts) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetMsgFeeDiscounts) GetMsgFeeDiscounts() []MsgFeeDiscount {
+	if m != nil {
+		return m.MsgFeeDiscounts
+	}
+	return nil
+}
+
+func (m *MsgSetMsgFeeDiscounts) GetRemoveMsgTypeUrls() []string {
+	if m != nil {
+		return m.RemoveMsgTypeUrls
+	}
+	return nil
+}
+
+// MsgSetMsgFeeDiscountsResponse defines the Msg/SetMsgFeeDiscounts response
+// type.
+//
+// Since: cosmos-sdk 0.46
+type MsgSetMsgFeeDiscountsResponse struct {
+}
+
+func (m *MsgSetMsgFeeDiscountsResponse) Reset()         { *m = MsgSetMsgFeeDiscountsResponse{} }
+func (m *MsgSetMsgFeeDiscountsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMsgFeeDiscountsResponse) ProtoMessage()    {}
+func (*MsgSetMsgFeeDiscountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{1}
+}
+func (m *MsgSetMsgFeeDiscountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMsgFeeDiscountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMsgFeeDiscountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMsgFeeDiscountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMsgFeeDiscountsResponse.Merge(m, src)
+}
+func (m *MsgSetMsgFeeDiscountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMsgFeeDiscountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMsgFeeDiscountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMsgFeeDiscountsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetMsgFeeDiscounts)(nil), "cosmos.auth.v1beta1.MsgSetMsgFeeDiscounts")
+	proto.RegisterType((*MsgSetMsgFeeDiscountsResponse)(nil), "cosmos.auth.v1beta1.MsgSetMsgFeeDiscountsResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/auth/v1beta1/tx.proto", fileDescriptor_c2d62bd9c4c212e5) }
+
+var fileDescriptor_c2d62bd9c4c212e5 = []byte{
+	// 365 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x4e, 0xea, 0x40,
+	0x14, 0x86, 0xdb, 0xdb, 0x9b, 0x9b, 0x30, 0x37, 0xd1, 0x50, 0x31, 0x56, 0xa2, 0x85, 0xe0, 0x06,
+	0x49, 0x68, 0x03, 0x26, 0x2e, 0xdc, 0x89, 0xc6, 0x5d, 0x37, 0x45, 0x36, 0x6e, 0x1a, 0xa0, 0xe3,
+	0xd0, 0x48, 0x99, 0x66, 0xce, 0x94, 0x40, 0xdc, 0xf9, 0x04, 0x3e, 0x8a, 0x0b, 0x1f, 0x82, 0x25,
+	0x71, 0xe5, 0xca, 0x18, 0x58, 0xf0, 0x1a, 0xa6, 0x9d, 0x21, 0x44, 0xad, 0x89, 0xab, 0x33, 0xc9,
+	0xf7, 0xcf, 0xf9, 0xcf, 0x39, 0x3f, 0x3a, 0xe8, 0x53, 0x08, 0x29, 0xd8, 0xdd, 0x98, 0x0f, 0xec,
+	0x71, 0xa3, 0x87, 0x79, 0xb7, 0x61, 0xf3, 0x89, 0x15, 0x31, 0xca, 0xa9, 0xbe, 0x23, 0xa8, 0x95,
+	0x50, 0x4b, 0xd2, 0x62, 0x81, 0x50, 0x42, 0x53, 0x6e, 0x27, 0x2f, 0x21, 0x2d, 0xee, 0x0b, 0xa9,
+	0x27, 0x80, 0xfc, 0x27, 0xd0, 0x9e, 0xf4, 0x08, 0x81, 0xd8, 0xe3, 0x46, 0x52, 0x24, 0x30, 0xb3,
+	0xcc, 0x53, 0xaf, 0x94, 0x57, 0x56, 0x2a, 0xda, 0x75, 0x80, 0xb4, 0x31, 0x77, 0x80, 0x5c, 0x61,
+	0x7c, 0x19, 0x40, 0x9f, 0xc6, 0x23, 0x0e, 0xfa, 0x29, 0xca, 0x25, 0x3a, 0xca, 0x02, 0x3e, 0x35,
+	0xd4, 0xb2, 0x5a, 0xcd, 0xb5, 0x8c, 0x97, 0xe7, 0x7a, 0x41, 0xfa, 0x9e, 0xfb, 0x3e, 0xc3, 0x00,
+	0x6d, 0xce, 0x82, 0x11, 0x71, 0x37, 0x52, 0xbd, 0x83, 0xf2, 0x21, 0x10, 0xef, 0x16, 0x63, 0xcf,
+	0x5f, 0x37, 0x33, 0xfe, 0x94, 0xb5, 0xea, 0xff, 0xe6, 0x91, 0x95, 0xb1, 0xac, 0xf5, 0xd9, 0xb8,
+	0xf5, 0x77, 0xf6, 0x56, 0x52, 0xdc, 0xed, 0xf0, 0xcb, 0x38, 0x36, 0x2a, 0x30, 0x1c, 0xd2, 0x31,
+	0xf6, 0x92, 0xee, 0x7c, 0x1a, 0x61, 0x2f, 0x66, 0x43, 0x30, 0xb4, 0xb2, 0x56, 0xcd, 0xb9, 0x79,
+	0xc1, 0x1c, 0x20, 0xd7, 0xd3, 0x08, 0x77, 0xd8, 0x10, 0xce, 0xb6, 0x1e, 0x56, 0x4f, 0xb5, 0xcd,
+	0x5c, 0x95, 0x12, 0x3a, 0xcc, 0x5c, 0xd4, 0xc5, 0x10, 0xd1, 0x11, 0xe0, 0xe6, 0x3d, 0xd2, 0x1c,
+	0x20, 0x3a, 0x47, 0x7a, 0xc6, 0x35, 0x6a, 0x3f, 0x8d, 0xfe, 0x5d, 0x5b, 0x6c, 0xfe, 0x5e, 0xbb,
+	0x36, 0x6f, 0x5d, 0xcc, 0x16, 0xa6, 0x3a, 0x5f, 0x98, 0xea, 0xfb, 0xc2, 0x54, 0x1f, 0x97, 0xa6,
+	0x32, 0x5f, 0x9a, 0xca, 0xeb, 0xd2, 0x54, 0x6e, 0x8e, 0x49, 0xc0, 0x07, 0x71, 0xcf, 0xea, 0xd3,
+	0x50, 0x66, 0x2e, 0x4b, 0x1d, 0xfc, 0x3b, 0x7b, 0x22, 0x92, 0x4d, 0xee, 0x01, 0xbd, 0x7f, 0x69,
+	0xa6, 0x27, 0x1f, 0x03, 0x00, 0x0e, 0xed, 0x11, 0x20, 0x72, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetMsgFeeDiscounts is a governance operation for setting the fee discounts
+	// of the transactions executing given Msg types. The authority is defined in
+	// the keeper.
+	//
+	// Since: cosmos-sdk 0.46
+	SetMsgFeeDiscounts(ctx context.Context, in *MsgSetMsgFeeDiscounts, opts ...grpc.CallOption) (*MsgSetMsgFeeDiscountsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetMsgFeeDiscounts(ctx context.Context, in *MsgSetMsgFeeDiscounts, opts ...grpc.CallOption) (*MsgSetMsgFeeDiscountsResponse, error) {
+	out := new(MsgSetMsgFeeDiscountsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Msg/SetMsgFeeDiscounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SetMsgFeeDiscounts is a governance operation for setting the fee discounts
+	// of the transactions executing given Msg types. The authority is defined in
+	// the keeper.
+	//
+	// Since: cosmos-sdk 0.46
+	SetMsgFeeDiscounts(context.Context, *MsgSetMsgFeeDiscounts) (*MsgSetMsgFeeDiscountsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetMsgFeeDiscounts(ctx context.Context, req *MsgSetMsgFeeDiscounts) (*MsgSetMsgFeeDiscountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMsgFeeDiscounts not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetMsgFeeDiscounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMsgFeeDiscounts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMsgFeeDiscounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Msg/SetMsgFeeDiscounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMsgFeeDiscounts(ctx, req.(*MsgSetMsgFeeDiscounts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.auth.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetMsgFeeDiscounts",
+			Handler:    _Msg_SetMsgFeeDiscounts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/auth/v1beta1/tx.proto",
+}
+
+func (m *MsgSetMsgFeeDiscounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMsgFeeDiscounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMsgFeeDiscounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoveMsgTypeUrls) > 0 {
+		for iNdEx := len(m.RemoveMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.RemoveMsgTypeUrls[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MsgFeeDiscounts) > 0 {
+		for iNdEx := len(m.MsgFeeDiscounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFeeDiscounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMsgFeeDiscountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMsgFeeDiscountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMsgFeeDiscountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetMsgFeeDiscounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MsgFeeDiscounts) > 0 {
+		for _, e := range m.MsgFeeDiscounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveMsgTypeUrls) > 0 {
+		for _, s := range m.RemoveMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetMsgFeeDiscountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetMsgFeeDiscounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMsgFeeDiscounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMsgFeeDiscounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFeeDiscounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFeeDiscounts = append(m.MsgFeeDiscounts, MsgFeeDiscount{})
+			if err := m.MsgFeeDiscounts[len(m.MsgFeeDiscounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveMsgTypeUrls = append(m.RemoveMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMsgFeeDiscountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMsgFeeDiscountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMsgFeeDiscountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
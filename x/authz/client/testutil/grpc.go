@@ -218,3 +218,52 @@ func (s *IntegrationTestSuite) TestQueryGranterGrantsGRPC() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestQueryGranteeGrantsGRPC() {
+	val := s.network.Validators[0]
+	grantee := s.grantee[1]
+	require := s.Require()
+
+	testCases := []struct {
+		name      string
+		url       string
+		expectErr bool
+		errMsg    string
+		numItems  int
+	}{
+		{
+			"invalid account address",
+			fmt.Sprintf("%s/cosmos/authz/v1beta1/grants/grantee/%s", val.APIAddress, "invalid address"),
+			true,
+			"decoding bech32 failed",
+			0,
+		},
+		{
+			"no authorizations found",
+			fmt.Sprintf("%s/cosmos/authz/v1beta1/grants/grantee/%s", val.APIAddress, val.Address.String()),
+			false,
+			"",
+			0,
+		},
+		{
+			"valid query",
+			fmt.Sprintf("%s/cosmos/authz/v1beta1/grants/grantee/%s", val.APIAddress, grantee.String()),
+			false,
+			"",
+			1,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			resp, _ := rest.GetRequest(tc.url)
+			if tc.expectErr {
+				require.Contains(string(resp), tc.errMsg)
+			} else {
+				var grants authz.QueryGranteeGrantsResponse
+				err := val.ClientCtx.Codec.UnmarshalJSON(resp, &grants)
+				require.NoError(err)
+				require.Len(grants.Grants, tc.numItems)
+			}
+		})
+	}
+}
@@ -161,7 +161,7 @@ func (s *IntegrationTestSuite) TestQueryAuthorization() {
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			false,
-			`{"@type":"/cosmos.bank.v1beta1.SendAuthorization","spend_limit":[{"denom":"steak","amount":"100"}]}`,
+			`{"@type":"/cosmos.bank.v1beta1.SendAuthorization","spend_limit":[{"denom":"steak","amount":"100"}],"allow_list":[]}`,
 		},
 	}
 	for _, tc := range testCases {
@@ -252,3 +252,68 @@ func (s *IntegrationTestSuite) TestQueryGranterGrants() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestQueryGranteeGrants() {
+	val := s.network.Validators[0]
+	grantee := s.grantee[1]
+	require := s.Require()
+
+	testCases := []struct {
+		name        string
+		args        []string
+		expectErr   bool
+		expectedErr string
+		expItems    int
+	}{
+		{
+			"invalid address",
+			[]string{
+				"invalid-address",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			"decoding bech32 failed",
+			0,
+		},
+		{
+			"no authorization found",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			"",
+			0,
+		},
+		{
+			"valid case",
+			[]string{
+				grantee.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			"",
+			1,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetQueryGranteeGrants()
+			clientCtx := val.ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				require.Error(err)
+				require.Contains(out.String(), tc.expectedErr)
+			} else {
+				require.NoError(err)
+				var grants authz.QueryGranteeGrantsResponse
+				require.NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &grants))
+				require.Len(grants.Grants, tc.expItems)
+				for _, grant := range grants.Grants {
+					require.Equal(val.Address.String(), grant.Granter)
+					require.Equal(grantee.String(), grant.Grantee)
+				}
+			}
+		})
+	}
+}
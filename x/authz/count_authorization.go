@@ -0,0 +1,113 @@
+package authz
+
+import (
+	proto "github.com/gogo/protobuf/proto"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ Authorization                    = &CountAuthorization{}
+	_ cdctypes.UnpackInterfacesMessage = &CountAuthorization{}
+)
+
+// NewCountAuthorization creates a new CountAuthorization object, allowing the
+// given authorization to be executed allowedExecutions times.
+func NewCountAuthorization(authorization Authorization, allowedExecutions uint64) (*CountAuthorization, error) {
+	any, err := newAuthorizationAny(authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CountAuthorization{
+		Authorization:     any,
+		AllowedExecutions: allowedExecutions,
+	}, nil
+}
+
+// GetAuthorization returns the wrapped authorization.
+func (a CountAuthorization) GetAuthorization() Authorization {
+	return cachedAuthorization(a.Authorization)
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a CountAuthorization) MsgTypeURL() string {
+	authorization := a.GetAuthorization()
+	if authorization == nil {
+		return ""
+	}
+	return authorization.MsgTypeURL()
+}
+
+// Accept implements Authorization.Accept. The Msg must be accepted by the wrapped
+// authorization, and the authorization is deleted after its last allowed execution.
+func (a CountAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	authorization := a.GetAuthorization()
+	if authorization == nil {
+		return AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("missing wrapped authorization")
+	}
+
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil || !resp.Accept {
+		return resp, err
+	}
+
+	if resp.Delete || a.AllowedExecutions <= 1 {
+		return AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	updated := &CountAuthorization{
+		Authorization:     a.Authorization,
+		AllowedExecutions: a.AllowedExecutions - 1,
+	}
+	if resp.Updated != nil {
+		updated.Authorization, err = newAuthorizationAny(resp.Updated)
+		if err != nil {
+			return AcceptResponse{}, err
+		}
+	}
+
+	return AcceptResponse{Accept: true, Updated: updated}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a CountAuthorization) ValidateBasic() error {
+	authorization := a.GetAuthorization()
+	if authorization == nil {
+		return sdkerrors.ErrInvalidType.Wrap("missing wrapped authorization")
+	}
+	if a.AllowedExecutions == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("allowed executions must be positive")
+	}
+	return authorization.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a CountAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(a.Authorization, &authorization)
+}
+
+// newAuthorizationAny packs an authorization into an Any.
+func newAuthorizationAny(a Authorization) (*cdctypes.Any, error) {
+	msg, ok := a.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", a)
+	}
+
+	return cdctypes.NewAnyWithValue(msg)
+}
+
+// cachedAuthorization returns the authorization cached in an Any if present.
+func cachedAuthorization(any *cdctypes.Any) Authorization {
+	if any == nil {
+		return nil
+	}
+	a, ok := any.GetCachedValue().(Authorization)
+	if !ok {
+		return nil
+	}
+	return a
+}
@@ -0,0 +1,75 @@
+package authz_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	fromAddr = sdk.AccAddress("_____from _____")
+	toAddr   = sdk.AccAddress("_______to________")
+)
+
+func TestCountAuthorization(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	sendMsgType := banktypes.SendAuthorization{}.MsgTypeURL()
+
+	t.Log("verify ValidateBasic fails without executions or wrapped authorization")
+	authorization, err := authz.NewCountAuthorization(authz.NewGenericAuthorization(sendMsgType), 0)
+	require.NoError(t, err)
+	require.Error(t, authorization.ValidateBasic())
+	require.Error(t, authz.CountAuthorization{AllowedExecutions: 1}.ValidateBasic())
+
+	t.Log("verify the executions are counted")
+	authorization, err = authz.NewCountAuthorization(authz.NewGenericAuthorization(sendMsgType), 2)
+	require.NoError(t, err)
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, sendMsgType, authorization.MsgTypeURL())
+
+	send := banktypes.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	resp, err := authorization.Accept(ctx, send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated, ok := resp.Updated.(*authz.CountAuthorization)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), updated.AllowedExecutions)
+
+	t.Log("verify the authorization is deleted after its last execution")
+	resp, err = updated.Accept(ctx, send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+
+	t.Log("verify the wrapped authorization is updated")
+	sendAuthorization := banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 300)), nil)
+	authorization, err = authz.NewCountAuthorization(sendAuthorization, 5)
+	require.NoError(t, err)
+	resp, err = authorization.Accept(ctx, send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	updated, ok = resp.Updated.(*authz.CountAuthorization)
+	require.True(t, ok)
+	require.Equal(t, uint64(4), updated.AllowedExecutions)
+	require.Equal(t, banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 200)), nil), updated.GetAuthorization())
+
+	t.Log("verify the authorization is deleted with the wrapped authorization")
+	send = banktypes.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)))
+	resp, err = updated.Accept(ctx, send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+
+	t.Log("verify the wrapped authorization rejection is returned")
+	send = banktypes.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 400)))
+	_, err = updated.Accept(ctx, send)
+	require.Error(t, err)
+}
@@ -7,4 +7,5 @@ import (
 // x/authz module sentinel errors
 var (
 	ErrInvalidExpirationTime = sdkerrors.Register(ModuleName, 3, "expiration time of authorization should be more than current time")
+	ErrMaxExecDepth          = sdkerrors.Register(ModuleName, 4, "maximum depth of nested MsgExec exceeded")
 )
@@ -18,6 +18,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
+const (
+	// MaxExecDepth is the maximum number of nested MsgExec levels, the top level
+	// MsgExec being at depth 1.
+	MaxExecDepth = 5
+
+	// nestedExecGasCost is the gas consumed for each message dispatched by a nested
+	// MsgExec, on top of the gas consumed by the message execution itself.
+	nestedExecGasCost = uint64(1000)
+)
+
+// execDepthKey is the context key of the current depth of nested MsgExec.
+type execDepthKey struct{}
+
 type Keeper struct {
 	storeKey   storetypes.StoreKey
 	cdc        codec.BinaryCodec
@@ -60,7 +73,7 @@ func (k Keeper) update(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccA
 
 	msg, ok := updated.(proto.Message)
 	if !ok {
-		sdkerrors.ErrPackAny.Wrapf("cannot proto marshal %T", updated)
+		return sdkerrors.ErrPackAny.Wrapf("cannot proto marshal %T", updated)
 	}
 
 	any, err := codectypes.NewAnyWithValue(msg)
@@ -76,9 +89,24 @@ func (k Keeper) update(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccA
 
 // DispatchActions attempts to execute the provided messages via authorization
 // grants from the message signer to the grantee.
+//
+// The messages can be MsgExecs themselves, whose grantee is then the granter of
+// the outer MsgExec, so that grants can be chained up to MaxExecDepth levels. All
+// the levels share the transaction gas meter, and every message dispatched by a
+// nested MsgExec consumes an additional nestedExecGasCost.
 func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
+	depth := execDepth(ctx) + 1
+	if depth > MaxExecDepth {
+		return nil, authz.ErrMaxExecDepth.Wrapf("depth %d, max %d", depth, MaxExecDepth)
+	}
+	ctx = ctx.WithValue(execDepthKey{}, depth)
+
 	var results = make([][]byte, len(msgs))
 	for i, msg := range msgs {
+		if depth > 1 {
+			ctx.GasMeter().ConsumeGas(nestedExecGasCost, "nested exec")
+		}
+
 		signers := msg.GetSigners()
 		if len(signers) != 1 {
 			return nil, sdkerrors.ErrInvalidRequest.Wrap("authorization can be given to msg with only one signer")
@@ -131,6 +159,13 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []
 	return results, nil
 }
 
+// execDepth returns the depth of the MsgExec being dispatched, or 0 outside of
+// any MsgExec.
+func execDepth(ctx sdk.Context) int {
+	depth, _ := ctx.Value(execDepthKey{}).(int)
+	return depth
+}
+
 // SaveGrant method grants the provided authorization to the grantee on the granter's account
 // with the provided expiration time. If there is an existing authorization grant for the
 // same `sdk.Msg` type, this grant overwrites that.
@@ -142,9 +177,18 @@ func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, auth
 		return err
 	}
 
+	msgType := authorization.MsgTypeURL()
+	skey := grantStoreKey(grantee, granter, msgType)
+
+	// the overwritten grant may expire at another time
+	if oldGrant, found := k.getGrant(ctx, skey); found {
+		store.Delete(grantQueueKey(oldGrant.Expiration, grantee, granter, msgType))
+	}
+
 	bz := k.cdc.MustMarshal(&grant)
-	skey := grantStoreKey(grantee, granter, authorization.MsgTypeURL())
 	store.Set(skey, bz)
+	store.Set(granteeStoreKey(grantee, granter, msgType), []byte{})
+	store.Set(grantQueueKey(expiration, grantee, granter, msgType), []byte{})
 	return ctx.EventManager().EmitTypedEvent(&authz.EventGrant{
 		MsgTypeUrl: authorization.MsgTypeURL(),
 		Granter:    granter.String(),
@@ -155,13 +199,11 @@ func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, auth
 // DeleteGrant revokes any authorization for the provided message type granted to the grantee
 // by the granter.
 func (k Keeper) DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) error {
-	store := ctx.KVStore(k.storeKey)
-	skey := grantStoreKey(grantee, granter, msgType)
-	_, found := k.getGrant(ctx, skey)
+	grant, found := k.getGrant(ctx, grantStoreKey(grantee, granter, msgType))
 	if !found {
 		return sdkerrors.ErrNotFound.Wrap("authorization not found")
 	}
-	store.Delete(skey)
+	k.removeGrant(ctx, grantee, granter, msgType, grant.Expiration)
 	return ctx.EventManager().EmitTypedEvent(&authz.EventRevoke{
 		MsgTypeUrl: msgType,
 		Granter:    granter.String(),
@@ -169,6 +211,34 @@ func (k Keeper) DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk
 	})
 }
 
+// removeGrant removes a grant and its indexes from the store.
+func (k Keeper) removeGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string, expiration time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(grantStoreKey(grantee, granter, msgType))
+	store.Delete(granteeStoreKey(grantee, granter, msgType))
+	store.Delete(grantQueueKey(expiration, grantee, granter, msgType))
+}
+
+// DequeueAndDeleteExpiredGrants deletes the grants which expired before the
+// current block time from the store, using the grants expiration queue.
+func (k Keeper) DequeueAndDeleteExpiredGrants(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(GrantQueuePrefix, grantQueueTimePrefix(ctx.BlockTime()))
+	defer iter.Close()
+
+	var queueKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		queueKeys = append(queueKeys, iter.Key())
+	}
+
+	for _, queueKey := range queueKeys {
+		granter, grantee, msgType := parseGrantStoreKey(grantKeyFromGrantQueueKey(queueKey))
+		store.Delete(grantStoreKey(grantee, granter, msgType))
+		store.Delete(granteeStoreKey(grantee, granter, msgType))
+		store.Delete(queueKey)
+	}
+}
+
 // GetAuthorizations Returns list of `Authorizations` granted to the grantee by the granter.
 func (k Keeper) GetAuthorizations(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress) (authorizations []authz.Authorization) {
 	store := ctx.KVStore(k.storeKey)
@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -197,6 +198,109 @@ func (s *TestSuite) TestKeeperFees() {
 	s.Require().NotNil(authorization)
 }
 
+func (s *TestSuite) TestDispatchActionsCountAuthorization() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	require := s.Require()
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+	require.NoError(testutil.FundAccount(app.BankKeeper, ctx, granterAddr, sdk.NewCoins(sdk.NewInt64Coin("steak", 10000))))
+	now := ctx.BlockHeader().Time
+
+	countAuthorization, err := authz.NewCountAuthorization(authz.NewGenericAuthorization(bankSendAuthMsgType), 2)
+	require.NoError(err)
+	err = app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, countAuthorization, now.Add(time.Hour))
+	require.NoError(err)
+
+	msgs := []sdk.Msg{
+		&banktypes.MsgSend{
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("steak", 2)),
+			FromAddress: granterAddr.String(),
+			ToAddress:   recipientAddr.String(),
+		},
+	}
+
+	s.T().Log("verify the remaining executions are persisted")
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, msgs)
+	require.NoError(err)
+	authorization, _ := app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	require.NotNil(authorization)
+	require.Equal(uint64(1), authorization.(*authz.CountAuthorization).AllowedExecutions)
+
+	s.T().Log("verify the grant is deleted after the last execution")
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, msgs)
+	require.NoError(err)
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	require.Nil(authorization)
+
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, msgs)
+	require.Error(err)
+}
+
+func (s *TestSuite) TestDispatchActionsNestedExec() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	require := s.Require()
+
+	granterAddr := addrs[0]
+	recipientAddr := addrs[2]
+	require.NoError(testutil.FundAccount(app.BankKeeper, ctx, granterAddr, sdk.NewCoins(sdk.NewInt64Coin("steak", 10000))))
+	now := ctx.BlockHeader().Time
+	execMsgType := sdk.MsgTypeURL(&authz.MsgExec{})
+
+	// granter grants a MsgSend to grantees[0], which grants a MsgExec to
+	// grantees[1], which grants a MsgExec to grantees[2], and so on.
+	grantees := simapp.AddTestAddrs(app, ctx, keeper.MaxExecDepth+1, sdk.NewInt(30000000))
+	err := app.AuthzKeeper.SaveGrant(ctx, grantees[0], granterAddr, authz.NewGenericAuthorization(bankSendAuthMsgType), now.Add(time.Hour))
+	require.NoError(err)
+	for i := 1; i < len(grantees); i++ {
+		err = app.AuthzKeeper.SaveGrant(ctx, grantees[i], grantees[i-1], authz.NewGenericAuthorization(execMsgType), now.Add(time.Hour))
+		require.NoError(err)
+	}
+
+	// nestedExec returns the messages to dispatch for grantees[depth-1] to send
+	// the granter's coins.
+	nestedExec := func(depth int) []sdk.Msg {
+		var msg sdk.Msg = &banktypes.MsgSend{
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("steak", 2)),
+			FromAddress: granterAddr.String(),
+			ToAddress:   recipientAddr.String(),
+		}
+		for i := 0; i < depth-1; i++ {
+			execMsg := authz.NewMsgExec(grantees[i], []sdk.Msg{msg})
+			msg = &execMsg
+		}
+		return []sdk.Msg{msg}
+	}
+
+	// the first send writes the recipient balance, making it more expensive
+	_, err = app.AuthzKeeper.DispatchActions(ctx, grantees[0], nestedExec(1))
+	require.NoError(err)
+
+	var gasUsed uint64
+	for depth := 1; depth <= keeper.MaxExecDepth; depth++ {
+		ctx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, err = app.AuthzKeeper.DispatchActions(ctx, grantees[depth-1], nestedExec(depth))
+		require.NoError(err, "depth %d", depth)
+
+		s.T().Log("verify the gas consumed grows with the depth")
+		require.Greater(ctx.GasMeter().GasConsumed(), gasUsed)
+		gasUsed = ctx.GasMeter().GasConsumed()
+	}
+
+	s.T().Log("verify the maximum depth is enforced")
+	depth := keeper.MaxExecDepth + 1
+	_, err = app.AuthzKeeper.DispatchActions(ctx, grantees[depth-1], nestedExec(depth))
+	require.ErrorIs(err, authz.ErrMaxExecDepth)
+
+	s.T().Log("verify a nested MsgExec requires a MsgExec grant")
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granterAddr, nestedExec(2))
+	require.Error(err)
+
+	recipientBalance := app.BankKeeper.GetBalance(ctx, recipientAddr, "steak")
+	require.Equal(sdk.NewInt64Coin("steak", int64(2*(keeper.MaxExecDepth+1))), recipientBalance)
+}
+
 // Tests that all msg events included in an authz MsgExec tx
 // Ref: https://github.com/cosmos/cosmos-sdk/issues/9501
 func (s *TestSuite) TestDispatchedEvents() {
@@ -248,6 +352,55 @@ func (s *TestSuite) TestDispatchedEvents() {
 	}
 }
 
+func (s *TestSuite) TestDequeueAndDeleteExpiredGrants() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	require := s.Require()
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	now := ctx.BlockHeader().Time
+	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+
+	// the send grant expires first, the generic grant is overwritten with a later expiration
+	genericMsgType := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+	err := app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, &banktypes.SendAuthorization{SpendLimit: newCoins}, now.Add(time.Hour))
+	require.NoError(err)
+	err = app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, authz.NewGenericAuthorization(genericMsgType), now.Add(time.Hour))
+	require.NoError(err)
+	err = app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, authz.NewGenericAuthorization(genericMsgType), now.Add(2*time.Hour))
+	require.NoError(err)
+
+	granteeGrants := func(ctx sdk.Context) []*authz.GrantAuthorization {
+		res, err := app.AuthzKeeper.GranteeGrants(sdk.WrapSDKContext(ctx), &authz.QueryGranteeGrantsRequest{Grantee: granteeAddr.String()})
+		require.NoError(err)
+		return res.Grants
+	}
+
+	// the grants are not expired at their expiration time
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx)
+	require.Len(app.AuthzKeeper.GetAuthorizations(ctx, granteeAddr, granterAddr), 2)
+	require.Len(granteeGrants(ctx), 2)
+
+	ctx = ctx.WithBlockTime(now.Add(time.Hour + time.Second))
+	app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx)
+	authorizations := app.AuthzKeeper.GetAuthorizations(ctx, granteeAddr, granterAddr)
+	require.Len(authorizations, 1)
+	require.Equal(genericMsgType, authorizations[0].MsgTypeURL())
+	require.Len(granteeGrants(ctx), 1)
+
+	ctx = ctx.WithBlockTime(now.Add(3 * time.Hour))
+	app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx)
+	require.Empty(app.AuthzKeeper.GetAuthorizations(ctx, granteeAddr, granterAddr))
+	require.Empty(granteeGrants(ctx))
+
+	// the expiration queue is empty
+	store := ctx.KVStore(app.GetKey(authz.ModuleName))
+	iter := sdk.KVStorePrefixIterator(store, keeper.GrantQueuePrefix)
+	defer iter.Close()
+	require.False(iter.Valid())
+}
+
 func TestTestSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
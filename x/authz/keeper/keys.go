@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/internal/conv"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -10,7 +12,9 @@ import (
 
 // Keys for store prefixes
 var (
-	GrantKey = []byte{0x01} // prefix for each key
+	GrantKey         = []byte{0x01} // prefix for each key
+	GrantQueuePrefix = []byte{0x02} // prefix for the grants expiration queue
+	GranteeKey       = []byte{0x03} // prefix for the grantee index of the grants
 )
 
 // StoreKey is the store key string for authz
@@ -35,6 +39,43 @@ func grantStoreKey(grantee sdk.AccAddress, granter sdk.AccAddress, msgType strin
 	return key
 }
 
+// granteeStoreKey - return the grantee index key of a grant
+// Items are stored with the following key: values
+//
+// - 0x03<granteeAddressLen (1 Byte)><granteeAddress_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><msgType_Bytes>: []byte{}
+func granteeStoreKey(grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) []byte {
+	key := grantStoreKey(granter, grantee, msgType)
+	key[0] = GranteeKey[0]
+	return key
+}
+
+// grantKeyFromGranteeStoreKey - return the grant store key from a grantee index key
+func grantKeyFromGranteeStoreKey(key []byte) []byte {
+	// the grantee index key has the same layout as the grant key, with swapped addresses
+	grantee, granter, msgType := parseGrantStoreKey(key)
+	return grantStoreKey(grantee, granter, msgType)
+}
+
+// grantQueueTimePrefix - return the prefix of the grants expiring at the given time
+func grantQueueTimePrefix(expiration time.Time) []byte {
+	return append(GrantQueuePrefix, sdk.FormatTimeBytes(expiration)...)
+}
+
+// grantQueueKey - return the expiration queue key of a grant
+// Items are stored with the following key: values
+//
+// - 0x02<expiration_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgType_Bytes>: []byte{}
+func grantQueueKey(expiration time.Time, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) []byte {
+	return append(grantQueueTimePrefix(expiration), grantStoreKey(grantee, granter, msgType)[1:]...)
+}
+
+// grantKeyFromGrantQueueKey - return the grant store key from an expiration queue key
+func grantKeyFromGrantQueueKey(key []byte) []byte {
+	timeLen := len(sdk.FormatTimeBytes(time.Time{}))
+	kv.AssertKeyAtLeastLength(key, 1+timeLen+1)
+	return append([]byte{GrantKey[0]}, key[1+timeLen:]...)
+}
+
 // addressesFromGrantStoreKey - split granter & grantee address from the authorization key
 func addressesFromGrantStoreKey(key []byte) (granterAddr, granteeAddr sdk.AccAddress) {
 	// key is of format:
@@ -49,3 +90,9 @@ func addressesFromGrantStoreKey(key []byte) (granterAddr, granteeAddr sdk.AccAdd
 
 	return granterAddr, granteeAddr
 }
+
+// parseGrantStoreKey - split granter & grantee address and msg type from the authorization key
+func parseGrantStoreKey(key []byte) (granterAddr, granteeAddr sdk.AccAddress, msgType string) {
+	granterAddr, granteeAddr = addressesFromGrantStoreKey(key)
+	return granterAddr, granteeAddr, string(key[3+len(granterAddr)+len(granteeAddr):])
+}
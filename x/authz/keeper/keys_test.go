@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -24,3 +26,26 @@ func TestGrantkey(t *testing.T) {
 	require.Equal(granter, granter1)
 	require.Equal(grantee, grantee1)
 }
+
+func TestGranteeKey(t *testing.T) {
+	require := require.New(t)
+	key := granteeStoreKey(grantee, granter, msgType)
+	require.Equal(GranteeKey, key[:1])
+	require.Equal(grantStoreKey(grantee, granter, msgType), grantKeyFromGranteeStoreKey(key))
+
+	grantee1, granter1 := addressesFromGrantStoreKey(key)
+	require.Equal(granter, granter1)
+	require.Equal(grantee, grantee1)
+}
+
+func TestGrantQueueKey(t *testing.T) {
+	require := require.New(t)
+	expiration := time.Now().UTC()
+	key := grantQueueKey(expiration, grantee, granter, msgType)
+	require.True(bytes.HasPrefix(key, grantQueueTimePrefix(expiration)))
+	require.Equal(grantStoreKey(grantee, granter, msgType), grantKeyFromGrantQueueKey(key))
+
+	// the keys of the grants expiring first come first
+	laterKey := grantQueueKey(expiration.Add(time.Second), grantee, granter, msgType)
+	require.Equal(-1, bytes.Compare(key, laterKey))
+}
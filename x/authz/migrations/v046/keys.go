@@ -0,0 +1,44 @@
+package v046
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// Keys for store prefixes
+var (
+	GrantPrefix      = []byte{0x01}
+	GrantQueuePrefix = []byte{0x02}
+	GranteePrefix    = []byte{0x03}
+)
+
+// granteeKey returns the grantee index key of the grant stored at grantKey:
+// 0x03<granteeAddressLen (1 Byte)><granteeAddress_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><msgType_Bytes>
+func granteeKey(grantKey []byte) []byte {
+	// grantKey is of format:
+	// 0x01<granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgType_Bytes>
+	kv.AssertKeyAtLeastLength(grantKey, 2)
+	granterAddrLen := int(grantKey[1])
+	kv.AssertKeyAtLeastLength(grantKey, 3+granterAddrLen)
+	granter := grantKey[2 : 2+granterAddrLen]
+	granteeAddrLen := int(grantKey[2+granterAddrLen])
+	kv.AssertKeyAtLeastLength(grantKey, 3+granterAddrLen+granteeAddrLen)
+	grantee := grantKey[3+granterAddrLen : 3+granterAddrLen+granteeAddrLen]
+	msgType := grantKey[3+granterAddrLen+granteeAddrLen:]
+
+	key := append([]byte{}, GranteePrefix...)
+	key = append(key, address.MustLengthPrefix(grantee)...)
+	key = append(key, address.MustLengthPrefix(granter)...)
+	return append(key, msgType...)
+}
+
+// grantQueueKey returns the expiration queue key of the grant stored at grantKey:
+// 0x02<expiration_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgType_Bytes>
+func grantQueueKey(grantKey []byte, expiration time.Time) []byte {
+	key := append([]byte{}, GrantQueuePrefix...)
+	key = append(key, sdk.FormatTimeBytes(expiration)...)
+	return append(key, grantKey[len(GrantPrefix):]...)
+}
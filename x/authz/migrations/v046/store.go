@@ -0,0 +1,31 @@
+package v046
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// MigrateStore performs in-place store migrations from v0.45 to v0.46. The
+// migration includes:
+//
+// - Add the grantee index of the grants.
+// - Add the grants to the expiration queue.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	iter := sdk.KVStorePrefixIterator(store, GrantPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var grant authz.Grant
+		if err := cdc.Unmarshal(iter.Value(), &grant); err != nil {
+			return err
+		}
+
+		store.Set(granteeKey(iter.Key()), []byte{})
+		store.Set(grantQueueKey(iter.Key(), grant.Expiration), []byte{})
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package v046_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	v046 "github.com/cosmos/cosmos-sdk/x/authz/migrations/v046"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Codec
+	authzKey := sdk.NewKVStoreKey("authz")
+	ctx := testutil.DefaultContext(authzKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(authzKey)
+
+	_, _, granter := testdata.KeyTestPubAddr()
+	_, _, grantee := testdata.KeyTestPubAddr()
+	expiration := time.Unix(1e9, 0).UTC()
+	msgType := banktypes.SendAuthorization{}.MsgTypeURL()
+
+	grant, err := authz.NewGrant(banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("foo", 123)), nil), expiration)
+	require.NoError(t, err)
+	grantBz, err := cdc.Marshal(&grant)
+	require.NoError(t, err)
+
+	granterGranteeKey := append(address.MustLengthPrefix(granter), address.MustLengthPrefix(grantee)...)
+	grantKey := append(append([]byte{}, v046.GrantPrefix...), granterGranteeKey...)
+	grantKey = append(grantKey, msgType...)
+	store.Set(grantKey, grantBz)
+
+	// Run migrations.
+	err = v046.MigrateStore(ctx, authzKey, cdc)
+	require.NoError(t, err)
+
+	granteeGranterKey := append(address.MustLengthPrefix(grantee), address.MustLengthPrefix(granter)...)
+	granteeKey := append(append([]byte{}, v046.GranteePrefix...), granteeGranterKey...)
+	granteeKey = append(granteeKey, msgType...)
+	require.True(t, store.Has(granteeKey))
+
+	queueKey := append(append([]byte{}, v046.GrantQueuePrefix...), sdk.FormatTimeBytes(expiration)...)
+	queueKey = append(queueKey, grantKey[1:]...)
+	require.True(t, store.Has(queueKey))
+
+	// the grant is left untouched
+	require.Equal(t, grantBz, store.Get(grantKey))
+}
@@ -0,0 +1,17 @@
+package authz
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+)
+
+// BeginBlocker called at every block, deletes the grants which have expired.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(authz.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.DequeueAndDeleteExpiredGrants(ctx)
+}
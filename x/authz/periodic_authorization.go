@@ -0,0 +1,135 @@
+package authz
+
+import (
+	"time"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ Authorization                    = &PeriodicAuthorization{}
+	_ cdctypes.UnpackInterfacesMessage = &PeriodicAuthorization{}
+)
+
+// NewPeriodicAuthorization creates a new PeriodicAuthorization object, restoring
+// the given authorization every period. The first period starts at the first
+// execution.
+func NewPeriodicAuthorization(authorization Authorization, period time.Duration) (*PeriodicAuthorization, error) {
+	any, err := newAuthorizationAny(authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PeriodicAuthorization{
+		Authorization:       any,
+		Period:              period,
+		PeriodAuthorization: any,
+	}, nil
+}
+
+// GetAuthorization returns the wrapped authorization, as restored at the start of each period.
+func (a PeriodicAuthorization) GetAuthorization() Authorization {
+	return cachedAuthorization(a.Authorization)
+}
+
+// GetPeriodAuthorization returns what is left of the wrapped authorization in the
+// current period, or nil if it is used up.
+func (a PeriodicAuthorization) GetPeriodAuthorization() Authorization {
+	return cachedAuthorization(a.PeriodAuthorization)
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a PeriodicAuthorization) MsgTypeURL() string {
+	authorization := a.GetAuthorization()
+	if authorization == nil {
+		return ""
+	}
+	return authorization.MsgTypeURL()
+}
+
+// Accept implements Authorization.Accept. The Msg must be accepted by what is left
+// of the wrapped authorization in the current period. The PeriodicAuthorization
+// is never deleted, a used up period authorization is restored in the next period.
+func (a PeriodicAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	a.tryResetPeriod(ctx.BlockTime())
+
+	periodAuthorization := a.GetPeriodAuthorization()
+	if periodAuthorization == nil {
+		return AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("authorization used up until %s", a.PeriodReset)
+	}
+
+	resp, err := periodAuthorization.Accept(ctx, msg)
+	if err != nil || !resp.Accept {
+		// the wrapped authorization is deleted for the current period only
+		return AcceptResponse{Accept: resp.Accept}, err
+	}
+
+	switch {
+	case resp.Delete:
+		a.PeriodAuthorization = nil
+	case resp.Updated != nil:
+		a.PeriodAuthorization, err = newAuthorizationAny(resp.Updated)
+		if err != nil {
+			return AcceptResponse{}, err
+		}
+	}
+
+	return AcceptResponse{Accept: true, Updated: &a}, nil
+}
+
+// tryResetPeriod restores the period authorization if the PeriodReset has been hit,
+// and updates the PeriodReset. If we are within one Period, it steps from the last
+// PeriodReset, if we are more than one Period out, the next reset is one Period from
+// the block time.
+func (a *PeriodicAuthorization) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	a.PeriodAuthorization = a.Authorization
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a PeriodicAuthorization) ValidateBasic() error {
+	authorization := a.GetAuthorization()
+	if authorization == nil {
+		return sdkerrors.ErrInvalidType.Wrap("missing wrapped authorization")
+	}
+	if err := authorization.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if a.Period <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("period must be positive")
+	}
+
+	if a.PeriodAuthorization != nil {
+		periodAuthorization := a.GetPeriodAuthorization()
+		if periodAuthorization == nil {
+			return sdkerrors.ErrInvalidType.Wrap("invalid period authorization")
+		}
+		if periodAuthorization.MsgTypeURL() != authorization.MsgTypeURL() {
+			return sdkerrors.ErrInvalidType.Wrapf("period authorization is for %s, expected %s", periodAuthorization.MsgTypeURL(), authorization.MsgTypeURL())
+		}
+		return periodAuthorization.ValidateBasic()
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a PeriodicAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var authorization Authorization
+	if err := unpacker.UnpackAny(a.Authorization, &authorization); err != nil {
+		return err
+	}
+
+	var periodAuthorization Authorization
+	return unpacker.UnpackAny(a.PeriodAuthorization, &periodAuthorization)
+}
@@ -0,0 +1,83 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestPeriodicAuthorization(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amount)) }
+
+	t.Log("verify ValidateBasic fails with an invalid period or wrapped authorization")
+	sendAuthorization := banktypes.NewSendAuthorization(coins(300), nil)
+	authorization, err := authz.NewPeriodicAuthorization(sendAuthorization, 0)
+	require.NoError(t, err)
+	require.Error(t, authorization.ValidateBasic())
+	authorization, err = authz.NewPeriodicAuthorization(banktypes.NewSendAuthorization(nil, nil), time.Hour)
+	require.NoError(t, err)
+	require.Error(t, authorization.ValidateBasic())
+	require.Error(t, authz.PeriodicAuthorization{Period: time.Hour}.ValidateBasic())
+
+	authorization, err = authz.NewPeriodicAuthorization(sendAuthorization, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, sendAuthorization.MsgTypeURL(), authorization.MsgTypeURL())
+
+	t.Log("verify the first execution starts the period")
+	resp, err := authorization.Accept(ctx, banktypes.NewMsgSend(fromAddr, toAddr, coins(200)))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated, ok := resp.Updated.(*authz.PeriodicAuthorization)
+	require.True(t, ok)
+	require.NoError(t, updated.ValidateBasic())
+	require.Equal(t, now.Add(time.Hour), updated.PeriodReset)
+	require.Equal(t, sendAuthorization, updated.GetAuthorization())
+	require.Equal(t, banktypes.NewSendAuthorization(coins(100), nil), updated.GetPeriodAuthorization())
+
+	t.Log("verify the spend limit applies within the period")
+	_, err = updated.Accept(ctx, banktypes.NewMsgSend(fromAddr, toAddr, coins(200)))
+	require.Error(t, err)
+
+	t.Log("verify the used up period authorization is kept")
+	resp, err = updated.Accept(ctx, banktypes.NewMsgSend(fromAddr, toAddr, coins(100)))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated, ok = resp.Updated.(*authz.PeriodicAuthorization)
+	require.True(t, ok)
+	require.NoError(t, updated.ValidateBasic())
+	require.Nil(t, updated.GetPeriodAuthorization())
+	_, err = updated.Accept(ctx, banktypes.NewMsgSend(fromAddr, toAddr, coins(1)))
+	require.Error(t, err)
+
+	t.Log("verify the wrapped authorization is restored in the next period")
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	resp, err = updated.Accept(ctx, banktypes.NewMsgSend(fromAddr, toAddr, coins(300)))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	updated, ok = resp.Updated.(*authz.PeriodicAuthorization)
+	require.True(t, ok)
+	require.Equal(t, now.Add(2*time.Hour), updated.PeriodReset)
+	require.Nil(t, updated.GetPeriodAuthorization())
+
+	t.Log("verify the next period starts from the block time after some inactivity")
+	ctx = ctx.WithBlockTime(now.Add(5 * time.Hour))
+	resp, err = updated.Accept(ctx, banktypes.NewMsgSend(fromAddr, toAddr, coins(100)))
+	require.NoError(t, err)
+	updated, ok = resp.Updated.(*authz.PeriodicAuthorization)
+	require.True(t, ok)
+	require.Equal(t, now.Add(6*time.Hour), updated.PeriodReset)
+	require.Equal(t, banktypes.NewSendAuthorization(coins(200), nil), updated.GetPeriodAuthorization())
+}
@@ -20,6 +20,9 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &grantA)
 			cdc.MustUnmarshal(kvB.Value, &grantB)
 			return fmt.Sprintf("%v\n%v", grantA, grantB)
+		case bytes.Equal(kvA.Key[:1], keeper.GrantQueuePrefix), bytes.Equal(kvA.Key[:1], keeper.GranteeKey):
+			// the expiration queue and grantee index only hold keys
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
 		default:
 			panic(fmt.Sprintf("invalid authz key %X", kvA.Key))
 		}
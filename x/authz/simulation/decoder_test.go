@@ -20,12 +20,13 @@ func TestDecodeStore(t *testing.T) {
 	cdc := simapp.MakeTestEncodingConfig().Codec
 	dec := simulation.NewDecodeStore(cdc)
 
-	grant, _ := authz.NewGrant(banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("foo", 123))), time.Now().UTC())
+	grant, _ := authz.NewGrant(banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("foo", 123)), nil), time.Now().UTC())
 	grantBz, err := cdc.Marshal(&grant)
 	require.NoError(t, err)
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: []byte(keeper.GrantKey), Value: grantBz},
+			{Key: append(keeper.GrantQueuePrefix, 0x01), Value: []byte{}},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -36,6 +37,7 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Grant", false, fmt.Sprintf("%v\n%v", grant, grant)},
+		{"GrantQueue", false, "0201\n0201"},
 		{"other", true, ""},
 	}
 
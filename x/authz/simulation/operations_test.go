@@ -132,7 +132,7 @@ func (suite *SimTestSuite) TestSimulateRevoke() {
 
 	granter := accounts[0]
 	grantee := accounts[1]
-	authorization := banktypes.NewSendAuthorization(initCoins)
+	authorization := banktypes.NewSendAuthorization(initCoins, nil)
 
 	err := suite.app.AuthzKeeper.SaveGrant(suite.ctx, grantee.Address, granter.Address, authorization, time.Now().Add(30*time.Hour))
 	suite.Require().NoError(err)
@@ -167,7 +167,7 @@ func (suite *SimTestSuite) TestSimulateExec() {
 
 	granter := accounts[0]
 	grantee := accounts[1]
-	authorization := banktypes.NewSendAuthorization(initCoins)
+	authorization := banktypes.NewSendAuthorization(initCoins, nil)
 
 	err := suite.app.AuthzKeeper.SaveGrant(suite.ctx, grantee.Address, granter.Address, authorization, time.Now().Add(30*time.Hour))
 	suite.Require().NoError(err)
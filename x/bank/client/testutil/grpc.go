@@ -248,6 +248,24 @@ func (s *IntegrationTestSuite) TestBalancesGRPCHandler() {
 				},
 			},
 		},
+		{
+			"gRPC spendable account balances",
+			fmt.Sprintf("%s/cosmos/bank/v1beta1/spendable_balances/%s", baseURL, val.Address.String()),
+			&types.QuerySpendableBalancesResponse{},
+			&types.QuerySpendableBalancesResponse{
+				Balances: sdk.NewCoins(
+					sdk.NewCoin(fmt.Sprintf("%stoken", val.Moniker), s.cfg.AccountTokens),
+					sdk.NewCoin(s.cfg.BondDenom, s.cfg.StakingTokens.Sub(s.cfg.BondedTokens)),
+				),
+				Pagination: &query.PageResponse{
+					Total: 2,
+				},
+				Breakdowns: []types.BalanceBreakdown{
+					newBalanceBreakdown(fmt.Sprintf("%stoken", val.Moniker), s.cfg.AccountTokens),
+					newBalanceBreakdown(s.cfg.BondDenom, s.cfg.StakingTokens.Sub(s.cfg.BondedTokens)),
+				},
+			},
+		},
 		{
 			"gPRC account balance of a denom",
 			fmt.Sprintf("%s/cosmos/bank/v1beta1/balances/%s/by_denom?denom=%s", baseURL, val.Address.String(), s.cfg.BondDenom),
@@ -283,3 +301,16 @@ func (s *IntegrationTestSuite) TestBalancesGRPCHandler() {
 		})
 	}
 }
+
+// newBalanceBreakdown returns the balance breakdown of a denom held by a
+// non-vesting account.
+func newBalanceBreakdown(denom string, amount sdk.Int) types.BalanceBreakdown {
+	return types.BalanceBreakdown{
+		Denom:            denom,
+		Total:            amount,
+		Spendable:        amount,
+		Locked:           sdk.ZeroInt(),
+		DelegatedVesting: sdk.ZeroInt(),
+		DelegatedFree:    sdk.ZeroInt(),
+	}
+}
@@ -75,6 +75,11 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		},
 	}
 
+	bankGenesis.SendEnabled = []types.SendEnabled{
+		{Denom: "uatom", Enabled: true},
+		{Denom: "wei", Enabled: false},
+	}
+
 	bankGenesisBz, err := s.cfg.Codec.MarshalJSON(&bankGenesis)
 	s.Require().NoError(err)
 	genesisState[types.ModuleName] = bankGenesisBz
@@ -163,6 +168,59 @@ func (s *IntegrationTestSuite) TestGetBalancesCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetSpendableBalancesCmd() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		respType  proto.Message
+		expected  proto.Message
+	}{
+		{"no address provided", []string{}, true, nil, nil},
+		{"invalid address", []string{"foo"}, true, nil, nil},
+		{
+			"spendable account balances",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+			&types.QuerySpendableBalancesResponse{},
+			&types.QuerySpendableBalancesResponse{
+				Balances: sdk.NewCoins(
+					sdk.NewCoin(fmt.Sprintf("%stoken", val.Moniker), s.cfg.AccountTokens),
+					sdk.NewCoin(s.cfg.BondDenom, s.cfg.StakingTokens.Sub(s.cfg.BondedTokens)),
+				),
+				Pagination: &query.PageResponse{},
+				Breakdowns: []types.BalanceBreakdown{
+					newBalanceBreakdown(fmt.Sprintf("%stoken", val.Moniker), s.cfg.AccountTokens),
+					newBalanceBreakdown(s.cfg.BondDenom, s.cfg.StakingTokens.Sub(s.cfg.BondedTokens)),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetSpendableBalancesCmd()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType))
+				s.Require().Equal(tc.expected.String(), tc.respType.String())
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryTotalSupply() {
 	val := s.network.Validators[0]
 
@@ -362,6 +420,51 @@ func (s *IntegrationTestSuite) TestGetCmdQueryDenomsMetadata() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQuerySendEnabled() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name     string
+		args     []string
+		expected proto.Message
+	}{
+		{
+			"all entries",
+			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			&types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{
+					types.NewSendEnabled("uatom", true),
+					types.NewSendEnabled("wei", false),
+				},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			"specific denoms",
+			[]string{"wei", "unknowncoin", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			&types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{
+					types.NewSendEnabled("wei", false),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQuerySendEnabled()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+
+			var res types.QuerySendEnabledResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+			s.Require().Equal(tc.expected.String(), res.String())
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewSendTxCmdGenOnly() {
 	val := s.network.Validators[0]
 
@@ -9,9 +9,15 @@ import (
 )
 
 // InitGenesis initializes the bank module's state from a given genesis state.
+// The SendEnabled entries of the deprecated genState.Params.SendEnabled field
+// are set along with the genState.SendEnabled ones.
 func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
 
+	for _, se := range genState.SendEnabled {
+		k.SetSendEnabled(ctx, se.Denom, se.Enabled)
+	}
+
 	totalSupply := sdk.Coins{}
 	genState.Balances = types.SanitizeGenesisBalances(genState.Balances)
 
@@ -53,5 +59,6 @@ func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.GetAccountsBalances(ctx),
 		totalSupply,
 		k.GetAllDenomMetaData(ctx),
+		k.GetAllSendEnabledEntries(ctx),
 	)
 }
@@ -70,6 +70,26 @@ func (suite *IntegrationTestSuite) TestInitGenesis() {
 	suite.Require().Equal(m, m2)
 }
 
+func (suite *IntegrationTestSuite) TestInitGenesisSendEnabled() {
+	ctx, _ := suite.ctx.CacheContext()
+	g := types.DefaultGenesisState()
+	g.SendEnabled = []types.SendEnabled{{Denom: "foocoin", Enabled: false}}
+	g.Params.SendEnabled = types.SendEnabledParams{types.NewSendEnabled("barcoin", true)}
+	bk := suite.app.BankKeeper
+	bk.InitGenesis(ctx, g)
+
+	suite.Require().Empty(bk.GetParams(ctx).SendEnabled)
+	expected := []types.SendEnabled{
+		{Denom: "barcoin", Enabled: true},
+		{Denom: "foocoin", Enabled: false},
+	}
+	suite.Require().Equal(expected, bk.GetAllSendEnabledEntries(ctx))
+
+	exportGenesis := bk.ExportGenesis(ctx)
+	suite.Require().Empty(exportGenesis.Params.SendEnabled)
+	suite.Require().Equal(expected, exportGenesis.SendEnabled)
+}
+
 func (suite *IntegrationTestSuite) TestTotalSupply() {
 	// Prepare some test data.
 	defaultGenesis := types.DefaultGenesisState()
@@ -92,17 +112,17 @@ func (suite *IntegrationTestSuite) TestTotalSupply() {
 	}{
 		{
 			"calculation NOT matching genesis Supply field",
-			types.NewGenesisState(defaultGenesis.Params, balances, sdk.NewCoins(sdk.NewCoin("wrongcoin", sdk.NewInt(1))), defaultGenesis.DenomMetadata),
+			types.NewGenesisState(defaultGenesis.Params, balances, sdk.NewCoins(sdk.NewCoin("wrongcoin", sdk.NewInt(1))), defaultGenesis.DenomMetadata, defaultGenesis.SendEnabled),
 			nil, true, "genesis supply is incorrect, expected 1wrongcoin, got 21barcoin,11foocoin",
 		},
 		{
 			"calculation matches genesis Supply field",
-			types.NewGenesisState(defaultGenesis.Params, balances, totalSupply, defaultGenesis.DenomMetadata),
+			types.NewGenesisState(defaultGenesis.Params, balances, totalSupply, defaultGenesis.DenomMetadata, defaultGenesis.SendEnabled),
 			totalSupply, false, "",
 		},
 		{
 			"calculation is correct, empty genesis Supply field",
-			types.NewGenesisState(defaultGenesis.Params, balances, nil, defaultGenesis.DenomMetadata),
+			types.NewGenesisState(defaultGenesis.Params, balances, nil, defaultGenesis.DenomMetadata, defaultGenesis.SendEnabled),
 			totalSupply, false, "",
 		},
 	}
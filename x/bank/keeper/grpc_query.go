@@ -75,6 +75,74 @@ func (k BaseKeeper) AllBalances(ctx context.Context, req *types.QueryAllBalances
 	return &types.QueryAllBalancesResponse{Balances: balances, Pagination: pageRes}, nil
 }
 
+// SpendableBalances implements the Query/SpendableBalances gRPC method
+func (k BaseKeeper) SpendableBalances(ctx context.Context, req *types.QuerySpendableBalancesRequest) (*types.QuerySpendableBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	balances := sdk.NewCoins()
+	accountStore := k.getAccountStore(sdkCtx, addr)
+
+	pageRes, err := query.Paginate(accountStore, req.Pagination, func(key, value []byte) error {
+		var amount sdk.Int
+		if err := amount.Unmarshal(value); err != nil {
+			return err
+		}
+		balances = append(balances, sdk.NewCoin(string(key), amount))
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	var locked, delegatedVesting, delegatedFree sdk.Coins
+	if vacc, ok := k.ak.GetAccount(sdkCtx, addr).(types.VestingAccount); ok {
+		locked = vacc.LockedCoins(sdkCtx.BlockTime())
+		delegatedVesting = vacc.GetDelegatedVesting()
+		delegatedFree = vacc.GetDelegatedFree()
+	}
+
+	spendable := make(sdk.Coins, 0, len(balances))
+	breakdowns := make([]types.BalanceBreakdown, 0, len(balances))
+	for _, balance := range balances {
+		lockedAmt := locked.AmountOf(balance.Denom)
+
+		spendableAmt := sdk.ZeroInt()
+		if balance.Amount.GT(lockedAmt) {
+			spendableAmt = balance.Amount.Sub(lockedAmt)
+		}
+
+		// Fully locked denoms are only reported in the breakdowns, as in
+		// SpendableCoins, so that the spendable balances remain valid coins.
+		if spendableAmt.IsPositive() {
+			spendable = append(spendable, sdk.NewCoin(balance.Denom, spendableAmt))
+		}
+		breakdowns = append(breakdowns, types.BalanceBreakdown{
+			Denom:            balance.Denom,
+			Total:            balance.Amount,
+			Spendable:        spendableAmt,
+			Locked:           lockedAmt,
+			DelegatedVesting: delegatedVesting.AmountOf(balance.Denom),
+			DelegatedFree:    delegatedFree.AmountOf(balance.Denom),
+		})
+	}
+
+	return &types.QuerySpendableBalancesResponse{Balances: spendable, Pagination: pageRes, Breakdowns: breakdowns}, nil
+}
+
 // TotalSupply implements the Query/TotalSupply gRPC method
 func (k BaseKeeper) TotalSupply(ctx context.Context, req *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -210,3 +278,35 @@ func (k BaseKeeper) DenomOwners(
 
 	return &types.QueryDenomOwnersResponse{DenomOwners: denomOwners, Pagination: pageRes}, nil
 }
+
+// SendEnabled implements Query/SendEnabled gRPC method. It only returns the
+// denoms with a SendEnabled entry, either the requested ones or, if no denom is
+// requested, a page of all the entries.
+func (k BaseKeeper) SendEnabled(goCtx context.Context, req *types.QuerySendEnabledRequest) (*types.QuerySendEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &types.QuerySendEnabledResponse{}
+	if len(req.Denoms) > 0 {
+		for _, denom := range req.Denoms {
+			if se, ok := k.GetSendEnabledEntry(ctx, denom); ok {
+				resp.SendEnabled = append(resp.SendEnabled, types.NewSendEnabled(se.Denom, se.Enabled))
+			}
+		}
+		return resp, nil
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SendEnabledPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		resp.SendEnabled = append(resp.SendEnabled, types.NewSendEnabled(string(key), fromBoolB(value)))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp.Pagination = pageRes
+	return resp, nil
+}
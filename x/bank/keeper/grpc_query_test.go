@@ -3,11 +3,15 @@ package keeper_test
 import (
 	gocontext "context"
 	"fmt"
+	"time"
+
+	tmtime "github.com/tendermint/tendermint/libs/time"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
@@ -86,6 +90,116 @@ func (suite *IntegrationTestSuite) TestQueryAllBalances() {
 	suite.Nil(res.Pagination.NextKey)
 }
 
+func (suite *IntegrationTestSuite) TestQuerySpendableBalances() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, err := queryClient.SpendableBalances(gocontext.Background(), &types.QuerySpendableBalancesRequest{})
+	suite.Require().Error(err)
+
+	pageReq := &query.PageRequest{
+		Key:        nil,
+		Limit:      2,
+		CountTotal: false,
+	}
+	req := types.NewQuerySpendableBalancesRequest(addr, pageReq)
+	res, err := queryClient.SpendableBalances(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+	suite.True(res.Balances.IsZero())
+	suite.Empty(res.Breakdowns)
+
+	now := tmtime.Now()
+	ctx = ctx.WithBlockTime(now)
+	endTime := now.Add(24 * time.Hour)
+
+	origCoins := sdk.NewCoins(newFooCoin(50), newBarCoin(30))
+	vestingCoins := sdk.NewCoins(newFooCoin(20))
+	delCoins := sdk.NewCoins(newFooCoin(15))
+
+	addrModule := sdk.AccAddress([]byte("moduleAcc___________"))
+	macc := app.AccountKeeper.NewAccountWithAddress(ctx, addrModule)
+	acc := vesting.NewContinuousVestingAccount(authtypes.NewBaseAccountWithAddress(addr), vestingCoins, now.Unix(), endTime.Unix())
+
+	app.AccountKeeper.SetAccount(ctx, macc)
+	app.AccountKeeper.SetAccount(ctx, acc)
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr, origCoins))
+
+	// move halfway through the vesting period and delegate more than the vested amount
+	ctx = ctx.WithBlockTime(now.Add(12 * time.Hour))
+	suite.Require().NoError(app.BankKeeper.DelegateCoins(ctx, addr, addrModule, delCoins))
+
+	res, err = app.BankKeeper.SpendableBalances(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+	suite.Require().Len(res.Breakdowns, 2)
+
+	// 10foo are still vesting, all of which are delegated, so nothing is locked
+	// and the remaining 35foo are spendable.
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(30), newFooCoin(35)), res.Balances)
+	suite.Require().Equal(types.BalanceBreakdown{
+		Denom:            barDenom,
+		Total:            sdk.NewInt(30),
+		Spendable:        sdk.NewInt(30),
+		Locked:           sdk.ZeroInt(),
+		DelegatedVesting: sdk.ZeroInt(),
+		DelegatedFree:    sdk.ZeroInt(),
+	}, res.Breakdowns[0])
+	suite.Require().Equal(types.BalanceBreakdown{
+		Denom:            fooDenom,
+		Total:            sdk.NewInt(35),
+		Spendable:        sdk.NewInt(35),
+		Locked:           sdk.ZeroInt(),
+		DelegatedVesting: sdk.NewInt(10),
+		DelegatedFree:    sdk.NewInt(5),
+	}, res.Breakdowns[1])
+
+	suite.T().Log("query one balance per page")
+	pageReq = &query.PageRequest{
+		Key:        nil,
+		Limit:      1,
+		CountTotal: true,
+	}
+	req = types.NewQuerySpendableBalancesRequest(addr, pageReq)
+	res, err = app.BankKeeper.SpendableBalances(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.Balances.Len(), 1)
+	suite.Require().Len(res.Breakdowns, 1)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	pageReq = &query.PageRequest{
+		Key:   res.Pagination.NextKey,
+		Limit: 1,
+	}
+	req = types.NewQuerySpendableBalancesRequest(addr, pageReq)
+	res, err = app.BankKeeper.SpendableBalances(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.Balances.Len(), 1)
+	suite.Require().Equal(fooDenom, res.Breakdowns[0].Denom)
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	suite.T().Log("fully locked denoms are only reported in the breakdowns")
+	_, _, lockedAddr := testdata.KeyTestPubAddr()
+	lockedAcc := vesting.NewDelayedVestingAccount(authtypes.NewBaseAccountWithAddress(lockedAddr), sdk.NewCoins(newBarCoin(30)), endTime.Unix())
+	app.AccountKeeper.SetAccount(ctx, lockedAcc)
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, lockedAddr, sdk.NewCoins(newFooCoin(10), newBarCoin(30))))
+
+	req = types.NewQuerySpendableBalancesRequest(lockedAddr, nil)
+	res, err = app.BankKeeper.SpendableBalances(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Balances.IsValid())
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), res.Balances)
+	suite.Require().Equal(app.BankKeeper.SpendableCoins(ctx, lockedAddr), res.Balances)
+	suite.Require().Len(res.Breakdowns, 2)
+	suite.Require().Equal(types.BalanceBreakdown{
+		Denom:            barDenom,
+		Total:            sdk.NewInt(30),
+		Spendable:        sdk.ZeroInt(),
+		Locked:           sdk.NewInt(30),
+		DelegatedVesting: sdk.ZeroInt(),
+		DelegatedFree:    sdk.ZeroInt(),
+	}, res.Breakdowns[0])
+}
+
 func (suite *IntegrationTestSuite) TestQueryTotalSupply() {
 	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
 	res, err := queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{})
@@ -133,6 +247,49 @@ func (suite *IntegrationTestSuite) TestQueryParams() {
 	suite.Require().Equal(suite.app.BankKeeper.GetParams(suite.ctx), res.GetParams())
 }
 
+func (suite *IntegrationTestSuite) TestQuerySendEnabled() {
+	ctx, bk := suite.ctx, suite.app.BankKeeper
+
+	bk.SetSendEnabled(ctx, "falsestcoin", false)
+	bk.SetSendEnabled(ctx, "truestcoin", true)
+
+	testCases := []struct {
+		name string
+		req  *types.QuerySendEnabledRequest
+		exp  []*types.SendEnabled
+	}{
+		{
+			"no denoms, no pagination",
+			&types.QuerySendEnabledRequest{},
+			[]*types.SendEnabled{
+				types.NewSendEnabled("falsestcoin", false),
+				types.NewSendEnabled("truestcoin", true),
+			},
+		},
+		{
+			"denoms, including unknown ones",
+			&types.QuerySendEnabledRequest{Denoms: []string{"truestcoin", "unknowncoin", "falsestcoin"}},
+			[]*types.SendEnabled{
+				types.NewSendEnabled("truestcoin", true),
+				types.NewSendEnabled("falsestcoin", false),
+			},
+		},
+		{
+			"pagination",
+			&types.QuerySendEnabledRequest{Pagination: &query.PageRequest{Offset: 1, Limit: 1}},
+			[]*types.SendEnabled{types.NewSendEnabled("truestcoin", true)},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.queryClient.SendEnabled(gocontext.Background(), tc.req)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.exp, res.SendEnabled)
+		})
+	}
+}
+
 func (suite *IntegrationTestSuite) QueryDenomsMetadataRequest() {
 	var (
 		req         *types.QueryDenomsMetadataRequest
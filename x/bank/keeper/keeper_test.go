@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -19,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -86,7 +89,7 @@ func (suite *IntegrationTestSuite) initKeepersWithmAccPerms(blockedAddrs map[str
 	)
 	keeper := keeper.NewBaseKeeper(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), blockedAddrs,
+		app.GetSubspace(types.ModuleName), blockedAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	return authKeeper, keeper
@@ -505,8 +508,8 @@ func (suite *IntegrationTestSuite) TestSendEnabled() {
 
 	// Set default send_enabled to !enabled, add a foodenom that overrides default as enabled
 	params.DefaultSendEnabled = !enabled
-	params = params.SetSendEnabledParam(fooCoin.Denom, enabled)
 	app.BankKeeper.SetParams(ctx, params)
+	app.BankKeeper.SetSendEnabled(ctx, fooCoin.Denom, enabled)
 
 	// Expect our specific override to be enabled, others to be !enabled.
 	suite.Require().Equal(enabled, app.BankKeeper.IsSendEnabledCoin(ctx, fooCoin))
@@ -1053,7 +1056,7 @@ func (suite *IntegrationTestSuite) TestBalanceTrackingEvents() {
 	)
 
 	suite.app.BankKeeper = keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
-		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil)
+		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	// set account with multiple permissions
 	suite.app.AccountKeeper.SetModuleAccount(suite.ctx, multiPermAcc)
@@ -1170,3 +1173,241 @@ func (suite *IntegrationTestSuite) getTestMetadata() []types.Metadata {
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
+
+func (suite *IntegrationTestSuite) TestSendRestrictions() {
+	app, ctx := suite.app, suite.ctx
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	blockedAddr := sdk.AccAddress([]byte("blocked_____________"))
+	addrModule := sdk.AccAddress([]byte("moduleAcc___________"))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addrModule))
+
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(100))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addrModule, balances))
+	suite.Require().NoError(testutil.FundModuleAccount(app.BankKeeper, ctx, minttypes.ModuleName, balances))
+
+	// the restrictions are shared by the copies of the keeper made before they are registered
+	bankKeeper := app.BankKeeper
+
+	var calls []string
+	frozenDenom := func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+		calls = append(calls, "frozen denom")
+		if amt.AmountOf(fooDenom).IsPositive() {
+			return sdkerrors.ErrUnauthorized.Wrapf("%s is frozen", fooDenom)
+		}
+		return nil
+	}
+	complianceList := func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+		calls = append(calls, "compliance list")
+		if blockedAddr.Equals(toAddr) {
+			return sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed to receive funds", toAddr)
+		}
+		return nil
+	}
+	app.BankKeeper.AppendSendRestriction(complianceList)
+	app.BankKeeper.PrependSendRestriction(frozenDenom)
+
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().Equal([]string{"frozen denom", "compliance list"}, calls, "restrictions should run in order")
+
+	testCases := []struct {
+		name string
+		send func() error
+	}{
+		{
+			"send frozen denom",
+			func() error { return bankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10))) },
+		},
+		{
+			"send to blocked address",
+			func() error { return bankKeeper.SendCoins(ctx, addr1, blockedAddr, sdk.NewCoins(newBarCoin(10))) },
+		},
+		{
+			"multi-send to blocked address",
+			func() error {
+				return bankKeeper.InputOutputCoins(ctx,
+					[]types.Input{{Address: addr1.String(), Coins: sdk.NewCoins(newBarCoin(20))}},
+					[]types.Output{
+						{Address: addr2.String(), Coins: sdk.NewCoins(newBarCoin(10))},
+						{Address: blockedAddr.String(), Coins: sdk.NewCoins(newBarCoin(10))},
+					},
+				)
+			},
+		},
+		{
+			"multi-send of frozen denom with several inputs",
+			func() error {
+				return bankKeeper.InputOutputCoins(ctx,
+					[]types.Input{
+						{Address: addr1.String(), Coins: sdk.NewCoins(newBarCoin(10))},
+						{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+					},
+					[]types.Output{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(10), newBarCoin(10))}},
+				)
+			},
+		},
+		{
+			"send from module to blocked address",
+			func() error {
+				return bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, blockedAddr, sdk.NewCoins(newBarCoin(10)))
+			},
+		},
+		{
+			"delegate frozen denom",
+			func() error { return bankKeeper.DelegateCoins(ctx, addr1, addrModule, sdk.NewCoins(newFooCoin(10))) },
+		},
+		{
+			"undelegate to blocked address",
+			func() error {
+				return bankKeeper.UndelegateCoins(ctx, addrModule, blockedAddr, sdk.NewCoins(newBarCoin(10)))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Require().ErrorIs(tc.send(), sdkerrors.ErrUnauthorized)
+		})
+	}
+
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(100), newBarCoin(90)), app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, blockedAddr).Empty())
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addrModule))
+
+	app.BankKeeper.ClearSendRestriction()
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, addr1, blockedAddr, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), app.BankKeeper.GetAllBalances(ctx, blockedAddr))
+}
+
+// mockSendHooks records the transfers it is called on, and vetoes the
+// transfers to blockedAddr
+type mockSendHooks struct {
+	blockedAddr   sdk.AccAddress
+	before, after []string
+}
+
+func (h *mockSendHooks) BeforeSend(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if h.blockedAddr.Equals(toAddr) {
+		return sdkerrors.ErrUnauthorized
+	}
+
+	h.before = append(h.before, fmt.Sprintf("%s->%s:%s", fromAddr, toAddr, amt))
+	return nil
+}
+
+func (h *mockSendHooks) AfterSend(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	h.after = append(h.after, fmt.Sprintf("%s->%s:%s", fromAddr, toAddr, amt))
+	return nil
+}
+
+func (suite *IntegrationTestSuite) TestSendHooks() {
+	app, ctx := suite.app, suite.ctx
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, sdk.NewCoins(newFooCoin(100))))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr2, sdk.NewCoins(newFooCoin(100))))
+
+	hooks := &mockSendHooks{blockedAddr: addr3}
+	app.BankKeeper.SetHooks(types.NewMultiSendHooks(hooks))
+	suite.Require().Panics(func() { app.BankKeeper.SetHooks(hooks) }, "hooks should not be set twice")
+
+	// send
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10))))
+	expected := []string{fmt.Sprintf("%s->%s:10foo", addr1, addr2)}
+	suite.Require().Equal(expected, hooks.before)
+	suite.Require().Equal(expected, hooks.after)
+
+	// multi-send with several inputs
+	hooks.before, hooks.after = nil, nil
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx,
+		[]types.Input{
+			{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+			{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(20))},
+		},
+		[]types.Output{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(30))}},
+	))
+	expected = []string{
+		fmt.Sprintf("%s->:10foo", addr1),
+		fmt.Sprintf("%s->:20foo", addr2),
+		fmt.Sprintf("->%s:30foo", addr1),
+	}
+	suite.Require().Equal(expected, hooks.before)
+	suite.Require().Equal(expected, hooks.after)
+
+	// vetoed send
+	hooks.before, hooks.after = nil, nil
+	suite.Require().ErrorIs(app.BankKeeper.SendCoins(ctx, addr1, addr3, sdk.NewCoins(newFooCoin(10))), sdkerrors.ErrUnauthorized)
+	suite.Require().Empty(hooks.after)
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr3).Empty())
+}
+
+func (suite *IntegrationTestSuite) TestSendEnabledEntries() {
+	app, ctx := suite.app, suite.ctx
+	bk := app.BankKeeper
+
+	_, found := bk.GetSendEnabledEntry(ctx, fooDenom)
+	suite.Require().False(found)
+	suite.Require().Empty(bk.GetAllSendEnabledEntries(ctx))
+
+	bk.SetSendEnabled(ctx, fooDenom, false)
+	bk.SetAllSendEnabled(ctx, []*types.SendEnabled{
+		types.NewSendEnabled(barDenom, true),
+		types.NewSendEnabled("bazcoin", false),
+	})
+
+	entry, found := bk.GetSendEnabledEntry(ctx, fooDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(types.SendEnabled{Denom: fooDenom, Enabled: false}, entry)
+
+	suite.Require().Equal([]types.SendEnabled{
+		{Denom: barDenom, Enabled: true},
+		{Denom: "bazcoin", Enabled: false},
+		{Denom: fooDenom, Enabled: false},
+	}, bk.GetAllSendEnabledEntries(ctx))
+
+	var denoms []string
+	bk.IterateSendEnabledEntries(ctx, func(denom string, _ bool) bool {
+		denoms = append(denoms, denom)
+		return len(denoms) == 2
+	})
+	suite.Require().Equal([]string{barDenom, "bazcoin"}, denoms)
+
+	bk.DeleteSendEnabled(ctx, "bazcoin", fooDenom, "quxcoin")
+	suite.Require().Equal([]types.SendEnabled{{Denom: barDenom, Enabled: true}}, bk.GetAllSendEnabledEntries(ctx))
+}
+
+func (suite *IntegrationTestSuite) TestIsSendEnabledDenom() {
+	app, ctx := suite.app, suite.ctx
+	bk := app.BankKeeper
+
+	bk.SetSendEnabled(ctx, fooDenom, false)
+	bk.SetSendEnabled(ctx, barDenom, true)
+
+	for _, defaultSendEnabled := range []bool{true, false} {
+		bk.SetParams(ctx, types.NewParams(defaultSendEnabled))
+
+		suite.Require().False(bk.IsSendEnabledDenom(ctx, fooDenom))
+		suite.Require().True(bk.IsSendEnabledDenom(ctx, barDenom))
+		suite.Require().Equal(defaultSendEnabled, bk.IsSendEnabledDenom(ctx, sdk.DefaultBondDenom))
+	}
+}
+
+func (suite *IntegrationTestSuite) TestSetParamsMovesSendEnabled() {
+	app, ctx := suite.app, suite.ctx
+	bk := app.BankKeeper
+
+	params := types.NewParams(false)
+	params.SendEnabled = types.SendEnabledParams{types.NewSendEnabled(fooDenom, true)}
+	bk.SetParams(ctx, params)
+
+	suite.Require().Empty(bk.GetParams(ctx).SendEnabled)
+	suite.Require().False(bk.GetParams(ctx).DefaultSendEnabled)
+	suite.Require().Equal([]types.SendEnabled{{Denom: fooDenom, Enabled: true}}, bk.GetAllSendEnabledEntries(ctx))
+	suite.Require().True(bk.IsSendEnabledDenom(ctx, fooDenom))
+	suite.Require().False(bk.IsSendEnabledDenom(ctx, barDenom))
+}
@@ -104,3 +104,41 @@ func (k msgServer) MultiSend(goCtx context.Context, msg *types.MsgMultiSend) (*t
 
 	return &types.MsgMultiSendResponse{}, nil
 }
+
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("expected %s got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if len(msg.Params.SendEnabled) > 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("use of send_enabled in params is no longer supported, use MsgSetSendEnabled")
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (k msgServer) SetSendEnabled(goCtx context.Context, msg *types.MsgSetSendEnabled) (*types.MsgSetSendEnabledResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("expected %s got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if len(msg.SendEnabled) > 0 {
+		k.SetAllSendEnabled(ctx, msg.SendEnabled)
+	}
+
+	if len(msg.UseDefaultFor) > 0 {
+		k.DeleteSendEnabled(ctx, msg.UseDefaultFor...)
+	}
+
+	return &types.MsgSetSendEnabledResponse{}, nil
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *IntegrationTestSuite) TestMsgUpdateParams() {
+	msgServer := keeper.NewMsgServerImpl(suite.app.BankKeeper)
+	authority := suite.app.BankKeeper.GetAuthority()
+
+	params := types.NewParams(false)
+	legacyParams := params
+	legacyParams.SendEnabled = types.SendEnabledParams{types.NewSendEnabled("foocoin", true)}
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgUpdateParams
+		expErrMsg string
+	}{
+		{
+			"invalid authority",
+			types.NewMsgUpdateParams(sdk.AccAddress("invalid").String(), params),
+			"expected " + authority,
+		},
+		{
+			"send enabled entries in params",
+			types.NewMsgUpdateParams(authority, legacyParams),
+			"use of send_enabled in params is no longer supported",
+		},
+		{
+			"valid params",
+			types.NewMsgUpdateParams(authority, params),
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.expErrMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				suite.Require().True(suite.app.BankKeeper.GetParams(ctx).DefaultSendEnabled)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().False(suite.app.BankKeeper.GetParams(ctx).DefaultSendEnabled)
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestMsgSetSendEnabled() {
+	msgServer := keeper.NewMsgServerImpl(suite.app.BankKeeper)
+	authority := suite.app.BankKeeper.GetAuthority()
+
+	suite.app.BankKeeper.SetSendEnabled(suite.ctx, "barcoin", false)
+	suite.app.BankKeeper.SetSendEnabled(suite.ctx, "bazcoin", false)
+
+	testCases := []struct {
+		name       string
+		msg        *types.MsgSetSendEnabled
+		expErrMsg  string
+		expEntries []types.SendEnabled
+	}{
+		{
+			"invalid authority",
+			types.NewMsgSetSendEnabled(sdk.AccAddress("invalid").String(), []*types.SendEnabled{types.NewSendEnabled("foocoin", false)}, nil),
+			"expected " + authority,
+			nil,
+		},
+		{
+			"set and delete entries",
+			types.NewMsgSetSendEnabled(authority, []*types.SendEnabled{
+				types.NewSendEnabled("foocoin", false),
+				types.NewSendEnabled("barcoin", true),
+			}, []string{"bazcoin", "quxcoin"}),
+			"",
+			[]types.SendEnabled{
+				{Denom: "barcoin", Enabled: true},
+				{Denom: "foocoin", Enabled: false},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			_, err := msgServer.SetSendEnabled(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.expErrMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expEntries, suite.app.BankKeeper.GetAllSendEnabledEntries(ctx))
+		})
+	}
+}
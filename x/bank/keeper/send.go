@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -23,10 +25,22 @@ type SendKeeper interface {
 	GetParams(ctx sdk.Context) types.Params
 	SetParams(ctx sdk.Context, params types.Params)
 
+	IsSendEnabledDenom(ctx sdk.Context, denom string) bool
 	IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
 	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
+	GetSendEnabledEntry(ctx sdk.Context, denom string) (types.SendEnabled, bool)
+	SetSendEnabled(ctx sdk.Context, denom string, value bool)
+	SetAllSendEnabled(ctx sdk.Context, sendEnableds []*types.SendEnabled)
+	DeleteSendEnabled(ctx sdk.Context, denoms ...string)
+	IterateSendEnabledEntries(ctx sdk.Context, cb func(denom string, sendEnabled bool) (stop bool))
+	GetAllSendEnabledEntries(ctx sdk.Context) []types.SendEnabled
 
 	BlockedAddr(addr sdk.AccAddress) bool
+
+	AppendSendRestriction(restriction types.SendRestrictionFn)
+	PrependSendRestriction(restriction types.SendRestrictionFn)
+	ClearSendRestriction()
+	SetHooks(sh types.SendHooks)
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -43,11 +57,29 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
+
+	// the address capable of executing a MsgUpdateParams or a
+	// MsgSetSendEnabled message, typically the gov module account
+	authority string
+
+	// the send restriction and hooks, shared by all the copies of the keeper
+	sendExtensions *sendExtensions
+}
+
+// sendExtensions holds the send restriction and hooks of a BaseSendKeeper, so
+// that they can be registered after the keeper is passed to other keepers.
+type sendExtensions struct {
+	restriction types.SendRestrictionFn
+	hooks       types.SendHooks
 }
 
 func NewBaseSendKeeper(
 	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace, blockedAddrs map[string]bool,
+	authority string,
 ) BaseSendKeeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
+	}
 
 	return BaseSendKeeper{
 		BaseViewKeeper: NewBaseViewKeeper(cdc, storeKey, ak),
@@ -56,9 +88,68 @@ func NewBaseSendKeeper(
 		storeKey:       storeKey,
 		paramSpace:     paramSpace,
 		blockedAddrs:   blockedAddrs,
+		authority:      authority,
+		sendExtensions: &sendExtensions{},
 	}
 }
 
+// GetAuthority returns the address capable of updating the bank params and
+// send enabled flags
+func (k BaseSendKeeper) GetAuthority() string {
+	return k.authority
+}
+
+// AppendSendRestriction adds the provided SendRestrictionFn to run after the
+// previously provided restrictions.
+func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendExtensions.restriction = k.sendExtensions.restriction.Then(restriction)
+}
+
+// PrependSendRestriction adds the provided SendRestrictionFn to run before the
+// previously provided restrictions.
+func (k BaseSendKeeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendExtensions.restriction = restriction.Then(k.sendExtensions.restriction)
+}
+
+// ClearSendRestriction removes the send restrictions.
+func (k BaseSendKeeper) ClearSendRestriction() {
+	k.sendExtensions.restriction = nil
+}
+
+// SetHooks sets the send hooks. They are shared by all the copies of the keeper.
+func (k BaseSendKeeper) SetHooks(sh types.SendHooks) {
+	if k.sendExtensions.hooks != nil {
+		panic("cannot set bank send hooks twice")
+	}
+
+	k.sendExtensions.hooks = sh
+}
+
+// beforeSend runs the send restriction and the BeforeSend hooks, either of
+// which can veto the transfer.
+func (k BaseSendKeeper) beforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if k.sendExtensions.restriction != nil {
+		if err := k.sendExtensions.restriction(ctx, fromAddr, toAddr, amt); err != nil {
+			return err
+		}
+	}
+
+	if k.sendExtensions.hooks != nil {
+		return k.sendExtensions.hooks.BeforeSend(ctx, fromAddr, toAddr, amt)
+	}
+
+	return nil
+}
+
+// afterSend runs the AfterSend hooks.
+func (k BaseSendKeeper) afterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if k.sendExtensions.hooks != nil {
+		return k.sendExtensions.hooks.AfterSend(ctx, fromAddr, toAddr, amt)
+	}
+
+	return nil
+}
+
 // GetParams returns the total set of bank parameters.
 func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -66,7 +157,16 @@ func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 }
 
 // SetParams sets the total set of bank parameters.
+//
+// Note: params.SendEnabled is deprecated, but it should be here regardless:
+// its entries are moved to the send enabled store, and the params are set
+// without them.
 func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
+	if len(params.SendEnabled) > 0 {
+		k.SetAllSendEnabled(ctx, params.SendEnabled)
+	}
+
+	params = types.NewParams(params.DefaultSendEnabled)
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
@@ -80,6 +180,13 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
+	err := forEachInputOutputTransfer(inputs, outputs, func(fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+		return k.beforeSend(ctx, fromAddr, toAddr, amt)
+	})
+	if err != nil {
+		return err
+	}
+
 	for _, in := range inputs {
 		inAddress, err := sdk.AccAddressFromBech32(in.Address)
 		if err != nil {
@@ -128,12 +235,19 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		}
 	}
 
-	return nil
+	return forEachInputOutputTransfer(inputs, outputs, func(fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+		return k.afterSend(ctx, fromAddr, toAddr, amt)
+	})
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// An error is returned upon failure, or if the send restriction or hooks veto
+// the transfer.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.beforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
 	err := k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
@@ -169,7 +283,7 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 		),
 	})
 
-	return nil
+	return k.afterSend(ctx, fromAddr, toAddr, amt)
 }
 
 // subUnlockedCoins removes the unlocked amt coins of the given account. An error is
@@ -315,7 +429,105 @@ func (k BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) e
 
 // IsSendEnabledCoin returns the current SendEnabled status of the provided coin's denom
 func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
-	return k.GetParams(ctx).SendEnabledDenom(coin.Denom)
+	return k.IsSendEnabledDenom(ctx, coin.Denom)
+}
+
+// IsSendEnabledDenom returns the current SendEnabled status of the provided
+// denom, the DefaultSendEnabled param if it has no SendEnabled entry.
+func (k BaseSendKeeper) IsSendEnabledDenom(ctx sdk.Context, denom string) bool {
+	sendEnabled, found := k.getSendEnabled(ctx, denom)
+	if !found {
+		return k.GetParams(ctx).DefaultSendEnabled
+	}
+
+	return sendEnabled
+}
+
+// GetSendEnabledEntry gets a SendEnabled entry for the given denom.
+// The second return argument is true iff a specific entry exists for the given denom.
+func (k BaseSendKeeper) GetSendEnabledEntry(ctx sdk.Context, denom string) (types.SendEnabled, bool) {
+	sendEnabled, found := k.getSendEnabled(ctx, denom)
+	if !found {
+		return types.SendEnabled{}, false
+	}
+
+	return types.SendEnabled{Denom: denom, Enabled: sendEnabled}, true
+}
+
+// SetSendEnabled sets the SendEnabled flag for a denom to the provided value.
+func (k BaseSendKeeper) SetSendEnabled(ctx sdk.Context, denom string, value bool) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CreateSendEnabledKey(denom), toBoolB(value))
+}
+
+// SetAllSendEnabled sets all the provided SendEnabled entries in the bank store.
+func (k BaseSendKeeper) SetAllSendEnabled(ctx sdk.Context, sendEnableds []*types.SendEnabled) {
+	for _, se := range sendEnableds {
+		k.SetSendEnabled(ctx, se.Denom, se.Enabled)
+	}
+}
+
+// DeleteSendEnabled deletes the SendEnabled flags for one or more denoms.
+// If a denom is provided that doesn't have a SendEnabled entry, it is ignored.
+func (k BaseSendKeeper) DeleteSendEnabled(ctx sdk.Context, denoms ...string) {
+	store := ctx.KVStore(k.storeKey)
+	for _, denom := range denoms {
+		store.Delete(types.CreateSendEnabledKey(denom))
+	}
+}
+
+// IterateSendEnabledEntries iterates over all the SendEnabled entries, in the
+// order of their denoms, until cb returns true.
+func (k BaseSendKeeper) IterateSendEnabledEntries(ctx sdk.Context, cb func(denom string, sendEnabled bool) (stop bool)) {
+	sendEnabledStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SendEnabledPrefix)
+
+	iterator := sendEnabledStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key()), fromBoolB(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetAllSendEnabledEntries gets all the SendEnabled entries that are stored.
+// Any denoms not returned use the default value (set in Params).
+func (k BaseSendKeeper) GetAllSendEnabledEntries(ctx sdk.Context) []types.SendEnabled {
+	var sendEnabled []types.SendEnabled
+	k.IterateSendEnabledEntries(ctx, func(denom string, enabled bool) bool {
+		sendEnabled = append(sendEnabled, types.SendEnabled{Denom: denom, Enabled: enabled})
+		return false
+	})
+
+	return sendEnabled
+}
+
+// getSendEnabled returns the SendEnabled flag of a denom, and whether the
+// denom has a SendEnabled entry.
+func (k BaseSendKeeper) getSendEnabled(ctx sdk.Context, denom string) (bool, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.CreateSendEnabledKey(denom))
+	if bz == nil {
+		return false, false
+	}
+
+	return fromBoolB(bz), true
+}
+
+// toBoolB converts a bool to the byte representation of a SendEnabled flag.
+func toBoolB(value bool) []byte {
+	if value {
+		return []byte{0x01}
+	}
+
+	return []byte{0x00}
+}
+
+// fromBoolB converts the byte representation of a SendEnabled flag to a bool.
+func fromBoolB(bz []byte) bool {
+	return len(bz) == 1 && bz[0] == 0x01
 }
 
 // BlockedAddr checks if a given address is restricted from
@@ -323,3 +535,53 @@ func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
 func (k BaseSendKeeper) BlockedAddr(addr sdk.AccAddress) bool {
 	return k.blockedAddrs[addr.String()]
 }
+
+// forEachInputOutputTransfer calls fn on the transfers of a multi-send, as
+// documented in types.SendRestrictionFn.
+func forEachInputOutputTransfer(
+	inputs []types.Input, outputs []types.Output, fn func(fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error,
+) error {
+	if len(inputs) == 1 {
+		inAddress, err := sdk.AccAddressFromBech32(inputs[0].Address)
+		if err != nil {
+			return err
+		}
+
+		for _, out := range outputs {
+			outAddress, err := sdk.AccAddressFromBech32(out.Address)
+			if err != nil {
+				return err
+			}
+
+			if err := fn(inAddress, outAddress, out.Coins); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	for _, in := range inputs {
+		inAddress, err := sdk.AccAddressFromBech32(in.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(inAddress, nil, in.Coins); err != nil {
+			return err
+		}
+	}
+
+	for _, out := range outputs {
+		outAddress, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(nil, outAddress, out.Coins); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
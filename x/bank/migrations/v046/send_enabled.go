@@ -0,0 +1,32 @@
+package v046
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateSendEnabled performs in-place store migrations of the send enabled
+// flags. The migration includes:
+//
+// - Moving the SendEnabled entries of the x/params subspace to the bank store.
+// - Clearing the SendEnabled param.
+func MigrateSendEnabled(ctx sdk.Context, storeKey storetypes.StoreKey, paramSubspace paramtypes.Subspace) error {
+	var sendEnabled []*types.SendEnabled
+	if paramSubspace.Has(ctx, types.KeySendEnabled) {
+		paramSubspace.Get(ctx, types.KeySendEnabled, &sendEnabled)
+	}
+
+	store := ctx.KVStore(storeKey)
+	for _, se := range sendEnabled {
+		value := []byte{0x00}
+		if se.Enabled {
+			value = []byte{0x01}
+		}
+		store.Set(types.CreateSendEnabledKey(se.Denom), value)
+	}
+
+	paramSubspace.Set(ctx, types.KeySendEnabled, []*types.SendEnabled{})
+	return nil
+}
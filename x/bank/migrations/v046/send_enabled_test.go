@@ -0,0 +1,43 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateSendEnabled(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	bankKey := sdk.NewKVStoreKey("bank")
+	tBankKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(bankKey, tBankKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, bankKey, tBankKey, types.ModuleName).WithKeyTable(types.ParamKeyTable())
+
+	// params from before the send enabled flags were moved to the store
+	params := types.Params{
+		SendEnabled: types.SendEnabledParams{
+			types.NewSendEnabled("foocoin", true),
+			types.NewSendEnabled("barcoin", false),
+		},
+		DefaultSendEnabled: false,
+	}
+	paramstore.SetParamSet(ctx, &params)
+
+	require.NoError(t, v046.MigrateSendEnabled(ctx, bankKey, paramstore))
+
+	var newParams types.Params
+	paramstore.GetParamSet(ctx, &newParams)
+	require.Empty(t, newParams.SendEnabled)
+	require.False(t, newParams.DefaultSendEnabled)
+
+	store := ctx.KVStore(bankKey)
+	require.Equal(t, []byte{0x01}, store.Get(types.CreateSendEnabledKey("foocoin")))
+	require.Equal(t, []byte{0x00}, store.Get(types.CreateSendEnabledKey("barcoin")))
+}
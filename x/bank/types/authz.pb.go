@@ -27,11 +27,16 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // SendAuthorization allows the grantee to spend up to spend_limit coins from
-// the granter's account.
+// the granter's account, optionally only to the addresses of allow_list.
 //
 // Since: cosmos-sdk 0.43
 type SendAuthorization struct {
 	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// allow_list specifies the only addresses the grantee can send to, all the
+	// addresses are allowed if it is empty.
+	//
+	// Since: cosmos-sdk 0.46
+	AllowList []string `protobuf:"bytes,2,rep,name=allow_list,json=allowList,proto3" json:"allow_list,omitempty"`
 }
 
 func (m *SendAuthorization) Reset()         { *m = SendAuthorization{} }
@@ -74,6 +79,13 @@ func (m *SendAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.C
 	return nil
 }
 
+func (m *SendAuthorization) GetAllowList() []string {
+	if m != nil {
+		return m.AllowList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SendAuthorization)(nil), "cosmos.bank.v1beta1.SendAuthorization")
 }
@@ -81,24 +93,27 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/authz.proto", fileDescriptor_a4d2a37888ea779f) }
 
 var fileDescriptor_a4d2a37888ea779f = []byte{
-	// 257 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4a, 0xcc, 0xcb, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x2c, 0x2d, 0xc9, 0xa8, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83, 0x58,
-	0x10, 0xa5, 0x52, 0x92, 0x10, 0xa5, 0xf1, 0x10, 0x09, 0xa8, 0x3e, 0x88, 0x94, 0x1c, 0xdc, 0x9a,
-	0xe2, 0x54, 0xb8, 0x35, 0xc9, 0xf9, 0x99, 0x79, 0x10, 0x79, 0xa5, 0x29, 0x8c, 0x5c, 0x82, 0xc1,
-	0xa9, 0x79, 0x29, 0x8e, 0xa5, 0x25, 0x19, 0xf9, 0x45, 0x99, 0x55, 0x89, 0x25, 0x99, 0xf9, 0x79,
-	0x42, 0x39, 0x5c, 0xdc, 0xc5, 0x05, 0xa9, 0x79, 0x29, 0xf1, 0x39, 0x99, 0xb9, 0x99, 0x25, 0x12,
-	0x8c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x92, 0x7a, 0x70, 0x17, 0x15, 0xa7, 0xc2, 0x5c, 0xa4, 0xe7,
-	0x9c, 0x9f, 0x99, 0xe7, 0x64, 0x70, 0xe2, 0x9e, 0x3c, 0xc3, 0xaa, 0xfb, 0xf2, 0x1a, 0xe9, 0x99,
-	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0x50, 0x67, 0x40, 0x29, 0xdd, 0xe2, 0x94, 0x6c,
-	0xfd, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xb0, 0x86, 0xe2, 0x20, 0x2e, 0xb0, 0xf9, 0x3e, 0x20, 0xe3,
-	0xad, 0x04, 0x4f, 0x6d, 0xd1, 0xe5, 0x45, 0x71, 0x80, 0x93, 0xf3, 0x89, 0x47, 0x72, 0x8c, 0x17,
-	0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c,
-	0x37, 0x1e, 0xcb, 0x31, 0x44, 0x69, 0xe2, 0xb5, 0xa2, 0x02, 0x12, 0x9e, 0x60, 0x9b, 0x92, 0xd8,
-	0xc0, 0x5e, 0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x86, 0xc3, 0x2e, 0x6b, 0x01, 0x00,
-	0x00,
+	// 306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xbd, 0x6e, 0xf2, 0x30,
+	0x14, 0x86, 0x93, 0x0f, 0xe9, 0x93, 0x30, 0xea, 0x00, 0x65, 0x00, 0x06, 0x83, 0x3a, 0xd1, 0x01,
+	0xa7, 0xb4, 0x43, 0xa5, 0x6e, 0xc0, 0xca, 0x04, 0x5b, 0x17, 0x94, 0x10, 0x2b, 0x58, 0x24, 0x3e,
+	0x51, 0x8e, 0xd3, 0x1f, 0xae, 0xa2, 0xd7, 0xd1, 0x99, 0x8b, 0x40, 0x95, 0x2a, 0xa1, 0x4e, 0x9d,
+	0xda, 0x2a, 0xb9, 0x91, 0x2a, 0x76, 0x1a, 0xa9, 0x4b, 0x27, 0x5b, 0x7a, 0x9f, 0x73, 0xde, 0x47,
+	0x36, 0xe9, 0xaf, 0x01, 0x23, 0x40, 0xc7, 0x73, 0xe5, 0xd6, 0xb9, 0x1b, 0x7b, 0x5c, 0xb9, 0x63,
+	0xc7, 0x4d, 0xd5, 0x66, 0xc7, 0xe2, 0x04, 0x14, 0xb4, 0x4e, 0x0d, 0xc0, 0x0a, 0x80, 0x95, 0x40,
+	0xaf, 0x1d, 0x40, 0x00, 0x3a, 0x77, 0x8a, 0x9b, 0x41, 0x7b, 0x5d, 0x83, 0xae, 0x4c, 0x50, 0xce,
+	0x99, 0x88, 0x56, 0x35, 0xc8, 0xab, 0x9a, 0x35, 0x08, 0x69, 0xf2, 0xb3, 0x57, 0x9b, 0x34, 0x97,
+	0x5c, 0xfa, 0x93, 0x54, 0x6d, 0x20, 0x11, 0x3b, 0x57, 0x09, 0x90, 0xad, 0x90, 0x34, 0x30, 0xe6,
+	0xd2, 0x5f, 0x85, 0x22, 0x12, 0xaa, 0x63, 0x0f, 0x6a, 0xc3, 0xc6, 0x65, 0x97, 0x55, 0x46, 0xc8,
+	0x7f, 0x8c, 0xd8, 0x0c, 0x84, 0x9c, 0x5e, 0x1c, 0x3e, 0xfa, 0xd6, 0xf3, 0x67, 0x7f, 0x18, 0x08,
+	0xb5, 0x49, 0x3d, 0xb6, 0x86, 0xa8, 0xd4, 0x28, 0x8f, 0x11, 0xfa, 0x5b, 0x47, 0x3d, 0xc6, 0x1c,
+	0xf5, 0x00, 0x2e, 0x88, 0xde, 0x3f, 0x2f, 0xd6, 0xb7, 0xae, 0x09, 0x71, 0xc3, 0x10, 0xee, 0x57,
+	0xa1, 0x40, 0xd5, 0xf9, 0x37, 0xa8, 0x0d, 0xeb, 0xd3, 0xce, 0xdb, 0x7e, 0xd4, 0x2e, 0xfb, 0x26,
+	0xbe, 0x9f, 0x70, 0xc4, 0xa5, 0x4a, 0x84, 0x0c, 0x16, 0x75, 0xcd, 0xce, 0x05, 0xaa, 0x9b, 0xe6,
+	0xcb, 0x7e, 0x74, 0xf2, 0xcb, 0x7c, 0x3a, 0x3b, 0x64, 0xd4, 0x3e, 0x66, 0xd4, 0xfe, 0xca, 0xa8,
+	0xfd, 0x94, 0x53, 0xeb, 0x98, 0x53, 0xeb, 0x3d, 0xa7, 0xd6, 0xed, 0xf9, 0x9f, 0x6e, 0x0f, 0xe6,
+	0x23, 0xb4, 0xa2, 0xf7, 0x5f, 0xbf, 0xcd, 0xd5, 0xf7, 0x00, 0x7f, 0xef, 0x70, 0xe6, 0xa4, 0x01,
+	0x00, 0x00,
 }
 
 func (m *SendAuthorization) Marshal() (dAtA []byte, err error) {
@@ -121,6 +136,15 @@ func (m *SendAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowList) > 0 {
+		for iNdEx := len(m.AllowList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowList[iNdEx])
+			copy(dAtA[i:], m.AllowList[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowList[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.SpendLimit) > 0 {
 		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -161,6 +185,12 @@ func (m *SendAuthorization) Size() (n int) {
 			n += 1 + l + sovAuthz(uint64(l))
 		}
 	}
+	if len(m.AllowList) > 0 {
+		for _, s := range m.AllowList {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -233,6 +263,38 @@ func (m *SendAuthorization) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowList = append(m.AllowList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
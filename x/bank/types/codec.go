@@ -14,12 +14,16 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSend{}, "cosmos-sdk/MsgSend", nil)
 	cdc.RegisterConcrete(&MsgMultiSend{}, "cosmos-sdk/MsgMultiSend", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "cosmos-sdk/x/bank/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetSendEnabled{}, "cosmos-sdk/MsgSetSendEnabled", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSend{},
 		&MsgMultiSend{},
+		&MsgUpdateParams{},
+		&MsgSetSendEnabled{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
@@ -26,3 +26,12 @@ type AccountKeeper interface {
 	GetModuleAccount(ctx sdk.Context, moduleName string) types.ModuleAccountI
 	SetModuleAccount(ctx sdk.Context, macc types.ModuleAccountI)
 }
+
+// SendHooks defines the hooks called by the bank keeper when coins are
+// transferred between accounts, on the same transfer paths and with the same
+// arguments as a SendRestrictionFn. BeforeSend can veto the transfer by
+// returning an error.
+type SendHooks interface {
+	BeforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	AfterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
@@ -17,6 +17,7 @@ func (gs GenesisState) Validate() error {
 
 	seenBalances := make(map[string]bool)
 	seenMetadatas := make(map[string]bool)
+	seenSendEnabled := make(map[string]bool)
 
 	totalSupply := sdk.Coins{}
 
@@ -46,6 +47,20 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	// the send enabled entries of the deprecated params field are moved to the
+	// keeper along with the genesis ones, a denom can only appear once in both
+	for _, sendEnabled := range gs.GetAllSendEnabled() {
+		if seenSendEnabled[sendEnabled.Denom] {
+			return fmt.Errorf("duplicate send enabled found: '%s'", sendEnabled.Denom)
+		}
+
+		if err := sendEnabled.Validate(); err != nil {
+			return err
+		}
+
+		seenSendEnabled[sendEnabled.Denom] = true
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
@@ -62,18 +77,31 @@ func (gs GenesisState) Validate() error {
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, balances []Balance, supply sdk.Coins, denomMetaData []Metadata) *GenesisState {
+func NewGenesisState(params Params, balances []Balance, supply sdk.Coins, denomMetaData []Metadata, sendEnabled []SendEnabled) *GenesisState {
 	return &GenesisState{
 		Params:        params,
 		Balances:      balances,
 		Supply:        supply,
 		DenomMetadata: denomMetaData,
+		SendEnabled:   sendEnabled,
 	}
 }
 
 // DefaultGenesisState returns a default bank module genesis state.
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(), []Balance{}, sdk.Coins{}, []Metadata{})
+	return NewGenesisState(DefaultParams(), []Balance{}, sdk.Coins{}, []Metadata{}, []SendEnabled{})
+}
+
+// GetAllSendEnabled returns the SendEnabled entries of the genesis state,
+// followed by the ones still in its deprecated Params.SendEnabled field.
+func (gs GenesisState) GetAllSendEnabled() []SendEnabled {
+	sendEnabled := make([]SendEnabled, 0, len(gs.SendEnabled)+len(gs.Params.SendEnabled))
+	sendEnabled = append(sendEnabled, gs.SendEnabled...)
+	for _, se := range gs.Params.SendEnabled {
+		sendEnabled = append(sendEnabled, *se)
+	}
+
+	return sendEnabled
 }
 
 // GetGenesisStateFromAppState returns x/bank GenesisState given raw application
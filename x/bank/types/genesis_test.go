@@ -55,6 +55,43 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid send enabled entries",
+			GenesisState{
+				Params: Params{
+					SendEnabled: []*SendEnabled{
+						{"barcoin", true},
+					},
+				},
+				SendEnabled: []SendEnabled{
+					{"foocoin", false},
+				},
+			},
+			false,
+		},
+		{
+			"invalid send enabled entry",
+			GenesisState{
+				SendEnabled: []SendEnabled{
+					{"", true},
+				},
+			},
+			true,
+		},
+		{
+			"dup send enabled entries in genesis and params",
+			GenesisState{
+				Params: Params{
+					SendEnabled: []*SendEnabled{
+						{"foocoin", true},
+					},
+				},
+				SendEnabled: []SendEnabled{
+					{"foocoin", false},
+				},
+			},
+			true,
+		},
 		{
 			"dup balances",
 			GenesisState{
@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ SendHooks = MultiSendHooks{}
+
+// MultiSendHooks combines multiple send hooks, all hook functions are run in array sequence
+// and the first error aborts the transfer
+type MultiSendHooks []SendHooks
+
+func NewMultiSendHooks(hooks ...SendHooks) MultiSendHooks {
+	return hooks
+}
+
+func (h MultiSendHooks) BeforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	for i := range h {
+		if err := h[i].BeforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiSendHooks) AfterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	for i := range h {
+		if err := h[i].AfterSend(ctx, fromAddr, toAddr, amt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -25,6 +25,7 @@ var (
 	SupplyKey           = []byte{0x00}
 	DenomMetadataPrefix = []byte{0x1}
 	DenomAddressPrefix  = []byte{0x03}
+	SendEnabledPrefix   = []byte{0x04}
 
 	// BalancesPrefix is the prefix for the account balances store. We use a byte
 	// (instead of `[]byte("balances")` to save some disk space).
@@ -67,3 +68,11 @@ func CreateDenomAddressPrefix(denom string) []byte {
 	copy(key[len(DenomAddressPrefix):], denom)
 	return key
 }
+
+// CreateSendEnabledKey creates the key of the SendEnabled entry of a denom.
+func CreateSendEnabledKey(denom string) []byte {
+	key := make([]byte, len(SendEnabledPrefix)+len(denom))
+	copy(key, SendEnabledPrefix)
+	copy(key[len(SendEnabledPrefix):], denom)
+	return key
+}
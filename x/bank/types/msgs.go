@@ -181,3 +181,118 @@ func ValidateInputsOutputs(inputs []Input, outputs []Output) error {
 
 	return nil
 }
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetSendEnabled{}
+)
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{Authority: authority, Params: params}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgUpdateParams) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return validateUpdatedParams(msg.Params)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// validateUpdatedParams validates the params of a MsgUpdateParams, which can't
+// set send enabled flags, these are set with a MsgSetSendEnabled.
+func validateUpdatedParams(params Params) error {
+	if len(params.SendEnabled) > 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("use of send_enabled in params is no longer supported, use MsgSetSendEnabled")
+	}
+
+	if err := params.Validate(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgSetSendEnabled creates a new MsgSetSendEnabled instance
+func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefaultFor []string) *MsgSetSendEnabled {
+	return &MsgSetSendEnabled{
+		Authority:     authority,
+		SendEnabled:   sendEnabled,
+		UseDefaultFor: useDefaultFor,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgSetSendEnabled) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgSetSendEnabled) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface. A denom can only appear once
+// in the SendEnabled entries and the UseDefaultFor denoms.
+func (msg MsgSetSendEnabled) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	seen := make(map[string]bool)
+	for _, se := range msg.SendEnabled {
+		if se == nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("send enabled entries cannot be nil")
+		}
+
+		if seen[se.Denom] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom entries found for %q", se.Denom)
+		}
+
+		if err := se.Validate(); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid SendEnabled denom %q: %s", se.Denom, err)
+		}
+
+		seen[se.Denom] = true
+	}
+
+	for _, denom := range msg.UseDefaultFor {
+		if seen[denom] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom entries found for %q", denom)
+		}
+
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid UseDefaultFor denom %q: %s", denom, err)
+		}
+
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetSendEnabled) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetSendEnabled) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
@@ -252,3 +252,65 @@ func TestMsgSendGetSigners(t *testing.T) {
 	require.Equal(t, 1, len(res))
 	require.True(t, from.Equals(res[0]))
 }
+
+func TestMsgUpdateParamsValidation(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________")).String()
+	legacyParams := NewParams(true)
+	legacyParams.SendEnabled = SendEnabledParams{NewSendEnabled("foocoin", true)}
+
+	cases := []struct {
+		name   string
+		msg    *MsgUpdateParams
+		expErr bool
+	}{
+		{"valid", NewMsgUpdateParams(authority, DefaultParams()), false},
+		{"invalid authority", NewMsgUpdateParams("", DefaultParams()), true},
+		{"send enabled entries in params", NewMsgUpdateParams(authority, legacyParams), true},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgSetSendEnabledValidation(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________")).String()
+
+	cases := []struct {
+		name   string
+		msg    *MsgSetSendEnabled
+		expErr bool
+	}{
+		{"valid", NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("foocoin", true)}, []string{"barcoin"}), false},
+		{"empty", NewMsgSetSendEnabled(authority, nil, nil), false},
+		{"invalid authority", NewMsgSetSendEnabled("", []*SendEnabled{NewSendEnabled("foocoin", true)}, nil), true},
+		{"nil entry", NewMsgSetSendEnabled(authority, []*SendEnabled{nil}, nil), true},
+		{"invalid denom", NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("0foo", true)}, nil), true},
+		{"invalid use default for denom", NewMsgSetSendEnabled(authority, nil, []string{"0foo"}), true},
+		{"duplicate entries", NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("foocoin", true), NewSendEnabled("foocoin", false)}, nil), true},
+		{"duplicate use default for", NewMsgSetSendEnabled(authority, nil, []string{"foocoin", "foocoin"}), true},
+		{"entry and use default for", NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("foocoin", true)}, []string{"foocoin"}), true},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgSetSendEnabledGetSigners(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________"))
+	msg := NewMsgSetSendEnabled(authority.String(), nil, nil)
+	res := msg.GetSigners()
+	require.Equal(t, 1, len(res))
+	require.True(t, authority.Equals(res[0]))
+}
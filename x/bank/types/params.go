@@ -27,21 +27,20 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-// NewParams creates a new parameter configuration for the bank module
-func NewParams(defaultSendEnabled bool, sendEnabledParams SendEnabledParams) Params {
+// NewParams creates a new parameter configuration for the bank module. The
+// per-denom send enabled flags are no longer part of the params, they are set
+// in the keeper.
+func NewParams(defaultSendEnabled bool) Params {
 	return Params{
-		SendEnabled:        sendEnabledParams,
+		SendEnabled:        SendEnabledParams{},
 		DefaultSendEnabled: defaultSendEnabled,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the bank module
 func DefaultParams() Params {
-	return Params{
-		SendEnabled: SendEnabledParams{},
-		// The default send enabled value allows send transfers for all coin denoms
-		DefaultSendEnabled: true,
-	}
+	// The default send enabled value allows send transfers for all coin denoms
+	return NewParams(DefaultSendEnabled)
 }
 
 // Validate all bank module parameters
@@ -58,29 +57,6 @@ func (p Params) String() string {
 	return string(out)
 }
 
-// SendEnabledDenom returns true if the given denom is enabled for sending
-func (p Params) SendEnabledDenom(denom string) bool {
-	for _, pse := range p.SendEnabled {
-		if pse.Denom == denom {
-			return pse.Enabled
-		}
-	}
-	return p.DefaultSendEnabled
-}
-
-// SetSendEnabledParam returns an updated set of Parameters with the given denom
-// send enabled flag set.
-func (p Params) SetSendEnabledParam(denom string, sendEnabled bool) Params {
-	var sendParams SendEnabledParams
-	for _, p := range p.SendEnabled {
-		if p.Denom != denom {
-			sendParams = append(sendParams, NewSendEnabled(p.Denom, p.Enabled))
-		}
-	}
-	sendParams = append(sendParams, NewSendEnabled(denom, sendEnabled))
-	return NewParams(p.DefaultSendEnabled, sendParams)
-}
-
 // ParamSetPairs implements params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
@@ -90,6 +66,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 }
 
 // SendEnabledParams is a collection of parameters indicating if a coin denom is enabled for sending
+//
+// Deprecated: the send enabled flags are set in the keeper, the ones in the
+// params are only read by the store migration.
 type SendEnabledParams []*SendEnabled
 
 func validateSendEnabledParams(i interface{}) error {
@@ -135,12 +114,17 @@ func (se SendEnabled) String() string {
 	return string(out)
 }
 
+// Validate gets any errors with this SendEnabled entry.
+func (se SendEnabled) Validate() error {
+	return sdk.ValidateDenom(se.Denom)
+}
+
 func validateSendEnabled(i interface{}) error {
 	param, ok := i.(SendEnabled)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	return sdk.ValidateDenom(param.Denom)
+	return param.Validate()
 }
 
 func validateIsBool(i interface{}) error {
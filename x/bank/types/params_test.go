@@ -62,47 +62,27 @@ func Test_validateParams(t *testing.T) {
 	require.NoError(t, params.Validate())
 
 	// default case is all denoms are enabled for sending
-	require.True(t, params.SendEnabledDenom(sdk.DefaultBondDenom))
-	require.True(t, params.SendEnabledDenom("foodenom"))
+	require.True(t, params.DefaultSendEnabled)
+	require.Empty(t, params.SendEnabled)
 
-	params.DefaultSendEnabled = false
-	params = params.SetSendEnabledParam("foodenom", true)
-
-	require.NoError(t, validateSendEnabledParams(params.SendEnabled))
-	require.True(t, params.SendEnabledDenom("foodenom"))
-	require.False(t, params.SendEnabledDenom(sdk.DefaultBondDenom))
-
-	params.DefaultSendEnabled = true
-	params = params.SetSendEnabledParam("foodenom", false)
-
-	require.NoError(t, validateSendEnabledParams(params.SendEnabled))
-	require.False(t, params.SendEnabledDenom("foodenom"))
-	require.True(t, params.SendEnabledDenom(sdk.DefaultBondDenom))
-
-	params = params.SetSendEnabledParam("foodenom", true)
-	require.True(t, params.SendEnabledDenom("foodenom"))
-
-	params = params.SetSendEnabledParam("foodenom", false)
-	require.False(t, params.SendEnabledDenom("foodenom"))
-
-	require.True(t, params.SendEnabledDenom("foodenom2"))
-	params = params.SetSendEnabledParam("foodenom2", false)
-	require.True(t, params.SendEnabledDenom(""))
-	require.True(t, params.SendEnabledDenom(sdk.DefaultBondDenom))
-	require.False(t, params.SendEnabledDenom("foodenom2"))
-
-	paramYaml := "default_send_enabled: true\nsend_enabled:\n- denom: foodenom\n- denom: foodenom2\n"
+	paramYaml := "default_send_enabled: true\n"
 	require.Equal(t, paramYaml, params.String())
 
-	// Ensure proper format of yaml output when false
-	params.DefaultSendEnabled = false
-	paramYaml = "send_enabled:\n- denom: foodenom\n- denom: foodenom2\n"
-	require.Equal(t, paramYaml, params.String())
+	params = NewParams(false)
+	require.NoError(t, params.Validate())
+	require.False(t, params.DefaultSendEnabled)
 
-	params = NewParams(true, SendEnabledParams{
+	// the deprecated send enabled entries are still validated
+	params.SendEnabled = SendEnabledParams{
+		NewSendEnabled("foodenom", false),
+		NewSendEnabled("foodenom2", true),
+	}
+	require.NoError(t, params.Validate())
+
+	params.SendEnabled = SendEnabledParams{
 		NewSendEnabled("foodenom", false),
 		NewSendEnabled("foodenom", true), // this is not allowed
-	})
+	}
 
 	// fails due to duplicate entries.
 	require.Error(t, params.Validate())
@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendRestrictionFn can veto a transfer of coins between accounts by returning
+// an error. It is called on every transfer path of the bank keeper, including
+// the sends from and to module accounts, DelegateCoins and UndelegateCoins.
+//
+// For multi-sends with a single input, it is called for each output with the
+// input address as fromAddr. With several inputs, the coins of an output can't
+// be attributed to an input: it is called for each input with a nil toAddr,
+// then for each output with a nil fromAddr.
+type SendRestrictionFn func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+
+// Then returns a SendRestrictionFn running r, then second if r does not veto
+// the transfer. A nil SendRestrictionFn is a no-op.
+func (r SendRestrictionFn) Then(second SendRestrictionFn) SendRestrictionFn {
+	if r == nil {
+		return second
+	}
+
+	if second == nil {
+		return r
+	}
+
+	return func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+		if err := r(ctx, fromAddr, toAddr, amt); err != nil {
+			return err
+		}
+
+		return second(ctx, fromAddr, toAddr, amt)
+	}
+}
+
+// ComposeSendRestrictions combines multiple send restrictions into one, run in
+// sequence, the first error vetoing the transfer.
+func ComposeSendRestrictions(restrictions ...SendRestrictionFn) SendRestrictionFn {
+	var composed SendRestrictionFn
+	for _, r := range restrictions {
+		composed = composed.Then(r)
+	}
+
+	return composed
+}
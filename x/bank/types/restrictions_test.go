@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestComposeSendRestrictions(t *testing.T) {
+	var calls []string
+	restriction := func(name string, err error) types.SendRestrictionFn {
+		return func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) error {
+			calls = append(calls, name)
+			return err
+		}
+	}
+	errVeto := errors.New("veto")
+
+	testCases := []struct {
+		name         string
+		restrictions []types.SendRestrictionFn
+		expCalls     []string
+		expErr       error
+	}{
+		{"no restriction", nil, nil, nil},
+		{"nil restrictions", []types.SendRestrictionFn{nil, nil}, nil, nil},
+		{
+			"restrictions run in order",
+			[]types.SendRestrictionFn{restriction("a", nil), nil, restriction("b", nil), restriction("c", nil)},
+			[]string{"a", "b", "c"},
+			nil,
+		},
+		{
+			"first error vetoes the transfer",
+			[]types.SendRestrictionFn{restriction("a", nil), restriction("b", errVeto), restriction("c", nil)},
+			[]string{"a", "b"},
+			errVeto,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls = nil
+			composed := types.ComposeSendRestrictions(tc.restrictions...)
+			if tc.expCalls == nil {
+				require.Nil(t, composed)
+				return
+			}
+
+			err := composed(sdk.Context{}, nil, nil, nil)
+			require.ErrorIs(t, err, tc.expErr)
+			require.Equal(t, tc.expCalls, calls)
+		})
+	}
+}
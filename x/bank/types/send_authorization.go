@@ -6,14 +6,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
+// gasCostPerIteration is the gas consumed for each address of the allow list
+// checked when accepting a MsgSend.
+const gasCostPerIteration = uint64(10)
+
 var (
 	_ authz.Authorization = &SendAuthorization{}
 )
 
-// NewSendAuthorization creates a new SendAuthorization object.
-func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
+// NewSendAuthorization creates a new SendAuthorization object. The allowed
+// recipients list is optional.
+func NewSendAuthorization(spendLimit sdk.Coins, allowed []sdk.AccAddress) *SendAuthorization {
 	return &SendAuthorization{
 		SpendLimit: spendLimit,
+		AllowList:  toBech32Addresses(allowed),
 	}
 }
 
@@ -28,6 +34,19 @@ func (a SendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptRes
 	if !ok {
 		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
 	}
+
+	isAddrExists := len(a.AllowList) == 0
+	for _, addr := range a.AllowList {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "send authorization")
+		if addr == mSend.ToAddress {
+			isAddrExists = true
+			break
+		}
+	}
+	if !isAddrExists {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot send to %s address", mSend.ToAddress)
+	}
+
 	limitLeft, isNegative := a.SpendLimit.SafeSub(mSend.Amount)
 	if isNegative {
 		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
@@ -36,7 +55,7 @@ func (a SendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptRes
 		return authz.AcceptResponse{Accept: true, Delete: true}, nil
 	}
 
-	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &SendAuthorization{SpendLimit: limitLeft}}, nil
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &SendAuthorization{SpendLimit: limitLeft, AllowList: a.AllowList}}, nil
 }
 
 // ValidateBasic implements Authorization.ValidateBasic.
@@ -47,5 +66,38 @@ func (a SendAuthorization) ValidateBasic() error {
 	if !a.SpendLimit.IsAllPositive() {
 		return sdkerrors.ErrInvalidCoins.Wrapf("spend limit cannot be negitive")
 	}
+
+	if err := validateAddressList(a.AllowList); err != nil {
+		return sdkerrors.Wrap(err, "invalid allow list")
+	}
+
+	return nil
+}
+
+// validateAddressList checks that a list holds valid and unique bech32 addresses.
+func validateAddressList(addrs []string) error {
+	found := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid address %s: %s", addr, err)
+		}
+		if found[addr] {
+			return sdkerrors.ErrInvalidAddress.Wrapf("duplicate address %s", addr)
+		}
+		found[addr] = true
+	}
+
 	return nil
 }
+
+func toBech32Addresses(addrs []sdk.AccAddress) []string {
+	if len(addrs) == 0 {
+		return nil
+	}
+
+	bech32Addrs := make([]string, len(addrs))
+	for i, addr := range addrs {
+		bech32Addrs[i] = addr.String()
+	}
+	return bech32Addrs
+}
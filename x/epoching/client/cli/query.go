@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	epochingQueryCmd := &cobra.Command{
+		Use:                        epoching.ModuleName,
+		Short:                      "Querying commands for the epoching module",
+		Long:                       "",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	epochingQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryCurrentEpoch(),
+		GetCmdQueryEpochMsgs(),
+		GetCmdQueryQueuedMsgs(),
+	)
+	return epochingQueryCmd
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Args:    cobra.NoArgs,
+		Short:   "query the current epoching parameters",
+		Example: fmt.Sprintf(`$ %s query %s params`, version.AppName, epoching.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := epoching.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(cmd.Context(), &epoching.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCurrentEpoch implements the query current-epoch command.
+func GetCmdQueryCurrentEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "current-epoch",
+		Args:    cobra.NoArgs,
+		Short:   "query the current epoch number and the height at which it ends",
+		Example: fmt.Sprintf(`$ %s query %s current-epoch`, version.AppName, epoching.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := epoching.NewQueryClient(clientCtx)
+			res, err := queryClient.CurrentEpoch(cmd.Context(), &epoching.QueryCurrentEpochRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryEpochMsgs implements the query epoch-msgs command.
+func GetCmdQueryEpochMsgs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "epoch-msgs [epoch-number]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the messages buffered for execution at the end of an epoch",
+		Example: fmt.Sprintf(`$ %s query %s epoch-msgs 3`, version.AppName, epoching.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := epoching.NewQueryClient(clientCtx)
+
+			epochNumber, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("epoch-number %s not a valid int, please input a valid epoch-number", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EpochMsgs(cmd.Context(), &epoching.QueryEpochMsgsRequest{
+				EpochNumber: epochNumber,
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "epoch-msgs")
+	return cmd
+}
+
+// GetCmdQueryQueuedMsgs implements the query queued-msgs command.
+func GetCmdQueryQueuedMsgs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "queued-msgs",
+		Args:    cobra.NoArgs,
+		Short:   "query all buffered messages, across all epochs",
+		Example: fmt.Sprintf(`$ %s query %s queued-msgs`, version.AppName, epoching.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := epoching.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueuedMsgs(cmd.Context(), &epoching.QueryQueuedMsgsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "queued-msgs")
+	return cmd
+}
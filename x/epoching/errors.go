@@ -0,0 +1,12 @@
+package epoching
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/epoching module sentinel errors
+var (
+	ErrUnsupportedMsg = sdkerrors.Register(ModuleName, 2, "message cannot be buffered for an epoch")
+	ErrInvalidParams  = sdkerrors.Register(ModuleName, 3, "invalid epoching params")
+	ErrMsgNotFound    = sdkerrors.Register(ModuleName, 4, "queued message not found")
+)
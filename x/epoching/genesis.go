@@ -0,0 +1,74 @@
+package epoching
+
+import (
+	"fmt"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// DefaultNextActionID is the id given to the first queued message.
+const DefaultNextActionID = uint64(1)
+
+var _ cdctypes.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, epochNumber int64, nextActionID uint64, queuedMsgs []QueuedMsg) *GenesisState {
+	return &GenesisState{
+		Params:       params,
+		EpochNumber:  epochNumber,
+		NextActionId: nextActionID,
+		QueuedMsgs:   queuedMsgs,
+	}
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), 0, DefaultNextActionID, nil)
+}
+
+// ValidateGenesis check the given genesis state has no integrity issues
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if data.EpochNumber < 0 {
+		return fmt.Errorf("epoch number cannot be negative: %d", data.EpochNumber)
+	}
+
+	if data.NextActionId == 0 {
+		return fmt.Errorf("next action id cannot be zero")
+	}
+
+	actionIDs := make(map[uint64]bool, len(data.QueuedMsgs))
+	for _, m := range data.QueuedMsgs {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if m.EpochNumber < data.EpochNumber {
+			return fmt.Errorf("queued msg %d belongs to past epoch %d", m.ActionId, m.EpochNumber)
+		}
+
+		if m.ActionId >= data.NextActionId {
+			return fmt.Errorf("queued msg action id %d must be lower than next action id %d", m.ActionId, data.NextActionId)
+		}
+
+		if actionIDs[m.ActionId] {
+			return fmt.Errorf("duplicate queued msg action id %d", m.ActionId)
+		}
+		actionIDs[m.ActionId] = true
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, m := range data.QueuedMsgs {
+		if err := m.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
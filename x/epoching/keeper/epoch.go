@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// ExecuteEpoch executes all the messages queued for the current epoch, in
+// the order they were queued, and moves on to the next epoch.
+//
+// The escrowed funds of a message are returned to their owner before the
+// message is executed. A message that fails is discarded without affecting
+// the other messages, and its owner keeps the returned funds.
+func (k Keeper) ExecuteEpoch(ctx sdk.Context) {
+	epochNumber := k.GetEpochNumber(ctx)
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := k.GetEpochActionsIteratorByEpoch(ctx, epochNumber)
+	var (
+		keys [][]byte
+		msgs []sdk.Msg
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		msgs = append(msgs, k.GetEpochActionByIterator(iterator))
+	}
+	iterator.Close()
+
+	for i, msg := range msgs {
+		_, actionID := ParseActionStoreKey(keys[i])
+		store.Delete(keys[i])
+
+		event := epoching.EventExecuteMsg{
+			EpochNumber: epochNumber,
+			ActionId:    actionID,
+			MsgTypeUrl:  sdk.MsgTypeURL(msg),
+		}
+
+		if err := k.executeEpochMsg(ctx, msg); err != nil {
+			k.Logger(ctx).Info(
+				"failed to execute epoch msg", "epoch", epochNumber, "action_id", actionID, "err", err,
+			)
+			event.Error = err.Error()
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+			panic(err)
+		}
+	}
+
+	k.IncreaseEpochNumber(ctx)
+}
+
+// executeEpochMsg refunds the funds escrowed for a queued message and
+// executes it in a cached context, which is only written on success.
+func (k Keeper) executeEpochMsg(ctx sdk.Context, msg sdk.Msg) error {
+	delegator, coins, err := epoching.EscrowedCoins(msg)
+	if err != nil {
+		return err
+	}
+
+	// The refund is not reverted when the message fails: the escrow is only
+	// held while the message is buffered.
+	if !coins.Empty() {
+		if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, epoching.EpochDelegationPoolName, delegator, coins); err != nil {
+			panic(err)
+		}
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	goCtx := sdk.WrapSDKContext(cacheCtx)
+
+	switch msg := msg.(type) {
+	case *stakingtypes.MsgCreateValidator:
+		_, err = k.stakingMsgServer.CreateValidator(goCtx, msg)
+	case *stakingtypes.MsgEditValidator:
+		_, err = k.stakingMsgServer.EditValidator(goCtx, msg)
+	case *stakingtypes.MsgDelegate:
+		_, err = k.stakingMsgServer.Delegate(goCtx, msg)
+	case *stakingtypes.MsgBeginRedelegate:
+		_, err = k.stakingMsgServer.BeginRedelegate(goCtx, msg)
+	case *stakingtypes.MsgUndelegate:
+		_, err = k.stakingMsgServer.Undelegate(goCtx, msg)
+	case *stakingtypes.MsgCancelUnbondingDelegation:
+		_, err = k.stakingMsgServer.CancelUnbondingDelegation(goCtx, msg)
+	default:
+		err = sdkerrors.Wrapf(epoching.ErrUnsupportedMsg, "%s", sdk.MsgTypeURL(msg))
+	}
+	if err != nil {
+		return err
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+)
+
+// InitGenesis initializes the epoching module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *epoching.GenesisState) {
+	k.SetParams(ctx, data.Params)
+	k.SetEpochNumber(ctx, data.EpochNumber)
+	k.SetNextActionID(ctx, data.NextActionId)
+
+	for _, m := range data.QueuedMsgs {
+		k.setEpochMsg(ctx, m.EpochNumber, m.ActionId, m.GetMsg())
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *epoching.GenesisState {
+	return epoching.NewGenesisState(
+		k.GetParams(ctx),
+		k.GetEpochNumber(ctx),
+		k.GetNextActionID(ctx),
+		k.GetQueuedMsgs(ctx),
+	)
+}
@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *TestSuite) TestExportAndInitGenesis() {
+	k := s.app.EpochingKeeper
+	amount := sdk.NewCoin(s.bondDenom, sdk.NewInt(1000))
+	k.SetParams(s.ctx, epoching.NewParams(7))
+	k.SetEpochNumber(s.ctx, 2)
+
+	_, err := s.msgServer.Delegate(sdk.WrapSDKContext(s.ctx), stakingtypes.NewMsgDelegate(s.addrs[0], s.validator, amount))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Undelegate(sdk.WrapSDKContext(s.ctx), stakingtypes.NewMsgUndelegate(s.addrs[1], s.validator, amount))
+	s.Require().NoError(err)
+
+	genesis := k.ExportGenesis(s.ctx)
+	s.Require().Equal(epoching.NewParams(7), genesis.Params)
+	s.Require().Equal(int64(2), genesis.EpochNumber)
+	s.Require().Equal(uint64(3), genesis.NextActionId)
+	s.Require().Len(genesis.QueuedMsgs, 2)
+	s.Require().NoError(epoching.ValidateGenesis(*genesis))
+
+	// round trip through JSON
+	cdc := s.app.AppCodec()
+	bz := cdc.MustMarshalJSON(genesis)
+	var imported epoching.GenesisState
+	cdc.MustUnmarshalJSON(bz, &imported)
+
+	k.DequeueEpochActions(s.ctx)
+	k.SetEpochNumber(s.ctx, 0)
+	k.SetNextActionID(s.ctx, 1)
+	s.Require().Empty(k.GetEpochActions(s.ctx))
+
+	k.InitGenesis(s.ctx, &imported)
+	s.Require().Equal(bz, cdc.MustMarshalJSON(k.ExportGenesis(s.ctx)))
+}
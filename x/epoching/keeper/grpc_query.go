@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+)
+
+var _ epoching.QueryServer = Keeper{}
+
+// Params queries the parameters of the epoching module
+func (k Keeper) Params(goCtx context.Context, r *epoching.QueryParamsRequest) (*epoching.QueryParamsResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &epoching.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// CurrentEpoch queries the current epoch number and the height at which it ends
+func (k Keeper) CurrentEpoch(goCtx context.Context, r *epoching.QueryCurrentEpochRequest) (*epoching.QueryCurrentEpochResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &epoching.QueryCurrentEpochResponse{
+		CurrentEpoch:   k.GetEpochNumber(ctx),
+		EpochEndHeight: k.GetEpochEndHeight(ctx),
+	}, nil
+}
+
+// EpochMsgs queries the messages buffered for execution at the end of an epoch
+func (k Keeper) EpochMsgs(goCtx context.Context, r *epoching.QueryEpochMsgsRequest) (*epoching.QueryEpochMsgsResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if r.EpochNumber < 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("negative epoch number %d", r.EpochNumber)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), EpochActionsPrefix(r.EpochNumber))
+
+	var msgs []epoching.QueuedMsg
+	pageRes, err := query.Paginate(store, r.Pagination, func(key []byte, value []byte) error {
+		qm, err := k.unmarshalQueuedMsg(r.EpochNumber, sdk.BigEndianToUint64(key), value)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, qm)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &epoching.QueryEpochMsgsResponse{
+		Msgs:       msgs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// QueuedMsgs queries all buffered messages, across all epochs
+func (k Keeper) QueuedMsgs(goCtx context.Context, r *epoching.QueryQueuedMsgsRequest) (*epoching.QueryQueuedMsgsResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), EpochActionQueuePrefix)
+
+	var msgs []epoching.QueuedMsg
+	pageRes, err := query.Paginate(store, r.Pagination, func(key []byte, value []byte) error {
+		epochNumber, actionID := ParseActionStoreKey(append(EpochActionQueuePrefix, key...))
+		qm, err := k.unmarshalQueuedMsg(epochNumber, actionID, value)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, qm)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &epoching.QueryQueuedMsgsResponse{
+		Msgs:       msgs,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) unmarshalQueuedMsg(epochNumber int64, actionID uint64, bz []byte) (epoching.QueuedMsg, error) {
+	var msg sdk.Msg
+	if err := k.cdc.UnmarshalInterface(bz, &msg); err != nil {
+		return epoching.QueuedMsg{}, err
+	}
+
+	return epoching.NewQueuedMsg(epochNumber, actionID, msg)
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *TestSuite) TestParamsQuery() {
+	res, err := s.queryClient.Params(gocontext.Background(), &epoching.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(s.app.EpochingKeeper.GetParams(s.ctx), res.Params)
+}
+
+func (s *TestSuite) TestCurrentEpochQuery() {
+	s.app.EpochingKeeper.SetParams(s.ctx, epoching.NewParams(10))
+	s.app.EpochingKeeper.SetEpochNumber(s.ctx, 3)
+
+	res, err := s.queryClient.CurrentEpoch(gocontext.Background(), &epoching.QueryCurrentEpochRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(3), res.CurrentEpoch)
+	s.Require().Equal(int64(10), res.EpochEndHeight)
+}
+
+func (s *TestSuite) TestEpochMsgsQuery() {
+	_, err := s.app.EpochingKeeper.EpochMsgs(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Error(err)
+
+	amount := sdk.NewCoin(s.bondDenom, sdk.NewInt(1000))
+	for _, addr := range s.addrs {
+		_, err = s.msgServer.Delegate(sdk.WrapSDKContext(s.ctx), stakingtypes.NewMsgDelegate(addr, s.validator, amount))
+		s.Require().NoError(err)
+	}
+	s.app.EpochingKeeper.QueueMsgForEpoch(s.ctx, 1, stakingtypes.NewMsgUndelegate(s.addrs[0], s.validator, amount))
+
+	testCases := []struct {
+		msg    string
+		req    *epoching.QueryEpochMsgsRequest
+		expErr bool
+		expLen int
+	}{
+		{"negative epoch", &epoching.QueryEpochMsgsRequest{EpochNumber: -1}, true, 0},
+		{"current epoch", &epoching.QueryEpochMsgsRequest{EpochNumber: 0}, false, 3},
+		{"next epoch", &epoching.QueryEpochMsgsRequest{EpochNumber: 1}, false, 1},
+		{"empty epoch", &epoching.QueryEpochMsgsRequest{EpochNumber: 2}, false, 0},
+		{
+			"paginated",
+			&epoching.QueryEpochMsgsRequest{EpochNumber: 0, Pagination: &query.PageRequest{Limit: 2}},
+			false, 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			res, err := s.queryClient.EpochMsgs(gocontext.Background(), tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(res.Msgs, tc.expLen)
+			for i, m := range res.Msgs {
+				s.Require().Equal(tc.req.EpochNumber, m.EpochNumber)
+				s.Require().NotNil(m.GetMsg())
+				if i > 0 {
+					s.Require().Greater(m.ActionId, res.Msgs[i-1].ActionId)
+				}
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestQueuedMsgsQuery() {
+	amount := sdk.NewCoin(s.bondDenom, sdk.NewInt(1000))
+	s.app.EpochingKeeper.QueueMsgForEpoch(s.ctx, 1, stakingtypes.NewMsgUndelegate(s.addrs[0], s.validator, amount))
+	_, err := s.msgServer.Delegate(sdk.WrapSDKContext(s.ctx), stakingtypes.NewMsgDelegate(s.addrs[0], s.validator, amount))
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.QueuedMsgs(gocontext.Background(), &epoching.QueryQueuedMsgsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Msgs, 2)
+
+	// messages are ordered by epoch first
+	s.Require().Equal(int64(0), res.Msgs[0].EpochNumber)
+	s.Require().Equal(uint64(2), res.Msgs[0].ActionId)
+	s.Require().IsType(&stakingtypes.MsgDelegate{}, res.Msgs[0].GetMsg())
+	s.Require().Equal(int64(1), res.Msgs[1].EpochNumber)
+	s.Require().Equal(uint64(1), res.Msgs[1].ActionId)
+	s.Require().IsType(&stakingtypes.MsgUndelegate{}, res.Msgs[1].GetMsg())
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+)
+
+const poolInvariant = "epoch-delegation-pool"
+
+// RegisterInvariants registers all epoching invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(epoching.ModuleName, poolInvariant, EpochDelegationPoolInvariant(keeper))
+}
+
+// EpochDelegationPoolInvariant checks that the balance of the epoch
+// delegation pool equals the sum of the funds escrowed by the queued
+// messages.
+func EpochDelegationPoolInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		bondDenom := keeper.stakingKeeper.BondDenom(ctx)
+		escrowed := sdk.NewCoin(bondDenom, sdk.ZeroInt())
+
+		for _, msg := range keeper.GetEpochActions(ctx) {
+			_, coins, err := epoching.EscrowedCoins(msg)
+			if err != nil {
+				return sdk.FormatInvariant(epoching.ModuleName, poolInvariant, err.Error()), true
+			}
+			escrowed = escrowed.AddAmount(coins.AmountOf(bondDenom))
+		}
+
+		poolAddr := keeper.authKeeper.GetModuleAddress(epoching.EpochDelegationPoolName)
+		balance := keeper.bankKeeper.GetBalance(ctx, poolAddr, bondDenom)
+		broken := !balance.IsEqual(escrowed)
+
+		return sdk.FormatInvariant(epoching.ModuleName, poolInvariant, fmt.Sprintf(
+			"\tepoch delegation pool balance: %s\n"+
+				"\tsum of queued escrows: %s\n",
+			balance, escrowed,
+		)), broken
+	}
+}
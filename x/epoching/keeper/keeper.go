@@ -1,47 +1,89 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	db "github.com/tendermint/tm-db"
-)
-
-const (
-	DefaultEpochActionID = 1
-	DefaultEpochNumber   = 0
-)
-
-var (
-	NextEpochActionID      = []byte{0x11}
-	EpochNumberID          = []byte{0x12}
-	EpochActionQueuePrefix = []byte{0x13} // prefix for the epoch
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Keeper of the store
 type Keeper struct {
-	storeKey storetypes.StoreKey
-	cdc      codec.BinaryCodec
+	storeKey   storetypes.StoreKey
+	cdc        codec.BinaryCodec
+	paramSpace paramtypes.Subspace
+
+	authKeeper    epoching.AccountKeeper
+	bankKeeper    epoching.BankKeeper
+	stakingKeeper epoching.StakingKeeper
+
+	// stakingMsgServer executes the buffered x/staking messages at the end of
+	// each epoch.
+	stakingMsgServer stakingtypes.MsgServer
+
 	// Used to calculate the estimated next epoch time.
 	// This is local to every node
 	// TODO: remove in favor of consensus param when its added
 	commitTimeout time.Duration
 }
 
-// NewKeeper creates a epoch queue manager
-func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, commitTimeout time.Duration) Keeper {
+// NewKeeper creates a epoch queue manager. The stakingMsgServer is the
+// x/staking Msg service implementation buffered messages are executed
+// against, usually stakingkeeper.NewMsgServerImpl(stakingKeeper).
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	ak epoching.AccountKeeper, bk epoching.BankKeeper, sk epoching.StakingKeeper,
+	stakingMsgServer stakingtypes.MsgServer, commitTimeout time.Duration,
+) Keeper {
+	// ensure the epoch delegation pool module account is set
+	if addr := ak.GetModuleAddress(epoching.EpochDelegationPoolName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", epoching.EpochDelegationPoolName))
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(epoching.ParamKeyTable())
+	}
+
 	return Keeper{
-		storeKey:      key,
-		cdc:           cdc,
-		commitTimeout: commitTimeout,
+		storeKey:         key,
+		cdc:              cdc,
+		paramSpace:       paramSpace,
+		authKeeper:       ak,
+		bankKeeper:       bk,
+		stakingKeeper:    sk,
+		stakingMsgServer: stakingMsgServer,
+		commitTimeout:    commitTimeout,
 	}
 }
 
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", epoching.ModuleName))
+}
+
 // GetNewActionID returns ID to be used for next epoch
 func (k Keeper) GetNewActionID(ctx sdk.Context) uint64 {
+	id := k.GetNextActionID(ctx)
+
+	// increment next action ID
+	k.SetNextActionID(ctx, id+1)
+
+	return id
+}
+
+// GetNextActionID returns the ID the next queued action will get, without
+// consuming it.
+func (k Keeper) GetNextActionID(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
 
 	bz := store.Get(NextEpochActionID)
@@ -49,21 +91,19 @@ func (k Keeper) GetNewActionID(ctx sdk.Context) uint64 {
 		// return default action ID to 1
 		return DefaultEpochActionID
 	}
-	id := sdk.BigEndianToUint64(bz)
 
-	// increment next action ID
-	store.Set(NextEpochActionID, sdk.Uint64ToBigEndian(id+1))
-
-	return id
+	return sdk.BigEndianToUint64(bz)
 }
 
-// ActionStoreKey returns action store key from ID
-func ActionStoreKey(epochNumber int64, actionID uint64) []byte {
-	return append(EpochActionQueuePrefix, byte(epochNumber), byte(actionID))
+// SetNextActionID sets the ID the next queued action will get.
+func (k Keeper) SetNextActionID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(NextEpochActionID, sdk.Uint64ToBigEndian(id))
 }
 
 // QueueMsgForEpoch save the actions that need to be executed on next epoch
-func (k Keeper) QueueMsgForEpoch(ctx sdk.Context, epochNumber int64, msg sdk.Msg) {
+// and returns the ID of the queued action.
+func (k Keeper) QueueMsgForEpoch(ctx sdk.Context, epochNumber int64, msg sdk.Msg) uint64 {
 	store := ctx.KVStore(k.storeKey)
 
 	bz, err := k.cdc.MarshalInterface(msg)
@@ -73,6 +113,8 @@ func (k Keeper) QueueMsgForEpoch(ctx sdk.Context, epochNumber int64, msg sdk.Msg
 
 	actionID := k.GetNewActionID(ctx)
 	store.Set(ActionStoreKey(epochNumber, actionID), bz)
+
+	return actionID
 }
 
 // RestoreEpochAction restore the actions that need to be executed on next epoch
@@ -89,6 +131,18 @@ func (k Keeper) RestoreEpochAction(ctx sdk.Context, epochNumber int64, action *c
 	store.Set(ActionStoreKey(epochNumber, actionID), bz)
 }
 
+// setEpochMsg stores a msg under the given epoch number and action ID.
+func (k Keeper) setEpochMsg(ctx sdk.Context, epochNumber int64, actionID uint64, msg sdk.Msg) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := k.cdc.MarshalInterface(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(ActionStoreKey(epochNumber, actionID), bz)
+}
+
 // GetEpochMsg gets a msg by ID
 func (k Keeper) GetEpochMsg(ctx sdk.Context, epochNumber int64, actionID uint64) sdk.Msg {
 	store := ctx.KVStore(k.storeKey)
@@ -99,7 +153,9 @@ func (k Keeper) GetEpochMsg(ctx sdk.Context, epochNumber int64, actionID uint64)
 	}
 
 	var action sdk.Msg
-	k.cdc.UnmarshalInterface(bz, &action)
+	if err := k.cdc.UnmarshalInterface(bz, &action); err != nil {
+		panic(err)
+	}
 
 	return action
 }
@@ -111,28 +167,54 @@ func (k Keeper) GetEpochActions(ctx sdk.Context) []sdk.Msg {
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		var action sdk.Msg
-		bz := iterator.Value()
-		k.cdc.UnmarshalInterface(bz, &action)
-		actions = append(actions, action)
+		actions = append(actions, k.GetEpochActionByIterator(iterator))
 	}
 
 	return actions
 }
 
+// GetQueuedMsgs returns all queued actions along with their epoch number and
+// action ID, ordered by epoch number and action ID.
+func (k Keeper) GetQueuedMsgs(ctx sdk.Context) []epoching.QueuedMsg {
+	msgs := []epoching.QueuedMsg{}
+	iterator := k.GetEpochActionsIterator(ctx)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		epochNumber, actionID := ParseActionStoreKey(iterator.Key())
+		qm, err := epoching.NewQueuedMsg(epochNumber, actionID, k.GetEpochActionByIterator(iterator))
+		if err != nil {
+			panic(err)
+		}
+		msgs = append(msgs, qm)
+	}
+
+	return msgs
+}
+
 // GetEpochActionsIterator returns iterator for EpochActions
 func (k Keeper) GetEpochActionsIterator(ctx sdk.Context) db.Iterator {
 	return sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), EpochActionQueuePrefix)
 }
 
+// GetEpochActionsIteratorByEpoch returns iterator for the actions queued for
+// the given epoch
+func (k Keeper) GetEpochActionsIteratorByEpoch(ctx sdk.Context, epochNumber int64) db.Iterator {
+	return sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), EpochActionsPrefix(epochNumber))
+}
+
 // DequeueEpochActions dequeue all the actions store on epoch
 func (k Keeper) DequeueEpochActions(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, EpochActionQueuePrefix)
 	defer iterator.Close()
 
+	keys := [][]byte{}
 	for ; iterator.Valid(); iterator.Next() {
-		key := iterator.Key()
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
 		store.Delete(key)
 	}
 }
@@ -148,7 +230,9 @@ func (k Keeper) GetEpochActionByIterator(iterator db.Iterator) sdk.Msg {
 	bz := iterator.Value()
 
 	var action sdk.Msg
-	k.cdc.UnmarshalInterface(bz, &action)
+	if err := k.cdc.UnmarshalInterface(bz, &action); err != nil {
+		panic(err)
+	}
 
 	return action
 }
@@ -190,3 +274,19 @@ func (k Keeper) GetNextEpochTime(ctx sdk.Context, epochInterval int64) time.Time
 
 	return currentTime.Add(k.commitTimeout * time.Duration(k.GetNextEpochHeight(ctx, epochInterval)-currentHeight))
 }
+
+// GetEpochEndHeight returns the height of the last block of the current
+// epoch, at the end of which the buffered messages are executed.
+func (k Keeper) GetEpochEndHeight(ctx sdk.Context) int64 {
+	epochLength := k.EpochLength(ctx)
+	if ctx.BlockHeight()%epochLength == 0 {
+		return ctx.BlockHeight()
+	}
+
+	return k.GetNextEpochHeight(ctx, epochLength)
+}
+
+// IsEpochEnd returns true if the current block is the last block of an epoch.
+func (k Keeper) IsEpochEnd(ctx sdk.Context) bool {
+	return ctx.BlockHeight()%k.EpochLength(ctx) == 0
+}
@@ -0,0 +1,279 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmtime "github.com/tendermint/tendermint/libs/time"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	validator   sdk.ValAddress
+	bondDenom   string
+	msgServer   stakingtypes.MsgServer
+	queryClient epoching.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: tmtime.Now(), Height: 1})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	epoching.RegisterQueryServer(queryHelper, app.EpochingKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.queryClient = epoching.NewQueryClient(queryHelper)
+	s.msgServer = keeper.NewStakingMsgServerImpl(app.EpochingKeeper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+	s.validator = app.StakingKeeper.GetAllValidators(ctx)[0].GetOperator()
+	s.bondDenom = app.StakingKeeper.BondDenom(ctx)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) poolBalance() sdk.Coin {
+	poolAddr := s.app.AccountKeeper.GetModuleAddress(epoching.EpochDelegationPoolName)
+	return s.app.BankKeeper.GetBalance(s.ctx, poolAddr, s.bondDenom)
+}
+
+func (s *TestSuite) TestActionStoreKey() {
+	key := keeper.ActionStoreKey(300, 1000)
+	epochNumber, actionID := keeper.ParseActionStoreKey(key)
+	s.Require().Equal(int64(300), epochNumber)
+	s.Require().Equal(uint64(1000), actionID)
+	s.Require().NotEqual(key, keeper.ActionStoreKey(300+256, 1000+256))
+}
+
+func (s *TestSuite) TestGetNewActionID() {
+	k := s.app.EpochingKeeper
+	s.Require().Equal(uint64(1), k.GetNewActionID(s.ctx))
+	s.Require().Equal(uint64(2), k.GetNewActionID(s.ctx))
+	s.Require().Equal(uint64(3), k.GetNextActionID(s.ctx))
+}
+
+func (s *TestSuite) TestDelegate() {
+	k := s.app.EpochingKeeper
+	delegator := s.addrs[0]
+	amount := sdk.NewCoin(s.bondDenom, sdk.NewInt(1000))
+	balance := s.app.BankKeeper.GetBalance(s.ctx, delegator, s.bondDenom)
+
+	_, err := s.msgServer.Delegate(sdk.WrapSDKContext(s.ctx), stakingtypes.NewMsgDelegate(delegator, s.validator, amount))
+	s.Require().NoError(err)
+
+	// the delegation is buffered and its funds escrowed
+	_, found := s.app.StakingKeeper.GetDelegation(s.ctx, delegator, s.validator)
+	s.Require().False(found)
+	s.Require().Equal(balance.Sub(amount), s.app.BankKeeper.GetBalance(s.ctx, delegator, s.bondDenom))
+	s.Require().Equal(amount, s.poolBalance())
+	s.Require().Len(k.GetEpochActions(s.ctx), 1)
+
+	validator, _ := s.app.StakingKeeper.GetValidator(s.ctx, s.validator)
+	expShares, err := validator.SharesFromTokens(amount.Amount)
+	s.Require().NoError(err)
+
+	k.ExecuteEpoch(s.ctx)
+
+	delegation, found := s.app.StakingKeeper.GetDelegation(s.ctx, delegator, s.validator)
+	s.Require().True(found)
+	s.Require().Equal(expShares, delegation.Shares)
+	s.Require().True(s.poolBalance().IsZero())
+	s.Require().Empty(k.GetEpochActions(s.ctx))
+	s.Require().Equal(int64(1), k.GetEpochNumber(s.ctx))
+}
+
+func (s *TestSuite) TestDelegateVestingCoins() {
+	k := s.app.EpochingKeeper
+	delegator := sdk.AccAddress([]byte("vesting_____________"))
+	amount := sdk.NewCoin(s.bondDenom, sdk.NewInt(1000))
+
+	// all the coins of the delayed vesting account are locked
+	baseAccount := authtypes.NewBaseAccountWithAddress(delegator)
+	vestingAccount := vestingtypes.NewDelayedVestingAccount(baseAccount, sdk.NewCoins(amount), s.ctx.BlockTime().Add(time.Hour).Unix())
+	s.app.AccountKeeper.SetAccount(s.ctx, vestingAccount)
+	s.Require().NoError(banktestutil.FundAccount(s.app.BankKeeper, s.ctx, delegator, sdk.NewCoins(amount)))
+	s.Require().True(s.app.BankKeeper.SpendableCoins(s.ctx, delegator).IsZero())
+
+	_, err := s.msgServer.Delegate(sdk.WrapSDKContext(s.ctx), stakingtypes.NewMsgDelegate(delegator, s.validator, amount))
+	s.Require().NoError(err)
+	s.Require().Equal(amount, s.poolBalance())
+
+	k.ExecuteEpoch(s.ctx)
+
+	_, found := s.app.StakingKeeper.GetDelegation(s.ctx, delegator, s.validator)
+	s.Require().True(found)
+	s.Require().True(s.poolBalance().IsZero())
+	acc := s.app.AccountKeeper.GetAccount(s.ctx, delegator).(*vestingtypes.DelayedVestingAccount)
+	s.Require().Equal(sdk.NewCoins(amount), acc.GetDelegatedVesting())
+	s.Require().True(acc.GetDelegatedFree().IsZero())
+}
+
+func (s *TestSuite) TestDelegateInvalid() {
+	testCases := []struct {
+		name string
+		msg  *stakingtypes.MsgDelegate
+	}{
+		{
+			"unknown validator",
+			stakingtypes.NewMsgDelegate(s.addrs[0], sdk.ValAddress(s.addrs[1]), sdk.NewCoin(s.bondDenom, sdk.NewInt(1000))),
+		},
+		{
+			"invalid denom",
+			stakingtypes.NewMsgDelegate(s.addrs[0], s.validator, sdk.NewCoin("foo", sdk.NewInt(1000))),
+		},
+		{
+			"insufficient funds",
+			stakingtypes.NewMsgDelegate(s.addrs[0], s.validator, sdk.NewCoin(s.bondDenom, sdk.NewInt(40000000))),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.Delegate(sdk.WrapSDKContext(s.ctx), tc.msg)
+			s.Require().Error(err)
+			s.Require().Empty(s.app.EpochingKeeper.GetEpochActions(s.ctx))
+		})
+	}
+}
+
+func (s *TestSuite) TestUndelegate() {
+	k := s.app.EpochingKeeper
+	delegator := s.addrs[0]
+	amount := sdk.NewCoin(s.bondDenom, sdk.NewInt(1000))
+
+	_, err := s.msgServer.Delegate(sdk.WrapSDKContext(s.ctx), stakingtypes.NewMsgDelegate(delegator, s.validator, amount))
+	s.Require().NoError(err)
+	k.ExecuteEpoch(s.ctx)
+
+	_, err = s.msgServer.Undelegate(sdk.WrapSDKContext(s.ctx), stakingtypes.NewMsgUndelegate(delegator, s.validator, amount))
+	s.Require().NoError(err)
+
+	// the delegation is untouched until the end of the epoch
+	_, found := s.app.StakingKeeper.GetDelegation(s.ctx, delegator, s.validator)
+	s.Require().True(found)
+	_, found = s.app.StakingKeeper.GetUnbondingDelegation(s.ctx, delegator, s.validator)
+	s.Require().False(found)
+
+	k.ExecuteEpoch(s.ctx)
+
+	_, found = s.app.StakingKeeper.GetDelegation(s.ctx, delegator, s.validator)
+	s.Require().False(found)
+	_, found = s.app.StakingKeeper.GetUnbondingDelegation(s.ctx, delegator, s.validator)
+	s.Require().True(found)
+}
+
+func (s *TestSuite) TestCancelUnbondingDelegation() {
+	k := s.app.EpochingKeeper
+	delegator := s.addrs[0]
+	amount := sdk.NewCoin(s.bondDenom, sdk.NewInt(1000))
+
+	_, err := s.msgServer.Delegate(sdk.WrapSDKContext(s.ctx), stakingtypes.NewMsgDelegate(delegator, s.validator, amount))
+	s.Require().NoError(err)
+	k.ExecuteEpoch(s.ctx)
+	_, err = s.msgServer.Undelegate(sdk.WrapSDKContext(s.ctx), stakingtypes.NewMsgUndelegate(delegator, s.validator, amount))
+	s.Require().NoError(err)
+	k.ExecuteEpoch(s.ctx)
+
+	msg := stakingtypes.NewMsgCancelUnbondingDelegation(delegator, s.validator, s.ctx.BlockHeight(), amount)
+	_, err = s.msgServer.CancelUnbondingDelegation(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	// the unbonding delegation is untouched until the end of the epoch
+	_, found := s.app.StakingKeeper.GetUnbondingDelegation(s.ctx, delegator, s.validator)
+	s.Require().True(found)
+
+	k.ExecuteEpoch(s.ctx)
+
+	_, found = s.app.StakingKeeper.GetUnbondingDelegation(s.ctx, delegator, s.validator)
+	s.Require().False(found)
+	_, found = s.app.StakingKeeper.GetDelegation(s.ctx, delegator, s.validator)
+	s.Require().True(found)
+}
+
+func (s *TestSuite) TestCreateValidatorFailureRefunds() {
+	k := s.app.EpochingKeeper
+	valAddr := sdk.ValAddress(s.addrs[1])
+	pks := simapp.CreateTestPubKeys(2)
+	amount := sdk.NewCoin(s.bondDenom, sdk.NewInt(1000))
+	balance := s.app.BankKeeper.GetBalance(s.ctx, s.addrs[1], s.bondDenom)
+	commission := stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+
+	// the second message fails at execution as the validator already exists
+	for _, pk := range pks {
+		msg, err := stakingtypes.NewMsgCreateValidator(
+			valAddr, pk, amount, stakingtypes.Description{Moniker: "moniker"}, commission, sdk.OneInt(),
+		)
+		s.Require().NoError(err)
+		_, err = s.msgServer.CreateValidator(sdk.WrapSDKContext(s.ctx), msg)
+		s.Require().NoError(err)
+	}
+	s.Require().Equal(amount.Add(amount), s.poolBalance())
+
+	_, found := s.app.StakingKeeper.GetValidator(s.ctx, valAddr)
+	s.Require().False(found)
+
+	k.ExecuteEpoch(s.ctx)
+
+	validator, found := s.app.StakingKeeper.GetValidator(s.ctx, valAddr)
+	s.Require().True(found)
+	s.Require().Equal(amount.Amount, validator.Tokens)
+	s.Require().True(s.poolBalance().IsZero())
+	s.Require().Equal(balance.Sub(amount), s.app.BankKeeper.GetBalance(s.ctx, s.addrs[1], s.bondDenom))
+}
+
+func (s *TestSuite) TestGenesisTxsExecutedImmediately() {
+	ctx := s.ctx.WithBlockHeight(0)
+	amount := sdk.NewCoin(s.bondDenom, sdk.NewInt(1000))
+
+	_, err := s.msgServer.Delegate(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgDelegate(s.addrs[0], s.validator, amount))
+	s.Require().NoError(err)
+
+	_, found := s.app.StakingKeeper.GetDelegation(ctx, s.addrs[0], s.validator)
+	s.Require().True(found)
+	s.Require().Empty(s.app.EpochingKeeper.GetEpochActions(ctx))
+}
+
+func (s *TestSuite) TestIsEpochEnd() {
+	k := s.app.EpochingKeeper
+	k.SetParams(s.ctx, epoching.NewParams(5))
+
+	s.Require().False(k.IsEpochEnd(s.ctx.WithBlockHeight(4)))
+	s.Require().True(k.IsEpochEnd(s.ctx.WithBlockHeight(5)))
+	s.Require().Equal(int64(5), k.GetEpochEndHeight(s.ctx.WithBlockHeight(3)))
+	s.Require().Equal(int64(5), k.GetEpochEndHeight(s.ctx.WithBlockHeight(5)))
+	s.Require().Equal(int64(10), k.GetEpochEndHeight(s.ctx.WithBlockHeight(6)))
+}
+
+func (s *TestSuite) TestEpochDelegationPoolInvariant() {
+	invariant := keeper.EpochDelegationPoolInvariant(s.app.EpochingKeeper)
+	amount := sdk.NewCoin(s.bondDenom, sdk.NewInt(1000))
+
+	_, err := s.msgServer.Delegate(sdk.WrapSDKContext(s.ctx), stakingtypes.NewMsgDelegate(s.addrs[0], s.validator, amount))
+	s.Require().NoError(err)
+	_, broken := invariant(s.ctx)
+	s.Require().False(broken)
+
+	// a queued delegation without escrow breaks the invariant
+	s.app.EpochingKeeper.QueueMsgForEpoch(s.ctx, 0, stakingtypes.NewMsgDelegate(s.addrs[1], s.validator, amount))
+	_, broken = invariant(s.ctx)
+	s.Require().True(broken)
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+)
+
+const (
+	DefaultEpochActionID = epoching.DefaultNextActionID
+	DefaultEpochNumber   = 0
+)
+
+var (
+	NextEpochActionID      = []byte{0x11}
+	EpochNumberID          = []byte{0x12}
+	EpochActionQueuePrefix = []byte{0x13} // prefix for the epoch
+)
+
+// StoreKey is the store key string for epoching
+const StoreKey = epoching.ModuleName
+
+// EpochActionsPrefix returns the prefix of all actions queued for the given epoch
+// Items are stored with the following key: values
+// 0x13<epochNumber (8 Bytes)><actionID (8 Bytes)>: sdk.Msg
+func EpochActionsPrefix(epochNumber int64) []byte {
+	key := make([]byte, len(EpochActionQueuePrefix)+8)
+	copy(key, EpochActionQueuePrefix)
+	copy(key[len(EpochActionQueuePrefix):], sdk.Uint64ToBigEndian(uint64(epochNumber)))
+	return key
+}
+
+// ActionStoreKey returns action store key from ID
+func ActionStoreKey(epochNumber int64, actionID uint64) []byte {
+	prefix := EpochActionsPrefix(epochNumber)
+	key := make([]byte, len(prefix)+8)
+	copy(key, prefix)
+	copy(key[len(prefix):], sdk.Uint64ToBigEndian(actionID))
+	return key
+}
+
+// ParseActionStoreKey returns the epoch number and action ID encoded in an
+// action store key.
+func ParseActionStoreKey(key []byte) (epochNumber int64, actionID uint64) {
+	key = key[len(EpochActionQueuePrefix):]
+	return int64(sdk.BigEndianToUint64(key[:8])), sdk.BigEndianToUint64(key[8:16])
+}
@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type stakingMsgServer struct {
+	Keeper
+}
+
+// NewStakingMsgServerImpl returns an implementation of the x/staking
+// MsgServer interface which buffers the messages until the end of the current
+// epoch instead of executing them immediately.
+func NewStakingMsgServerImpl(keeper Keeper) stakingtypes.MsgServer {
+	return &stakingMsgServer{Keeper: keeper}
+}
+
+var _ stakingtypes.MsgServer = stakingMsgServer{}
+
+// CreateValidator buffers a MsgCreateValidator and escrows its self-delegation.
+func (k stakingMsgServer) CreateValidator(goCtx context.Context, msg *stakingtypes.MsgCreateValidator) (*stakingtypes.MsgCreateValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// gentxs are executed immediately so that the chain starts with a
+	// validator set
+	if ctx.BlockHeight() == 0 {
+		return k.stakingMsgServer.CreateValidator(goCtx, msg)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); found {
+		return nil, stakingtypes.ErrValidatorOwnerExists
+	}
+
+	if err := k.checkBondDenom(ctx, msg.Value); err != nil {
+		return nil, err
+	}
+
+	if err := k.queueEpochMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &stakingtypes.MsgCreateValidatorResponse{}, nil
+}
+
+// EditValidator buffers a MsgEditValidator.
+func (k stakingMsgServer) EditValidator(goCtx context.Context, msg *stakingtypes.MsgEditValidator) (*stakingtypes.MsgEditValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkValidator(ctx, msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	if err := k.queueEpochMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &stakingtypes.MsgEditValidatorResponse{}, nil
+}
+
+// Delegate buffers a MsgDelegate and escrows the delegated amount.
+func (k stakingMsgServer) Delegate(goCtx context.Context, msg *stakingtypes.MsgDelegate) (*stakingtypes.MsgDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if ctx.BlockHeight() == 0 {
+		return k.stakingMsgServer.Delegate(goCtx, msg)
+	}
+
+	if err := k.checkValidator(ctx, msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	if err := k.checkBondDenom(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.queueEpochMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &stakingtypes.MsgDelegateResponse{}, nil
+}
+
+// BeginRedelegate buffers a MsgBeginRedelegate. The completion time of the
+// redelegation is only known once it is executed at the end of the epoch.
+func (k stakingMsgServer) BeginRedelegate(goCtx context.Context, msg *stakingtypes.MsgBeginRedelegate) (*stakingtypes.MsgBeginRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkValidator(ctx, msg.ValidatorSrcAddress); err != nil {
+		return nil, err
+	}
+
+	// x/staking reports a missing destination validator with its own error
+	if err := k.checkValidator(ctx, msg.ValidatorDstAddress); errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+		return nil, stakingtypes.ErrBadRedelegationDst
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkBondDenom(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.queueEpochMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &stakingtypes.MsgBeginRedelegateResponse{}, nil
+}
+
+// Undelegate buffers a MsgUndelegate. The completion time of the unbonding
+// is only known once it is executed at the end of the epoch.
+func (k stakingMsgServer) Undelegate(goCtx context.Context, msg *stakingtypes.MsgUndelegate) (*stakingtypes.MsgUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkValidator(ctx, msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	if err := k.checkBondDenom(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.queueEpochMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &stakingtypes.MsgUndelegateResponse{}, nil
+}
+
+// CancelUnbondingDelegation buffers a MsgCancelUnbondingDelegation. The
+// unbonding delegation entry is only checked once it is executed at the end of
+// the epoch.
+func (k stakingMsgServer) CancelUnbondingDelegation(goCtx context.Context, msg *stakingtypes.MsgCancelUnbondingDelegation) (*stakingtypes.MsgCancelUnbondingDelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkValidator(ctx, msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	if err := k.checkBondDenom(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.queueEpochMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &stakingtypes.MsgCancelUnbondingDelegationResponse{}, nil
+}
+
+// checkValidator returns an error if the validator does not exist.
+func (k stakingMsgServer) checkValidator(ctx sdk.Context, valAddrStr string) error {
+	valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+	if err != nil {
+		return err
+	}
+
+	if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); !found {
+		return stakingtypes.ErrNoValidatorFound
+	}
+
+	return nil
+}
+
+// checkBondDenom returns an error if the coin is not denominated in the
+// staking bond denom.
+func (k stakingMsgServer) checkBondDenom(ctx sdk.Context, coin sdk.Coin) error {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	if coin.Denom != bondDenom {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", coin.Denom, bondDenom,
+		)
+	}
+
+	return nil
+}
+
+// queueEpochMsg escrows the funds moved by the message, if any, and queues
+// the message for the current epoch. The funds are escrowed as a delegation,
+// so that vesting accounts can delegate their locked coins.
+func (k stakingMsgServer) queueEpochMsg(ctx sdk.Context, msg sdk.Msg) error {
+	delegator, coins, err := epoching.EscrowedCoins(msg)
+	if err != nil {
+		return err
+	}
+
+	if !coins.Empty() {
+		if err := k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, delegator, epoching.EpochDelegationPoolName, coins); err != nil {
+			return err
+		}
+	}
+
+	epochNumber := k.GetEpochNumber(ctx)
+	actionID := k.QueueMsgForEpoch(ctx, epochNumber, msg)
+
+	return ctx.EventManager().EmitTypedEvent(&epoching.EventQueueMsg{
+		EpochNumber: epochNumber,
+		ActionId:    actionID,
+		MsgTypeUrl:  sdk.MsgTypeURL(msg),
+	})
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+)
+
+// EpochLength returns the number of blocks in an epoch
+func (k Keeper) EpochLength(ctx sdk.Context) (res int64) {
+	k.paramSpace.Get(ctx, epoching.KeyEpochLength, &res)
+	return
+}
+
+// GetParams returns the total set of epoching parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params epoching.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the epoching parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params epoching.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,20 @@
+package epoching
+
+const (
+	// ModuleName is the name of the epoching module
+	ModuleName = "epoching"
+
+	// StoreKey is the default store key for epoching
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for epoching
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for epoching
+	QuerierRoute = ModuleName
+
+	// EpochDelegationPoolName is the name of the module account escrowing
+	// the funds of buffered delegations until they are executed. It must have
+	// the staking permission.
+	EpochDelegationPoolName = ModuleName
+)
@@ -0,0 +1,22 @@
+package module
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+)
+
+// EndBlocker executes the messages buffered for the current epoch if the
+// current block is the last block of the epoch.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(epoching.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if !k.IsEpochEnd(ctx) {
+		return
+	}
+
+	k.ExecuteEpoch(ctx)
+}
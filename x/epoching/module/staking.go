@@ -0,0 +1,50 @@
+package module
+
+import (
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingAppModule wraps the x/staking AppModule so that its Msg service is
+// served by the epoching module: MsgCreateValidator, MsgEditValidator,
+// MsgDelegate, MsgBeginRedelegate, MsgUndelegate and
+// MsgCancelUnbondingDelegation are buffered until the end of the current epoch
+// instead of being executed immediately. Everything else is delegated to the
+// wrapped module.
+type StakingAppModule struct {
+	staking.AppModule
+	keeper keeper.Keeper
+}
+
+// NewStakingAppModule creates a new StakingAppModule object. It replaces the
+// x/staking AppModule in the app's module manager to enable epoch-based
+// staking.
+func NewStakingAppModule(stakingModule staking.AppModule, keeper keeper.Keeper) StakingAppModule {
+	return StakingAppModule{
+		AppModule: stakingModule,
+		keeper:    keeper,
+	}
+}
+
+// RegisterServices registers the x/staking query service and migrations, and
+// the buffered x/staking Msg service in place of the x/staking one.
+func (am StakingAppModule) RegisterServices(cfg module.Configurator) {
+	am.AppModule.RegisterServices(stakingConfigurator{Configurator: cfg})
+	stakingtypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewStakingMsgServerImpl(am.keeper))
+}
+
+// stakingConfigurator discards the services registered on its Msg server.
+type stakingConfigurator struct {
+	module.Configurator
+}
+
+func (stakingConfigurator) MsgServer() gogogrpc.Server { return noopServer{} }
+
+type noopServer struct{}
+
+func (noopServer) RegisterService(*grpc.ServiceDesc, interface{}) {}
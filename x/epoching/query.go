@@ -0,0 +1,25 @@
+package epoching
+
+import (
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryEpochMsgsResponse) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, msg := range m.Msgs {
+		if err := msg.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryQueuedMsgsResponse) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, msg := range m.Msgs {
+		if err := msg.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
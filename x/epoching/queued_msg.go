@@ -0,0 +1,105 @@
+package epoching
+
+import (
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ cdctypes.UnpackInterfacesMessage = QueuedMsg{}
+
+// NewQueuedMsg creates a new QueuedMsg wrapping the given message.
+func NewQueuedMsg(epochNumber int64, actionID uint64, msg sdk.Msg) (QueuedMsg, error) {
+	any, err := cdctypes.NewAnyWithValue(msg)
+	if err != nil {
+		return QueuedMsg{}, err
+	}
+
+	return QueuedMsg{
+		EpochNumber: epochNumber,
+		ActionId:    actionID,
+		Msg:         any,
+	}, nil
+}
+
+// GetMsg returns the cached sdk.Msg of the QueuedMsg, or nil if it was not
+// unpacked.
+func (m QueuedMsg) GetMsg() sdk.Msg {
+	if m.Msg == nil {
+		return nil
+	}
+	msg, ok := m.Msg.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil
+	}
+	return msg
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QueuedMsg) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(m.Msg, &msg)
+}
+
+// ValidateBasic performs a stateless validation of the QueuedMsg.
+func (m QueuedMsg) ValidateBasic() error {
+	if m.EpochNumber < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "negative epoch number %d", m.EpochNumber)
+	}
+	if m.ActionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "action id cannot be zero")
+	}
+
+	msg := m.GetMsg()
+	if msg == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidType, "queued msg must be an unpacked sdk.Msg")
+	}
+	if !IsEpochMsg(msg) {
+		return sdkerrors.Wrapf(ErrUnsupportedMsg, "%s", sdk.MsgTypeURL(msg))
+	}
+
+	return msg.ValidateBasic()
+}
+
+// IsEpochMsg returns true if the message is one of the x/staking messages
+// that are buffered until the end of an epoch.
+func IsEpochMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *stakingtypes.MsgCreateValidator,
+		*stakingtypes.MsgEditValidator,
+		*stakingtypes.MsgDelegate,
+		*stakingtypes.MsgUndelegate,
+		*stakingtypes.MsgBeginRedelegate,
+		*stakingtypes.MsgCancelUnbondingDelegation:
+		return true
+	default:
+		return false
+	}
+}
+
+// EscrowedCoins returns the coins that are escrowed in the epoch delegation
+// pool while the message is buffered, together with the account they are
+// escrowed from. It returns nil coins for messages which do not move funds.
+func EscrowedCoins(msg sdk.Msg) (sdk.AccAddress, sdk.Coins, error) {
+	var (
+		delegator string
+		amount    sdk.Coin
+	)
+
+	switch msg := msg.(type) {
+	case *stakingtypes.MsgCreateValidator:
+		delegator, amount = msg.DelegatorAddress, msg.Value
+	case *stakingtypes.MsgDelegate:
+		delegator, amount = msg.DelegatorAddress, msg.Amount
+	default:
+		return nil, nil, nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return addr, sdk.NewCoins(amount), nil
+}
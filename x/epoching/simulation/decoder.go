@@ -0,0 +1,34 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+)
+
+// NewDecodeStore returns a decoder function closure that umarshals the KVPair's
+// Value to the corresponding epoching type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], keeper.NextEpochActionID),
+			bytes.Equal(kvA.Key[:1], keeper.EpochNumberID):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], keeper.EpochActionQueuePrefix):
+			var msgA, msgB sdk.Msg
+			if err := cdc.UnmarshalInterface(kvA.Value, &msgA); err != nil {
+				panic(err)
+			}
+			if err := cdc.UnmarshalInterface(kvB.Value, &msgB); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", msgA, msgB)
+		default:
+			panic(fmt.Sprintf("invalid epoching key %X", kvA.Key))
+		}
+	}
+}
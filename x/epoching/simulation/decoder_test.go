@@ -0,0 +1,62 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epoching/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	delPk1   = ed25519.GenPrivKey().PubKey()
+	delAddr1 = sdk.AccAddress(delPk1.Address())
+	valAddr1 = sdk.ValAddress(delPk1.Address())
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Codec
+	dec := simulation.NewDecodeStore(cdc)
+
+	var msg sdk.Msg = stakingtypes.NewMsgDelegate(delAddr1, valAddr1, sdk.NewInt64Coin("stake", 100))
+	msgBz, err := cdc.MarshalInterface(msg)
+	require.NoError(t, err)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: keeper.NextEpochActionID, Value: sdk.Uint64ToBigEndian(5)},
+			{Key: keeper.EpochNumberID, Value: sdk.Uint64ToBigEndian(2)},
+			{Key: keeper.ActionStoreKey(2, 4), Value: msgBz},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectErr   bool
+		expectedLog string
+	}{
+		{"NextEpochActionID", false, "5\n5"},
+		{"EpochNumber", false, "2\n2"},
+		{"EpochAction", false, fmt.Sprintf("%v\n%v", msg, msg)},
+		{"other", true, ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expectErr {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			} else {
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
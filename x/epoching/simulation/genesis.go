@@ -0,0 +1,30 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+)
+
+// Simulation parameter constants
+const epochLength = "epoch_length"
+
+// GenEpochLength randomized EpochLength
+func GenEpochLength(r *rand.Rand) int64 {
+	return int64(r.Intn(20) + 1)
+}
+
+// RandomizedGenState generates a random GenesisState for epoching.
+func RandomizedGenState(simState *module.SimulationState) {
+	var length int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, epochLength, &length, simState.Rand,
+		func(r *rand.Rand) { length = GenEpochLength(r) },
+	)
+
+	epochingGenesis := epoching.NewGenesisState(
+		epoching.NewParams(length), 0, epoching.DefaultNextActionID, nil,
+	)
+	simState.GenState[epoching.ModuleName] = simState.Cdc.MustMarshalJSON(epochingGenesis)
+}
@@ -0,0 +1,28 @@
+package feemarket
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NextBaseGasPrice returns the base gas price of the next block, given the gas
+// used by the current block and the maximum block gas. As in EIP-1559, the base
+// gas price moves toward the target gas, params.TargetBlockUtilization of the
+// maximum block gas:
+//
+//	next = base + base * (gasUsed - target) / target / params.BaseGasPriceChangeDenominator
+//
+// The base gas price is unchanged if the block gas is unlimited, and is never
+// lower than params.MinBaseGasPrice.
+func NextBaseGasPrice(params Params, baseGasPrice sdk.Dec, gasUsed, maxBlockGas uint64) sdk.Dec {
+	target := params.TargetBlockUtilization.MulInt(sdk.NewIntFromUint64(maxBlockGas))
+	if !target.IsPositive() {
+		return sdk.MaxDec(baseGasPrice, params.MinBaseGasPrice)
+	}
+
+	delta := baseGasPrice.
+		Mul(sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed)).Sub(target)).
+		Quo(target).
+		QuoInt64(int64(params.BaseGasPriceChangeDenominator))
+
+	return sdk.MaxDec(baseGasPrice.Add(delta), params.MinBaseGasPrice)
+}
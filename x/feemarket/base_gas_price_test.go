@@ -0,0 +1,76 @@
+package feemarket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+func TestNextBaseGasPrice(t *testing.T) {
+	params := feemarket.DefaultParams()
+	params.MinBaseGasPrice = sdk.NewDecWithPrec(1, 2)
+	baseGasPrice := sdk.NewDecWithPrec(8, 1)
+
+	testCases := []struct {
+		name        string
+		gasUsed     uint64
+		maxBlockGas uint64
+		exp         sdk.Dec
+	}{
+		{"block at target", 500, 1000, baseGasPrice},
+		{"full block", 1000, 1000, sdk.NewDecWithPrec(9, 1)},
+		{"block above max gas", 1500, 1000, sdk.NewDec(1)},
+		{"half full target", 250, 1000, sdk.NewDecWithPrec(75, 2)},
+		{"empty block", 0, 1000, sdk.NewDecWithPrec(7, 1)},
+		{"unlimited block gas", 1000, 0, baseGasPrice},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, feemarket.NextBaseGasPrice(params, baseGasPrice, tc.gasUsed, tc.maxBlockGas))
+		})
+	}
+
+	// the base gas price never goes below the min base gas price
+	require.Equal(t, params.MinBaseGasPrice, feemarket.NextBaseGasPrice(params, params.MinBaseGasPrice, 0, 1000))
+	require.Equal(t, params.MinBaseGasPrice, feemarket.NextBaseGasPrice(params, sdk.ZeroDec(), 1000, 0))
+
+	// the fee market stays disabled with a zero min base gas price
+	params.MinBaseGasPrice = sdk.ZeroDec()
+	require.True(t, feemarket.NextBaseGasPrice(params, sdk.ZeroDec(), 1000, 1000).IsZero())
+}
+
+func TestValidateGenesis(t *testing.T) {
+	params := feemarket.DefaultParams()
+	params.MinBaseGasPrice = sdk.NewDecWithPrec(1, 2)
+
+	testCases := []struct {
+		name   string
+		data   *feemarket.GenesisState
+		expErr bool
+	}{
+		{"default genesis", feemarket.DefaultGenesisState(), false},
+		{"base gas price above min", feemarket.NewGenesisState(params, sdk.NewDec(1)), false},
+		{"base gas price below min", feemarket.NewGenesisState(params, sdk.ZeroDec()), true},
+		{"invalid fee denom", feemarket.NewGenesisState(feemarket.NewParams("1", sdk.ZeroDec(), sdk.OneDec(), 8, ""), sdk.ZeroDec()), true},
+		{"negative min base gas price", feemarket.NewGenesisState(feemarket.NewParams("stake", sdk.NewDec(-1), sdk.OneDec(), 8, ""), sdk.ZeroDec()), true},
+		{"zero target block utilization", feemarket.NewGenesisState(feemarket.NewParams("stake", sdk.ZeroDec(), sdk.ZeroDec(), 8, ""), sdk.ZeroDec()), true},
+		{"target block utilization above one", feemarket.NewGenesisState(feemarket.NewParams("stake", sdk.ZeroDec(), sdk.NewDec(2), 8, ""), sdk.ZeroDec()), true},
+		{"zero change denominator", feemarket.NewGenesisState(feemarket.NewParams("stake", sdk.ZeroDec(), sdk.OneDec(), 0, ""), sdk.ZeroDec()), true},
+		{"feemarket base fee recipient", feemarket.NewGenesisState(feemarket.NewParams("stake", sdk.ZeroDec(), sdk.OneDec(), 8, feemarket.ModuleName), sdk.ZeroDec()), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := feemarket.ValidateGenesis(*tc.data)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	feemarketQueryCmd := &cobra.Command{
+		Use:                        feemarket.ModuleName,
+		Short:                      "Querying commands for the feemarket module",
+		Long:                       "",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feemarketQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryBaseGasPrice(),
+	)
+	return feemarketQueryCmd
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Args:    cobra.NoArgs,
+		Short:   "query the current feemarket parameters",
+		Example: fmt.Sprintf(`$ %s query %s params`, version.AppName, feemarket.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := feemarket.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(cmd.Context(), &feemarket.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryBaseGasPrice implements the query base-gas-price command.
+func GetCmdQueryBaseGasPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "base-gas-price",
+		Args:    cobra.NoArgs,
+		Short:   "query the current base gas price",
+		Example: fmt.Sprintf(`$ %s query %s base-gas-price`, version.AppName, feemarket.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := feemarket.NewQueryClient(clientCtx)
+			res, err := queryClient.BaseGasPrice(cmd.Context(), &feemarket.QueryBaseGasPriceRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&res.BaseGasPrice)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
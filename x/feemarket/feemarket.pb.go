@@ -0,0 +1,525 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/feemarket/v1beta1/feemarket.proto
+
+package feemarket
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the feemarket module.
+type Params struct {
+	// fee_denom is the denom the base gas price is denominated in.
+	FeeDenom string `protobuf:"bytes,1,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty" yaml:"fee_denom"`
+	// min_base_gas_price is the lower bound of the base gas price. The fee market
+	// is disabled while the base gas price is zero.
+	MinBaseGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_base_gas_price,json=minBaseGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_base_gas_price" yaml:"min_base_gas_price"`
+	// target_block_utilization is the fraction of the maximum block gas the base
+	// gas price is adjusted toward.
+	TargetBlockUtilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=target_block_utilization,json=targetBlockUtilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_block_utilization" yaml:"target_block_utilization"`
+	// base_gas_price_change_denominator bounds the change of the base gas price
+	// between two blocks to 1/base_gas_price_change_denominator of its value.
+	BaseGasPriceChangeDenominator uint32 `protobuf:"varint,4,opt,name=base_gas_price_change_denominator,json=baseGasPriceChangeDenominator,proto3" json:"base_gas_price_change_denominator,omitempty" yaml:"base_gas_price_change_denominator"`
+	// base_fee_recipient is the name of the module account receiving the base
+	// portion of the fees. The base portion is burned if it is empty.
+	BaseFeeRecipient string `protobuf:"bytes,5,opt,name=base_fee_recipient,json=baseFeeRecipient,proto3" json:"base_fee_recipient,omitempty" yaml:"base_fee_recipient"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3047acb548fa7c8, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetFeeDenom() string {
+	if m != nil {
+		return m.FeeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetBaseGasPriceChangeDenominator() uint32 {
+	if m != nil {
+		return m.BaseGasPriceChangeDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFeeRecipient() string {
+	if m != nil {
+		return m.BaseFeeRecipient
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.feemarket.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/feemarket/v1beta1/feemarket.proto", fileDescriptor_f3047acb548fa7c8)
+}
+
+var fileDescriptor_f3047acb548fa7c8 = []byte{
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xb1, 0x6e, 0xd4, 0x30,
+	0x18, 0xc7, 0x63, 0x38, 0x2a, 0x6a, 0x09, 0x51, 0x59, 0x15, 0x4a, 0x2b, 0x35, 0x29, 0x19, 0x50,
+	0x06, 0x9a, 0xe8, 0xc4, 0xd6, 0x31, 0x44, 0x30, 0xb0, 0x54, 0x91, 0x18, 0x60, 0xb1, 0x1c, 0xf7,
+	0xbb, 0xd4, 0xba, 0x73, 0x7c, 0x8a, 0xdd, 0x8a, 0xf2, 0x00, 0xcc, 0x8c, 0xb0, 0xb1, 0xb3, 0xf2,
+	0x10, 0x1d, 0x4f, 0x4c, 0x88, 0x21, 0x42, 0x77, 0x6f, 0x70, 0x4f, 0x80, 0x62, 0x07, 0x02, 0x9c,
+	0x00, 0x75, 0x8a, 0xf3, 0xff, 0x7e, 0xfe, 0xbe, 0xbf, 0xff, 0x36, 0x8e, 0xb9, 0xd2, 0x52, 0xe9,
+	0x74, 0x02, 0x20, 0x59, 0x33, 0x05, 0x93, 0x5e, 0x8c, 0x4b, 0x30, 0x6c, 0x3c, 0x28, 0xc9, 0xbc,
+	0x51, 0x46, 0x11, 0xdf, 0x91, 0xc9, 0xa0, 0xf7, 0xe4, 0xfe, 0x9e, 0xab, 0x50, 0xcb, 0xa5, 0x3d,
+	0x66, 0x7f, 0xf6, 0x77, 0x2b, 0x55, 0x29, 0xa7, 0x77, 0x2b, 0xa7, 0x46, 0x1f, 0x47, 0x78, 0xeb,
+	0x84, 0x35, 0x4c, 0x6a, 0x32, 0xc6, 0xdb, 0x13, 0x00, 0x7a, 0x0a, 0xb5, 0x92, 0x3e, 0x3a, 0x44,
+	0xf1, 0x76, 0xb6, 0xbb, 0x6e, 0xc3, 0x9d, 0x4b, 0x26, 0x67, 0xc7, 0xd1, 0xcf, 0x52, 0x54, 0xdc,
+	0x9e, 0x00, 0xe4, 0xdd, 0x92, 0xbc, 0x41, 0x98, 0x48, 0x51, 0xd3, 0x92, 0x69, 0xa0, 0x15, 0xeb,
+	0xe6, 0x0a, 0x0e, 0xfe, 0x0d, 0xbb, 0xf9, 0xc5, 0x55, 0x1b, 0x7a, 0x5f, 0xdb, 0xf0, 0x41, 0x25,
+	0xcc, 0xd9, 0x79, 0x99, 0x70, 0x25, 0x7b, 0x47, 0xfd, 0xe7, 0x48, 0x9f, 0x4e, 0x53, 0x73, 0x39,
+	0x07, 0x9d, 0xe4, 0xc0, 0xd7, 0x6d, 0xb8, 0xe7, 0x46, 0x6d, 0x76, 0x8c, 0x3e, 0x7f, 0x3a, 0xc2,
+	0xfd, 0x69, 0x72, 0xe0, 0xc5, 0x5d, 0x29, 0xea, 0x8c, 0x69, 0x78, 0xca, 0xf4, 0x49, 0x57, 0x27,
+	0xef, 0x11, 0xf6, 0x0d, 0x6b, 0x2a, 0x30, 0xb4, 0x9c, 0x29, 0x3e, 0xa5, 0xe7, 0x46, 0xcc, 0xc4,
+	0x6b, 0x66, 0x84, 0xaa, 0xfd, 0x9b, 0xd6, 0x0e, 0xbd, 0xb6, 0x9d, 0xd0, 0xd9, 0xf9, 0x5b, 0xdf,
+	0x3f, 0x4d, 0xdd, 0x73, 0x60, 0xd6, 0x71, 0xcf, 0x07, 0x8c, 0x5c, 0xe0, 0xfb, 0xbf, 0x9f, 0x86,
+	0xf2, 0x33, 0x56, 0x57, 0x7d, 0x9c, 0xa2, 0x66, 0x46, 0x35, 0xfe, 0xe8, 0x10, 0xc5, 0x77, 0xb2,
+	0x87, 0xeb, 0x36, 0x8c, 0xdd, 0xd4, 0xff, 0x6e, 0x89, 0x8a, 0x83, 0xf2, 0x97, 0x08, 0x1e, 0x5b,
+	0x20, 0x1f, 0xea, 0xe4, 0x19, 0x26, 0xb6, 0x49, 0x77, 0x73, 0x0d, 0x70, 0x31, 0x17, 0x50, 0x1b,
+	0xff, 0x96, 0x0d, 0xe3, 0x60, 0x48, 0x7b, 0x93, 0x89, 0x8a, 0x9d, 0x4e, 0x7c, 0x02, 0x50, 0xfc,
+	0x90, 0x8e, 0x47, 0xef, 0x3e, 0x84, 0x5e, 0x96, 0x5d, 0x2d, 0x03, 0xb4, 0x58, 0x06, 0xe8, 0xdb,
+	0x32, 0x40, 0x6f, 0x57, 0x81, 0xb7, 0x58, 0x05, 0xde, 0x97, 0x55, 0xe0, 0xbd, 0x8c, 0xff, 0x99,
+	0xea, 0xab, 0xe1, 0x09, 0x97, 0x5b, 0xf6, 0xe1, 0x3d, 0xfa, 0x3e, 0x00, 0x76, 0x36, 0x9b, 0x2c,
+	0xef, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseFeeRecipient) > 0 {
+		i -= len(m.BaseFeeRecipient)
+		copy(dAtA[i:], m.BaseFeeRecipient)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.BaseFeeRecipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BaseGasPriceChangeDenominator != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseGasPriceChangeDenominator))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.TargetBlockUtilization.Size()
+		i -= size
+		if _, err := m.TargetBlockUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinBaseGasPrice.Size()
+		i -= size
+		if _, err := m.MinBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeemarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.MinBaseGasPrice.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.TargetBlockUtilization.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.BaseGasPriceChangeDenominator != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseGasPriceChangeDenominator))
+	}
+	l = len(m.BaseFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	return n
+}
+
+func sovFeemarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeemarket(x uint64) (n int) {
+	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetBlockUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPriceChangeDenominator", wireType)
+			}
+			m.BaseGasPriceChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseGasPriceChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeemarket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeemarket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeemarket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeemarket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeemarket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeemarket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeemarket = fmt.Errorf("proto: unexpected end of group")
+)
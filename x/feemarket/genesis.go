@@ -0,0 +1,34 @@
+package feemarket
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, baseGasPrice sdk.Dec) *GenesisState {
+	return &GenesisState{
+		Params:       params,
+		BaseGasPrice: baseGasPrice,
+	}
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *GenesisState {
+	params := DefaultParams()
+	return NewGenesisState(params, params.MinBaseGasPrice)
+}
+
+// ValidateGenesis check the given genesis state has no integrity issues
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if data.BaseGasPrice.IsNil() || data.BaseGasPrice.LT(data.Params.MinBaseGasPrice) {
+		return fmt.Errorf("base gas price %s must be at least the min base gas price %s", data.BaseGasPrice, data.Params.MinBaseGasPrice)
+	}
+
+	return nil
+}
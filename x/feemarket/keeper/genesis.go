@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+// InitGenesis initializes the feemarket module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *feemarket.GenesisState) {
+	if recipient := data.Params.BaseFeeRecipient; recipient != "" {
+		if err := validateModuleAccount(k.accountKeeper, recipient); err != nil {
+			panic(err)
+		}
+	}
+	k.SetParams(ctx, data.Params)
+	k.SetBaseGasPrice(ctx, data.BaseGasPrice)
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *feemarket.GenesisState {
+	return feemarket.NewGenesisState(k.GetParams(ctx), k.GetBaseGasPrice(ctx))
+}
@@ -0,0 +1,31 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+func (s *TestSuite) TestExportAndInitGenesis() {
+	k := s.app.FeeMarketKeeper
+
+	genesis := k.ExportGenesis(s.ctx)
+	s.Require().Equal(sdk.NewDecWithPrec(1, 2), genesis.Params.MinBaseGasPrice)
+	s.Require().Equal(sdk.NewDecWithPrec(8, 1), genesis.BaseGasPrice)
+	s.Require().NoError(feemarket.ValidateGenesis(*genesis))
+
+	// round trip through JSON
+	cdc := s.app.AppCodec()
+	bz := cdc.MustMarshalJSON(genesis)
+	var imported feemarket.GenesisState
+	cdc.MustUnmarshalJSON(bz, &imported)
+
+	k.SetParams(s.ctx, feemarket.DefaultParams())
+	k.SetBaseGasPrice(s.ctx, sdk.ZeroDec())
+
+	k.InitGenesis(s.ctx, &imported)
+	s.Require().Equal(bz, cdc.MustMarshalJSON(k.ExportGenesis(s.ctx)))
+
+	// the base fee recipient must be a module account
+	imported.Params.BaseFeeRecipient = "unknown"
+	s.Require().Panics(func() { k.InitGenesis(s.ctx, &imported) })
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+var _ feemarket.QueryServer = Keeper{}
+
+// Params queries the parameters of the feemarket module
+func (k Keeper) Params(goCtx context.Context, r *feemarket.QueryParamsRequest) (*feemarket.QueryParamsResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &feemarket.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// BaseGasPrice queries the current base gas price
+func (k Keeper) BaseGasPrice(goCtx context.Context, r *feemarket.QueryBaseGasPriceRequest) (*feemarket.QueryBaseGasPriceResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &feemarket.QueryBaseGasPriceResponse{BaseGasPrice: k.GetBaseGasPriceCoin(ctx)}, nil
+}
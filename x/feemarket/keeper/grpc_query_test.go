@@ -0,0 +1,20 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+func (s *TestSuite) TestGRPCQueryParams() {
+	res, err := s.queryClient.Params(gocontext.Background(), &feemarket.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(s.app.FeeMarketKeeper.GetParams(s.ctx), res.Params)
+}
+
+func (s *TestSuite) TestGRPCQueryBaseGasPrice() {
+	res, err := s.queryClient.BaseGasPrice(gocontext.Background(), &feemarket.QueryBaseGasPriceRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(8, 1)), res.BaseGasPrice)
+}
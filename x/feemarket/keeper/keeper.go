@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	storeKey   storetypes.StoreKey
+	cdc        codec.BinaryCodec
+	paramSpace paramtypes.Subspace
+
+	accountKeeper    feemarket.AccountKeeper
+	bankKeeper       feemarket.BankKeeper
+	feeCollectorName string
+}
+
+// NewKeeper creates a new feemarket Keeper instance. The base fees are
+// collected from the feeCollectorName module account.
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	ak feemarket.AccountKeeper, bk feemarket.BankKeeper, feeCollectorName string,
+) Keeper {
+	// ensure the feemarket module account is set
+	if addr := ak.GetModuleAddress(feemarket.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", feemarket.ModuleName))
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(paramKeyTable(ak))
+	}
+
+	return Keeper{
+		storeKey:         key,
+		cdc:              cdc,
+		paramSpace:       paramSpace,
+		accountKeeper:    ak,
+		bankKeeper:       bk,
+		feeCollectorName: feeCollectorName,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", feemarket.ModuleName))
+}
+
+// GetBaseGasPrice returns the current base gas price, in the fee denom.
+func (k Keeper) GetBaseGasPrice(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(BaseGasPriceKey)
+	if bz == nil {
+		return k.GetParams(ctx).MinBaseGasPrice
+	}
+
+	var price sdk.Dec
+	if err := price.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return price
+}
+
+// SetBaseGasPrice sets the current base gas price.
+func (k Keeper) SetBaseGasPrice(ctx sdk.Context, price sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := price.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(BaseGasPriceKey, bz)
+}
+
+// GetBaseGasPriceCoin returns the current base gas price along with the fee
+// denom.
+func (k Keeper) GetBaseGasPriceCoin(ctx sdk.Context) sdk.DecCoin {
+	return sdk.NewDecCoinFromDec(k.GetParams(ctx).FeeDenom, k.GetBaseGasPrice(ctx))
+}
+
+// UpdateBaseGasPrice adjusts the base gas price of the next block toward the
+// target block utilization, given the gas consumed by the current block.
+func (k Keeper) UpdateBaseGasPrice(ctx sdk.Context) {
+	var maxBlockGas uint64
+	if cp := ctx.ConsensusParams(); cp != nil && cp.Block != nil && cp.Block.MaxGas > 0 {
+		maxBlockGas = uint64(cp.Block.MaxGas)
+	}
+
+	params := k.GetParams(ctx)
+	price := feemarket.NextBaseGasPrice(params, k.GetBaseGasPrice(ctx), ctx.BlockGasMeter().GasConsumed(), maxBlockGas)
+	k.SetBaseGasPrice(ctx, price)
+}
+
+// CollectBaseFee moves the base portion of the fees of a transaction out of the
+// fee collector: it is sent to the BaseFeeRecipient module account if set, and
+// burned otherwise.
+func (k Keeper) CollectBaseFee(ctx sdk.Context, baseFee sdk.Coins) error {
+	if baseFee.IsZero() {
+		return nil
+	}
+
+	if recipient := k.GetParams(ctx).BaseFeeRecipient; recipient != "" {
+		if err := validateModuleAccount(k.accountKeeper, recipient); err != nil {
+			return err
+		}
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, recipient, baseFee)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, feemarket.ModuleName, baseFee); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, feemarket.ModuleName, baseFee)
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmtime "github.com/tendermint/tendermint/libs/time"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient feemarket.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: tmtime.Now(), Height: 1})
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	feemarket.RegisterQueryServer(queryHelper, app.FeeMarketKeeper)
+
+	params := feemarket.DefaultParams()
+	params.MinBaseGasPrice = sdk.NewDecWithPrec(1, 2)
+	app.FeeMarketKeeper.SetParams(ctx, params)
+	app.FeeMarketKeeper.SetBaseGasPrice(ctx, sdk.NewDecWithPrec(8, 1))
+
+	s.app = app
+	s.ctx = ctx
+	s.queryClient = feemarket.NewQueryClient(queryHelper)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) TestUpdateBaseGasPrice() {
+	k := s.app.FeeMarketKeeper
+	maxBlockGas := s.ctx.ConsensusParams().Block.MaxGas
+
+	s.T().Log("verify the base gas price increases after a full block")
+	ctx := s.ctx.WithBlockGasMeter(sdk.NewGasMeter(uint64(maxBlockGas)))
+	ctx.BlockGasMeter().ConsumeGas(uint64(maxBlockGas), "full block")
+	k.UpdateBaseGasPrice(ctx)
+	s.Require().Equal(sdk.NewDecWithPrec(9, 1), k.GetBaseGasPrice(s.ctx))
+
+	s.T().Log("verify the base gas price is unchanged after a block at target")
+	ctx = s.ctx.WithBlockGasMeter(sdk.NewGasMeter(uint64(maxBlockGas)))
+	ctx.BlockGasMeter().ConsumeGas(uint64(maxBlockGas/2), "block at target")
+	k.UpdateBaseGasPrice(ctx)
+	s.Require().Equal(sdk.NewDecWithPrec(9, 1), k.GetBaseGasPrice(s.ctx))
+
+	s.T().Log("verify the base gas price decreases down to the min after empty blocks")
+	for i := 0; i < 50; i++ {
+		k.UpdateBaseGasPrice(s.ctx.WithBlockGasMeter(sdk.NewGasMeter(uint64(maxBlockGas))))
+	}
+	s.Require().Equal(sdk.NewDecWithPrec(1, 2), k.GetBaseGasPrice(s.ctx))
+}
+
+func (s *TestSuite) TestCollectBaseFee() {
+	k := s.app.FeeMarketKeeper
+	baseFee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	feeCollector := s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	s.Require().NoError(testutil.FundModuleAccount(s.app.BankKeeper, s.ctx, authtypes.FeeCollectorName, baseFee.Add(baseFee...)))
+	supply := s.app.BankKeeper.GetSupply(s.ctx, sdk.DefaultBondDenom)
+
+	s.T().Log("verify the base fee is burned by default")
+	s.Require().NoError(k.CollectBaseFee(s.ctx, baseFee))
+	s.Require().Equal(baseFee, s.app.BankKeeper.GetAllBalances(s.ctx, feeCollector))
+	s.Require().Equal(supply.Sub(baseFee[0]), s.app.BankKeeper.GetSupply(s.ctx, sdk.DefaultBondDenom))
+
+	s.T().Log("verify the base fee is sent to the base fee recipient if set")
+	params := k.GetParams(s.ctx)
+	params.BaseFeeRecipient = distrtypes.ModuleName
+	k.SetParams(s.ctx, params)
+	distrAddr := s.app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	distrBalance := s.app.BankKeeper.GetBalance(s.ctx, distrAddr, sdk.DefaultBondDenom)
+	s.Require().NoError(k.CollectBaseFee(s.ctx, baseFee))
+	s.Require().True(s.app.BankKeeper.GetAllBalances(s.ctx, feeCollector).IsZero())
+	s.Require().Equal(distrBalance.Add(baseFee[0]), s.app.BankKeeper.GetBalance(s.ctx, distrAddr, sdk.DefaultBondDenom))
+
+	s.T().Log("verify the fee collector must hold the base fee")
+	s.Require().Error(k.CollectBaseFee(s.ctx, baseFee))
+
+	s.T().Log("verify the base fee recipient must be a module account")
+	s.Require().NoError(testutil.FundModuleAccount(s.app.BankKeeper, s.ctx, authtypes.FeeCollectorName, baseFee))
+	params.BaseFeeRecipient = "unknown"
+	k.SetParams(s.ctx, params)
+	s.Require().ErrorIs(k.CollectBaseFee(s.ctx, baseFee), sdkerrors.ErrUnknownAddress)
+	s.Require().Equal(baseFee, s.app.BankKeeper.GetAllBalances(s.ctx, feeCollector))
+}
+
+func (s *TestSuite) TestUpdateBaseFeeRecipient() {
+	subspace := s.app.GetSubspace(feemarket.ModuleName)
+
+	s.Require().NoError(subspace.Update(s.ctx, feemarket.KeyBaseFeeRecipient, []byte(`"distribution"`)))
+	s.Require().Equal(distrtypes.ModuleName, s.app.FeeMarketKeeper.GetParams(s.ctx).BaseFeeRecipient)
+	s.Require().NoError(subspace.Update(s.ctx, feemarket.KeyBaseFeeRecipient, []byte(`""`)))
+
+	// a param change proposal can't set a recipient which isn't a module account
+	s.Require().Error(subspace.Update(s.ctx, feemarket.KeyBaseFeeRecipient, []byte(`"distributon"`)))
+	s.Require().Error(subspace.Update(s.ctx, feemarket.KeyBaseFeeRecipient, []byte(`"feemarket"`)))
+	s.Require().Empty(s.app.FeeMarketKeeper.GetParams(s.ctx).BaseFeeRecipient)
+}
@@ -0,0 +1,11 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+// BaseGasPriceKey is the key of the current base gas price.
+var BaseGasPriceKey = []byte{0x01}
+
+// StoreKey is the store key string for feemarket
+const StoreKey = feemarket.ModuleName
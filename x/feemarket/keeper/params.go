@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// GetParams returns the total set of feemarket parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params feemarket.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the feemarket parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params feemarket.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// paramKeyTable returns the parameter key table of the feemarket module, which
+// also checks that the base fee recipient is a module account, so that a
+// parameter change can't prevent collecting the base fees.
+func paramKeyTable(ak feemarket.AccountKeeper) paramtypes.KeyTable {
+	table := paramtypes.NewKeyTable()
+	for _, pair := range (&feemarket.Params{}).ParamSetPairs() {
+		if bytes.Equal(pair.Key, feemarket.KeyBaseFeeRecipient) {
+			validate := pair.ValidatorFn
+			pair.ValidatorFn = func(i interface{}) error {
+				if err := validate(i); err != nil {
+					return err
+				}
+				if recipient := i.(string); recipient != "" {
+					return validateModuleAccount(ak, recipient)
+				}
+				return nil
+			}
+		}
+		table = table.RegisterType(pair)
+	}
+	return table
+}
+
+// validateModuleAccount returns an error if name is not the name of a module
+// account.
+func validateModuleAccount(ak feemarket.AccountKeeper, name string) error {
+	if ak.GetModuleAddress(name) == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "base fee recipient %s is not a module account", name)
+	}
+	return nil
+}
@@ -0,0 +1,12 @@
+package feemarket
+
+const (
+	// ModuleName is the name of the feemarket module
+	ModuleName = "feemarket"
+
+	// StoreKey is the default store key for feemarket
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for feemarket
+	QuerierRoute = ModuleName
+)
@@ -0,0 +1,18 @@
+package module
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+)
+
+// EndBlocker adjusts the base gas price of the next block given the gas
+// consumed by the current block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(feemarket.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.UpdateBaseGasPrice(ctx)
+}
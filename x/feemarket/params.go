@@ -0,0 +1,153 @@
+package feemarket
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// DefaultBaseGasPriceChangeDenominator is the default bound of the change of
+// the base gas price between two blocks, as in EIP-1559.
+const DefaultBaseGasPriceChangeDenominator = uint32(8)
+
+// Parameter store keys
+var (
+	KeyFeeDenom                      = []byte("FeeDenom")
+	KeyMinBaseGasPrice               = []byte("MinBaseGasPrice")
+	KeyTargetBlockUtilization        = []byte("TargetBlockUtilization")
+	KeyBaseGasPriceChangeDenominator = []byte("BaseGasPriceChangeDenominator")
+	KeyBaseFeeRecipient              = []byte("BaseFeeRecipient")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the parameter key table of the feemarket module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(
+	feeDenom string, minBaseGasPrice, targetBlockUtilization sdk.Dec,
+	baseGasPriceChangeDenominator uint32, baseFeeRecipient string,
+) Params {
+	return Params{
+		FeeDenom:                      feeDenom,
+		MinBaseGasPrice:               minBaseGasPrice,
+		TargetBlockUtilization:        targetBlockUtilization,
+		BaseGasPriceChangeDenominator: baseGasPriceChangeDenominator,
+		BaseFeeRecipient:              baseFeeRecipient,
+	}
+}
+
+// DefaultParams returns the default parameters of the feemarket module. The
+// minimum base gas price is zero, so that the fee market is disabled until it
+// is raised.
+func DefaultParams() Params {
+	return NewParams(
+		sdk.DefaultBondDenom, sdk.ZeroDec(), sdk.NewDecWithPrec(5, 1),
+		DefaultBaseGasPriceChangeDenominator, "",
+	)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyFeeDenom, &p.FeeDenom, validateFeeDenom),
+		paramtypes.NewParamSetPair(KeyMinBaseGasPrice, &p.MinBaseGasPrice, validateMinBaseGasPrice),
+		paramtypes.NewParamSetPair(KeyTargetBlockUtilization, &p.TargetBlockUtilization, validateTargetBlockUtilization),
+		paramtypes.NewParamSetPair(KeyBaseGasPriceChangeDenominator, &p.BaseGasPriceChangeDenominator, validateBaseGasPriceChangeDenominator),
+		paramtypes.NewParamSetPair(KeyBaseFeeRecipient, &p.BaseFeeRecipient, validateBaseFeeRecipient),
+	}
+}
+
+// Validate performs a basic validation of the parameters.
+func (p Params) Validate() error {
+	if err := validateFeeDenom(p.FeeDenom); err != nil {
+		return err
+	}
+	if err := validateMinBaseGasPrice(p.MinBaseGasPrice); err != nil {
+		return err
+	}
+	if err := validateTargetBlockUtilization(p.TargetBlockUtilization); err != nil {
+		return err
+	}
+	if err := validateBaseGasPriceChangeDenominator(p.BaseGasPriceChangeDenominator); err != nil {
+		return err
+	}
+
+	return validateBaseFeeRecipient(p.BaseFeeRecipient)
+}
+
+func validateFeeDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return sdk.ValidateDenom(v)
+}
+
+func validateMinBaseGasPrice(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min base gas price must be non-negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateTargetBlockUtilization(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("target block utilization must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("target block utilization too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateBaseGasPriceChangeDenominator(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("base gas price change denominator must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateBaseFeeRecipient(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == ModuleName {
+		return fmt.Errorf("base fee recipient cannot be the %s module account, leave it empty to burn the base fee", ModuleName)
+	}
+
+	return nil
+}
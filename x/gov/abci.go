@@ -36,19 +36,51 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 		logger.Info(
 			"proposal did not meet minimum deposit; deleted",
 			"proposal", proposal.ProposalId,
-			"min_deposit", sdk.NewCoins(keeper.GetDepositParams(ctx).MinDeposit...).String(),
+			"min_deposit", sdk.NewCoins(keeper.GetProposalDepositParams(ctx, proposal).MinDeposit...).String(),
 			"total_deposit", sdk.NewCoins(proposal.TotalDeposit...).String(),
 		)
 
 		return false
 	})
 
+	// expedited proposals converted to regular proposals are inserted back in the active
+	// proposal queue once the iteration is done
+	var convertedProposals []v1beta2.Proposal
+
 	// fetch active proposals whose voting periods have ended (are passed the block time)
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1beta2.Proposal) bool {
 		var tagValue, logMsg string
 
 		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
 
+		// an expedited proposal which fails is converted to a regular proposal: its
+		// voting period is extended to the regular one and its deposits and votes are kept.
+		if proposal.Expedited && !passes {
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, *proposal.VotingEndTime)
+
+			proposal.Expedited = false
+			endTime := proposal.VotingStartTime.Add(*keeper.GetProposalVotingParams(ctx, proposal).VotingPeriod)
+			proposal.VotingEndTime = &endTime
+
+			keeper.SetProposal(ctx, proposal)
+			convertedProposals = append(convertedProposals, proposal)
+
+			logger.Info(
+				"expedited proposal rejected; converted to regular proposal",
+				"proposal", proposal.ProposalId,
+				"voting_end_time", endTime,
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+
 		if burnDeposits {
 			keeper.DeleteAndBurnDeposits(ctx, proposal.ProposalId)
 		} else {
@@ -107,6 +139,7 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 
 		keeper.SetProposal(ctx, proposal)
 		keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, *proposal.VotingEndTime)
+		keeper.DeleteVotes(ctx, proposal.ProposalId)
 
 		// when proposal become active
 		keeper.AfterProposalVotingPeriodEnded(ctx, proposal.ProposalId)
@@ -126,4 +159,8 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 		)
 		return false
 	})
+
+	for _, proposal := range convertedProposals {
+		keeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, *proposal.VotingEndTime)
+	}
 }
@@ -38,6 +38,7 @@ func TestTickExpiredDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		nil,
+		false,
 	)
 	require.NoError(t, err)
 
@@ -91,6 +92,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		nil,
+		false,
 	)
 	require.NoError(t, err)
 
@@ -115,6 +117,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		nil,
+		false,
 	)
 	require.NoError(t, err)
 
@@ -173,6 +176,7 @@ func TestTickPassedDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		nil,
+		false,
 	)
 	require.NoError(t, err)
 
@@ -225,7 +229,7 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	activeQueue.Close()
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 5))}
-	newProposalMsg, err := v1beta2.NewMsgSubmitProposal([]sdk.Msg{mkTestLegacyContent(t)}, proposalCoins, addrs[0].String(), nil)
+	newProposalMsg, err := v1beta2.NewMsgSubmitProposal([]sdk.Msg{mkTestLegacyContent(t)}, proposalCoins, addrs[0].String(), nil, false)
 	require.NoError(t, err)
 
 	wrapCtx := sdk.WrapSDKContext(ctx)
@@ -293,7 +297,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, nil)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, nil, addrs[0], false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
@@ -343,7 +347,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// Create a proposal where the handler will pass for the test proposal
 	// because the value of contextKeyBadProposal is true.
 	ctx = ctx.WithValue(contextKeyBadProposal, true)
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, nil)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, nil, addrs[0], false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
@@ -369,6 +373,75 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	gov.EndBlocker(ctx, app.GovKeeper)
 }
 
+func TestExpeditedProposalEndBlocker(t *testing.T) {
+	testCases := []struct {
+		name       string
+		voteOption v1beta2.VoteOption
+		passes     bool
+	}{
+		{"expedited proposal passes", v1beta2.OptionYes, true},
+		{"expedited proposal fails and is converted to a regular proposal", v1beta2.OptionNo, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 1, valTokens)
+
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, nil, addrs[0], true)
+			require.NoError(t, err)
+
+			proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+			_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, addrs[0], proposalCoins)
+			require.NoError(t, err)
+
+			err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], v1beta2.NewNonSplitVoteOption(tc.voteOption))
+			require.NoError(t, err)
+
+			votingParams := app.GovKeeper.GetVotingParams(ctx)
+			startTime := ctx.BlockHeader().Time
+			ctx = ctx.WithBlockTime(startTime.Add(*votingParams.ExpeditedVotingPeriod))
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+
+			if tc.passes {
+				require.Equal(t, v1beta2.StatusPassed, proposal.Status)
+				require.Empty(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalId))
+				return
+			}
+
+			// the proposal keeps its deposits and votes and follows the regular voting period
+			require.Equal(t, v1beta2.StatusVotingPeriod, proposal.Status)
+			require.False(t, proposal.Expedited)
+			require.Equal(t, startTime.Add(*votingParams.VotingPeriod), *proposal.VotingEndTime)
+			require.Len(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalId), 1)
+			require.Len(t, app.GovKeeper.GetDeposits(ctx, proposal.ProposalId), 1)
+
+			activeQueue := app.GovKeeper.ActiveProposalQueueIterator(ctx, *proposal.VotingEndTime)
+			require.True(t, activeQueue.Valid())
+			activeQueue.Close()
+
+			ctx = ctx.WithBlockTime(*proposal.VotingEndTime)
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+			require.Equal(t, v1beta2.StatusRejected, proposal.Status)
+		})
+	}
+}
+
 func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sdk.Context, addrs []sdk.ValAddress, powerAmt []int64) {
 	require.True(t, len(addrs) <= len(pubkeys), "Not enough pubkeys specified at top of file.")
 
@@ -55,22 +55,23 @@ func parseSubmitLegacyProposalFlags(fs *pflag.FlagSet) (*legacyProposal, error)
 // proposal defines the new Msg-based proposal.
 type proposal struct {
 	// Msgs defines an array of sdk.Msgs proto-JSON-encoded as Anys.
-	Messages []json.RawMessage
-	Metadata []byte
-	Deposit  string
+	Messages  []json.RawMessage
+	Metadata  []byte
+	Deposit   string
+	Expedited bool
 }
 
-func parseSubmitProposal(cdc codec.Codec, path string) ([]sdk.Msg, []byte, sdk.Coins, error) {
+func parseSubmitProposal(cdc codec.Codec, path string) ([]sdk.Msg, []byte, sdk.Coins, bool, error) {
 	var proposal proposal
 
 	contents, err := os.ReadFile(path)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, false, err
 	}
 
 	err = json.Unmarshal(contents, &proposal)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, false, err
 	}
 
 	msgs := make([]sdk.Msg, len(proposal.Messages))
@@ -78,7 +79,7 @@ func parseSubmitProposal(cdc codec.Codec, path string) ([]sdk.Msg, []byte, sdk.C
 		var msg sdk.Msg
 		err := cdc.UnmarshalInterfaceJSON(anyJSON, &msg)
 		if err != nil {
-			return nil, nil, nil, err
+			return nil, nil, nil, false, err
 		}
 
 		msgs[i] = msg
@@ -86,8 +87,8 @@ func parseSubmitProposal(cdc codec.Codec, path string) ([]sdk.Msg, []byte, sdk.C
 
 	deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, false, err
 	}
 
-	return msgs, proposal.Metadata, deposit, nil
+	return msgs, proposal.Metadata, deposit, proposal.Expedited, nil
 }
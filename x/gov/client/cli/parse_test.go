@@ -114,23 +114,25 @@ func TestParseSubmitProposal(t *testing.T) {
 		}
   	],
 	"metadata": "%s",
-	"deposit": "1000test"
+	"deposit": "1000test",
+	"expedited": true
 }
 `, addr, addr, addr, addr, addr, base64.StdEncoding.EncodeToString(expectedMetadata)))
 
 	badJSON := testutil.WriteToNewTempFile(t, "bad json")
 
 	// nonexistent json
-	_, _, _, err := parseSubmitProposal(cdc, "fileDoesNotExist")
+	_, _, _, _, err := parseSubmitProposal(cdc, "fileDoesNotExist")
 	require.Error(t, err)
 
 	// invalid json
-	_, _, _, err = parseSubmitProposal(cdc, badJSON.Name())
+	_, _, _, _, err = parseSubmitProposal(cdc, badJSON.Name())
 	require.Error(t, err)
 
 	// ok json
-	msgs, metadata, deposit, err := parseSubmitProposal(cdc, okJSON.Name())
+	msgs, metadata, deposit, expedited, err := parseSubmitProposal(cdc, okJSON.Name())
 	require.NoError(t, err, "unexpected error")
+	require.True(t, expedited)
 	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(1000))), deposit)
 	require.Equal(t, expectedMetadata, metadata)
 	require.Len(t, msgs, 3)
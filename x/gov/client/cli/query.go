@@ -38,6 +38,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQueryMessageBasedParams(),
 	)
 
 	return govQueryCmd
@@ -629,6 +630,66 @@ $ %s query gov param deposit
 	return cmd
 }
 
+// GetCmdQueryMessageBasedParams implements the query message based params command.
+func GetCmdQueryMessageBasedParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "message-based-params [msg-url]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the governance parameters of a Msg type, or of all the Msg types which have some",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the governance parameters applying to the proposals executing a given
+Msg type. The parameters of all the Msg types which have some are returned when no Msg type URL
+is given.
+
+Example:
+$ %s query gov message-based-params /cosmos.bank.v1beta1.MsgSend
+$ %s query gov message-based-params
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1beta2.NewQueryClient(clientCtx)
+
+			if len(args) == 1 {
+				res, err := queryClient.MessageBasedParams(
+					cmd.Context(),
+					&v1beta2.QueryMessageBasedParamsRequest{MsgUrl: args[0]},
+				)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res.Params)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllMessageBasedParams(
+				cmd.Context(),
+				&v1beta2.QueryAllMessageBasedParamsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "message based params")
+
+	return cmd
+}
+
 // GetCmdQueryProposer implements the query proposer command.
 func GetCmdQueryProposer() *cobra.Command {
 	cmd := &cobra.Command{
@@ -12,9 +12,11 @@ import (
 // InitGenesis - store genesis parameters
 func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, data *v1beta2.GenesisState) {
 	k.SetProposalID(ctx, data.StartingProposalId)
-	k.SetDepositParams(ctx, *data.DepositParams)
-	k.SetVotingParams(ctx, *data.VotingParams)
-	k.SetTallyParams(ctx, *data.TallyParams)
+	k.SetParams(ctx, v1beta2.NewParams(*data.VotingParams, *data.TallyParams, *data.DepositParams))
+
+	for _, params := range data.MessageBasedParams {
+		k.SetMessageBasedParams(ctx, *params)
+	}
 
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
@@ -57,9 +59,8 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 // ExportGenesis - output genesis parameters
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *v1beta2.GenesisState {
 	startingProposalID, _ := k.GetProposalID(ctx)
-	depositParams := k.GetDepositParams(ctx)
-	votingParams := k.GetVotingParams(ctx)
-	tallyParams := k.GetTallyParams(ctx)
+	params := k.GetParams(ctx)
+	messageBasedParams := k.GetAllMessageBasedParams(ctx)
 	proposals := k.GetProposals(ctx)
 
 	var proposalsDeposits v1beta2.Deposits
@@ -77,8 +78,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *v1beta2.GenesisState {
 		Deposits:           proposalsDeposits,
 		Votes:              proposalsVotes,
 		Proposals:          proposals,
-		DepositParams:      &depositParams,
-		VotingParams:       &votingParams,
-		TallyParams:        &tallyParams,
+		DepositParams:      &params.DepositParams,
+		VotingParams:       &params.VotingParams,
+		TallyParams:        &params.TallyParams,
+		MessageBasedParams: messageBasedParams,
 	}
 }
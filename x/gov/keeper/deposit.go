@@ -73,6 +73,44 @@ func (keeper Keeper) DeleteAndBurnDeposits(ctx sdk.Context, proposalID uint64) {
 	})
 }
 
+// ChargeDeposits burns the cancelRatio fraction of all the deposits on a specific proposal,
+// refunds the rest to the depositors and deletes the deposits.
+func (keeper Keeper) ChargeDeposits(ctx sdk.Context, proposalID uint64, cancelRatio sdk.Dec) {
+	store := ctx.KVStore(keeper.storeKey)
+	burnAmount := sdk.NewCoins()
+
+	keeper.IterateDeposits(ctx, proposalID, func(deposit v1beta2.Deposit) bool {
+		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
+		if err != nil {
+			panic(err)
+		}
+
+		var charge sdk.Coins
+		for _, coin := range deposit.Amount {
+			amount := coin.Amount.ToDec().Mul(cancelRatio).TruncateInt()
+			charge = charge.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+		burnAmount = burnAmount.Add(charge...)
+
+		refund := sdk.NewCoins(deposit.Amount...).Sub(charge)
+		if !refund.IsZero() {
+			err = keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, refund)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		store.Delete(types.DepositKey(proposalID, depositor))
+		return false
+	})
+
+	if !burnAmount.IsZero() {
+		if err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, burnAmount); err != nil {
+			panic(err)
+		}
+	}
+}
+
 // IterateAllDeposits iterates over the all the stored deposits and performs a callback function
 func (keeper Keeper) IterateAllDeposits(ctx sdk.Context, cb func(deposit v1beta2.Deposit) (stop bool)) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -136,7 +174,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
 
-	if proposal.Status == v1beta2.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	if proposal.Status == v1beta2.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(keeper.GetProposalDepositParams(ctx, proposal).MinDeposit) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 
 		activatedVotingPeriod = true
@@ -184,6 +184,55 @@ func (q Keeper) Params(c context.Context, req *v1beta2.QueryParamsRequest) (*v1b
 	}
 }
 
+// MessageBasedParams queries the governance params of a Msg type URL
+func (q Keeper) MessageBasedParams(c context.Context, req *v1beta2.QueryMessageBasedParamsRequest) (*v1beta2.QueryMessageBasedParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.MsgUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty msg url")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	params, found := q.GetMessageBasedParams(ctx, req.MsgUrl)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no params for msg url %s", req.MsgUrl)
+	}
+
+	return &v1beta2.QueryMessageBasedParamsResponse{Params: &params}, nil
+}
+
+// AllMessageBasedParams queries the governance params of all the Msg type URLs
+func (q Keeper) AllMessageBasedParams(c context.Context, req *v1beta2.QueryAllMessageBasedParamsRequest) (*v1beta2.QueryAllMessageBasedParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var params []*v1beta2.MessageBasedParams
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	paramsStore := prefix.NewStore(store, types.MessageBasedParamsKeyPrefix)
+
+	pageRes, err := query.Paginate(paramsStore, req.Pagination, func(key []byte, value []byte) error {
+		var p v1beta2.MessageBasedParams
+		if err := q.cdc.Unmarshal(value, &p); err != nil {
+			return err
+		}
+
+		params = append(params, &p)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1beta2.QueryAllMessageBasedParamsResponse{Params: params, Pagination: pageRes}, nil
+}
+
 // Deposit queries single deposit information based proposalID, depositAddr
 func (q Keeper) Deposit(c context.Context, req *v1beta2.QueryDepositRequest) (*v1beta2.QueryDepositResponse, error) {
 	if req == nil {
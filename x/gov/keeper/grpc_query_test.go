@@ -3,6 +3,7 @@ package keeper_test
 import (
 	gocontext "context"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -53,7 +54,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1beta2.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, nil)
+				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, nil, addr, false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -127,7 +128,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposal() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1beta2.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, nil)
+				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, nil, addr, false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -192,7 +193,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 					testProposal := []sdk.Msg{
 						v1beta2.NewMsgVote(govAddress, uint64(i), v1beta2.OptionYes),
 					}
-					proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal, nil)
+					proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal, nil, addr, false)
 					suite.Require().NotEmpty(proposal)
 					suite.Require().NoError(err)
 					testProposals = append(testProposals, &proposal)
@@ -367,7 +368,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, addr, false)
 				suite.Require().NoError(err)
 
 				req = &v1beta2.QueryVoteRequest{
@@ -472,7 +473,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, addr, false)
 				suite.Require().NoError(err)
 
 				req = &v1beta2.QueryVotesRequest{
@@ -573,7 +574,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, addr, false)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryVotesRequest{
@@ -855,7 +856,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, addr, false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -944,7 +945,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, addr, false)
 				suite.Require().NoError(err)
 
 				req = &v1beta2.QueryDepositsRequest{
@@ -1036,7 +1037,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 			"create a proposal and get tally",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, addr, false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -1105,3 +1106,33 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryMessageBasedParams() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	votingParams := v1beta2.NewVotingParams(time.Hour, time.Minute)
+	sendParams := v1beta2.NewMessageBasedParams(msgSendURL, nil, &votingParams, nil)
+	tallyParams := v1beta2.DefaultTallyParams()
+	textParams := v1beta2.NewMessageBasedParams(textProposalURL, nil, nil, &tallyParams)
+	app.GovKeeper.SetMessageBasedParams(ctx, sendParams)
+	app.GovKeeper.SetMessageBasedParams(ctx, textParams)
+
+	_, err := queryClient.MessageBasedParams(gocontext.Background(), &v1beta2.QueryMessageBasedParamsRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.MessageBasedParams(gocontext.Background(), &v1beta2.QueryMessageBasedParamsRequest{MsgUrl: "/cosmos.bank.v1beta1.MsgMultiSend"})
+	suite.Require().Error(err)
+
+	res, err := queryClient.MessageBasedParams(gocontext.Background(), &v1beta2.QueryMessageBasedParamsRequest{MsgUrl: msgSendURL})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&sendParams, res.Params)
+
+	allRes, err := queryClient.AllMessageBasedParams(gocontext.Background(), &v1beta2.QueryAllMessageBasedParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1beta2.MessageBasedParams{&sendParams, &textParams}, allRes.Params)
+
+	allRes, err = queryClient.AllMessageBasedParams(gocontext.Background(), &v1beta2.QueryAllMessageBasedParamsRequest{Pagination: &query.PageRequest{Limit: 1}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1beta2.MessageBasedParams{&sendParams}, allRes.Params)
+	suite.Require().NotNil(allRes.Pagination.NextKey)
+}
@@ -18,7 +18,8 @@ import (
 
 // Keeper defines the governance module Keeper
 type Keeper struct {
-	// The reference to the Paramstore to get and set gov specific params
+	// The reference to the legacy x/params subspace, from which the gov params
+	// are migrated to the gov store
 	paramSpace types.ParamSubspace
 
 	authKeeper types.AccountKeeper
@@ -43,6 +44,10 @@ type Keeper struct {
 	router *middleware.MsgServiceRouter
 
 	config types.Config
+
+	// the address capable of executing a MsgUpdateParams or a
+	// MsgUpdateMessageBasedParams message, typically the gov module account
+	authority string
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -56,7 +61,7 @@ func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace types.ParamSubspace,
 	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, sk types.StakingKeeper,
 	legacyRouter v1beta1.Router, router *middleware.MsgServiceRouter,
-	config types.Config,
+	config types.Config, authority string,
 ) Keeper {
 
 	// ensure governance module account is set
@@ -64,6 +69,10 @@ func NewKeeper(
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
 
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
+	}
+
 	// It is vital to seal the governance proposal router here as to not allow
 	// further handlers to be registered after the keeper is created since this
 	// could create invalid or non-deterministic behavior.
@@ -84,6 +93,7 @@ func NewKeeper(
 		legacyRouter: legacyRouter,
 		router:       router,
 		config:       config,
+		authority:    authority,
 	}
 }
 
@@ -103,6 +113,11 @@ func (keeper Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
+// GetAuthority returns the address capable of updating the gov params
+func (keeper Keeper) GetAuthority() string {
+	return keeper.authority
+}
+
 // Router returns the gov keeper's router
 func (keeper Keeper) Router() *middleware.MsgServiceRouter {
 	return keeper.router
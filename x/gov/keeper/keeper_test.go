@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -58,17 +59,17 @@ func TestIncrementProposalNumber(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
 
 	tp := TestProposal
-	_, err := app.GovKeeper.SubmitProposal(ctx, tp, nil)
+	_, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
 	require.NoError(t, err)
-	_, err = app.GovKeeper.SubmitProposal(ctx, tp, nil)
+	_, err = app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
 	require.NoError(t, err)
-	_, err = app.GovKeeper.SubmitProposal(ctx, tp, nil)
+	_, err = app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
 	require.NoError(t, err)
-	_, err = app.GovKeeper.SubmitProposal(ctx, tp, nil)
+	_, err = app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
 	require.NoError(t, err)
-	_, err = app.GovKeeper.SubmitProposal(ctx, tp, nil)
+	_, err = app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
 	require.NoError(t, err)
-	proposal6, err := app.GovKeeper.SubmitProposal(ctx, tp, nil)
+	proposal6, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
 	require.NoError(t, err)
 
 	require.Equal(t, uint64(6), proposal6.ProposalId)
@@ -80,7 +81,7 @@ func TestProposalQueues(t *testing.T) {
 
 	// create test proposals
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
 	require.NoError(t, err)
 
 	inactiveIterator := app.GovKeeper.InactiveProposalQueueIterator(ctx, *proposal.DepositEndTime)
@@ -104,6 +105,47 @@ func TestProposalQueues(t *testing.T) {
 	activeIterator.Close()
 }
 
+func (suite *KeeperTestSuite) TestGetSetParams() {
+	app, ctx := suite.app, suite.ctx
+
+	params := v1beta2.DefaultParams()
+	params.VotingParams = v1beta2.NewVotingParams(time.Hour, time.Minute)
+	app.GovKeeper.SetParams(ctx, params)
+	suite.Require().Equal(params, app.GovKeeper.GetParams(ctx))
+	suite.Require().Equal(params.VotingParams, app.GovKeeper.GetVotingParams(ctx))
+
+	// setting a subset of the params keeps the others
+	tallyParams := v1beta2.NewTallyParams(sdk.NewDecWithPrec(5, 1), v1beta2.DefaultThreshold, v1beta2.DefaultVetoThreshold, v1beta2.DefaultExpeditedThreshold)
+	app.GovKeeper.SetTallyParams(ctx, tallyParams)
+	suite.Require().Equal(tallyParams, app.GovKeeper.GetTallyParams(ctx))
+	suite.Require().Equal(params.VotingParams, app.GovKeeper.GetVotingParams(ctx))
+	suite.Require().Equal(params.DepositParams, app.GovKeeper.GetDepositParams(ctx))
+}
+
+func (suite *KeeperTestSuite) TestMsgUpdateParams() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.GovKeeper)
+	authority := app.GovKeeper.GetAuthority()
+	suite.Require().Equal(govAcct.String(), authority)
+
+	params := v1beta2.DefaultParams()
+	params.VotingParams = v1beta2.NewVotingParams(time.Hour, time.Minute)
+
+	// only the authority can update the params
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), v1beta2.NewMsgUpdateParams(addr.String(), params))
+	suite.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	invalidParams := v1beta2.DefaultParams()
+	invalidParams.VotingParams = v1beta2.NewVotingParams(time.Minute, time.Hour)
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), v1beta2.NewMsgUpdateParams(authority, invalidParams))
+	suite.Require().Error(err)
+	suite.Require().Equal(v1beta2.DefaultParams(), app.GovKeeper.GetParams(ctx))
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), v1beta2.NewMsgUpdateParams(authority, params))
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, app.GovKeeper.GetParams(ctx))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta2"
+)
+
+// GetMessageBasedParams gets the governance params of a Msg type URL from the store
+func (keeper Keeper) GetMessageBasedParams(ctx sdk.Context, msgURL string) (params v1beta2.MessageBasedParams, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.MessageBasedParamsKey(msgURL))
+	if bz == nil {
+		return params, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &params)
+
+	return params, true
+}
+
+// SetMessageBasedParams sets the governance params of a Msg type URL to the store
+func (keeper Keeper) SetMessageBasedParams(ctx sdk.Context, params v1beta2.MessageBasedParams) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&params)
+	store.Set(types.MessageBasedParamsKey(params.MsgUrl), bz)
+}
+
+// DeleteMessageBasedParams deletes the governance params of a Msg type URL from the store
+func (keeper Keeper) DeleteMessageBasedParams(ctx sdk.Context, msgURL string) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.MessageBasedParamsKey(msgURL))
+}
+
+// IterateMessageBasedParams iterates over the governance params of all the Msg type URLs
+// and performs a callback function
+func (keeper Keeper) IterateMessageBasedParams(ctx sdk.Context, cb func(params v1beta2.MessageBasedParams) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.MessageBasedParamsKeyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var params v1beta2.MessageBasedParams
+
+		keeper.cdc.MustUnmarshal(iterator.Value(), &params)
+
+		if cb(params) {
+			break
+		}
+	}
+}
+
+// GetAllMessageBasedParams returns the governance params of all the Msg type URLs from the store
+func (keeper Keeper) GetAllMessageBasedParams(ctx sdk.Context) (params []*v1beta2.MessageBasedParams) {
+	keeper.IterateMessageBasedParams(ctx, func(p v1beta2.MessageBasedParams) bool {
+		params = append(params, &p)
+		return false
+	})
+
+	return
+}
+
+// GetProposalDepositParams returns the DepositParams applying to a proposal: the most
+// restrictive combination of the DepositParams of each of its messages.
+func (keeper Keeper) GetProposalDepositParams(ctx sdk.Context, proposal v1beta2.Proposal) v1beta2.DepositParams {
+	global := keeper.GetDepositParams(ctx)
+
+	var depositParams *v1beta2.DepositParams
+	for _, msgParams := range keeper.proposalMessageBasedParams(ctx, proposal) {
+		dp := global
+		if msgParams != nil && msgParams.DepositParams != nil {
+			dp = *msgParams.DepositParams
+		}
+		if depositParams != nil {
+			dp = depositParams.Stricter(dp)
+		}
+		depositParams = &dp
+	}
+
+	if depositParams == nil {
+		return global
+	}
+	return *depositParams
+}
+
+// GetProposalVotingParams returns the VotingParams applying to a proposal: the most
+// restrictive combination of the VotingParams of each of its messages.
+func (keeper Keeper) GetProposalVotingParams(ctx sdk.Context, proposal v1beta2.Proposal) v1beta2.VotingParams {
+	global := keeper.GetVotingParams(ctx)
+
+	var votingParams *v1beta2.VotingParams
+	for _, msgParams := range keeper.proposalMessageBasedParams(ctx, proposal) {
+		vp := global
+		if msgParams != nil && msgParams.VotingParams != nil {
+			vp = *msgParams.VotingParams
+		}
+		if votingParams != nil {
+			vp = votingParams.Stricter(vp)
+		}
+		votingParams = &vp
+	}
+
+	if votingParams == nil {
+		return global
+	}
+	return *votingParams
+}
+
+// GetProposalTallyParams returns the TallyParams applying to a proposal: the most
+// restrictive combination of the TallyParams of each of its messages.
+func (keeper Keeper) GetProposalTallyParams(ctx sdk.Context, proposal v1beta2.Proposal) v1beta2.TallyParams {
+	global := keeper.GetTallyParams(ctx)
+
+	var tallyParams *v1beta2.TallyParams
+	for _, msgParams := range keeper.proposalMessageBasedParams(ctx, proposal) {
+		tp := global
+		if msgParams != nil && msgParams.TallyParams != nil {
+			tp = *msgParams.TallyParams
+		}
+		if tallyParams != nil {
+			tp = tallyParams.Stricter(tp)
+		}
+		tallyParams = &tp
+	}
+
+	if tallyParams == nil {
+		return global
+	}
+	return *tallyParams
+}
+
+// proposalMessageBasedParams returns the governance params of each message of a proposal,
+// or nil for the messages whose type has none. The params of the legacy content wrapped
+// in a MsgExecLegacyContent take precedence over the ones of MsgExecLegacyContent itself.
+func (keeper Keeper) proposalMessageBasedParams(ctx sdk.Context, proposal v1beta2.Proposal) []*v1beta2.MessageBasedParams {
+	params := make([]*v1beta2.MessageBasedParams, len(proposal.Messages))
+	for i, msg := range proposal.Messages {
+		for _, msgURL := range messageBasedParamsURLs(msg) {
+			if p, found := keeper.GetMessageBasedParams(ctx, msgURL); found {
+				params[i] = &p
+				break
+			}
+		}
+	}
+
+	return params
+}
+
+// messageBasedParamsURLs returns the type URLs under which the governance params of a
+// proposal message are looked up, by order of precedence.
+func messageBasedParamsURLs(msg *codectypes.Any) []string {
+	if execMsg, ok := msg.GetCachedValue().(*v1beta2.MsgExecLegacyContent); ok && execMsg.Content != nil {
+		return []string{execMsg.Content.TypeUrl, msg.TypeUrl}
+	}
+
+	return []string{msg.TypeUrl}
+}
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta2"
+)
+
+var (
+	msgSendURL      = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	textProposalURL = "/" + proto.MessageName(&v1beta1.TextProposal{})
+)
+
+func (suite *KeeperTestSuite) TestGetSetMessageBasedParams() {
+	app, ctx := suite.app, suite.ctx
+
+	_, found := app.GovKeeper.GetMessageBasedParams(ctx, msgSendURL)
+	suite.Require().False(found)
+
+	tallyParams := v1beta2.DefaultTallyParams()
+	params := v1beta2.NewMessageBasedParams(msgSendURL, nil, nil, &tallyParams)
+	app.GovKeeper.SetMessageBasedParams(ctx, params)
+
+	gotParams, found := app.GovKeeper.GetMessageBasedParams(ctx, msgSendURL)
+	suite.Require().True(found)
+	suite.Require().Equal(params, gotParams)
+	suite.Require().Equal([]*v1beta2.MessageBasedParams{&params}, app.GovKeeper.GetAllMessageBasedParams(ctx))
+
+	app.GovKeeper.DeleteMessageBasedParams(ctx, msgSendURL)
+	_, found = app.GovKeeper.GetMessageBasedParams(ctx, msgSendURL)
+	suite.Require().False(found)
+	suite.Require().Empty(app.GovKeeper.GetAllMessageBasedParams(ctx))
+}
+
+func (suite *KeeperTestSuite) TestGetProposalParams() {
+	app, ctx := suite.app, suite.ctx
+	globalDeposit := app.GovKeeper.GetDepositParams(ctx)
+	globalVoting := app.GovKeeper.GetVotingParams(ctx)
+	globalTally := app.GovKeeper.GetTallyParams(ctx)
+
+	// TestProposal executes a MsgSend and a MsgExecLegacyContent wrapping a TextProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, addr, false)
+	suite.Require().NoError(err)
+	msgSendProposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal[:1], nil, addr, false)
+	suite.Require().NoError(err)
+
+	// without message based params, the global params apply
+	suite.Require().Equal(globalDeposit, app.GovKeeper.GetProposalDepositParams(ctx, proposal))
+	suite.Require().Equal(globalVoting, app.GovKeeper.GetProposalVotingParams(ctx, proposal))
+	suite.Require().Equal(globalTally, app.GovKeeper.GetProposalTallyParams(ctx, proposal))
+
+	// a lower min deposit and a longer voting period for MsgSend
+	sendDeposit := v1beta2.NewDepositParams(
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, v1beta2.DefaultMinDepositTokens.QuoRaw(2)), sdk.NewInt64Coin("atom", 10)),
+		v1beta2.DefaultPeriod, v1beta2.DefaultProposalCancelRatio,
+	)
+	sendVoting := v1beta2.NewVotingParams(2*v1beta2.DefaultPeriod, v1beta2.DefaultExpeditedPeriod)
+	app.GovKeeper.SetMessageBasedParams(ctx, v1beta2.NewMessageBasedParams(msgSendURL, &sendDeposit, &sendVoting, nil))
+
+	// a higher quorum and a lower veto threshold for text proposals
+	textTally := v1beta2.NewTallyParams(sdk.NewDecWithPrec(5, 1), v1beta2.DefaultThreshold, sdk.NewDecWithPrec(2, 1), v1beta2.DefaultExpeditedThreshold)
+	app.GovKeeper.SetMessageBasedParams(ctx, v1beta2.NewMessageBasedParams(textProposalURL, nil, nil, &textTally))
+
+	// the params of the only message apply, even if less restrictive than the global ones
+	suite.Require().Equal(sendDeposit, app.GovKeeper.GetProposalDepositParams(ctx, msgSendProposal))
+	suite.Require().Equal(sendVoting, app.GovKeeper.GetProposalVotingParams(ctx, msgSendProposal))
+	suite.Require().Equal(globalTally, app.GovKeeper.GetProposalTallyParams(ctx, msgSendProposal))
+
+	// the most restrictive params apply to a proposal with several messages
+	expDeposit := v1beta2.NewDepositParams(
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, v1beta2.DefaultMinDepositTokens), sdk.NewInt64Coin("atom", 10)),
+		v1beta2.DefaultPeriod, v1beta2.DefaultProposalCancelRatio,
+	)
+	suite.Require().Equal(expDeposit, app.GovKeeper.GetProposalDepositParams(ctx, proposal))
+	suite.Require().Equal(sendVoting, app.GovKeeper.GetProposalVotingParams(ctx, proposal))
+	suite.Require().Equal(textTally, app.GovKeeper.GetProposalTallyParams(ctx, proposal))
+
+	// the voting period of the proposal depends on its messages
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	suite.Require().True(ok)
+	suite.Require().Equal(ctx.BlockHeader().Time.Add(*sendVoting.VotingPeriod), *proposal.VotingEndTime)
+}
+
+func (suite *KeeperTestSuite) TestSubmitProposalMessageBasedDepositPeriod() {
+	app, ctx := suite.app, suite.ctx
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	depositParams.MaxDepositPeriod = new(time.Duration)
+	*depositParams.MaxDepositPeriod = time.Hour
+	app.GovKeeper.SetMessageBasedParams(ctx, v1beta2.NewMessageBasedParams(msgSendURL, &depositParams, nil, nil))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, addr, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(ctx.BlockHeader().Time.Add(time.Hour), *proposal.DepositEndTime)
+}
+
+func (suite *KeeperTestSuite) TestMsgUpdateMessageBasedParams() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.GovKeeper)
+	authority := govAcct.String()
+
+	votingParams := v1beta2.NewVotingParams(time.Hour, time.Minute)
+	params := v1beta2.NewMessageBasedParams(msgSendURL, nil, &votingParams, nil)
+
+	// only the gov account can update the params
+	_, err := msgServer.UpdateMessageBasedParams(sdk.WrapSDKContext(ctx), v1beta2.NewMsgUpdateMessageBasedParams(addr.String(), params))
+	suite.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	invalidVotingParams := v1beta2.NewVotingParams(time.Minute, time.Hour)
+	invalidParams := v1beta2.NewMessageBasedParams(msgSendURL, nil, &invalidVotingParams, nil)
+	_, err = msgServer.UpdateMessageBasedParams(sdk.WrapSDKContext(ctx), v1beta2.NewMsgUpdateMessageBasedParams(authority, invalidParams))
+	suite.Require().Error(err)
+
+	_, err = msgServer.UpdateMessageBasedParams(sdk.WrapSDKContext(ctx), v1beta2.NewMsgUpdateMessageBasedParams(authority, params))
+	suite.Require().NoError(err)
+	gotParams, found := app.GovKeeper.GetMessageBasedParams(ctx, msgSendURL)
+	suite.Require().True(found)
+	suite.Require().Equal(params, gotParams)
+
+	// the params are removed when none is set
+	_, err = msgServer.UpdateMessageBasedParams(sdk.WrapSDKContext(ctx), v1beta2.NewMsgUpdateMessageBasedParams(authority, v1beta2.MessageBasedParams{MsgUrl: msgSendURL}))
+	suite.Require().NoError(err)
+	_, found = app.GovKeeper.GetMessageBasedParams(ctx, msgSendURL)
+	suite.Require().False(found)
+}
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v046 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v046"
 	v043 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v043"
+	govv046 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v046"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -25,3 +26,8 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v046.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return govv046.MigrateParams(ctx, m.keeper.storeKey, m.keeper.paramSpace, m.keeper.cdc)
+}
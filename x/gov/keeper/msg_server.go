@@ -36,7 +36,12 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1beta2.MsgSubmitP
 		return nil, err
 	}
 
-	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata)
+	proposer, err := sdk.AccAddressFromBech32(msg.GetProposer())
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, proposer, msg.Expedited)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +59,6 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1beta2.MsgSubmitP
 
 	defer telemetry.IncrCounter(1, types.ModuleName, "proposal")
 
-	proposer, _ := sdk.AccAddressFromBech32(msg.GetProposer())
 	votingStarted, err := k.Keeper.AddDeposit(ctx, proposal.ProposalId, proposer, msg.GetInitialDeposit())
 	if err != nil {
 		return nil, err
@@ -207,6 +211,82 @@ func (k msgServer) Deposit(goCtx context.Context, msg *v1beta2.MsgDeposit) (*v1b
 	return &v1beta2.MsgDepositResponse{}, nil
 }
 
+func (k msgServer) CancelProposal(goCtx context.Context, msg *v1beta2.MsgCancelProposal) (*v1beta2.MsgCancelProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	proposer, err := sdk.AccAddressFromBech32(msg.Proposer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CancelProposal(ctx, msg.ProposalId, proposer); err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, "cancel_proposal"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel("proposal_id", strconv.Itoa(int(msg.ProposalId))),
+		},
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer),
+		),
+	)
+
+	return &v1beta2.MsgCancelProposalResponse{
+		ProposalId:     msg.ProposalId,
+		CanceledHeight: uint64(ctx.BlockHeight()),
+	}, nil
+}
+
+func (k msgServer) UpdateMessageBasedParams(goCtx context.Context, msg *v1beta2.MsgUpdateMessageBasedParams) (*v1beta2.MsgUpdateMessageBasedParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if msg.Params.IsEmpty() {
+		k.Keeper.DeleteMessageBasedParams(ctx, msg.Params.MsgUrl)
+	} else {
+		k.Keeper.SetMessageBasedParams(ctx, msg.Params)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateMessageBasedParams,
+			sdk.NewAttribute(types.AttributeKeyMsgURL, msg.Params.MsgUrl),
+		),
+	)
+
+	return &v1beta2.MsgUpdateMessageBasedParamsResponse{}, nil
+}
+
+func (k msgServer) UpdateParams(goCtx context.Context, msg *v1beta2.MsgUpdateParams) (*v1beta2.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.SetParams(ctx, msg.Params)
+
+	return &v1beta2.MsgUpdateParamsResponse{}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1beta2.MsgServer
@@ -231,6 +311,7 @@ func (k legacyMsgServer) SubmitProposal(goCtx context.Context, msg *v1beta1.MsgS
 		msg.InitialDeposit,
 		msg.Proposer,
 		nil,
+		false,
 	)
 	if err != nil {
 		return nil, err
@@ -11,7 +11,7 @@ import (
 )
 
 // SubmitProposal create new proposal given an array of messages
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata []byte) (v1beta2.Proposal, error) {
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata []byte, proposer sdk.AccAddress, expedited bool) (v1beta2.Proposal, error) {
 	if metadata != nil && uint64(len(metadata)) > keeper.config.MaxMetadataLen {
 		return v1beta2.Proposal{}, types.ErrMetadataTooLong.Wrapf("got metadata with length %d", len(metadata))
 	}
@@ -64,14 +64,21 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 		return v1beta2.Proposal{}, err
 	}
 
+	// the deposit end time depends on the params of the proposal messages, it is set
+	// once the proposal is created
 	submitTime := ctx.BlockHeader().Time
-	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
-
-	proposal, err := v1beta2.NewProposal(messages, proposalID, metadata, submitTime, submitTime.Add(*depositPeriod))
+	proposal, err := v1beta2.NewProposal(messages, proposalID, metadata, submitTime, submitTime, proposer, expedited)
 	if err != nil {
 		return v1beta2.Proposal{}, err
 	}
 
+	if expedited && keeper.GetProposalVotingParams(ctx, proposal).ExpeditedVotingPeriod == nil {
+		return v1beta2.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalType, "expedited voting period is not set")
+	}
+
+	depositEndTime := submitTime.Add(*keeper.GetProposalDepositParams(ctx, proposal).MaxDepositPeriod)
+	proposal.DepositEndTime = &depositEndTime
+
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, *proposal.DepositEndTime)
 	keeper.SetProposalID(ctx, proposalID+1)
@@ -90,6 +97,47 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	return proposal, nil
 }
 
+// CancelProposal cancels a proposal on behalf of its proposer. A proposal can only be canceled
+// before the end of its voting period. The ProposalCancelRatio fraction of the deposits is burnt
+// and the rest is refunded, then the proposal and its votes are deleted.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	if proposal.Proposer != proposer.String() {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "%s is not the proposer of proposal %d", proposer, proposalID)
+	}
+
+	if proposal.Status != v1beta2.StatusDepositPeriod && proposal.Status != v1beta2.StatusVotingPeriod {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	if proposal.VotingEndTime != nil && !ctx.BlockTime().Before(*proposal.VotingEndTime) {
+		return sdkerrors.Wrapf(types.ErrVotingPeriodEnded, "%d", proposalID)
+	}
+
+	cancelRatio, err := sdk.NewDecFromStr(keeper.GetProposalDepositParams(ctx, proposal).ProposalCancelRatio)
+	if err != nil {
+		return err
+	}
+
+	keeper.ChargeDeposits(ctx, proposalID, cancelRatio)
+	keeper.DeleteVotes(ctx, proposalID)
+	keeper.DeleteProposal(ctx, proposalID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposalProposer, proposer.String()),
+		),
+	)
+
+	return nil
+}
+
 // GetProposal get proposal from store by ProposalID.
 // Panics if can't unmarshal the proposal.
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (v1beta2.Proposal, bool) {
@@ -236,7 +284,11 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal v1beta2.Proposal) {
 	startTime := ctx.BlockHeader().Time
 	proposal.VotingStartTime = &startTime
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	votingParams := keeper.GetProposalVotingParams(ctx, proposal)
+	votingPeriod := votingParams.VotingPeriod
+	if proposal.Expedited {
+		votingPeriod = votingParams.ExpeditedVotingPeriod
+	}
 	endTime := proposal.VotingStartTime.Add(*votingPeriod)
 	proposal.VotingEndTime = &endTime
 	proposal.Status = v1beta2.StatusVotingPeriod
@@ -19,7 +19,7 @@ import (
 
 func (suite *KeeperTestSuite) TestGetSetProposal() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, nil)
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, nil, addr, false)
 	suite.Require().NoError(err)
 	proposalID := proposal.ProposalId
 	suite.app.GovKeeper.SetProposal(suite.ctx, proposal)
@@ -31,7 +31,7 @@ func (suite *KeeperTestSuite) TestGetSetProposal() {
 
 func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, nil)
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, nil, addr, false)
 	suite.Require().NoError(err)
 
 	suite.Require().Nil(proposal.VotingStartTime)
@@ -50,6 +50,80 @@ func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	activeIterator.Close()
 }
 
+func (suite *KeeperTestSuite) TestActivateVotingPeriodExpedited() {
+	tp := TestProposal
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, nil, addr, true)
+	suite.Require().NoError(err)
+	suite.Require().True(proposal.Expedited)
+
+	suite.app.GovKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+
+	proposal, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposal.ProposalId)
+	suite.Require().True(ok)
+	expeditedPeriod := suite.app.GovKeeper.GetVotingParams(suite.ctx).ExpeditedVotingPeriod
+	suite.Require().True(proposal.VotingEndTime.Equal(suite.ctx.BlockHeader().Time.Add(*expeditedPeriod)))
+}
+
+func (suite *KeeperTestSuite) TestCancelProposal() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+	// two deposits reaching the min deposit together, so that the proposal can be voted on
+	deposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, v1beta2.DefaultMinDepositTokens.QuoRaw(2).AddRaw(1)))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, addrs[0], false)
+	suite.Require().NoError(err)
+	proposalID := proposal.ProposalId
+
+	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, addrs[0], deposit)
+	suite.Require().NoError(err)
+	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, addrs[1], deposit)
+	suite.Require().NoError(err)
+	suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposalID, addrs[0], v1beta2.NewNonSplitVoteOption(v1beta2.OptionYes)))
+
+	addr0Balance := app.BankKeeper.GetAllBalances(ctx, addrs[0])
+	addr1Balance := app.BankKeeper.GetAllBalances(ctx, addrs[1])
+	supply := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+
+	// only the proposer can cancel the proposal
+	err = app.GovKeeper.CancelProposal(ctx, proposalID, addrs[1])
+	suite.Require().ErrorIs(err, types.ErrInvalidProposer)
+
+	err = app.GovKeeper.CancelProposal(ctx, proposalID+1, addrs[0])
+	suite.Require().ErrorIs(err, types.ErrUnknownProposal)
+
+	suite.Require().NoError(app.GovKeeper.CancelProposal(ctx, proposalID, addrs[0]))
+
+	_, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	suite.Require().False(ok)
+	suite.Require().Empty(app.GovKeeper.GetDeposits(ctx, proposalID))
+	suite.Require().Empty(app.GovKeeper.GetVotes(ctx, proposalID))
+
+	// with the default cancel ratio of 0.5, the burnt half of each deposit is truncated
+	refund := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, v1beta2.DefaultMinDepositTokens.QuoRaw(4).AddRaw(1)))
+	suite.Require().Equal(addr0Balance.Add(refund...), app.BankKeeper.GetAllBalances(ctx, addrs[0]))
+	suite.Require().Equal(addr1Balance.Add(refund...), app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+	suite.Require().Equal(supply.SubAmount(v1beta2.DefaultMinDepositTokens.QuoRaw(2)), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+}
+
+func (suite *KeeperTestSuite) TestCancelProposalVotingPeriodEnded() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, addrs[0], false)
+	suite.Require().NoError(err)
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	suite.Require().True(ok)
+
+	ctx = ctx.WithBlockTime(*proposal.VotingEndTime)
+	err = app.GovKeeper.CancelProposal(ctx, proposal.ProposalId, addrs[0])
+	suite.Require().ErrorIs(err, types.ErrVotingPeriodEnded)
+
+	proposal.Status = v1beta2.StatusPassed
+	app.GovKeeper.SetProposal(ctx, proposal)
+	err = app.GovKeeper.CancelProposal(ctx, proposal.ProposalId, addrs[0])
+	suite.Require().ErrorIs(err, types.ErrInactiveProposal)
+}
+
 type invalidProposalRoute struct{ v1beta1.TextProposal }
 
 func (invalidProposalRoute) ProposalRoute() string { return "nonexistingroute" }
@@ -82,7 +156,7 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	for i, tc := range testCases {
 		prop, err := v1beta2.NewLegacyContent(tc.content, tc.authority)
 		suite.Require().NoError(err)
-		_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata)
+		_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata, addr, false)
 		suite.Require().True(errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
@@ -95,7 +169,7 @@ func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 
 	for _, s := range status {
 		for i := 0; i < 50; i++ {
-			p, err := v1beta2.NewProposal(TestProposal, proposalID, nil, time.Now(), time.Now())
+			p, err := v1beta2.NewProposal(TestProposal, proposalID, nil, time.Now(), time.Now(), addr, false)
 			suite.Require().NoError(err)
 
 			p.Status = s
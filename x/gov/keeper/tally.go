@@ -9,7 +9,7 @@ import (
 // TODO: Break into several smaller functions for clarity
 
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
-// voters
+// voters. The votes are kept in store, they are deleted once the proposal is finalized.
 func (keeper Keeper) Tally(ctx sdk.Context, proposal v1beta2.Proposal) (passes bool, burnDeposits bool, tallyResults v1beta2.TallyResult) {
 	results := make(map[v1beta2.VoteOption]sdk.Dec)
 	results[v1beta2.OptionYes] = sdk.ZeroDec()
@@ -71,7 +71,6 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1beta2.Proposal) (passes b
 			return false
 		})
 
-		keeper.deleteVote(ctx, vote.ProposalId, voter)
 		return false
 	})
 
@@ -92,7 +91,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1beta2.Proposal) (passes b
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
-	tallyParams := keeper.GetTallyParams(ctx)
+	tallyParams := keeper.GetProposalTallyParams(ctx, proposal)
 	tallyResults = v1beta2.NewTallyResultFromMap(results)
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
@@ -119,8 +118,12 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1beta2.Proposal) (passes b
 		return false, true, tallyResults
 	}
 
-	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
+	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes.
+	// Expedited proposals need to reach the higher expedited threshold.
 	threshold, _ := sdk.NewDecFromStr(tallyParams.Threshold)
+	if proposal.Expedited {
+		threshold, _ = sdk.NewDecFromStr(tallyParams.ExpeditedThreshold)
+	}
 	if results[v1beta2.OptionYes].Quo(totalVotingPower.Sub(results[v1beta2.OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults
 	}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta2"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -20,7 +21,7 @@ func TestTallyNoOneVotes(t *testing.T) {
 	createValidators(t, ctx, app, []int64{5, 5, 5})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = v1beta2.StatusVotingPeriod
@@ -44,7 +45,7 @@ func TestTallyNoQuorum(t *testing.T) {
 	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = v1beta2.StatusVotingPeriod
@@ -67,7 +68,7 @@ func TestTallyOnlyValidatorsAllYes(t *testing.T) {
 	addrs, _ := createValidators(t, ctx, app, []int64{5, 5, 5})
 	tp := TestProposal
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = v1beta2.StatusVotingPeriod
@@ -93,7 +94,7 @@ func TestTallyOnlyValidators51No(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{5, 6, 0})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = v1beta2.StatusVotingPeriod
@@ -117,7 +118,7 @@ func TestTallyOnlyValidators51Yes(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{5, 6, 0})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = v1beta2.StatusVotingPeriod
@@ -142,7 +143,7 @@ func TestTallyOnlyValidatorsVetoed(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{6, 6, 7})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = v1beta2.StatusVotingPeriod
@@ -168,7 +169,7 @@ func TestTallyOnlyValidatorsAbstainPasses(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{6, 6, 7})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = v1beta2.StatusVotingPeriod
@@ -194,7 +195,7 @@ func TestTallyOnlyValidatorsAbstainFails(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{6, 6, 7})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = v1beta2.StatusVotingPeriod
@@ -221,7 +222,7 @@ func TestTallyOnlyValidatorsNonVoter(t *testing.T) {
 	valAccAddr1, valAccAddr2 := valAccAddrs[0], valAccAddrs[1]
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = v1beta2.StatusVotingPeriod
@@ -255,7 +256,7 @@ func TestTallyDelgatorOverride(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = v1beta2.StatusVotingPeriod
@@ -291,7 +292,7 @@ func TestTallyDelgatorInherit(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = v1beta2.StatusVotingPeriod
@@ -330,7 +331,7 @@ func TestTallyDelgatorMultipleOverride(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = v1beta2.StatusVotingPeriod
@@ -372,7 +373,7 @@ func TestTallyDelgatorMultipleInherit(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = v1beta2.StatusVotingPeriod
@@ -415,7 +416,7 @@ func TestTallyJailedValidator(t *testing.T) {
 	app.StakingKeeper.Jail(ctx, sdk.ConsAddress(consAddr.Bytes()))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = v1beta2.StatusVotingPeriod
@@ -448,7 +449,7 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 	require.NoError(t, err)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 	proposal.Status = v1beta2.StatusVotingPeriod
@@ -473,3 +474,34 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	require.True(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestTallyMessageBasedQuorum(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs, _ := createValidators(t, ctx, app, []int64{5, 5, 5})
+	tp := TestProposal
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, addr, false)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalId
+	proposal.Status = v1beta2.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], v1beta2.NewNonSplitVoteOption(v1beta2.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], v1beta2.NewNonSplitVoteOption(v1beta2.OptionYes)))
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, _, _ := app.GovKeeper.Tally(ctx, proposal)
+	require.True(t, passes)
+
+	// a quorum of 70% for MsgSend isn't reached with 2/3 of the voting power
+	tallyParams := app.GovKeeper.GetTallyParams(ctx)
+	tallyParams.Quorum = sdk.NewDecWithPrec(7, 1).String()
+	app.GovKeeper.SetMessageBasedParams(ctx, v1beta2.NewMessageBasedParams(sdk.MsgTypeURL(&banktypes.MsgSend{}), nil, nil, &tallyParams))
+
+	passes, burnDeposits, _ := app.GovKeeper.Tally(ctx, proposal)
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+}
@@ -165,22 +165,37 @@ func convertToNewVotes(oldVotes v1beta1.Votes) (v1beta2.Votes, error) {
 
 func convertToNewDepParams(oldDepParams v1beta1.DepositParams) v1beta2.DepositParams {
 	return v1beta2.DepositParams{
-		MinDeposit:       oldDepParams.MinDeposit,
-		MaxDepositPeriod: &oldDepParams.MaxDepositPeriod,
+		MinDeposit:          oldDepParams.MinDeposit,
+		MaxDepositPeriod:    &oldDepParams.MaxDepositPeriod,
+		ProposalCancelRatio: v1beta2.DefaultProposalCancelRatio.String(),
 	}
 }
 
 func convertToNewVotingParams(oldVoteParams v1beta1.VotingParams) v1beta2.VotingParams {
+	// the expedited voting period must be shorter than the voting period
+	expeditedVotingPeriod := v1beta2.DefaultExpeditedPeriod
+	if expeditedVotingPeriod >= oldVoteParams.VotingPeriod {
+		expeditedVotingPeriod = oldVoteParams.VotingPeriod / 2
+	}
+
 	return v1beta2.VotingParams{
-		VotingPeriod: &oldVoteParams.VotingPeriod,
+		VotingPeriod:          &oldVoteParams.VotingPeriod,
+		ExpeditedVotingPeriod: &expeditedVotingPeriod,
 	}
 }
 
 func convertToNewTallyParams(oldTallyParams v1beta1.TallyParams) v1beta2.TallyParams {
+	// the expedited threshold must be greater than the threshold
+	expeditedThreshold := v1beta2.DefaultExpeditedThreshold
+	if expeditedThreshold.LTE(oldTallyParams.Threshold) {
+		expeditedThreshold = oldTallyParams.Threshold.Add(types.OneDec()).QuoInt64(2)
+	}
+
 	return v1beta2.TallyParams{
-		Quorum:        oldTallyParams.Quorum.String(),
-		Threshold:     oldTallyParams.Threshold.String(),
-		VetoThreshold: oldTallyParams.VetoThreshold.String(),
+		Quorum:             oldTallyParams.Quorum.String(),
+		Threshold:          oldTallyParams.Threshold.String(),
+		VetoThreshold:      oldTallyParams.VetoThreshold.String(),
+		ExpeditedThreshold: expeditedThreshold.String(),
 	}
 }
 
@@ -80,12 +80,15 @@ func TestMigrateJSON(t *testing.T) {
 				"amount": "10000000",
 				"denom": "stake"
 			}
-		]
+		],
+		"proposal_cancel_ratio": "0.500000000000000000"
 	},
 	"deposits": [],
+	"message_based_params": [],
 	"proposals": [
 		{
 			"deposit_end_time": "2001-09-09T01:46:40Z",
+			"expedited": false,
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
@@ -105,6 +108,7 @@ func TestMigrateJSON(t *testing.T) {
 			],
 			"metadata": null,
 			"proposal_id": "1",
+			"proposer": "",
 			"status": "PROPOSAL_STATUS_DEPOSIT_PERIOD",
 			"submit_time": "2001-09-09T01:46:40Z",
 			"total_deposit": [
@@ -119,6 +123,7 @@ func TestMigrateJSON(t *testing.T) {
 	],
 	"starting_proposal_id": "1",
 	"tally_params": {
+		"expedited_threshold": "0.667000000000000000",
 		"quorum": "0.334000000000000000",
 		"threshold": "0.500000000000000000",
 		"veto_threshold": "0.334000000000000000"
@@ -146,6 +151,7 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"voting_params": {
+		"expedited_voting_period": "86400s",
 		"voting_period": "172800s"
 	}
 }`
@@ -6,7 +6,9 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v040 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v040"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta2"
 )
 
 // migrateProposals migrates all legacy proposals into MsgExecLegacyContent
@@ -49,3 +51,58 @@ func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.Binar
 
 	return migrateProposals(store, cdc)
 }
+
+// MigrateParams performs the in-place migration of the governance params from the
+// legacy x/params subspace to the gov store. The params added since the subspace
+// was last updated are set to their default values.
+func MigrateParams(ctx sdk.Context, storeKey storetypes.StoreKey, paramSpace types.ParamSubspace, cdc codec.BinaryCodec) error {
+	var (
+		depositParams v1beta2.DepositParams
+		votingParams  v1beta2.VotingParams
+		tallyParams   v1beta2.TallyParams
+	)
+	paramSpace.Get(ctx, v1beta2.ParamStoreKeyDepositParams, &depositParams)
+	paramSpace.Get(ctx, v1beta2.ParamStoreKeyVotingParams, &votingParams)
+	paramSpace.Get(ctx, v1beta2.ParamStoreKeyTallyParams, &tallyParams)
+
+	if depositParams.ProposalCancelRatio == "" {
+		depositParams.ProposalCancelRatio = v1beta2.DefaultProposalCancelRatio.String()
+	}
+
+	if votingParams.ExpeditedVotingPeriod == nil && votingParams.VotingPeriod != nil {
+		// the expedited voting period must be shorter than the voting period
+		expeditedVotingPeriod := v1beta2.DefaultExpeditedPeriod
+		if expeditedVotingPeriod >= *votingParams.VotingPeriod {
+			expeditedVotingPeriod = *votingParams.VotingPeriod / 2
+		}
+		votingParams.ExpeditedVotingPeriod = &expeditedVotingPeriod
+	}
+
+	if tallyParams.ExpeditedThreshold == "" {
+		threshold, err := sdk.NewDecFromStr(tallyParams.Threshold)
+		if err != nil {
+			return err
+		}
+
+		// the expedited threshold must be greater than the threshold
+		expeditedThreshold := v1beta2.DefaultExpeditedThreshold
+		if expeditedThreshold.LTE(threshold) {
+			expeditedThreshold = threshold.Add(sdk.OneDec()).QuoInt64(2)
+		}
+		tallyParams.ExpeditedThreshold = expeditedThreshold.String()
+	}
+
+	params := v1beta2.NewParams(votingParams, tallyParams, depositParams)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(storeKey).Set(types.ParamsKey, bz)
+
+	return nil
+}
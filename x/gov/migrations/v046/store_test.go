@@ -11,8 +11,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v040gov "github.com/cosmos/cosmos-sdk/x/gov/migrations/v040"
 	v046gov "github.com/cosmos/cosmos-sdk/x/gov/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta2"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
@@ -74,3 +76,38 @@ func compareProps(t *testing.T, oldProp v1beta1.Proposal, newProp v1beta2.Propos
 	require.Equal(t, oldProp.VotingStartTime.Unix(), newProp.VotingStartTime.Unix())
 	require.Equal(t, oldProp.VotingEndTime.Unix(), newProp.VotingEndTime.Unix())
 }
+
+func TestMigrateParams(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	govKey := sdk.NewKVStoreKey("gov")
+	tGovKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(govKey, tGovKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, govKey, tGovKey, "gov").WithKeyTable(v1beta2.ParamKeyTable())
+
+	// params from before the addition of the expedited proposals and of the proposal cancellation
+	votingPeriod := time.Hour
+	depositParams := v1beta2.NewDepositParams(v1beta2.DefaultDepositParams().MinDeposit, time.Hour, sdk.ZeroDec())
+	depositParams.ProposalCancelRatio = ""
+	votingParams := v1beta2.VotingParams{VotingPeriod: &votingPeriod}
+	tallyParams := v1beta2.DefaultTallyParams()
+	tallyParams.Threshold = sdk.NewDecWithPrec(8, 1).String()
+	tallyParams.ExpeditedThreshold = ""
+	paramstore.Set(ctx, v1beta2.ParamStoreKeyDepositParams, depositParams)
+	paramstore.Set(ctx, v1beta2.ParamStoreKeyVotingParams, votingParams)
+	paramstore.Set(ctx, v1beta2.ParamStoreKeyTallyParams, tallyParams)
+
+	// Run migrations.
+	err := v046gov.MigrateParams(ctx, govKey, paramstore, encCfg.Codec)
+	require.NoError(t, err)
+
+	var params v1beta2.Params
+	bz := ctx.KVStore(govKey).Get(types.ParamsKey)
+	require.NoError(t, encCfg.Codec.Unmarshal(bz, &params))
+	require.NoError(t, params.Validate())
+
+	depositParams.ProposalCancelRatio = v1beta2.DefaultProposalCancelRatio.String()
+	require.Equal(t, depositParams, params.DepositParams)
+	require.Equal(t, votingPeriod, *params.VotingParams.VotingPeriod)
+	require.Equal(t, votingPeriod/2, *params.VotingParams.ExpeditedVotingPeriod)
+	require.Equal(t, sdk.NewDecWithPrec(9, 1).String(), params.TallyParams.ExpeditedThreshold)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/simulation"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta2"
 )
 
 var (
@@ -37,6 +38,8 @@ func TestDecodeStore(t *testing.T) {
 	binary.LittleEndian.PutUint64(proposalIDBz, 1)
 	deposit := v1beta1.NewDeposit(1, delAddr1, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())))
 	vote := v1beta1.NewVote(1, delAddr1, v1beta1.NewNonSplitVoteOption(v1beta1.OptionYes))
+	tallyParams := v1beta2.DefaultTallyParams()
+	msgParams := v1beta2.NewMessageBasedParams("/cosmos.bank.v1beta1.MsgSend", nil, nil, &tallyParams)
 
 	proposalBzA, err := cdc.Marshal(&proposalA)
 	require.NoError(t, err)
@@ -73,6 +76,12 @@ func TestDecodeStore(t *testing.T) {
 			kv.Pair{Key: types.VoteKey(1, delAddr1), Value: cdc.MustMarshal(&vote)},
 			fmt.Sprintf("%v\n%v", vote, vote), false,
 		},
+		{
+			"message based params",
+			kv.Pair{Key: types.MessageBasedParamsKey(msgParams.MsgUrl), Value: cdc.MustMarshal(&msgParams)},
+			kv.Pair{Key: types.MessageBasedParamsKey(msgParams.MsgUrl), Value: cdc.MustMarshal(&msgParams)},
+			fmt.Sprintf("%v\n%v", msgParams, msgParams), false,
+		},
 		{
 			"other",
 			kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
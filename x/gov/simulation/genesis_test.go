@@ -41,16 +41,21 @@ func TestRandomizedGenState(t *testing.T) {
 	var govGenesis v1beta2.GenesisState
 	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &govGenesis)
 
-	dec1, _ := sdk.NewDecFromStr("0.361000000000000000")
-	dec2, _ := sdk.NewDecFromStr("0.512000000000000000")
-	dec3, _ := sdk.NewDecFromStr("0.267000000000000000")
+	dec1, _ := sdk.NewDecFromStr("0.375000000000000000")
+	dec2, _ := sdk.NewDecFromStr("0.478000000000000000")
+	dec3, _ := sdk.NewDecFromStr("0.324000000000000000")
+	dec4, _ := sdk.NewDecFromStr("0.865000000000000000")
+	dec5, _ := sdk.NewDecFromStr("0.330000000000000000")
 
 	require.Equal(t, "905stake", govGenesis.DepositParams.MinDeposit[0].String())
 	require.Equal(t, "77h26m10s", govGenesis.DepositParams.MaxDepositPeriod.String())
-	require.Equal(t, float64(148296), govGenesis.VotingParams.VotingPeriod.Seconds())
+	require.Equal(t, dec5.String(), govGenesis.DepositParams.ProposalCancelRatio)
+	require.Equal(t, float64(280623), govGenesis.VotingParams.VotingPeriod.Seconds())
+	require.Equal(t, float64(188705), govGenesis.VotingParams.ExpeditedVotingPeriod.Seconds())
 	require.Equal(t, dec1.String(), govGenesis.TallyParams.Quorum)
 	require.Equal(t, dec2.String(), govGenesis.TallyParams.Threshold)
 	require.Equal(t, dec3.String(), govGenesis.TallyParams.VetoThreshold)
+	require.Equal(t, dec4.String(), govGenesis.TallyParams.ExpeditedThreshold)
 	require.Equal(t, uint64(0x28), govGenesis.StartingProposalId)
 	require.Equal(t, []*v1beta2.Deposit{}, govGenesis.Deposits)
 	require.Equal(t, []*v1beta2.Vote{}, govGenesis.Votes)
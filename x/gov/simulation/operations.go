@@ -25,13 +25,15 @@ var (
 	TypeMsgVote           = sdk.MsgTypeURL(&v1beta2.MsgVote{})
 	TypeMsgVoteWeighted   = sdk.MsgTypeURL(&v1beta2.MsgVoteWeighted{})
 	TypeMsgSubmitProposal = sdk.MsgTypeURL(&v1beta2.MsgSubmitProposal{})
+	TypeMsgCancelProposal = sdk.MsgTypeURL(&v1beta2.MsgCancelProposal{})
 )
 
 // Simulation operation weights constants
 const (
-	OpWeightMsgDeposit      = "op_weight_msg_deposit"
-	OpWeightMsgVote         = "op_weight_msg_vote"
-	OpWeightMsgVoteWeighted = "op_weight_msg_weighted_vote"
+	OpWeightMsgDeposit        = "op_weight_msg_deposit"
+	OpWeightMsgVote           = "op_weight_msg_vote"
+	OpWeightMsgVoteWeighted   = "op_weight_msg_weighted_vote"
+	OpWeightMsgCancelProposal = "op_weight_msg_cancel_proposal"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -41,9 +43,10 @@ func WeightedOperations(
 ) simulation.WeightedOperations {
 
 	var (
-		weightMsgDeposit      int
-		weightMsgVote         int
-		weightMsgVoteWeighted int
+		weightMsgDeposit        int
+		weightMsgVote           int
+		weightMsgVoteWeighted   int
+		weightMsgCancelProposal int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgDeposit, &weightMsgDeposit, nil,
@@ -64,6 +67,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelProposal, &weightMsgCancelProposal, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelProposal = simappparams.DefaultWeightMsgCancelProposal
+		},
+	)
+
 	// generate the weighted operations for the proposal contents
 	var wProposalOps simulation.WeightedOperations
 
@@ -95,6 +104,10 @@ func WeightedOperations(
 			weightMsgVoteWeighted,
 			SimulateMsgVoteWeighted(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelProposal,
+			SimulateMsgCancelProposal(ak, bk, k),
+		),
 	}
 
 	return append(wProposalOps, wGovOps...)
@@ -152,7 +165,8 @@ func SimulateMsgSubmitProposal(
 			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSubmitProposal, "error converting legacy content into proposal message"), nil, err
 		}
 
-		msg, err := v1beta2.NewMsgSubmitProposal([]sdk.Msg{contentMsg}, deposit, simAccount.Address.String(), nil)
+		expedited := r.Intn(4) == 0
+		msg, err := v1beta2.NewMsgSubmitProposal([]sdk.Msg{contentMsg}, deposit, simAccount.Address.String(), nil, expedited)
 		if err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate a submit proposal msg"), nil, err
 		}
@@ -208,6 +222,9 @@ func SimulateMsgSubmitProposal(
 		// didntVote := whoVotes[numVotes:]
 		whoVotes = whoVotes[:numVotes]
 		votingPeriod := k.GetVotingParams(ctx).VotingPeriod
+		if expedited {
+			votingPeriod = k.GetVotingParams(ctx).ExpeditedVotingPeriod
+		}
 
 		fops := make([]simtypes.FutureOperation, numVotes+1)
 		for i := 0; i < numVotes; i++ {
@@ -378,6 +395,62 @@ func operationSimulateMsgVoteWeighted(ak types.AccountKeeper, bk types.BankKeepe
 	}
 }
 
+// SimulateMsgCancelProposal generates a MsgCancelProposal for a random proposal
+// submitted by one of the simulation accounts.
+func SimulateMsgCancelProposal(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		status := v1beta2.StatusDepositPeriod
+		if r.Intn(2) == 0 {
+			status = v1beta2.StatusVotingPeriod
+		}
+
+		proposalID, ok := randomProposalID(r, k, ctx, status)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelProposal, "unable to generate proposalID"), nil, nil
+		}
+
+		proposal, _ := k.GetProposal(ctx, proposalID)
+		proposer, err := sdk.AccAddressFromBech32(proposal.Proposer)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelProposal, "proposal has no proposer"), nil, nil
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, proposer)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelProposal, "proposer not found"), nil, nil
+		}
+
+		if proposal.VotingEndTime != nil && !ctx.BlockTime().Before(*proposal.VotingEndTime) {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelProposal, "voting period ended"), nil, nil
+		}
+
+		msg := v1beta2.NewMsgCancelProposal(proposalID, simAccount.Address)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
 // Pick a random deposit with a random denomination with a
 // deposit amount between (0, min(balance, minDepositAmount))
 // This is to simulate multiple users depositing to get the
@@ -16,6 +16,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgVote{}, "cosmos-sdk/v1beta2/MsgVote", nil)
 	cdc.RegisterConcrete(&MsgVoteWeighted{}, "cosmos-sdk/v1beta2/MsgVoteWeighted", nil)
 	cdc.RegisterConcrete(&MsgExecLegacyContent{}, "cosmos-sdk/v1beta2/MsgExecLegacyContent", nil)
+	cdc.RegisterConcrete(&MsgCancelProposal{}, "cosmos-sdk/v1beta2/MsgCancelProposal", nil)
+	cdc.RegisterConcrete(&MsgUpdateMessageBasedParams{}, "cosmos-sdk/v1beta2/MsgUpdateMessageBasedParams", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "cosmos-sdk/v1beta2/MsgUpdateParams", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -25,6 +28,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgVoteWeighted{},
 		&MsgDeposit{},
 		&MsgExecLegacyContent{},
+		&MsgCancelProposal{},
+		&MsgUpdateMessageBasedParams{},
+		&MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -53,6 +53,17 @@ func ValidateGenesis(data *GenesisState) error {
 		return fmt.Errorf("invalid deposit params: %w", err)
 	}
 
+	msgURLs := make(map[string]bool, len(data.MessageBasedParams))
+	for _, params := range data.MessageBasedParams {
+		if err := params.Validate(); err != nil {
+			return fmt.Errorf("invalid message based params: %w", err)
+		}
+		if msgURLs[params.MsgUrl] {
+			return fmt.Errorf("duplicate message based params for %s", params.MsgUrl)
+		}
+		msgURLs[params.MsgUrl] = true
+	}
+
 	return nil
 }
 
@@ -38,6 +38,8 @@ type GenesisState struct {
 	VotingParams *VotingParams `protobuf:"bytes,6,opt,name=voting_params,json=votingParams,proto3" json:"voting_params,omitempty"`
 	// params defines all the paramaters of related to tally.
 	TallyParams *TallyParams `protobuf:"bytes,7,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params,omitempty"`
+	// message_based_params defines the governance params of specific Msg types.
+	MessageBasedParams []*MessageBasedParams `protobuf:"bytes,8,rep,name=message_based_params,json=messageBasedParams,proto3" json:"message_based_params,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -122,6 +124,13 @@ func (m *GenesisState) GetTallyParams() *TallyParams {
 	return nil
 }
 
+func (m *GenesisState) GetMessageBasedParams() []*MessageBasedParams {
+	if m != nil {
+		return m.MessageBasedParams
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1beta2.GenesisState")
 }
@@ -129,29 +138,31 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta2/genesis.proto", fileDescriptor_7915ab39bb5aa171) }
 
 var fileDescriptor_7915ab39bb5aa171 = []byte{
-	// 345 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xbb, 0x4e, 0xc3, 0x30,
-	0x14, 0x86, 0x1b, 0x7a, 0x01, 0xdc, 0x96, 0xc1, 0x62, 0x88, 0xa0, 0x0a, 0x81, 0xa9, 0x0b, 0x4e,
-	0x29, 0x03, 0x12, 0x63, 0x05, 0xe2, 0x32, 0x55, 0x01, 0x31, 0xb0, 0x54, 0x6e, 0x63, 0x85, 0x88,
-	0xb6, 0x27, 0x8a, 0x0f, 0x16, 0x7d, 0x0b, 0x9e, 0x87, 0x27, 0x60, 0xec, 0xc8, 0x88, 0x9a, 0x17,
-	0x41, 0xb5, 0x13, 0x5a, 0x89, 0xc0, 0x14, 0x1d, 0xf9, 0xfb, 0xbf, 0xfc, 0xb2, 0x0f, 0x71, 0x47,
-	0x20, 0x27, 0x20, 0xbd, 0x10, 0x94, 0xa7, 0x4e, 0x86, 0x02, 0x79, 0xd7, 0x0b, 0xc5, 0x54, 0xc8,
-	0x48, 0xb2, 0x38, 0x01, 0x04, 0x4a, 0x0d, 0xc1, 0x42, 0x50, 0x2c, 0x23, 0xf6, 0x5a, 0x45, 0x29,
-	0x50, 0x26, 0x71, 0xf4, 0x5e, 0x26, 0x8d, 0x2b, 0xe3, 0xb8, 0x43, 0x8e, 0x82, 0x76, 0xc8, 0xae,
-	0x44, 0x9e, 0x60, 0x34, 0x0d, 0x07, 0x71, 0x02, 0x31, 0x48, 0x3e, 0x1e, 0x44, 0x81, 0x6d, 0xb9,
-	0x56, 0xbb, 0xe2, 0xd3, 0xfc, 0xac, 0x9f, 0x1d, 0xdd, 0x04, 0xf4, 0x8c, 0x6c, 0x05, 0x22, 0x06,
-	0x19, 0xa1, 0xb4, 0x37, 0xdc, 0x72, 0xbb, 0xde, 0xdd, 0x67, 0xbf, 0x7b, 0xb0, 0x0b, 0xc3, 0xf8,
-	0x3f, 0x30, 0x65, 0xa4, 0xaa, 0x00, 0x85, 0xb4, 0xcb, 0x3a, 0x65, 0x17, 0xa5, 0x1e, 0x00, 0x85,
-	0x6f, 0x30, 0x7a, 0x4e, 0xb6, 0xf3, 0x46, 0xd2, 0xae, 0xe8, 0x4c, 0xab, 0x28, 0x93, 0x77, 0xf3,
-	0x57, 0x38, 0xbd, 0x26, 0x3b, 0xd9, 0x7f, 0x07, 0x31, 0x4f, 0xf8, 0x44, 0xda, 0x55, 0xd7, 0x6a,
-	0xd7, 0xbb, 0x87, 0xff, 0x54, 0xed, 0x6b, 0xd0, 0x6f, 0x06, 0xeb, 0x23, 0xbd, 0x24, 0x4d, 0x05,
-	0xe6, 0x7a, 0x8c, 0xa8, 0xa6, 0x45, 0xee, 0x1f, 0xed, 0x97, 0x77, 0x65, 0x3c, 0x0d, 0xb5, 0x36,
-	0xd1, 0x1e, 0x69, 0x20, 0x1f, 0x8f, 0x67, 0xb9, 0x65, 0x53, 0x5b, 0x0e, 0x8a, 0x2c, 0xf7, 0x4b,
-	0x2e, 0x93, 0xd4, 0x71, 0x35, 0xf4, 0x6e, 0x3f, 0x16, 0x8e, 0x35, 0x5f, 0x38, 0xd6, 0xd7, 0xc2,
-	0xb1, 0xde, 0x52, 0xa7, 0x34, 0x4f, 0x9d, 0xd2, 0x67, 0xea, 0x94, 0x1e, 0x3b, 0x61, 0x84, 0x4f,
-	0x2f, 0x43, 0x36, 0x82, 0x89, 0x97, 0xbd, 0xbf, 0xf9, 0x1c, 0xcb, 0xe0, 0xd9, 0x7b, 0xd5, 0xcb,
-	0x80, 0xb3, 0x58, 0xc8, 0x7c, 0x25, 0x86, 0x35, 0xbd, 0x0f, 0xa7, 0xdf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xb6, 0x88, 0x7e, 0xc1, 0x65, 0x02, 0x00, 0x00,
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4d, 0x4b, 0xeb, 0x40,
+	0x14, 0x86, 0x9b, 0xdb, 0x8f, 0xdb, 0x3b, 0x6d, 0xef, 0x62, 0xe8, 0x22, 0x68, 0x89, 0xd1, 0x85,
+	0x74, 0x63, 0x52, 0xeb, 0x42, 0x70, 0x59, 0x14, 0x3f, 0x40, 0x28, 0x51, 0x44, 0xdc, 0x84, 0x49,
+	0x33, 0xc4, 0x60, 0xd3, 0x13, 0x72, 0xc6, 0x60, 0xff, 0x85, 0x0b, 0x7f, 0x94, 0xcb, 0x2e, 0x5d,
+	0x4a, 0xfb, 0x47, 0xa4, 0x33, 0x89, 0x2d, 0x34, 0xba, 0x2a, 0xa7, 0xf3, 0xbc, 0x4f, 0x5e, 0x0e,
+	0x87, 0x98, 0x23, 0xc0, 0x08, 0xd0, 0x0e, 0x20, 0xb5, 0xd3, 0x43, 0x8f, 0x0b, 0xd6, 0xb7, 0x03,
+	0x3e, 0xe1, 0x18, 0xa2, 0x15, 0x27, 0x20, 0x80, 0x52, 0x45, 0x58, 0x01, 0xa4, 0x56, 0x46, 0x6c,
+	0x75, 0x8a, 0x52, 0x90, 0xaa, 0xc4, 0xde, 0x5b, 0x85, 0x34, 0xcf, 0x95, 0xe3, 0x46, 0x30, 0xc1,
+	0x69, 0x8f, 0xb4, 0x51, 0xb0, 0x44, 0x84, 0x93, 0xc0, 0x8d, 0x13, 0x88, 0x01, 0xd9, 0xd8, 0x0d,
+	0x7d, 0x5d, 0x33, 0xb5, 0x6e, 0xc5, 0xa1, 0xf9, 0xdb, 0x30, 0x7b, 0xba, 0xf4, 0xe9, 0x31, 0xa9,
+	0xfb, 0x3c, 0x06, 0x0c, 0x05, 0xea, 0x7f, 0xcc, 0x72, 0xb7, 0xd1, 0xdf, 0xb6, 0x36, 0x7b, 0x58,
+	0xa7, 0x8a, 0x71, 0xbe, 0x61, 0x6a, 0x91, 0x6a, 0x0a, 0x82, 0xa3, 0x5e, 0x96, 0x29, 0xbd, 0x28,
+	0x75, 0x07, 0x82, 0x3b, 0x0a, 0xa3, 0x27, 0xe4, 0x5f, 0xde, 0x08, 0xf5, 0x8a, 0xcc, 0x74, 0x8a,
+	0x32, 0x79, 0x37, 0x67, 0x85, 0xd3, 0x0b, 0xf2, 0x3f, 0xfb, 0xae, 0x1b, 0xb3, 0x84, 0x45, 0xa8,
+	0x57, 0x4d, 0xad, 0xdb, 0xe8, 0xef, 0xfe, 0x52, 0x75, 0x28, 0x41, 0xa7, 0xe5, 0xaf, 0x8f, 0xf4,
+	0x8c, 0xb4, 0x52, 0x50, 0xeb, 0x51, 0xa2, 0x9a, 0x14, 0x99, 0x3f, 0xb4, 0x5f, 0xee, 0x4a, 0x79,
+	0x9a, 0xe9, 0xda, 0x44, 0x07, 0xa4, 0x29, 0xd8, 0x78, 0x3c, 0xcd, 0x2d, 0x7f, 0xa5, 0x65, 0xa7,
+	0xc8, 0x72, 0xbb, 0xe4, 0x32, 0x49, 0x43, 0xac, 0x06, 0x7a, 0x4f, 0xda, 0x11, 0x47, 0x64, 0x01,
+	0x77, 0x3d, 0x86, 0xdc, 0xcf, 0x5d, 0x75, 0xb9, 0x9b, 0xfd, 0x22, 0xd7, 0xb5, 0xe2, 0x07, 0x4b,
+	0x3c, 0x53, 0xd2, 0x68, 0xe3, 0xbf, 0xc1, 0xd5, 0xfb, 0xdc, 0xd0, 0x66, 0x73, 0x43, 0xfb, 0x9c,
+	0x1b, 0xda, 0xeb, 0xc2, 0x28, 0xcd, 0x16, 0x46, 0xe9, 0x63, 0x61, 0x94, 0x1e, 0x7a, 0x41, 0x28,
+	0x1e, 0x9f, 0x3d, 0x6b, 0x04, 0x91, 0x9d, 0x5d, 0x96, 0xfa, 0x39, 0x40, 0xff, 0xc9, 0x7e, 0x91,
+	0x67, 0x26, 0xa6, 0x31, 0xc7, 0xfc, 0xd8, 0xbc, 0x9a, 0xbc, 0xb4, 0xa3, 0xaf, 0x01, 0x00, 0x2a,
+	0x8a, 0xc3, 0x0d, 0xbf, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -174,6 +185,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MessageBasedParams) > 0 {
+		for iNdEx := len(m.MessageBasedParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageBasedParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.TallyParams != nil {
 		{
 			size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
@@ -310,6 +335,12 @@ func (m *GenesisState) Size() (n int) {
 		l = m.TallyParams.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.MessageBasedParams) > 0 {
+		for _, e := range m.MessageBasedParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -577,6 +608,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageBasedParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageBasedParams = append(m.MessageBasedParams, &MessageBasedParams{})
+			if err := m.MessageBasedParams[len(m.MessageBasedParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
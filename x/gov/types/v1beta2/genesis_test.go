@@ -2,7 +2,9 @@ package v1beta2_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta2"
 	"github.com/stretchr/testify/require"
 )
@@ -14,3 +16,106 @@ func TestEmptyGenesis(t *testing.T) {
 	state2 := v1beta2.DefaultGenesisState()
 	require.False(t, state2.Empty())
 }
+
+func TestValidateGenesisExpeditedParams(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(*v1beta2.GenesisState)
+		expErr   bool
+	}{
+		{"default params", func(*v1beta2.GenesisState) {}, false},
+		{
+			"expedited voting period longer than voting period",
+			func(gs *v1beta2.GenesisState) {
+				vp := v1beta2.NewVotingParams(time.Hour, 2*time.Hour)
+				gs.VotingParams = &vp
+			},
+			true,
+		},
+		{
+			"nil expedited voting period",
+			func(gs *v1beta2.GenesisState) {
+				gs.VotingParams.ExpeditedVotingPeriod = nil
+			},
+			true,
+		},
+		{
+			"expedited threshold lower than threshold",
+			func(gs *v1beta2.GenesisState) {
+				gs.TallyParams.ExpeditedThreshold = "0.4"
+			},
+			true,
+		},
+		{
+			"expedited threshold too large",
+			func(gs *v1beta2.GenesisState) {
+				gs.TallyParams.ExpeditedThreshold = "1.1"
+			},
+			true,
+		},
+		{
+			"negative proposal cancel ratio",
+			func(gs *v1beta2.GenesisState) {
+				gs.DepositParams.ProposalCancelRatio = sdk.NewDec(-1).String()
+			},
+			true,
+		},
+		{
+			"proposal cancel ratio too large",
+			func(gs *v1beta2.GenesisState) {
+				gs.DepositParams.ProposalCancelRatio = sdk.NewDec(2).String()
+			},
+			true,
+		},
+		{
+			"valid message based params",
+			func(gs *v1beta2.GenesisState) {
+				tp := v1beta2.DefaultTallyParams()
+				mp := v1beta2.NewMessageBasedParams("/cosmos.bank.v1beta1.MsgSend", nil, nil, &tp)
+				gs.MessageBasedParams = []*v1beta2.MessageBasedParams{&mp}
+			},
+			false,
+		},
+		{
+			"message based params without msg url",
+			func(gs *v1beta2.GenesisState) {
+				tp := v1beta2.DefaultTallyParams()
+				mp := v1beta2.NewMessageBasedParams("", nil, nil, &tp)
+				gs.MessageBasedParams = []*v1beta2.MessageBasedParams{&mp}
+			},
+			true,
+		},
+		{
+			"invalid message based params",
+			func(gs *v1beta2.GenesisState) {
+				vp := v1beta2.NewVotingParams(time.Hour, 2*time.Hour)
+				mp := v1beta2.NewMessageBasedParams("/cosmos.bank.v1beta1.MsgSend", nil, &vp, nil)
+				gs.MessageBasedParams = []*v1beta2.MessageBasedParams{&mp}
+			},
+			true,
+		},
+		{
+			"duplicate message based params",
+			func(gs *v1beta2.GenesisState) {
+				tp := v1beta2.DefaultTallyParams()
+				mp := v1beta2.NewMessageBasedParams("/cosmos.bank.v1beta1.MsgSend", nil, nil, &tp)
+				gs.MessageBasedParams = []*v1beta2.MessageBasedParams{&mp, &mp}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := v1beta2.DefaultGenesisState()
+			tc.malleate(gs)
+
+			err := v1beta2.ValidateGenesis(gs)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
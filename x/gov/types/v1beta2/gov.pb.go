@@ -248,6 +248,11 @@ type Proposal struct {
 	VotingEndTime    *time.Time     `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time,omitempty"`
 	// metadata is any arbitrary metadata attached to the proposal.
 	Metadata []byte `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// expedited defines if the proposal is expedited. An expedited proposal which
+	// fails its vote is converted to a regular proposal.
+	Expedited bool `protobuf:"varint,11,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// proposer is the address of the proposal submitter, allowed to cancel the proposal.
+	Proposer string `protobuf:"bytes,12,opt,name=proposer,proto3" json:"proposer,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -353,6 +358,20 @@ func (m *Proposal) GetMetadata() []byte {
 	return nil
 }
 
+func (m *Proposal) GetExpedited() bool {
+	if m != nil {
+		return m.Expedited
+	}
+	return false
+}
+
+func (m *Proposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	Yes        string `protobuf:"bytes,1,opt,name=yes,proto3" json:"yes,omitempty"`
@@ -491,6 +510,9 @@ type DepositParams struct {
 	//  Maximum period for Atom holders to deposit on a proposal. Initial value: 2
 	//  months.
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
+	//  The fraction of the deposits burnt when a proposal is canceled by its
+	//  proposer. The rest of the deposits is refunded.
+	ProposalCancelRatio string `protobuf:"bytes,3,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
 }
 
 func (m *DepositParams) Reset()         { *m = DepositParams{} }
@@ -540,10 +562,20 @@ func (m *DepositParams) GetMaxDepositPeriod() *time.Duration {
 	return nil
 }
 
+func (m *DepositParams) GetProposalCancelRatio() string {
+	if m != nil {
+		return m.ProposalCancelRatio
+	}
+	return ""
+}
+
 // VotingParams defines the params for voting on governance proposals.
 type VotingParams struct {
 	//  Length of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	//  Length of the voting period of expedited proposals. It must be shorter
+	//  than the voting period.
+	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,2,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
 }
 
 func (m *VotingParams) Reset()         { *m = VotingParams{} }
@@ -586,6 +618,13 @@ func (m *VotingParams) GetVotingPeriod() *time.Duration {
 	return nil
 }
 
+func (m *VotingParams) GetExpeditedVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.ExpeditedVotingPeriod
+	}
+	return nil
+}
+
 // TallyParams defines the params for tallying votes on governance proposals.
 type TallyParams struct {
 	//  Minimum percentage of total stake needed to vote for a result to be
@@ -596,6 +635,9 @@ type TallyParams struct {
 	//  Minimum value of Veto votes to Total votes ratio for proposal to be
 	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	//  Minimum proportion of Yes votes for an expedited proposal to pass. It
+	//  must be greater than the threshold. Default value: 0.667.
+	ExpeditedThreshold string `protobuf:"bytes,4,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
 }
 
 func (m *TallyParams) Reset()         { *m = TallyParams{} }
@@ -652,6 +694,157 @@ func (m *TallyParams) GetVetoThreshold() string {
 	return ""
 }
 
+func (m *TallyParams) GetExpeditedThreshold() string {
+	if m != nil {
+		return m.ExpeditedThreshold
+	}
+	return ""
+}
+
+// Params defines the parameters of the x/gov module.
+type Params struct {
+	// voting_params defines the params for voting on governance proposals.
+	VotingParams VotingParams `protobuf:"bytes,1,opt,name=voting_params,json=votingParams,proto3" json:"voting_params"`
+	// tally_params defines the params for tallying votes on governance proposals.
+	TallyParams TallyParams `protobuf:"bytes,2,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params"`
+	// deposit_params defines the params for deposits on governance proposals.
+	DepositParams DepositParams `protobuf:"bytes,3,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abf7b8852811c49, []int{8}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetVotingParams() VotingParams {
+	if m != nil {
+		return m.VotingParams
+	}
+	return VotingParams{}
+}
+
+func (m *Params) GetTallyParams() TallyParams {
+	if m != nil {
+		return m.TallyParams
+	}
+	return TallyParams{}
+}
+
+func (m *Params) GetDepositParams() DepositParams {
+	if m != nil {
+		return m.DepositParams
+	}
+	return DepositParams{}
+}
+
+// MessageBasedParams defines the governance params applied to the proposals
+// executing a given Msg type. The params left unset fall back to the global
+// governance params.
+type MessageBasedParams struct {
+	// msg_url is the type URL of the Msg the params apply to. For a
+	// MsgExecLegacyContent, the type URL of the wrapped legacy content can be
+	// used as well.
+	MsgUrl string `protobuf:"bytes,1,opt,name=msg_url,json=msgUrl,proto3" json:"msg_url,omitempty"`
+	// deposit_params defines the params for deposits on the proposals executing
+	// the Msg.
+	DepositParams *DepositParams `protobuf:"bytes,2,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params,omitempty"`
+	// voting_params defines the params for voting on the proposals executing
+	// the Msg.
+	VotingParams *VotingParams `protobuf:"bytes,3,opt,name=voting_params,json=votingParams,proto3" json:"voting_params,omitempty"`
+	// tally_params defines the params for tallying votes on the proposals
+	// executing the Msg.
+	TallyParams *TallyParams `protobuf:"bytes,4,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params,omitempty"`
+}
+
+func (m *MessageBasedParams) Reset()         { *m = MessageBasedParams{} }
+func (m *MessageBasedParams) String() string { return proto.CompactTextString(m) }
+func (*MessageBasedParams) ProtoMessage()    {}
+func (*MessageBasedParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abf7b8852811c49, []int{9}
+}
+func (m *MessageBasedParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageBasedParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageBasedParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageBasedParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageBasedParams.Merge(m, src)
+}
+func (m *MessageBasedParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageBasedParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageBasedParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageBasedParams proto.InternalMessageInfo
+
+func (m *MessageBasedParams) GetMsgUrl() string {
+	if m != nil {
+		return m.MsgUrl
+	}
+	return ""
+}
+
+func (m *MessageBasedParams) GetDepositParams() *DepositParams {
+	if m != nil {
+		return m.DepositParams
+	}
+	return nil
+}
+
+func (m *MessageBasedParams) GetVotingParams() *VotingParams {
+	if m != nil {
+		return m.VotingParams
+	}
+	return nil
+}
+
+func (m *MessageBasedParams) GetTallyParams() *TallyParams {
+	if m != nil {
+		return m.TallyParams
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1beta2.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1beta2.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -663,80 +856,97 @@ func init() {
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1beta2.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1beta2.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1beta2.TallyParams")
+	proto.RegisterType((*Params)(nil), "cosmos.gov.v1beta2.Params")
+	proto.RegisterType((*MessageBasedParams)(nil), "cosmos.gov.v1beta2.MessageBasedParams")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1beta2/gov.proto", fileDescriptor_5abf7b8852811c49) }
 
 var fileDescriptor_5abf7b8852811c49 = []byte{
-	// 1087 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x41, 0x53, 0xdb, 0x46,
-	0x14, 0x46, 0xb6, 0x30, 0xf0, 0x0c, 0x8e, 0xba, 0xd0, 0x46, 0x10, 0x62, 0xbb, 0x9e, 0x36, 0xe3,
-	0x61, 0x8a, 0x0d, 0x74, 0x26, 0x9d, 0xc9, 0xa9, 0x36, 0x56, 0x1a, 0x33, 0x14, 0xbb, 0x92, 0x62,
-	0x26, 0xbd, 0x68, 0x64, 0xb4, 0xb1, 0x35, 0xb5, 0xb4, 0xae, 0x76, 0x4d, 0xf0, 0x4f, 0xe8, 0x2d,
-	0xc7, 0xce, 0xf4, 0xd0, 0x1f, 0xd0, 0x6b, 0x7e, 0x44, 0x4e, 0x9d, 0x4c, 0x4e, 0xed, 0xc5, 0x6d,
-	0xe1, 0xc6, 0xad, 0xff, 0x20, 0xa3, 0xd5, 0x0a, 0x1b, 0x9b, 0x0c, 0x9c, 0xbc, 0x7a, 0xef, 0xfb,
-	0xbe, 0xf7, 0x9e, 0xf6, 0xdb, 0xb5, 0x60, 0xf3, 0x84, 0x50, 0x8f, 0xd0, 0x72, 0x87, 0x9c, 0x96,
-	0x4f, 0x77, 0xdb, 0x98, 0xd9, 0x7b, 0xe1, 0xba, 0xd4, 0x0f, 0x08, 0x23, 0x08, 0x45, 0xd9, 0x52,
-	0x18, 0x11, 0xd9, 0x8d, 0xac, 0x60, 0xb4, 0x6d, 0x8a, 0x05, 0x65, 0xb7, 0x7c, 0x42, 0x5c, 0x3f,
-	0xe2, 0x6c, 0xac, 0x75, 0x48, 0x87, 0xf0, 0x65, 0x39, 0x5c, 0x89, 0x68, 0xae, 0x43, 0x48, 0xa7,
-	0x87, 0xcb, 0xfc, 0xa9, 0x3d, 0x78, 0x59, 0x66, 0xae, 0x87, 0x29, 0xb3, 0xbd, 0xbe, 0x00, 0xac,
-	0x4f, 0x03, 0x6c, 0x7f, 0x28, 0x52, 0xd9, 0xe9, 0x94, 0x33, 0x08, 0x6c, 0xe6, 0x92, 0xb8, 0xe2,
-	0x7a, 0xd4, 0x91, 0x15, 0x15, 0x15, 0x2d, 0xf3, 0x87, 0x02, 0x03, 0x74, 0x8c, 0xdd, 0x4e, 0x97,
-	0x61, 0xa7, 0x45, 0x18, 0x6e, 0xf4, 0x43, 0x1a, 0x7a, 0x0c, 0x29, 0xc2, 0x57, 0xaa, 0x94, 0x97,
-	0x8a, 0x99, 0xbd, 0x6c, 0x69, 0x76, 0xce, 0xd2, 0x18, 0xaf, 0x0b, 0x34, 0x7a, 0x04, 0xa9, 0x57,
-	0x5c, 0x4d, 0x4d, 0xe4, 0xa5, 0xe2, 0x52, 0x35, 0xf3, 0xfe, 0xcd, 0x36, 0x08, 0x6a, 0x0d, 0x9f,
-	0xe8, 0x22, 0x5b, 0xf8, 0x4d, 0x82, 0x85, 0x1a, 0xee, 0x13, 0xea, 0x32, 0x94, 0x83, 0x74, 0x3f,
-	0x20, 0x7d, 0x42, 0xed, 0x9e, 0xe5, 0x3a, 0xbc, 0xa0, 0xac, 0x43, 0x1c, 0xaa, 0x3b, 0xe8, 0x31,
-	0x2c, 0x39, 0x11, 0x96, 0x04, 0x42, 0x57, 0x7d, 0xff, 0x66, 0x7b, 0x4d, 0xe8, 0x56, 0x1c, 0x27,
-	0xc0, 0x94, 0x1a, 0x2c, 0x70, 0xfd, 0x8e, 0x3e, 0x86, 0xa2, 0x6f, 0x20, 0x65, 0x7b, 0x64, 0xe0,
-	0x33, 0x35, 0x99, 0x4f, 0x16, 0xd3, 0x7b, 0xeb, 0xf1, 0x10, 0xe1, 0xc6, 0x88, 0x29, 0x76, 0x4b,
-	0xfb, 0xc4, 0xf5, 0xab, 0xf2, 0xdb, 0x51, 0x6e, 0x4e, 0x17, 0xf0, 0xc2, 0xff, 0x32, 0x2c, 0x36,
-	0x45, 0xfd, 0xdb, 0xdb, 0xdb, 0x81, 0x45, 0x0f, 0x53, 0x6a, 0x77, 0x30, 0x55, 0x13, 0xbc, 0xd0,
-	0x5a, 0x29, 0xda, 0x8f, 0x52, 0xbc, 0x1f, 0xa5, 0x8a, 0x3f, 0xd4, 0xaf, 0x50, 0xe8, 0x09, 0xa4,
-	0x28, 0xb3, 0xd9, 0x80, 0xaa, 0x49, 0xfe, 0x76, 0x0b, 0x37, 0xbd, 0xdd, 0xb8, 0x01, 0x83, 0x23,
-	0x75, 0xc1, 0x40, 0xdf, 0x03, 0x7a, 0xe9, 0xfa, 0x76, 0xcf, 0x62, 0x76, 0xaf, 0x37, 0xb4, 0x02,
-	0x4c, 0x07, 0x3d, 0xa6, 0xca, 0x79, 0xa9, 0x98, 0xde, 0xcb, 0xdd, 0xa4, 0x63, 0x86, 0x38, 0x9d,
-	0xc3, 0x74, 0x85, 0x53, 0x27, 0x22, 0xa8, 0x02, 0x69, 0x3a, 0x68, 0x7b, 0x2e, 0xb3, 0x42, 0xbb,
-	0xa9, 0xf3, 0x5c, 0x67, 0x63, 0xa6, 0x7f, 0x33, 0xf6, 0x62, 0x55, 0x7e, 0xfd, 0x4f, 0x4e, 0xd2,
-	0x21, 0x22, 0x85, 0x61, 0x74, 0x00, 0x8a, 0x78, 0xe7, 0x16, 0xf6, 0x9d, 0x48, 0x27, 0x75, 0x47,
-	0x9d, 0x8c, 0x60, 0x6a, 0xbe, 0xc3, 0xb5, 0x6a, 0xb0, 0xc2, 0x08, 0xb3, 0x7b, 0x96, 0x88, 0xab,
-	0x0b, 0x77, 0xdb, 0xb9, 0x65, 0xce, 0x8a, 0x1d, 0x75, 0x08, 0x9f, 0x9c, 0x12, 0xe6, 0xfa, 0x1d,
-	0x8b, 0x32, 0x3b, 0x10, 0xa3, 0x2d, 0xde, 0xb1, 0xa5, 0x7b, 0x11, 0xd5, 0x08, 0x99, 0xbc, 0xa7,
-	0x67, 0x20, 0x42, 0xe3, 0xf1, 0x96, 0xee, 0xa8, 0xb5, 0x12, 0x11, 0xe3, 0xe9, 0x36, 0x42, 0xa7,
-	0x30, 0xdb, 0xb1, 0x99, 0xad, 0x42, 0x5e, 0x2a, 0x2e, 0xeb, 0x57, 0xcf, 0x85, 0x3f, 0x24, 0x48,
-	0x4f, 0x6e, 0x4c, 0x1e, 0x92, 0x43, 0x4c, 0xb9, 0xdd, 0xae, 0x1f, 0xa3, 0xba, 0xcf, 0xf4, 0x30,
-	0x85, 0x8a, 0xb0, 0x60, 0xb7, 0x29, 0xb3, 0x5d, 0xff, 0x86, 0xc3, 0x16, 0xa2, 0xe2, 0x34, 0xca,
-	0x42, 0xc2, 0x27, 0xdc, 0x6b, 0xb3, 0xa0, 0x84, 0x4f, 0xd0, 0x0e, 0x2c, 0xfb, 0xc4, 0x7a, 0xe5,
-	0xb2, 0xae, 0x75, 0x8a, 0x19, 0xe1, 0x6e, 0x9a, 0x45, 0x82, 0x4f, 0x8e, 0x5d, 0xd6, 0x6d, 0x61,
-	0x46, 0x0a, 0xbf, 0x4b, 0x20, 0x87, 0xc7, 0xff, 0xf6, 0xd3, 0x51, 0x82, 0xf9, 0x53, 0xc2, 0xf0,
-	0xed, 0x07, 0x37, 0x82, 0xa1, 0x6f, 0x61, 0x21, 0xba, 0x4b, 0xa8, 0x2a, 0xf3, 0xbd, 0x7f, 0x74,
-	0x93, 0xa9, 0x67, 0xaf, 0x2c, 0x3d, 0xa6, 0x1d, 0xc8, 0x8b, 0x49, 0x45, 0x2e, 0xfc, 0x2d, 0xc1,
-	0x8a, 0xf0, 0x43, 0xd3, 0x0e, 0x6c, 0x8f, 0xa2, 0x17, 0x90, 0xf6, 0x5c, 0xff, 0xca, 0x59, 0xd2,
-	0x6d, 0xce, 0x7a, 0x18, 0x3a, 0xeb, 0x72, 0x94, 0xfb, 0x74, 0x82, 0xf5, 0x15, 0xf1, 0x5c, 0x86,
-	0xbd, 0x3e, 0x1b, 0xea, 0xe0, 0xb9, 0x7e, 0x6c, 0x38, 0x0f, 0x90, 0x67, 0x9f, 0xc5, 0x20, 0xab,
-	0x8f, 0x03, 0x97, 0x38, 0x7c, 0xe2, 0xb0, 0xc2, 0xb4, 0x4b, 0x6a, 0xe2, 0x72, 0xae, 0x7e, 0x71,
-	0x39, 0xca, 0x6d, 0xce, 0x12, 0xc7, 0x45, 0x7e, 0x0d, 0x4d, 0xa4, 0x78, 0xf6, 0x59, 0x3c, 0x09,
-	0xcf, 0x17, 0x4c, 0x58, 0x6e, 0x71, 0x63, 0x89, 0xc9, 0x6a, 0x20, 0x8c, 0x16, 0x57, 0x96, 0x6e,
-	0xab, 0x2c, 0x73, 0xe5, 0xe5, 0x88, 0x25, 0x54, 0xff, 0x8b, 0x1d, 0x28, 0x54, 0x9f, 0x40, 0xea,
-	0xe7, 0x01, 0x09, 0x06, 0x9e, 0x30, 0x61, 0xe1, 0x72, 0x94, 0x53, 0xa2, 0xc8, 0xb8, 0xc3, 0xe9,
-	0xfb, 0x3d, 0xca, 0xa3, 0x7d, 0x58, 0x62, 0xdd, 0x00, 0xd3, 0x2e, 0xe9, 0x39, 0x62, 0xe7, 0xbf,
-	0xbc, 0x1c, 0xe5, 0x56, 0xaf, 0x82, 0x1f, 0x55, 0x18, 0xf3, 0xd0, 0x0f, 0x90, 0x09, 0xed, 0x68,
-	0x8d, 0x95, 0x22, 0x0b, 0x6f, 0x5d, 0x8e, 0x72, 0xea, 0xf5, 0xcc, 0x47, 0xe5, 0x56, 0x42, 0x9c,
-	0x19, 0xc3, 0xb6, 0x7e, 0x91, 0x00, 0x26, 0xfe, 0xe6, 0x1e, 0xc0, 0xfd, 0x56, 0xc3, 0xd4, 0xac,
-	0x46, 0xd3, 0xac, 0x37, 0x8e, 0xac, 0xe7, 0x47, 0x46, 0x53, 0xdb, 0xaf, 0x3f, 0xad, 0x6b, 0x35,
-	0x65, 0x0e, 0xad, 0xc2, 0xbd, 0xc9, 0xe4, 0x0b, 0xcd, 0x50, 0x24, 0x74, 0x1f, 0x56, 0x27, 0x83,
-	0x95, 0xaa, 0x61, 0x56, 0xea, 0x47, 0x4a, 0x02, 0x21, 0xc8, 0x4c, 0x26, 0x8e, 0x1a, 0x4a, 0x12,
-	0x6d, 0x82, 0x7a, 0x3d, 0x66, 0x1d, 0xd7, 0xcd, 0x67, 0x56, 0x4b, 0x33, 0x1b, 0x8a, 0xbc, 0xf5,
-	0xa7, 0x04, 0x99, 0xeb, 0x97, 0x3c, 0xca, 0xc1, 0x83, 0xa6, 0xde, 0x68, 0x36, 0x8c, 0xca, 0xa1,
-	0x65, 0x98, 0x15, 0xf3, 0xb9, 0x31, 0xd5, 0x53, 0x01, 0xb2, 0xd3, 0x80, 0x9a, 0xd6, 0x6c, 0x18,
-	0x75, 0xd3, 0x6a, 0x6a, 0x7a, 0xbd, 0x51, 0x53, 0x24, 0xf4, 0x39, 0x3c, 0x9c, 0xc6, 0xb4, 0x1a,
-	0x66, 0xfd, 0xe8, 0xbb, 0x18, 0x92, 0x40, 0x1b, 0xf0, 0xd9, 0x34, 0xa4, 0x59, 0x31, 0x0c, 0xad,
-	0x16, 0x35, 0x3d, 0x9d, 0xd3, 0xb5, 0x03, 0x6d, 0xdf, 0xd4, 0x6a, 0x8a, 0x7c, 0x13, 0xf3, 0x69,
-	0xa5, 0x7e, 0xa8, 0xd5, 0x94, 0xf9, 0xea, 0xc1, 0xdb, 0xf3, 0xac, 0xf4, 0xee, 0x3c, 0x2b, 0xfd,
-	0x7b, 0x9e, 0x95, 0x5e, 0x5f, 0x64, 0xe7, 0xde, 0x5d, 0x64, 0xe7, 0xfe, 0xba, 0xc8, 0xce, 0xfd,
-	0xb8, 0xd3, 0x71, 0x59, 0x77, 0xd0, 0x2e, 0x9d, 0x10, 0x4f, 0x7c, 0x7d, 0x88, 0x9f, 0x6d, 0xea,
-	0xfc, 0x54, 0x3e, 0xe3, 0xdf, 0x56, 0x6c, 0xd8, 0xc7, 0x34, 0xfe, 0xc2, 0x6a, 0xa7, 0xb8, 0x67,
-	0xbf, 0xfe, 0x10, 0x00, 0x00, 0xff, 0xff, 0x71, 0x11, 0x94, 0x66, 0x7e, 0x09, 0x00, 0x00,
+	// 1318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0x4f, 0x6f, 0x1a, 0x47,
+	0x14, 0xf7, 0x02, 0xc6, 0xf6, 0x03, 0x13, 0x3a, 0x4e, 0xea, 0x8d, 0xe3, 0xb0, 0x04, 0xb5, 0x11,
+	0x8a, 0x1a, 0x88, 0xdd, 0x2a, 0x95, 0x72, 0x2a, 0x98, 0x4d, 0x8d, 0x9b, 0x18, 0xba, 0x10, 0xac,
+	0xf4, 0xb2, 0x5d, 0xbc, 0x13, 0xbc, 0x2a, 0xbb, 0x43, 0x77, 0x06, 0xc7, 0xbe, 0xf6, 0xd6, 0x5b,
+	0x8e, 0x95, 0x7a, 0xe8, 0x07, 0xa8, 0x7a, 0xcb, 0x07, 0xa8, 0x54, 0x55, 0xca, 0xa9, 0x8a, 0x72,
+	0xea, 0x89, 0x56, 0xf1, 0x8d, 0x4b, 0xbf, 0x42, 0xb5, 0xb3, 0xb3, 0xfc, 0x59, 0x63, 0xc1, 0xc9,
+	0xcb, 0x7b, 0xbf, 0xdf, 0xef, 0xfd, 0x99, 0xf7, 0x66, 0xd7, 0xb0, 0x7d, 0x4c, 0xa8, 0x4d, 0x68,
+	0xb1, 0x43, 0x4e, 0x8b, 0xa7, 0x3b, 0x6d, 0xcc, 0x8c, 0x5d, 0xef, 0xb9, 0xd0, 0x73, 0x09, 0x23,
+	0x08, 0xf9, 0xde, 0x82, 0x67, 0x11, 0xde, 0xad, 0x8c, 0x60, 0xb4, 0x0d, 0x8a, 0x05, 0x65, 0xa7,
+	0x78, 0x4c, 0x2c, 0xc7, 0xe7, 0x6c, 0x5d, 0xef, 0x90, 0x0e, 0xe1, 0x8f, 0x45, 0xef, 0x49, 0x58,
+	0x95, 0x0e, 0x21, 0x9d, 0x2e, 0x2e, 0xf2, 0x5f, 0xed, 0xfe, 0x8b, 0x22, 0xb3, 0x6c, 0x4c, 0x99,
+	0x61, 0xf7, 0x04, 0xe0, 0x66, 0x18, 0x60, 0x38, 0xe7, 0xc2, 0x95, 0x09, 0xbb, 0xcc, 0xbe, 0x6b,
+	0x30, 0x8b, 0x04, 0x11, 0x6f, 0xfa, 0x19, 0xe9, 0x7e, 0x50, 0x91, 0x32, 0xff, 0x91, 0x63, 0x80,
+	0x8e, 0xb0, 0xd5, 0x39, 0x61, 0xd8, 0x6c, 0x11, 0x86, 0x6b, 0x3d, 0x8f, 0x86, 0x1e, 0x42, 0x9c,
+	0xf0, 0x27, 0x59, 0xca, 0x4a, 0xf9, 0xd4, 0x6e, 0xa6, 0x70, 0xb9, 0xce, 0xc2, 0x18, 0xaf, 0x09,
+	0x34, 0xba, 0x0b, 0xf1, 0x97, 0x5c, 0x4d, 0x8e, 0x64, 0xa5, 0xfc, 0x5a, 0x39, 0xf5, 0xee, 0xf5,
+	0x7d, 0x10, 0xd4, 0x0a, 0x3e, 0xd6, 0x84, 0x37, 0xf7, 0xb3, 0x04, 0x2b, 0x15, 0xdc, 0x23, 0xd4,
+	0x62, 0x48, 0x81, 0x44, 0xcf, 0x25, 0x3d, 0x42, 0x8d, 0xae, 0x6e, 0x99, 0x3c, 0x60, 0x4c, 0x83,
+	0xc0, 0x54, 0x35, 0xd1, 0x43, 0x58, 0x33, 0x7d, 0x2c, 0x71, 0x85, 0xae, 0xfc, 0xee, 0xf5, 0xfd,
+	0xeb, 0x42, 0xb7, 0x64, 0x9a, 0x2e, 0xa6, 0xb4, 0xc1, 0x5c, 0xcb, 0xe9, 0x68, 0x63, 0x28, 0xfa,
+	0x1c, 0xe2, 0x86, 0x4d, 0xfa, 0x0e, 0x93, 0xa3, 0xd9, 0x68, 0x3e, 0xb1, 0x7b, 0x33, 0x28, 0xc2,
+	0x3b, 0x18, 0x51, 0xc5, 0x4e, 0x61, 0x8f, 0x58, 0x4e, 0x39, 0xf6, 0x66, 0xa0, 0x2c, 0x69, 0x02,
+	0x9e, 0xfb, 0x73, 0x19, 0x56, 0xeb, 0x22, 0xfe, 0xfc, 0xf4, 0x1e, 0xc0, 0xaa, 0x8d, 0x29, 0x35,
+	0x3a, 0x98, 0xca, 0x11, 0x1e, 0xe8, 0x7a, 0xc1, 0x3f, 0x8f, 0x42, 0x70, 0x1e, 0x85, 0x92, 0x73,
+	0xae, 0x8d, 0x50, 0xe8, 0x11, 0xc4, 0x29, 0x33, 0x58, 0x9f, 0xca, 0x51, 0xde, 0xdd, 0xdc, 0xac,
+	0xee, 0x06, 0x09, 0x34, 0x38, 0x52, 0x13, 0x0c, 0xf4, 0x14, 0xd0, 0x0b, 0xcb, 0x31, 0xba, 0x3a,
+	0x33, 0xba, 0xdd, 0x73, 0xdd, 0xc5, 0xb4, 0xdf, 0x65, 0x72, 0x2c, 0x2b, 0xe5, 0x13, 0xbb, 0xca,
+	0x2c, 0x9d, 0xa6, 0x87, 0xd3, 0x38, 0x4c, 0x4b, 0x73, 0xea, 0x84, 0x05, 0x95, 0x20, 0x41, 0xfb,
+	0x6d, 0xdb, 0x62, 0xba, 0x37, 0x6e, 0xf2, 0x32, 0xd7, 0xd9, 0xba, 0x94, 0x7f, 0x33, 0x98, 0xc5,
+	0x72, 0xec, 0xd5, 0x3f, 0x8a, 0xa4, 0x81, 0x4f, 0xf2, 0xcc, 0xe8, 0x00, 0xd2, 0xa2, 0xe7, 0x3a,
+	0x76, 0x4c, 0x5f, 0x27, 0xbe, 0xa0, 0x4e, 0x4a, 0x30, 0x55, 0xc7, 0xe4, 0x5a, 0x15, 0x58, 0x67,
+	0x84, 0x19, 0x5d, 0x5d, 0xd8, 0xe5, 0x95, 0xc5, 0x4e, 0x2e, 0xc9, 0x59, 0xc1, 0x44, 0x3d, 0x81,
+	0x0f, 0x4e, 0x09, 0xb3, 0x9c, 0x8e, 0x4e, 0x99, 0xe1, 0x8a, 0xd2, 0x56, 0x17, 0x4c, 0xe9, 0x9a,
+	0x4f, 0x6d, 0x78, 0x4c, 0x9e, 0xd3, 0x3e, 0x08, 0xd3, 0xb8, 0xbc, 0xb5, 0x05, 0xb5, 0xd6, 0x7d,
+	0x62, 0x50, 0xdd, 0x96, 0x37, 0x29, 0xcc, 0x30, 0x0d, 0x66, 0xc8, 0x90, 0x95, 0xf2, 0x49, 0x6d,
+	0xf4, 0x1b, 0x6d, 0xc3, 0x1a, 0x3e, 0xeb, 0x61, 0xd3, 0x62, 0xd8, 0x94, 0x13, 0x59, 0x29, 0xbf,
+	0xaa, 0x8d, 0x0d, 0xe8, 0x33, 0x58, 0xf5, 0x27, 0x0e, 0xbb, 0x72, 0x72, 0xce, 0x06, 0x8c, 0x90,
+	0xb9, 0x5f, 0x25, 0x48, 0x4c, 0x1e, 0x76, 0x16, 0xa2, 0xe7, 0x98, 0xca, 0xd2, 0xa5, 0xd5, 0xac,
+	0x3a, 0x4c, 0xf3, 0x5c, 0x28, 0x0f, 0x2b, 0x46, 0x9b, 0x32, 0xc3, 0x72, 0xe4, 0xc8, 0x4c, 0x54,
+	0xe0, 0x46, 0x19, 0x88, 0x38, 0x44, 0x8e, 0xce, 0x04, 0x45, 0x1c, 0x82, 0x1e, 0x40, 0xd2, 0x21,
+	0xfa, 0x4b, 0x8b, 0x9d, 0xe8, 0xa7, 0x98, 0x11, 0x39, 0x36, 0x13, 0x09, 0x0e, 0x39, 0xb2, 0xd8,
+	0x49, 0x0b, 0x33, 0x92, 0xfb, 0x45, 0x82, 0x98, 0x77, 0xa5, 0xcc, 0xdf, 0xb8, 0x02, 0x2c, 0x9f,
+	0x12, 0x86, 0xe7, 0x5f, 0x06, 0x3e, 0x0c, 0x7d, 0x01, 0x2b, 0xfe, 0xfd, 0x44, 0xe5, 0x18, 0x9f,
+	0xa7, 0xbb, 0xb3, 0x16, 0xe5, 0xf2, 0x35, 0xa8, 0x05, 0xb4, 0x83, 0xd8, 0x6a, 0x34, 0x1d, 0xcb,
+	0xfd, 0x1e, 0x81, 0x75, 0x31, 0x63, 0x75, 0xc3, 0x35, 0x6c, 0x8a, 0x9e, 0x43, 0xc2, 0xb6, 0x9c,
+	0xd1, 0xb4, 0x4a, 0xf3, 0xa6, 0xf5, 0xb6, 0x37, 0xad, 0xc3, 0x81, 0x72, 0x63, 0x82, 0xf5, 0x09,
+	0xb1, 0x2d, 0x86, 0xed, 0x1e, 0x3b, 0xd7, 0xc0, 0xb6, 0x9c, 0x60, 0x88, 0x6d, 0x40, 0xb6, 0x71,
+	0x16, 0x80, 0xf4, 0x1e, 0x76, 0x2d, 0x62, 0xf2, 0x8a, 0xbd, 0x08, 0xe1, 0xc9, 0xab, 0x88, 0x0b,
+	0xbf, 0xfc, 0xd1, 0x70, 0xa0, 0x6c, 0x5f, 0x26, 0x8e, 0x83, 0xfc, 0xe4, 0x0d, 0x66, 0xda, 0x36,
+	0xce, 0x82, 0x4a, 0xb8, 0x1f, 0x61, 0xb8, 0x31, 0x6a, 0xfa, 0xb1, 0xe1, 0x1c, 0xe3, 0xae, 0xce,
+	0x15, 0xc5, 0x11, 0xef, 0x0c, 0x07, 0x8a, 0x32, 0x13, 0x30, 0x56, 0x0e, 0xdd, 0xf5, 0x1b, 0x01,
+	0x7c, 0x8f, 0xa3, 0x35, 0x0f, 0x9c, 0xfb, 0x4d, 0x82, 0x64, 0x8b, 0x2f, 0x85, 0xe8, 0x60, 0x05,
+	0xc4, 0x92, 0x04, 0x15, 0x4a, 0xf3, 0x2a, 0x8c, 0xf1, 0x0a, 0x92, 0x3e, 0x4b, 0x64, 0x7f, 0x04,
+	0x9b, 0xa3, 0x65, 0xd1, 0xa7, 0xf5, 0x22, 0x8b, 0xe9, 0xdd, 0x18, 0xf1, 0x5b, 0x13, 0xc2, 0xb9,
+	0x3f, 0x22, 0x62, 0x85, 0x44, 0xba, 0x8f, 0x20, 0xfe, 0x7d, 0x9f, 0xb8, 0x7d, 0x5b, 0x6c, 0x51,
+	0x6e, 0x38, 0x50, 0xd2, 0xbe, 0xe5, 0xca, 0x46, 0x08, 0x06, 0xda, 0x83, 0x35, 0x76, 0xe2, 0x62,
+	0x7a, 0x42, 0xba, 0xa6, 0x18, 0xdd, 0x8f, 0x87, 0x03, 0x65, 0x63, 0x64, 0xbc, 0x52, 0x61, 0xcc,
+	0x43, 0x5f, 0x43, 0xca, 0xdb, 0x27, 0x7d, 0xac, 0xe4, 0x1f, 0xd0, 0xbd, 0xe1, 0x40, 0x91, 0xa7,
+	0x3d, 0x57, 0xca, 0xad, 0x7b, 0xb8, 0xe6, 0x48, 0xf2, 0x5b, 0xd8, 0x18, 0x37, 0x6f, 0xac, 0xeb,
+	0x6f, 0x6c, 0x71, 0x38, 0x50, 0x6e, 0xcf, 0x70, 0x5f, 0x29, 0x8e, 0x46, 0xe0, 0x51, 0x84, 0xdc,
+	0x7f, 0x12, 0xc4, 0x45, 0x03, 0xbf, 0x1a, 0x9f, 0x37, 0x37, 0x88, 0xf3, 0xce, 0x5e, 0xf1, 0x81,
+	0x31, 0x1a, 0x94, 0xe0, 0xa2, 0x3f, 0x9d, 0x1c, 0x9e, 0x7d, 0x48, 0xfa, 0xaf, 0x41, 0xa1, 0x15,
+	0x99, 0xf3, 0x1a, 0x9c, 0x92, 0x4a, 0xb0, 0x89, 0x73, 0x3d, 0x84, 0xd4, 0x68, 0x61, 0x7c, 0xad,
+	0x28, 0xd7, 0xba, 0x33, 0x4b, 0x6b, 0xea, 0x0e, 0x10, 0x6a, 0xeb, 0xe6, 0xa4, 0x31, 0xf7, 0x43,
+	0x04, 0xd0, 0x53, 0xff, 0x7d, 0x5f, 0x36, 0x28, 0x36, 0x45, 0x98, 0x4d, 0x58, 0xb1, 0x69, 0x47,
+	0xef, 0xbb, 0x5d, 0x7f, 0x7e, 0xb4, 0xb8, 0x4d, 0x3b, 0xcf, 0xdc, 0x2e, 0xda, 0xbf, 0x14, 0x3f,
+	0xb2, 0x60, 0xfc, 0x50, 0x64, 0xa4, 0x86, 0x1b, 0x1c, 0x5d, 0xac, 0xc1, 0xa1, 0xd6, 0x96, 0x43,
+	0xad, 0x8d, 0x2d, 0xd4, 0xda, 0xa9, 0xa6, 0xde, 0xfb, 0x51, 0x02, 0x98, 0xf8, 0xa8, 0xbc, 0x05,
+	0x9b, 0xad, 0x5a, 0x53, 0xd5, 0x6b, 0xf5, 0x66, 0xb5, 0x76, 0xa8, 0x3f, 0x3b, 0x6c, 0xd4, 0xd5,
+	0xbd, 0xea, 0xe3, 0xaa, 0x5a, 0x49, 0x2f, 0xa1, 0x0d, 0xb8, 0x36, 0xe9, 0x7c, 0xae, 0x36, 0xd2,
+	0x12, 0xda, 0x84, 0x8d, 0x49, 0x63, 0xa9, 0xdc, 0x68, 0x96, 0xaa, 0x87, 0xe9, 0x08, 0x42, 0x90,
+	0x9a, 0x74, 0x1c, 0xd6, 0xd2, 0x51, 0xb4, 0x0d, 0xf2, 0xb4, 0x4d, 0x3f, 0xaa, 0x36, 0xf7, 0xf5,
+	0x96, 0xda, 0xac, 0xa5, 0x63, 0xf7, 0xfe, 0x92, 0x20, 0x35, 0xfd, 0x49, 0x85, 0x14, 0xb8, 0x55,
+	0xd7, 0x6a, 0xf5, 0x5a, 0xa3, 0xf4, 0x44, 0x6f, 0x34, 0x4b, 0xcd, 0x67, 0x8d, 0x50, 0x4e, 0x39,
+	0xc8, 0x84, 0x01, 0x15, 0xb5, 0x5e, 0x6b, 0x54, 0x9b, 0x7a, 0x5d, 0xd5, 0xaa, 0xb5, 0x4a, 0x5a,
+	0x42, 0x77, 0xe0, 0x76, 0x18, 0xd3, 0xaa, 0x35, 0xab, 0x87, 0x5f, 0x06, 0x90, 0x08, 0xda, 0x82,
+	0x0f, 0xc3, 0x90, 0x7a, 0xa9, 0xd1, 0x50, 0x2b, 0x7e, 0xd2, 0x61, 0x9f, 0xa6, 0x1e, 0xa8, 0x7b,
+	0x4d, 0xb5, 0x92, 0x8e, 0xcd, 0x62, 0x3e, 0x2e, 0x55, 0x9f, 0xa8, 0x95, 0xf4, 0x72, 0xf9, 0xe0,
+	0xcd, 0xfb, 0x8c, 0xf4, 0xf6, 0x7d, 0x46, 0xfa, 0xf7, 0x7d, 0x46, 0x7a, 0x75, 0x91, 0x59, 0x7a,
+	0x7b, 0x91, 0x59, 0xfa, 0xfb, 0x22, 0xb3, 0xf4, 0xcd, 0x83, 0x8e, 0xc5, 0x4e, 0xfa, 0xed, 0xc2,
+	0x31, 0xb1, 0xc5, 0xb7, 0xbe, 0xf8, 0x73, 0x9f, 0x9a, 0xdf, 0x15, 0xcf, 0xf8, 0x7f, 0x32, 0xec,
+	0xbc, 0x87, 0x69, 0xf0, 0xff, 0x4c, 0x3b, 0xce, 0x6f, 0xc5, 0x4f, 0xff, 0x1f, 0x00, 0x94, 0x79,
+	0x0d, 0x13, 0xec, 0x0c, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -843,6 +1053,23 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1063,6 +1290,13 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProposalCancelRatio) > 0 {
+		i -= len(m.ProposalCancelRatio)
+		copy(dAtA[i:], m.ProposalCancelRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelRatio)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.MaxDepositPeriod != nil {
 		n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
 		if err6 != nil {
@@ -1110,14 +1344,24 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.VotingPeriod != nil {
-		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriod):])
+	if m.ExpeditedVotingPeriod != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
 		if err7 != nil {
 			return 0, err7
 		}
 		i -= n7
 		i = encodeVarintGov(dAtA, i, uint64(n7))
 		i--
+		dAtA[i] = 0x12
+	}
+	if m.VotingPeriod != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
@@ -1143,6 +1387,13 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExpeditedThreshold) > 0 {
+		i -= len(m.ExpeditedThreshold)
+		copy(dAtA[i:], m.ExpeditedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ExpeditedThreshold)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.VetoThreshold) > 0 {
 		i -= len(m.VetoThreshold)
 		copy(dAtA[i:], m.VetoThreshold)
@@ -1167,6 +1418,125 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DepositParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.VotingParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageBasedParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageBasedParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TallyParams != nil {
+		{
+			size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.VotingParams != nil {
+		{
+			size, err := m.VotingParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DepositParams != nil {
+		{
+			size, err := m.DepositParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgUrl) > 0 {
+		i -= len(m.MsgUrl)
+		copy(dAtA[i:], m.MsgUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1264,6 +1634,13 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.Expedited {
+		n += 2
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1330,6 +1707,10 @@ func (m *DepositParams) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxDepositPeriod)
 		n += 1 + l + sovGov(uint64(l))
 	}
+	l = len(m.ProposalCancelRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1343,6 +1724,10 @@ func (m *VotingParams) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriod)
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.ExpeditedVotingPeriod != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1364,6 +1749,50 @@ func (m *TallyParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	l = len(m.ExpeditedThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VotingParams.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.TallyParams.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.DepositParams.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *MessageBasedParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.DepositParams != nil {
+		l = m.DepositParams.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VotingParams != nil {
+		l = m.VotingParams.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.TallyParams != nil {
+		l = m.TallyParams.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1958,22 +2387,74 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				m.Metadata = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.Expedited = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
@@ -2391,6 +2872,38 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2477,6 +2990,42 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpeditedVotingPeriod == nil {
+				m.ExpeditedVotingPeriod = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2623,6 +3172,377 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			}
 			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TallyParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageBasedParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageBasedParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DepositParams == nil {
+				m.DepositParams = &DepositParams{}
+			}
+			if err := m.DepositParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingParams == nil {
+				m.VotingParams = &VotingParams{}
+			}
+			if err := m.VotingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TallyParams == nil {
+				m.TallyParams = &TallyParams{}
+			}
+			if err := m.TallyParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -127,6 +127,79 @@ func TestMsgVoteWeighted(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgCancelProposal
+func TestMsgCancelProposal(t *testing.T) {
+	tests := []struct {
+		proposalID   uint64
+		proposerAddr sdk.AccAddress
+		expectPass   bool
+	}{
+		{0, addrs[0], true},
+		{1, addrs[1], true},
+		{1, sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := v1beta2.NewMsgCancelProposal(tc.proposalID, tc.proposerAddr)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgUpdateMessageBasedParams
+func TestMsgUpdateMessageBasedParams(t *testing.T) {
+	dp := v1beta2.DefaultDepositParams()
+	invalidDp := v1beta2.NewDepositParams(coinsPos, 0, v1beta2.DefaultProposalCancelRatio)
+	tests := []struct {
+		authority  sdk.AccAddress
+		params     v1beta2.MessageBasedParams
+		expectPass bool
+	}{
+		{addrs[0], v1beta2.NewMessageBasedParams("/cosmos.bank.v1beta1.MsgSend", &dp, nil, nil), true},
+		{addrs[0], v1beta2.NewMessageBasedParams("/cosmos.bank.v1beta1.MsgSend", nil, nil, nil), true},
+		{addrs[0], v1beta2.NewMessageBasedParams("", &dp, nil, nil), false},
+		{addrs[0], v1beta2.NewMessageBasedParams("/cosmos.bank.v1beta1.MsgSend", &invalidDp, nil, nil), false},
+		{sdk.AccAddress{}, v1beta2.NewMessageBasedParams("/cosmos.bank.v1beta1.MsgSend", &dp, nil, nil), false},
+	}
+
+	for i, tc := range tests {
+		msg := v1beta2.NewMsgUpdateMessageBasedParams(tc.authority.String(), tc.params)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgUpdateParams
+func TestMsgUpdateParams(t *testing.T) {
+	invalidParams := v1beta2.DefaultParams()
+	invalidParams.TallyParams.Quorum = "2"
+	tests := []struct {
+		authority  sdk.AccAddress
+		params     v1beta2.Params
+		expectPass bool
+	}{
+		{addrs[0], v1beta2.DefaultParams(), true},
+		{addrs[0], invalidParams, false},
+		{addrs[0], v1beta2.Params{}, false},
+		{sdk.AccAddress{}, v1beta2.DefaultParams(), false},
+	}
+
+	for i, tc := range tests {
+		msg := v1beta2.NewMsgUpdateParams(tc.authority.String(), tc.params)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 func TestMsgSubmitProposal_ValidateBasic(t *testing.T) {
 	metadata := []byte{42}
 	// Valid msg
@@ -153,7 +226,7 @@ func TestMsgSubmitProposal_ValidateBasic(t *testing.T) {
 	}
 
 	for _, tc := range tests {
-		msg, err := v1beta2.NewMsgSubmitProposal(tc.messages, tc.initialDeposit, tc.proposer, tc.metadata)
+		msg, err := v1beta2.NewMsgSubmitProposal(tc.messages, tc.initialDeposit, tc.proposer, tc.metadata, false)
 		require.NoError(t, err)
 		if tc.expErr {
 			require.Error(t, msg.ValidateBasic(), "test: %s", tc.name)
@@ -166,7 +239,7 @@ func TestMsgSubmitProposal_ValidateBasic(t *testing.T) {
 // this tests that Amino JSON MsgSubmitProposal.GetSignBytes() still works with Content as Any using the ModuleCdc
 func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 	proposal := []sdk.Msg{v1beta2.NewMsgVote(addrs[0], 1, v1beta2.OptionYes)}
-	msg, err := v1beta2.NewMsgSubmitProposal(proposal, sdk.NewCoins(), sdk.AccAddress{}.String(), nil)
+	msg, err := v1beta2.NewMsgSubmitProposal(proposal, sdk.NewCoins(), sdk.AccAddress{}.String(), nil, false)
 	require.NoError(t, err)
 	var bz []byte
 	require.NotPanics(t, func() {
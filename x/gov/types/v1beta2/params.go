@@ -11,15 +11,18 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
 // Default governance params
 var (
-	DefaultMinDepositTokens = sdk.NewInt(10000000)
-	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
-	DefaultVetoThreshold    = sdk.NewDecWithPrec(334, 3)
+	DefaultMinDepositTokens    = sdk.NewInt(10000000)
+	DefaultQuorum              = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold           = sdk.NewDecWithPrec(5, 1)
+	DefaultVetoThreshold       = sdk.NewDecWithPrec(334, 3)
+	DefaultExpeditedThreshold  = sdk.NewDecWithPrec(667, 3)
+	DefaultProposalCancelRatio = sdk.NewDecWithPrec(5, 1)
 )
 
 // Parameter store key
@@ -29,7 +32,8 @@ var (
 	ParamStoreKeyTallyParams   = []byte("tallyparams")
 )
 
-// ParamKeyTable - Key declaration for parameters
+// ParamKeyTable - Key declaration for the parameters of the legacy x/params
+// subspace, from which the parameters are migrated to the gov store.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable(
 		paramtypes.NewParamSetPair(ParamStoreKeyDepositParams, DepositParams{}, validateDepositParams),
@@ -39,10 +43,11 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration, proposalCancelRatio sdk.Dec) DepositParams {
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: &maxDepositPeriod,
+		MinDeposit:          minDeposit,
+		MaxDepositPeriod:    &maxDepositPeriod,
+		ProposalCancelRatio: proposalCancelRatio.String(),
 	}
 }
 
@@ -51,12 +56,37 @@ func DefaultDepositParams() DepositParams {
 	return NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
+		DefaultProposalCancelRatio,
 	)
 }
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return sdk.Coins(dp.MinDeposit).IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return sdk.Coins(dp.MinDeposit).IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		dp.ProposalCancelRatio == dp2.ProposalCancelRatio
+}
+
+// Stricter returns the most restrictive combination of two DepositParams: the highest
+// minimum deposit of each denom, the shortest maximum deposit period and the highest
+// proposal cancel ratio.
+func (dp DepositParams) Stricter(other DepositParams) DepositParams {
+	minDeposit := sdk.NewCoins(dp.MinDeposit...)
+	for _, coin := range other.MinDeposit {
+		if amount := minDeposit.AmountOf(coin.Denom); coin.Amount.GT(amount) {
+			minDeposit = minDeposit.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(amount)))
+		}
+	}
+
+	maxDepositPeriod := dp.MaxDepositPeriod
+	if maxDepositPeriod == nil || (other.MaxDepositPeriod != nil && *other.MaxDepositPeriod < *maxDepositPeriod) {
+		maxDepositPeriod = other.MaxDepositPeriod
+	}
+
+	return DepositParams{
+		MinDeposit:          minDeposit,
+		MaxDepositPeriod:    maxDepositPeriod,
+		ProposalCancelRatio: stricterDec(dp.ProposalCancelRatio, other.ProposalCancelRatio, sdk.MaxDec),
+	}
 }
 
 func validateDepositParams(i interface{}) error {
@@ -72,26 +102,50 @@ func validateDepositParams(i interface{}) error {
 		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
 	}
 
+	proposalCancelRatio, err := sdk.NewDecFromStr(v.ProposalCancelRatio)
+	if err != nil {
+		return fmt.Errorf("invalid proposal cancel ratio string: %w", err)
+	}
+	if proposalCancelRatio.IsNegative() {
+		return fmt.Errorf("proposal cancel ratio cannot be negative: %s", proposalCancelRatio)
+	}
+	if proposalCancelRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("proposal cancel ratio too large: %s", proposalCancelRatio)
+	}
+
 	return nil
 }
 
 // NewTallyParams creates a new TallyParams object
-func NewTallyParams(quorum, threshold, vetoThreshold sdk.Dec) TallyParams {
+func NewTallyParams(quorum, threshold, vetoThreshold, expeditedThreshold sdk.Dec) TallyParams {
 	return TallyParams{
-		Quorum:        quorum.String(),
-		Threshold:     threshold.String(),
-		VetoThreshold: vetoThreshold.String(),
+		Quorum:             quorum.String(),
+		Threshold:          threshold.String(),
+		VetoThreshold:      vetoThreshold.String(),
+		ExpeditedThreshold: expeditedThreshold.String(),
 	}
 }
 
 // DefaultTallyParams default parameters for tallying
 func DefaultTallyParams() TallyParams {
-	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold)
+	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold, DefaultExpeditedThreshold)
 }
 
 // Equal checks equality of TallyParams
 func (tp TallyParams) Equal(other TallyParams) bool {
-	return tp.Quorum == other.Quorum && tp.Threshold == other.Threshold && tp.VetoThreshold == other.VetoThreshold
+	return tp.Quorum == other.Quorum && tp.Threshold == other.Threshold && tp.VetoThreshold == other.VetoThreshold &&
+		tp.ExpeditedThreshold == other.ExpeditedThreshold
+}
+
+// Stricter returns the most restrictive combination of two TallyParams: the highest
+// quorum, threshold and expedited threshold, and the lowest veto threshold.
+func (tp TallyParams) Stricter(other TallyParams) TallyParams {
+	return TallyParams{
+		Quorum:             stricterDec(tp.Quorum, other.Quorum, sdk.MaxDec),
+		Threshold:          stricterDec(tp.Threshold, other.Threshold, sdk.MaxDec),
+		VetoThreshold:      stricterDec(tp.VetoThreshold, other.VetoThreshold, sdk.MinDec),
+		ExpeditedThreshold: stricterDec(tp.ExpeditedThreshold, other.ExpeditedThreshold, sdk.MaxDec),
+	}
 }
 
 func validateTallyParams(i interface{}) error {
@@ -133,24 +187,45 @@ func validateTallyParams(i interface{}) error {
 		return fmt.Errorf("veto threshold too large: %s", v)
 	}
 
+	expeditedThreshold, err := sdk.NewDecFromStr(v.ExpeditedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid expedited threshold string: %w", err)
+	}
+	if expeditedThreshold.LTE(threshold) {
+		return fmt.Errorf("expedited vote threshold must be greater than the vote threshold: %s", expeditedThreshold)
+	}
+	if expeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", v)
+	}
+
 	return nil
 }
 
 // NewVotingParams creates a new VotingParams object
-func NewVotingParams(votingPeriod time.Duration) VotingParams {
+func NewVotingParams(votingPeriod, expeditedVotingPeriod time.Duration) VotingParams {
 	return VotingParams{
-		VotingPeriod: &votingPeriod,
+		VotingPeriod:          &votingPeriod,
+		ExpeditedVotingPeriod: &expeditedVotingPeriod,
 	}
 }
 
 // DefaultVotingParams default parameters for voting
 func DefaultVotingParams() VotingParams {
-	return NewVotingParams(DefaultPeriod)
+	return NewVotingParams(DefaultPeriod, DefaultExpeditedPeriod)
 }
 
 // Equal checks equality of TallyParams
 func (vp VotingParams) Equal(other VotingParams) bool {
-	return vp.VotingPeriod == other.VotingPeriod
+	return vp.VotingPeriod == other.VotingPeriod && vp.ExpeditedVotingPeriod == other.ExpeditedVotingPeriod
+}
+
+// Stricter returns the most restrictive combination of two VotingParams: the longest
+// voting period and the longest expedited voting period.
+func (vp VotingParams) Stricter(other VotingParams) VotingParams {
+	return VotingParams{
+		VotingPeriod:          longerDuration(vp.VotingPeriod, other.VotingPeriod),
+		ExpeditedVotingPeriod: longerDuration(vp.ExpeditedVotingPeriod, other.ExpeditedVotingPeriod),
+	}
 }
 
 func validateVotingParams(i interface{}) error {
@@ -167,19 +242,19 @@ func validateVotingParams(i interface{}) error {
 		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
 	}
 
-	return nil
-}
+	if v.ExpeditedVotingPeriod == nil {
+		return errors.New("expedited voting period must not be nil")
+	}
 
-// Params returns all of the governance params
-type Params struct {
-	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
-	TallyParams   TallyParams   `json:"tally_params" yaml:"tally_params"`
-	DepositParams DepositParams `json:"deposit_params" yaml:"deposit_params"`
-}
+	if v.ExpeditedVotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", v.ExpeditedVotingPeriod)
+	}
+
+	if *v.ExpeditedVotingPeriod >= *v.VotingPeriod {
+		return fmt.Errorf("expedited voting period %s must be shorter than the voting period %s", v.ExpeditedVotingPeriod, v.VotingPeriod)
+	}
 
-func (gp Params) String() string {
-	return gp.VotingParams.String() + "\n" +
-		gp.TallyParams.String() + "\n" + gp.DepositParams.String()
+	return nil
 }
 
 // NewParams creates a new gov Params instance
@@ -195,3 +270,86 @@ func NewParams(vp VotingParams, tp TallyParams, dp DepositParams) Params {
 func DefaultParams() Params {
 	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams())
 }
+
+// Validate performs a basic validation of the governance params.
+func (gp Params) Validate() error {
+	if err := validateVotingParams(gp.VotingParams); err != nil {
+		return fmt.Errorf("invalid voting params: %w", err)
+	}
+
+	if err := validateTallyParams(gp.TallyParams); err != nil {
+		return fmt.Errorf("invalid tally params: %w", err)
+	}
+
+	if err := validateDepositParams(gp.DepositParams); err != nil {
+		return fmt.Errorf("invalid deposit params: %w", err)
+	}
+
+	return nil
+}
+
+// NewMessageBasedParams creates a new MessageBasedParams object. The nil params
+// fall back to the global governance params.
+func NewMessageBasedParams(msgURL string, dp *DepositParams, vp *VotingParams, tp *TallyParams) MessageBasedParams {
+	return MessageBasedParams{
+		MsgUrl:        msgURL,
+		DepositParams: dp,
+		VotingParams:  vp,
+		TallyParams:   tp,
+	}
+}
+
+// IsEmpty returns true if none of the deposit, voting and tally params are set.
+func (p MessageBasedParams) IsEmpty() bool {
+	return p.DepositParams == nil && p.VotingParams == nil && p.TallyParams == nil
+}
+
+// Validate performs a basic validation of the MessageBasedParams.
+func (p MessageBasedParams) Validate() error {
+	if p.MsgUrl == "" {
+		return errors.New("msg url cannot be empty")
+	}
+
+	if p.DepositParams != nil {
+		if err := validateDepositParams(*p.DepositParams); err != nil {
+			return fmt.Errorf("invalid deposit params of %s: %w", p.MsgUrl, err)
+		}
+	}
+
+	if p.VotingParams != nil {
+		if err := validateVotingParams(*p.VotingParams); err != nil {
+			return fmt.Errorf("invalid voting params of %s: %w", p.MsgUrl, err)
+		}
+	}
+
+	if p.TallyParams != nil {
+		if err := validateTallyParams(*p.TallyParams); err != nil {
+			return fmt.Errorf("invalid tally params of %s: %w", p.MsgUrl, err)
+		}
+	}
+
+	return nil
+}
+
+// stricterDec returns the decimal selected by pick among the two decimal strings. An
+// invalid string is ignored in favor of the other one.
+func stricterDec(a, b string, pick func(sdk.Dec, sdk.Dec) sdk.Dec) string {
+	decA, errA := sdk.NewDecFromStr(a)
+	decB, errB := sdk.NewDecFromStr(b)
+	switch {
+	case errA != nil:
+		return b
+	case errB != nil:
+		return a
+	default:
+		return pick(decA, decB).String()
+	}
+}
+
+// longerDuration returns the longest of the two durations, ignoring the nil ones.
+func longerDuration(a, b *time.Duration) *time.Duration {
+	if a == nil || (b != nil && *b > *a) {
+		return b
+	}
+	return a
+}
@@ -23,7 +23,7 @@ const (
 )
 
 // NewProposal creates a new Proposal instance
-func NewProposal(messages []sdk.Msg, id uint64, metadata []byte, submitTime, depositEndTime time.Time) (Proposal, error) {
+func NewProposal(messages []sdk.Msg, id uint64, metadata []byte, submitTime, depositEndTime time.Time, proposer sdk.AccAddress, expedited bool) (Proposal, error) {
 
 	msgs, err := sdktx.SetMsgs(messages)
 	if err != nil {
@@ -40,6 +40,8 @@ func NewProposal(messages []sdk.Msg, id uint64, metadata []byte, submitTime, dep
 		FinalTallyResult: &tally,
 		SubmitTime:       &submitTime,
 		DepositEndTime:   &depositEndTime,
+		Proposer:         proposer.String(),
+		Expedited:        expedited,
 	}
 
 	return p, nil
@@ -873,6 +873,202 @@ func (m *QueryTallyResultResponse) GetTally() *TallyResult {
 	return nil
 }
 
+// QueryMessageBasedParamsRequest is the request type for the
+// Query/MessageBasedParams RPC method.
+type QueryMessageBasedParamsRequest struct {
+	// msg_url defines the type URL of the Msg.
+	MsgUrl string `protobuf:"bytes,1,opt,name=msg_url,json=msgUrl,proto3" json:"msg_url,omitempty"`
+}
+
+func (m *QueryMessageBasedParamsRequest) Reset()         { *m = QueryMessageBasedParamsRequest{} }
+func (m *QueryMessageBasedParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMessageBasedParamsRequest) ProtoMessage()    {}
+func (*QueryMessageBasedParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3efdc0c4615c3665, []int{16}
+}
+func (m *QueryMessageBasedParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMessageBasedParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMessageBasedParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMessageBasedParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMessageBasedParamsRequest.Merge(m, src)
+}
+func (m *QueryMessageBasedParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMessageBasedParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMessageBasedParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMessageBasedParamsRequest proto.InternalMessageInfo
+
+func (m *QueryMessageBasedParamsRequest) GetMsgUrl() string {
+	if m != nil {
+		return m.MsgUrl
+	}
+	return ""
+}
+
+// QueryMessageBasedParamsResponse is the response type for the
+// Query/MessageBasedParams RPC method.
+type QueryMessageBasedParamsResponse struct {
+	// params defines the governance params of the Msg type.
+	Params *MessageBasedParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *QueryMessageBasedParamsResponse) Reset()         { *m = QueryMessageBasedParamsResponse{} }
+func (m *QueryMessageBasedParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMessageBasedParamsResponse) ProtoMessage()    {}
+func (*QueryMessageBasedParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3efdc0c4615c3665, []int{17}
+}
+func (m *QueryMessageBasedParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMessageBasedParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMessageBasedParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMessageBasedParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMessageBasedParamsResponse.Merge(m, src)
+}
+func (m *QueryMessageBasedParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMessageBasedParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMessageBasedParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMessageBasedParamsResponse proto.InternalMessageInfo
+
+func (m *QueryMessageBasedParamsResponse) GetParams() *MessageBasedParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// QueryAllMessageBasedParamsRequest is the request type for the
+// Query/AllMessageBasedParams RPC method.
+type QueryAllMessageBasedParamsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllMessageBasedParamsRequest) Reset()         { *m = QueryAllMessageBasedParamsRequest{} }
+func (m *QueryAllMessageBasedParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMessageBasedParamsRequest) ProtoMessage()    {}
+func (*QueryAllMessageBasedParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3efdc0c4615c3665, []int{18}
+}
+func (m *QueryAllMessageBasedParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMessageBasedParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMessageBasedParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllMessageBasedParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMessageBasedParamsRequest.Merge(m, src)
+}
+func (m *QueryAllMessageBasedParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMessageBasedParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMessageBasedParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllMessageBasedParamsRequest proto.InternalMessageInfo
+
+func (m *QueryAllMessageBasedParamsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllMessageBasedParamsResponse is the response type for the
+// Query/AllMessageBasedParams RPC method.
+type QueryAllMessageBasedParamsResponse struct {
+	Params []*MessageBasedParams `protobuf:"bytes,1,rep,name=params,proto3" json:"params,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllMessageBasedParamsResponse) Reset()         { *m = QueryAllMessageBasedParamsResponse{} }
+func (m *QueryAllMessageBasedParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMessageBasedParamsResponse) ProtoMessage()    {}
+func (*QueryAllMessageBasedParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3efdc0c4615c3665, []int{19}
+}
+func (m *QueryAllMessageBasedParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMessageBasedParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMessageBasedParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllMessageBasedParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMessageBasedParamsResponse.Merge(m, src)
+}
+func (m *QueryAllMessageBasedParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMessageBasedParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMessageBasedParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllMessageBasedParamsResponse proto.InternalMessageInfo
+
+func (m *QueryAllMessageBasedParamsResponse) GetParams() []*MessageBasedParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func (m *QueryAllMessageBasedParamsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryProposalRequest)(nil), "cosmos.gov.v1beta2.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.gov.v1beta2.QueryProposalResponse")
@@ -890,72 +1086,85 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1beta2.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1beta2.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1beta2.QueryTallyResultResponse")
+	proto.RegisterType((*QueryMessageBasedParamsRequest)(nil), "cosmos.gov.v1beta2.QueryMessageBasedParamsRequest")
+	proto.RegisterType((*QueryMessageBasedParamsResponse)(nil), "cosmos.gov.v1beta2.QueryMessageBasedParamsResponse")
+	proto.RegisterType((*QueryAllMessageBasedParamsRequest)(nil), "cosmos.gov.v1beta2.QueryAllMessageBasedParamsRequest")
+	proto.RegisterType((*QueryAllMessageBasedParamsResponse)(nil), "cosmos.gov.v1beta2.QueryAllMessageBasedParamsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1beta2/query.proto", fileDescriptor_3efdc0c4615c3665) }
 
 var fileDescriptor_3efdc0c4615c3665 = []byte{
-	// 955 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x38, 0x49, 0x1b, 0xbf, 0xb4, 0x01, 0x1e, 0x05, 0x8c, 0x29, 0x4e, 0x58, 0xd1, 0xd6,
-	0xa4, 0xcd, 0x6e, 0x93, 0xd0, 0x96, 0x16, 0x0e, 0x24, 0x82, 0x52, 0x40, 0x48, 0xa9, 0x5b, 0x71,
-	0xe0, 0x12, 0x6d, 0xe2, 0xd5, 0xb2, 0xc2, 0xd9, 0xd9, 0xee, 0x8c, 0x2d, 0xa2, 0x10, 0x21, 0x71,
-	0x43, 0x08, 0x09, 0x44, 0x85, 0xc4, 0xa1, 0x27, 0x24, 0x3e, 0x01, 0x1f, 0x82, 0x63, 0x05, 0x1c,
-	0x38, 0xa2, 0x84, 0x13, 0x9f, 0x02, 0xed, 0xcc, 0x9b, 0xf5, 0x6e, 0xb2, 0xf6, 0xda, 0xa5, 0xea,
-	0x29, 0x9a, 0x99, 0xdf, 0x7b, 0xef, 0xf7, 0xfe, 0xec, 0xef, 0xc5, 0xd0, 0xd8, 0xe6, 0x62, 0x87,
-	0x0b, 0xc7, 0xe7, 0x3d, 0xa7, 0xb7, 0xbc, 0xe5, 0x49, 0x77, 0xc5, 0xb9, 0xd7, 0xf5, 0xe2, 0x5d,
-	0x3b, 0x8a, 0xb9, 0xe4, 0x88, 0xfa, 0xdd, 0xf6, 0x79, 0xcf, 0xa6, 0xf7, 0xfa, 0x22, 0xd9, 0x6c,
-	0xb9, 0xc2, 0xd3, 0x60, 0x32, 0x5d, 0x76, 0x22, 0xd7, 0x0f, 0x42, 0x57, 0x06, 0x3c, 0xd4, 0xf6,
-	0xf5, 0xb3, 0x3e, 0xe7, 0x7e, 0xc7, 0x73, 0xdc, 0x28, 0x70, 0xdc, 0x30, 0xe4, 0x52, 0x3d, 0x0a,
-	0xf3, 0x5a, 0x10, 0x3d, 0x89, 0xa4, 0x5f, 0x5f, 0xd4, 0xaf, 0x9b, 0xea, 0xe4, 0x10, 0x11, 0x75,
-	0xb0, 0xae, 0xc1, 0x99, 0xdb, 0x49, 0xe0, 0x8d, 0x98, 0x47, 0x5c, 0xb8, 0x9d, 0x96, 0x77, 0xaf,
-	0xeb, 0x09, 0x89, 0xf3, 0x30, 0x1b, 0xd1, 0xd5, 0x66, 0xd0, 0xae, 0xb1, 0x05, 0xd6, 0x9c, 0x6a,
-	0x81, 0xb9, 0x7a, 0xbf, 0x6d, 0xdd, 0x86, 0xe7, 0x8e, 0x18, 0x8a, 0x88, 0x87, 0xc2, 0xc3, 0x37,
-	0x60, 0xc6, 0xc0, 0x94, 0xd9, 0xec, 0xca, 0x59, 0xfb, 0x78, 0xee, 0x76, 0x6a, 0x97, 0xa2, 0xad,
-	0xfb, 0x95, 0x23, 0x3e, 0x85, 0x61, 0xf3, 0x21, 0x3c, 0x95, 0xb2, 0x11, 0xd2, 0x95, 0x5d, 0xa1,
-	0x5c, 0xcf, 0xad, 0x58, 0xc3, 0x5c, 0xdf, 0x51, 0xc8, 0xd6, 0x5c, 0x94, 0x3b, 0xa3, 0x0d, 0xd3,
-	0x3d, 0x2e, 0xbd, 0xb8, 0x56, 0x59, 0x60, 0xcd, 0xea, 0x7a, 0xed, 0xf7, 0x5f, 0x97, 0xce, 0x90,
-	0x97, 0xb5, 0x76, 0x3b, 0xf6, 0x84, 0xb8, 0x23, 0xe3, 0x20, 0xf4, 0x5b, 0x1a, 0x86, 0x57, 0xa1,
-	0xda, 0xf6, 0x22, 0x2e, 0x02, 0xc9, 0xe3, 0xda, 0x64, 0x89, 0x4d, 0x1f, 0x8a, 0x37, 0x01, 0xfa,
-	0x5d, 0xac, 0x4d, 0xa9, 0x52, 0x9c, 0x37, 0x7c, 0x93, 0x96, 0xdb, 0x7a, 0x3e, 0xa8, 0xe5, 0xf6,
-	0x86, 0xeb, 0x7b, 0x94, 0x70, 0x2b, 0x63, 0x69, 0x3d, 0x60, 0xf0, 0xfc, 0xd1, 0xb2, 0x50, 0xad,
-	0x6f, 0x40, 0xd5, 0x24, 0x97, 0x54, 0x64, 0xb2, 0xb4, 0xd8, 0x7d, 0x38, 0xbe, 0x97, 0xa3, 0x57,
-	0x51, 0xf4, 0x2e, 0x94, 0xd2, 0xd3, 0x81, 0x73, 0xfc, 0xb6, 0xe1, 0x69, 0x45, 0xef, 0x63, 0x2e,
-	0xbd, 0x51, 0xc7, 0x67, 0xdc, 0x26, 0x58, 0x6b, 0xf0, 0x4c, 0x26, 0x08, 0xa5, 0x7f, 0x09, 0xa6,
-	0x92, 0x57, 0x1a, 0xb3, 0x5a, 0x51, 0xe6, 0x0a, 0xaf, 0x50, 0xd6, 0x17, 0x19, 0x17, 0x62, 0x64,
-	0xa2, 0x37, 0x0b, 0xca, 0xf4, 0x28, 0x5d, 0xfc, 0x96, 0x01, 0x66, 0xc3, 0x53, 0x0a, 0x54, 0x07,
-	0xd3, 0xbd, 0xc1, 0x39, 0x68, 0xd8, 0xe3, 0xeb, 0xda, 0x15, 0xa2, 0xb3, 0xe1, 0xc6, 0xee, 0x4e,
-	0xae, 0x1c, 0xea, 0x62, 0x53, 0xee, 0x46, 0xba, 0xb0, 0xd5, 0xc4, 0x2c, 0xb9, 0xba, 0xbb, 0x1b,
-	0x79, 0xd6, 0xbf, 0x0c, 0x9e, 0xcd, 0xd9, 0x51, 0x1e, 0xef, 0xc2, 0xe9, 0x1e, 0x97, 0x41, 0xe8,
-	0x6f, 0x6a, 0x30, 0xf5, 0x64, 0x61, 0x40, 0x3e, 0x41, 0xe8, 0x93, 0x83, 0x53, 0xbd, 0xcc, 0x09,
-	0x6f, 0xc1, 0x1c, 0x7d, 0x40, 0xc6, 0x8f, 0x4e, 0xf1, 0x95, 0x22, 0x3f, 0xef, 0x68, 0x24, 0x39,
-	0x3a, 0xdd, 0xce, 0x1e, 0x71, 0x1d, 0x4e, 0x49, 0xb7, 0xd3, 0xd9, 0x35, 0x7e, 0x26, 0x95, 0x9f,
-	0xf9, 0x22, 0x3f, 0x77, 0x13, 0x1c, 0x79, 0x99, 0x95, 0xfd, 0x83, 0x15, 0x52, 0xae, 0x14, 0x68,
-	0xe4, 0x99, 0xc9, 0x29, 0x46, 0x65, 0x64, 0xc5, 0xb0, 0x3e, 0x22, 0x31, 0x4e, 0xe3, 0x51, 0x71,
-	0xaf, 0xc0, 0x49, 0x02, 0x51, 0x59, 0x5f, 0x1a, 0x52, 0x8e, 0x96, 0xc1, 0x5a, 0x5f, 0xe6, 0xdd,
-	0x3d, 0xf9, 0x99, 0xff, 0x89, 0x91, 0xa0, 0xf7, 0x19, 0x50, 0x46, 0xd7, 0x60, 0x86, 0x58, 0x9a,
-	0xc9, 0x1f, 0x9a, 0x52, 0x0a, 0x7e, 0x7c, 0xf3, 0x7f, 0x03, 0x5e, 0x50, 0xd4, 0x54, 0xf3, 0x5b,
-	0x9e, 0xe8, 0x76, 0xe4, 0x18, 0xbb, 0xaf, 0x76, 0xdc, 0x36, 0xed, 0xd5, 0xb4, 0x1a, 0x21, 0xea,
-	0xd4, 0xe0, 0x81, 0x23, 0x3b, 0x8d, 0x5e, 0xf9, 0xb3, 0x0a, 0xd3, 0xca, 0x27, 0xde, 0x67, 0x30,
-	0x63, 0xf4, 0x1a, 0x9b, 0x45, 0xe6, 0x45, 0x0b, 0xbb, 0xfe, 0xda, 0x08, 0x48, 0x4d, 0xd1, 0x5a,
-	0xfd, 0xea, 0x8f, 0x7f, 0x7e, 0xa8, 0x2c, 0xe1, 0x45, 0xa7, 0xe0, 0xbf, 0x86, 0x74, 0x41, 0x38,
-	0x7b, 0x99, 0x22, 0xec, 0xe3, 0xd7, 0x0c, 0xaa, 0xe9, 0x02, 0xc2, 0xf2, 0x68, 0x66, 0xda, 0xea,
-	0x8b, 0xa3, 0x40, 0x89, 0xd9, 0x39, 0xc5, 0x6c, 0x1e, 0x5f, 0x1e, 0xca, 0x0c, 0x7f, 0x64, 0x30,
-	0x95, 0x88, 0x22, 0xbe, 0x3a, 0xd0, 0x77, 0x66, 0x19, 0xd5, 0xcf, 0x95, 0xa0, 0x28, 0xf8, 0x9a,
-	0x0a, 0xfe, 0x26, 0x5e, 0x1f, 0xa3, 0x2c, 0x8e, 0x52, 0x65, 0x67, 0x4f, 0x2d, 0xa9, 0x7d, 0xfc,
-	0x9e, 0xc1, 0xb4, 0xd2, 0x77, 0x1c, 0x1e, 0x33, 0x2d, 0xce, 0xf9, 0x32, 0x18, 0x71, 0xbb, 0xae,
-	0xb8, 0xad, 0xe2, 0xf2, 0xd8, 0xdc, 0xf0, 0x1b, 0x06, 0x27, 0x48, 0x13, 0x07, 0x47, 0xcb, 0x6d,
-	0x81, 0xfa, 0x85, 0x52, 0x1c, 0xd1, 0xba, 0xac, 0x68, 0x2d, 0x62, 0xb3, 0x90, 0x96, 0xc2, 0x3a,
-	0x7b, 0x99, 0x85, 0xb2, 0x8f, 0xbf, 0x30, 0x38, 0x49, 0x5f, 0x35, 0x0e, 0x0e, 0x93, 0x17, 0xdc,
-	0x7a, 0xb3, 0x1c, 0x48, 0x84, 0x6e, 0x29, 0x42, 0xeb, 0xf8, 0xf6, 0x38, 0x75, 0x32, 0xe2, 0xe2,
-	0xec, 0xa5, 0x52, 0xbc, 0x8f, 0x0f, 0x18, 0xcc, 0x18, 0xd9, 0xc2, 0x52, 0x02, 0xa2, 0xfc, 0x33,
-	0x3c, 0xaa, 0x81, 0xd6, 0x5b, 0x8a, 0xeb, 0x55, 0x7c, 0xfd, 0x51, 0xb8, 0xe2, 0xcf, 0x0c, 0x66,
-	0x33, 0x3a, 0x82, 0x17, 0x07, 0x06, 0x3e, 0xae, 0x70, 0xf5, 0x4b, 0xa3, 0x81, 0xff, 0xcf, 0xf0,
-	0x29, 0x59, 0x5b, 0xff, 0xe0, 0xb7, 0x83, 0x06, 0x7b, 0x78, 0xd0, 0x60, 0x7f, 0x1f, 0x34, 0xd8,
-	0x77, 0x87, 0x8d, 0x89, 0x87, 0x87, 0x8d, 0x89, 0xbf, 0x0e, 0x1b, 0x13, 0x9f, 0x5c, 0xf6, 0x03,
-	0xf9, 0x69, 0x77, 0xcb, 0xde, 0xe6, 0x3b, 0xc6, 0xad, 0xfe, 0xb3, 0x24, 0xda, 0x9f, 0x39, 0x9f,
-	0xab, 0x18, 0xc9, 0xc8, 0x08, 0x13, 0x69, 0xeb, 0x84, 0xfa, 0xc5, 0xb2, 0xfa, 0x5f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xb2, 0x31, 0x71, 0x47, 0x6a, 0x0d, 0x00, 0x00,
+	// 1097 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xdf, 0x6f, 0xdb, 0x54,
+	0x14, 0xee, 0x4d, 0x7f, 0xe6, 0xb4, 0x2b, 0x70, 0x68, 0x59, 0x30, 0x23, 0xed, 0x2c, 0xd6, 0x85,
+	0x6e, 0x8d, 0xd7, 0x74, 0xed, 0x68, 0x41, 0x88, 0x46, 0x30, 0x06, 0x68, 0x52, 0x97, 0x0d, 0x1e,
+	0x78, 0x89, 0xdc, 0xc6, 0x32, 0xd1, 0x12, 0x5f, 0xcf, 0xd7, 0x89, 0xa8, 0x4a, 0x84, 0xc4, 0x1b,
+	0x42, 0x48, 0x20, 0x26, 0x24, 0x1e, 0xf6, 0x84, 0xc4, 0x1b, 0x6f, 0x3c, 0xc0, 0x7f, 0x80, 0xc4,
+	0x4b, 0x05, 0x2f, 0x3c, 0xa2, 0x96, 0x27, 0xfe, 0x0a, 0xe4, 0xeb, 0xe3, 0xd4, 0x4e, 0xed, 0x38,
+	0x29, 0x15, 0x4f, 0xd1, 0xf5, 0xfd, 0xce, 0xf7, 0x7d, 0xe7, 0xdc, 0x73, 0x7d, 0x1c, 0xc8, 0xef,
+	0x71, 0xd1, 0xe4, 0x42, 0x33, 0x79, 0x5b, 0x6b, 0xaf, 0xee, 0x1a, 0xae, 0x5e, 0xd2, 0x1e, 0xb5,
+	0x0c, 0x67, 0xbf, 0x68, 0x3b, 0xdc, 0xe5, 0x88, 0xfe, 0x7e, 0xd1, 0xe4, 0xed, 0x22, 0xed, 0x2b,
+	0xcb, 0x14, 0xb3, 0xab, 0x0b, 0xc3, 0x07, 0x53, 0xe8, 0xaa, 0x66, 0xeb, 0x66, 0xdd, 0xd2, 0xdd,
+	0x3a, 0xb7, 0xfc, 0x78, 0xe5, 0x92, 0xc9, 0xb9, 0xd9, 0x30, 0x34, 0xdd, 0xae, 0x6b, 0xba, 0x65,
+	0x71, 0x57, 0x6e, 0x8a, 0x60, 0x37, 0x46, 0xdd, 0x53, 0xf2, 0x77, 0x9f, 0xf7, 0x77, 0xab, 0x72,
+	0xa5, 0x91, 0x11, 0xb9, 0x50, 0x6f, 0xc1, 0xdc, 0x3d, 0x4f, 0x78, 0xc7, 0xe1, 0x36, 0x17, 0x7a,
+	0xa3, 0x62, 0x3c, 0x6a, 0x19, 0xc2, 0xc5, 0x05, 0x98, 0xb6, 0xe9, 0x51, 0xb5, 0x5e, 0xcb, 0xb1,
+	0x45, 0x56, 0x18, 0xab, 0x40, 0xf0, 0xe8, 0x9d, 0x9a, 0x7a, 0x0f, 0xe6, 0x7b, 0x02, 0x85, 0xcd,
+	0x2d, 0x61, 0xe0, 0x2b, 0x30, 0x15, 0xc0, 0x64, 0xd8, 0x74, 0xe9, 0x52, 0xf1, 0x74, 0xee, 0xc5,
+	0x6e, 0x5c, 0x17, 0xad, 0x3e, 0xce, 0xf4, 0x70, 0x8a, 0xc0, 0xcd, 0x7b, 0xf0, 0x54, 0xd7, 0x8d,
+	0x70, 0x75, 0xb7, 0x25, 0x24, 0xf5, 0x6c, 0x49, 0xed, 0x47, 0x7d, 0x5f, 0x22, 0x2b, 0xb3, 0x76,
+	0x64, 0x8d, 0x45, 0x18, 0x6f, 0x73, 0xd7, 0x70, 0x72, 0x99, 0x45, 0x56, 0xc8, 0x96, 0x73, 0xbf,
+	0xff, 0xb4, 0x32, 0x47, 0x2c, 0xdb, 0xb5, 0x9a, 0x63, 0x08, 0x71, 0xdf, 0x75, 0xea, 0x96, 0x59,
+	0xf1, 0x61, 0xb8, 0x01, 0xd9, 0x9a, 0x61, 0x73, 0x51, 0x77, 0xb9, 0x93, 0x1b, 0x4d, 0x89, 0x39,
+	0x81, 0xe2, 0x6d, 0x80, 0x93, 0x53, 0xcc, 0x8d, 0xc9, 0x52, 0x2c, 0x05, 0x7e, 0xbd, 0x23, 0x2f,
+	0xfa, 0xfd, 0x41, 0x47, 0x5e, 0xdc, 0xd1, 0x4d, 0x83, 0x12, 0xae, 0x84, 0x22, 0xd5, 0x27, 0x0c,
+	0x9e, 0xeb, 0x2d, 0x0b, 0xd5, 0x7a, 0x0b, 0xb2, 0x41, 0x72, 0x5e, 0x45, 0x46, 0x53, 0x8b, 0x7d,
+	0x02, 0xc7, 0xb7, 0x23, 0xf6, 0x32, 0xd2, 0xde, 0xd5, 0x54, 0x7b, 0xbe, 0x70, 0xc4, 0xdf, 0x1e,
+	0x3c, 0x2d, 0xed, 0x7d, 0xc0, 0x5d, 0x63, 0xd0, 0xf6, 0x19, 0xf6, 0x10, 0xd4, 0x6d, 0x78, 0x26,
+	0x24, 0x42, 0xe9, 0x5f, 0x87, 0x31, 0x6f, 0x97, 0xda, 0x2c, 0x17, 0x97, 0xb9, 0xc4, 0x4b, 0x94,
+	0xfa, 0x49, 0x88, 0x42, 0x0c, 0x6c, 0xf4, 0x76, 0x4c, 0x99, 0xce, 0x72, 0x8a, 0x5f, 0x32, 0xc0,
+	0xb0, 0x3c, 0xa5, 0x40, 0x75, 0x08, 0x4e, 0x2f, 0x39, 0x07, 0x1f, 0x76, 0x7e, 0xa7, 0xb6, 0x4e,
+	0x76, 0x76, 0x74, 0x47, 0x6f, 0x46, 0xca, 0x21, 0x1f, 0x54, 0xdd, 0x7d, 0xdb, 0x2f, 0x6c, 0xb6,
+	0x02, 0xfe, 0xa3, 0x07, 0xfb, 0xb6, 0xa1, 0xfe, 0xc3, 0xe0, 0xd9, 0x48, 0x1c, 0xe5, 0xf1, 0x16,
+	0x5c, 0x68, 0x73, 0xb7, 0x6e, 0x99, 0x55, 0x1f, 0x4c, 0x67, 0xb2, 0x98, 0x90, 0x4f, 0xdd, 0x32,
+	0x89, 0x60, 0xa6, 0x1d, 0x5a, 0xe1, 0x1d, 0x98, 0xa5, 0x0b, 0x14, 0xf0, 0xf8, 0x29, 0x5e, 0x8e,
+	0xe3, 0x79, 0xd3, 0x47, 0x12, 0xd1, 0x85, 0x5a, 0x78, 0x89, 0x65, 0x98, 0x71, 0xf5, 0x46, 0x63,
+	0x3f, 0xe0, 0x19, 0x95, 0x3c, 0x0b, 0x71, 0x3c, 0x0f, 0x3c, 0x1c, 0xb1, 0x4c, 0xbb, 0x27, 0x0b,
+	0xd5, 0xa2, 0x5c, 0x49, 0x68, 0xe0, 0x9e, 0x89, 0xbc, 0x31, 0x32, 0x03, 0xbf, 0x31, 0xd4, 0xbb,
+	0x30, 0x17, 0xd5, 0xa3, 0xe2, 0xae, 0xc3, 0x24, 0x81, 0xa8, 0xac, 0x2f, 0xf4, 0x29, 0x47, 0x25,
+	0xc0, 0xaa, 0x9f, 0x46, 0xe9, 0xfe, 0xff, 0x9e, 0xff, 0x8e, 0xc1, 0x7c, 0x8f, 0x03, 0xca, 0xe8,
+	0x16, 0x4c, 0x91, 0xcb, 0xa0, 0xf3, 0xfb, 0xa6, 0xd4, 0x05, 0x9f, 0x5f, 0xff, 0x6f, 0xc1, 0x45,
+	0x69, 0x4d, 0x1e, 0x7e, 0xc5, 0x10, 0xad, 0x86, 0x3b, 0xc4, 0xec, 0xcb, 0x9d, 0x8e, 0xed, 0x9e,
+	0xd5, 0xb8, 0x6c, 0xa1, 0x1c, 0x4b, 0x69, 0x38, 0x8a, 0xf3, 0xd1, 0xea, 0x26, 0xe4, 0x25, 0xe5,
+	0x5d, 0x43, 0x08, 0xdd, 0x34, 0xca, 0xba, 0x30, 0x6a, 0xd1, 0xab, 0x79, 0x11, 0x26, 0x9b, 0xc2,
+	0xac, 0xb6, 0x9c, 0x06, 0x5d, 0xcb, 0x89, 0xa6, 0x30, 0xdf, 0x77, 0x1a, 0xaa, 0x0e, 0x0b, 0x89,
+	0xa1, 0x64, 0xea, 0x75, 0x98, 0x88, 0x5c, 0xcb, 0xa5, 0x38, 0x57, 0x31, 0xf1, 0x14, 0xa5, 0x3e,
+	0x84, 0xcb, 0x52, 0x62, 0xbb, 0xd1, 0x48, 0x36, 0x18, 0xed, 0x1a, 0x76, 0xe6, 0xae, 0xf9, 0x91,
+	0x81, 0xda, 0x4f, 0x2d, 0x26, 0xa7, 0xd1, 0xe1, 0x73, 0x3a, 0xb7, 0x4e, 0x2a, 0xfd, 0x36, 0x03,
+	0xe3, 0xd2, 0x2f, 0x3e, 0x66, 0x30, 0x15, 0x8c, 0x5a, 0x2c, 0xc4, 0xf9, 0x89, 0xfb, 0xd6, 0x52,
+	0x5e, 0x1e, 0x00, 0xe9, 0xeb, 0xaa, 0x6b, 0x9f, 0xfd, 0xf1, 0xf7, 0x37, 0x99, 0x15, 0xbc, 0xa6,
+	0xc5, 0x7c, 0xf0, 0x75, 0x67, 0xbb, 0x76, 0x10, 0xea, 0xdf, 0x0e, 0x7e, 0xce, 0x20, 0x1b, 0x30,
+	0x09, 0x4c, 0x57, 0x0b, 0x4e, 0x54, 0x59, 0x1e, 0x04, 0x4a, 0xce, 0xae, 0x48, 0x67, 0x0b, 0xf8,
+	0x62, 0x5f, 0x67, 0xf8, 0x2d, 0x83, 0x31, 0x6f, 0x9e, 0xe1, 0x4b, 0x89, 0xdc, 0xa1, 0xef, 0x08,
+	0xe5, 0x4a, 0x0a, 0x8a, 0xc4, 0xb7, 0xa5, 0xf8, 0xab, 0xb8, 0x39, 0x44, 0x59, 0x34, 0x39, 0x50,
+	0xb5, 0x03, 0xef, 0xc7, 0xe9, 0xe0, 0xd7, 0x0c, 0xc6, 0x3d, 0x4e, 0x81, 0xfd, 0x35, 0xbb, 0xc5,
+	0x59, 0x4a, 0x83, 0x91, 0xb7, 0x4d, 0xe9, 0x6d, 0x0d, 0x57, 0x87, 0xf6, 0x86, 0x5f, 0x30, 0x98,
+	0xa0, 0x71, 0x96, 0xac, 0x16, 0xb9, 0x84, 0xca, 0xd5, 0x54, 0x1c, 0xd9, 0xba, 0x21, 0x6d, 0x2d,
+	0x63, 0x21, 0xd6, 0x96, 0xc4, 0x6a, 0x07, 0xa1, 0x6f, 0x81, 0x0e, 0xfe, 0xc0, 0x60, 0x92, 0x5e,
+	0xc8, 0x98, 0x2c, 0x13, 0x9d, 0x95, 0x4a, 0x21, 0x1d, 0x48, 0x86, 0xee, 0x48, 0x43, 0x65, 0x7c,
+	0x63, 0x98, 0x3a, 0x05, 0x73, 0x41, 0x3b, 0xe8, 0x4e, 0xd1, 0x0e, 0x3e, 0x61, 0x30, 0x45, 0xec,
+	0x02, 0x53, 0x0d, 0x88, 0xf4, 0x6b, 0xd8, 0x3b, 0xbe, 0xd4, 0xd7, 0xa4, 0xd7, 0x0d, 0xbc, 0x79,
+	0x16, 0xaf, 0xf8, 0x3d, 0x83, 0xe9, 0xd0, 0x08, 0xc0, 0x6b, 0x89, 0xc2, 0xa7, 0x87, 0x93, 0x72,
+	0x7d, 0x30, 0xf0, 0x7f, 0x69, 0x3e, 0x39, 0x91, 0xf0, 0x17, 0x06, 0x78, 0xfa, 0xf5, 0x89, 0xa5,
+	0x44, 0xfd, 0xc4, 0xc9, 0xa0, 0xac, 0x0d, 0x15, 0x43, 0xd6, 0xb7, 0xa4, 0xf5, 0x9b, 0x58, 0x8a,
+	0xb3, 0xde, 0xf4, 0xe3, 0xaa, 0xde, 0x7b, 0xba, 0x56, 0x0d, 0xda, 0x95, 0xe6, 0x63, 0x07, 0x7f,
+	0x66, 0x30, 0x1f, 0x3b, 0x3d, 0x70, 0x3d, 0xd1, 0x4a, 0xbf, 0xd9, 0xa6, 0x6c, 0x0c, 0x1b, 0x36,
+	0xc8, 0x2d, 0x8b, 0x4b, 0xa2, 0xfc, 0xee, 0xaf, 0x47, 0x79, 0x76, 0x78, 0x94, 0x67, 0x7f, 0x1d,
+	0xe5, 0xd9, 0x57, 0xc7, 0xf9, 0x91, 0xc3, 0xe3, 0xfc, 0xc8, 0x9f, 0xc7, 0xf9, 0x91, 0x0f, 0x6f,
+	0x98, 0x75, 0xf7, 0xa3, 0xd6, 0x6e, 0x71, 0x8f, 0x37, 0x03, 0x36, 0xff, 0x67, 0x45, 0xd4, 0x1e,
+	0x6a, 0x1f, 0x4b, 0x6a, 0xef, 0xa6, 0x8a, 0x40, 0x60, 0x77, 0x42, 0xfe, 0xc7, 0x5f, 0xfb, 0x77,
+	0x00, 0x41, 0x44, 0x69, 0xe4, 0x9c, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -986,6 +1195,11 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// MessageBasedParams queries the governance params of a Msg type.
+	MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error)
+	// AllMessageBasedParams queries the governance params of all the Msg types
+	// which have some.
+	AllMessageBasedParams(ctx context.Context, in *QueryAllMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryAllMessageBasedParamsResponse, error)
 }
 
 type queryClient struct {
@@ -1068,6 +1282,24 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error) {
+	out := new(QueryMessageBasedParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1beta2.Query/MessageBasedParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllMessageBasedParams(ctx context.Context, in *QueryAllMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryAllMessageBasedParamsResponse, error) {
+	out := new(QueryAllMessageBasedParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1beta2.Query/AllMessageBasedParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Proposal queries proposal details based on ProposalID.
@@ -1086,6 +1318,11 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// MessageBasedParams queries the governance params of a Msg type.
+	MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error)
+	// AllMessageBasedParams queries the governance params of all the Msg types
+	// which have some.
+	AllMessageBasedParams(context.Context, *QueryAllMessageBasedParamsRequest) (*QueryAllMessageBasedParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1116,6 +1353,12 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) MessageBasedParams(ctx context.Context, req *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageBasedParams not implemented")
+}
+func (*UnimplementedQueryServer) AllMessageBasedParams(ctx context.Context, req *QueryAllMessageBasedParamsRequest) (*QueryAllMessageBasedParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllMessageBasedParams not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1265,6 +1508,42 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MessageBasedParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMessageBasedParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MessageBasedParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1beta2.Query/MessageBasedParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MessageBasedParams(ctx, req.(*QueryMessageBasedParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllMessageBasedParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMessageBasedParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllMessageBasedParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1beta2.Query/AllMessageBasedParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllMessageBasedParams(ctx, req.(*QueryAllMessageBasedParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1beta2.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1301,6 +1580,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "MessageBasedParams",
+			Handler:    _Query_MessageBasedParams_Handler,
+		},
+		{
+			MethodName: "AllMessageBasedParams",
+			Handler:    _Query_AllMessageBasedParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1beta2/query.proto",
@@ -1942,87 +2229,236 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryMessageBasedParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
-	}
-	return n
+
+func (m *QueryMessageBasedParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMessageBasedParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Proposal != nil {
-		l = m.Proposal.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.MsgUrl) > 0 {
+		i -= len(m.MsgUrl)
+		copy(dAtA[i:], m.MsgUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgUrl)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalStatus != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalStatus))
-	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryMessageBasedParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryProposalsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMessageBasedParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMessageBasedParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Proposals) > 0 {
-		for _, e := range m.Proposals {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryVoteRequest) Size() (n int) {
-	if m == nil {
+func (m *QueryAllMessageBasedParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMessageBasedParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMessageBasedParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMessageBasedParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMessageBasedParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMessageBasedParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Params[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != nil {
+		l = m.Proposal.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalStatus != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalStatus))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteRequest) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -2208,6 +2644,64 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryMessageBasedParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMessageBasedParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMessageBasedParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMessageBasedParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Params) > 0 {
+		for _, e := range m.Params {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3877,6 +4371,380 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryMessageBasedParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMessageBasedParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMessageBasedParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMessageBasedParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMessageBasedParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMessageBasedParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &MessageBasedParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMessageBasedParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMessageBasedParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMessageBasedParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMessageBasedParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMessageBasedParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMessageBasedParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params, &MessageBasedParams{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
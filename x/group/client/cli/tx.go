@@ -45,6 +45,7 @@ func TxCmd(name string) *cobra.Command {
 		MsgCreateProposalCmd(),
 		MsgVoteCmd(),
 		MsgExecCmd(),
+		MsgLeaveGroupCmd(),
 	)
 
 	return txCmd
@@ -296,11 +297,17 @@ func MsgCreateGroupPolicyCmd() *cobra.Command {
 			fmt.Sprintf(`Create a group policy which is an account associated with a group and a decision policy.
 Note, the '--from' flag is ignored as it is implied from [admin].
 
-Example:
+Examples:
+$ %s tx group create-group-policy [admin] [group-id] [metadata] \
+'{"@type":"/cosmos.group.v1beta1.ThresholdDecisionPolicy", "threshold":"1", "timeout":"1s", "min_execution_period":"0s"}'
+
 $ %s tx group create-group-policy [admin] [group-id] [metadata] \
-'{"@type":"/cosmos.group.v1beta1.ThresholdDecisionPolicy", "threshold":"1", "timeout":"1s"}'
+'{"@type":"/cosmos.group.v1beta1.PercentageDecisionPolicy", "percentage":"0.5", "timeout":"1s", "min_execution_period":"0s"}'
+
+$ %s tx group create-group-policy [admin] [group-id] [metadata] \
+'{"@type":"/cosmos.group.v1beta1.QuorumDecisionPolicy", "quorum":"0.4", "threshold":"0.5", "veto_threshold":"0.334", "timeout":"1s", "min_execution_period":"0s"}'
 `,
-				version.AppName,
+				version.AppName, version.AppName, version.AppName,
 			),
 		),
 		Args: cobra.ExactArgs(4),
@@ -392,7 +399,19 @@ func MsgUpdateGroupPolicyDecisionPolicyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-group-policy-decision-policy [admin] [group-policy-account] [decision-policy]",
 		Short: "Update a group policy's decision policy",
-		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update a group policy's decision policy.
+The decision policy is a threshold, percentage or quorum decision policy.
+Note, the '--from' flag is ignored as it is implied from [admin].
+
+Example:
+$ %s tx group update-group-policy-decision-policy [admin] [group-policy-account] \
+'{"@type":"/cosmos.group.v1beta1.PercentageDecisionPolicy", "percentage":"0.5", "timeout":"1s", "min_execution_period":"0s"}'
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			err := cmd.Flags().Set(flags.FlagFrom, args[0])
 			if err != nil {
@@ -699,3 +718,53 @@ func MsgExecCmd() *cobra.Command {
 
 	return cmd
 }
+
+// MsgLeaveGroupCmd creates a CLI command for Msg/LeaveGroup.
+func MsgLeaveGroupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "leave-group [member-address] [group-id]",
+		Short: "Remove a member from a group",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove a member from a group. The member's weight is removed from the group total
+weight, and the command fails if a decision policy of the group becomes invalid.
+Note, the '--from' flag is ignored as it is implied from [member-address].
+
+Example:
+$ %s tx group leave-group [member-address] [group-id]
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmd.Flags().Set(flags.FlagFrom, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgLeaveGroup{
+				Address: clientCtx.GetFromAddress().String(),
+				GroupId: groupID,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
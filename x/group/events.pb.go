@@ -349,6 +349,8 @@ func (m *EventVote) GetProposalId() uint64 {
 type EventExec struct {
 	// proposal_id is the unique ID of the proposal.
 	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// result is the proposal execution result.
+	Result Proposal_ExecutorResult `protobuf:"varint,2,opt,name=result,proto3,enum=cosmos.group.v1beta1.Proposal_ExecutorResult" json:"result,omitempty"`
 }
 
 func (m *EventExec) Reset()         { *m = EventExec{} }
@@ -391,6 +393,68 @@ func (m *EventExec) GetProposalId() uint64 {
 	return 0
 }
 
+func (m *EventExec) GetResult() Proposal_ExecutorResult {
+	if m != nil {
+		return m.Result
+	}
+	return ProposalExecutorResultInvalid
+}
+
+// EventLeaveGroup is an event emitted when a group member leaves the group.
+type EventLeaveGroup struct {
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// address is the account address of the group member.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *EventLeaveGroup) Reset()         { *m = EventLeaveGroup{} }
+func (m *EventLeaveGroup) String() string { return proto.CompactTextString(m) }
+func (*EventLeaveGroup) ProtoMessage()    {}
+func (*EventLeaveGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7879e051fb126fc0, []int{8}
+}
+func (m *EventLeaveGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventLeaveGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLeaveGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventLeaveGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLeaveGroup.Merge(m, src)
+}
+func (m *EventLeaveGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLeaveGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLeaveGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLeaveGroup proto.InternalMessageInfo
+
+func (m *EventLeaveGroup) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *EventLeaveGroup) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventCreateGroup)(nil), "cosmos.group.v1beta1.EventCreateGroup")
 	proto.RegisterType((*EventUpdateGroup)(nil), "cosmos.group.v1beta1.EventUpdateGroup")
@@ -400,31 +464,36 @@ func init() {
 	proto.RegisterType((*EventWithdrawProposal)(nil), "cosmos.group.v1beta1.EventWithdrawProposal")
 	proto.RegisterType((*EventVote)(nil), "cosmos.group.v1beta1.EventVote")
 	proto.RegisterType((*EventExec)(nil), "cosmos.group.v1beta1.EventExec")
+	proto.RegisterType((*EventLeaveGroup)(nil), "cosmos.group.v1beta1.EventLeaveGroup")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1beta1/events.proto", fileDescriptor_7879e051fb126fc0) }
 
 var fileDescriptor_7879e051fb126fc0 = []byte{
-	// 295 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2f, 0xca, 0x2f, 0x2d, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81,
-	0x28, 0xd1, 0x03, 0x2b, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x84, 0x88, 0xc6, 0x83, 0xd5, 0xe8, 0x43,
-	0x95, 0x80, 0x39, 0x4a, 0xba, 0x5c, 0x02, 0xae, 0x20, 0x03, 0x9c, 0x8b, 0x52, 0x13, 0x4b, 0x52,
-	0xdd, 0x41, 0xda, 0x84, 0x24, 0xb9, 0x38, 0xc0, 0xfa, 0xe3, 0x33, 0x53, 0x24, 0x18, 0x15, 0x18,
-	0x35, 0x58, 0x82, 0xd8, 0xc1, 0x7c, 0xcf, 0x14, 0xb8, 0xf2, 0xd0, 0x82, 0x14, 0x62, 0x94, 0xfb,
-	0x70, 0x89, 0xa1, 0x9b, 0x1e, 0x90, 0x9f, 0x93, 0x99, 0x5c, 0x29, 0x64, 0xc4, 0xc5, 0x9e, 0x98,
-	0x92, 0x52, 0x94, 0x5a, 0x5c, 0x0c, 0xd6, 0xc3, 0xe9, 0x24, 0x71, 0x69, 0x8b, 0x2e, 0xcc, 0xf5,
-	0x8e, 0x10, 0x99, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0xf4, 0x20, 0x98, 0x42, 0xb8, 0x69, 0x48, 0x96,
-	0x53, 0x60, 0x9a, 0x19, 0x97, 0x30, 0x92, 0xdb, 0x02, 0x8a, 0xf2, 0x0b, 0xf2, 0x8b, 0x13, 0x73,
-	0x84, 0xe4, 0xb9, 0xb8, 0x0b, 0xa0, 0x6c, 0x84, 0x87, 0xb8, 0x60, 0x42, 0x9e, 0x29, 0x4a, 0x16,
-	0x5c, 0xa2, 0x60, 0x7d, 0xe1, 0x99, 0x25, 0x19, 0x29, 0x45, 0x89, 0xe5, 0xc4, 0xeb, 0xd4, 0xe1,
-	0xe2, 0x04, 0xeb, 0x0c, 0xcb, 0x2f, 0x49, 0x25, 0x5e, 0xb5, 0x6b, 0x45, 0x6a, 0x32, 0x41, 0xd5,
-	0x4e, 0x76, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84,
-	0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x92, 0x9e, 0x59,
-	0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0x0b, 0x8d, 0x7a, 0x28, 0xa5, 0x5b, 0x9c, 0x92, 0xad,
-	0x5f, 0x01, 0x49, 0x4d, 0x49, 0x6c, 0xe0, 0xe4, 0x60, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xcc,
-	0x13, 0x16, 0xd0, 0x64, 0x02, 0x00, 0x00,
+	// 364 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x4f, 0x4b, 0x3a, 0x41,
+	0x1c, 0xc6, 0x5d, 0xf9, 0xa1, 0x3f, 0xbf, 0x41, 0xc5, 0xf6, 0x07, 0xf5, 0xb0, 0x99, 0x74, 0xf0,
+	0xd0, 0xce, 0xa2, 0x41, 0x74, 0x0a, 0x32, 0x24, 0x04, 0x0f, 0xb2, 0x51, 0x41, 0x17, 0x5b, 0x77,
+	0x06, 0x5d, 0x52, 0x67, 0x98, 0x99, 0x35, 0x7d, 0x17, 0xbd, 0x98, 0x5e, 0x44, 0x47, 0xe9, 0xd4,
+	0x31, 0xf4, 0x8d, 0x84, 0xb3, 0xb3, 0x26, 0x21, 0x28, 0x74, 0xda, 0x9d, 0xd9, 0xcf, 0xf3, 0x7c,
+	0xff, 0xec, 0x03, 0xc7, 0x3e, 0x15, 0x7d, 0x2a, 0x9c, 0x0e, 0xa7, 0x21, 0x73, 0x86, 0xe5, 0x36,
+	0x91, 0x5e, 0xd9, 0x21, 0x43, 0x32, 0x90, 0x02, 0x31, 0x4e, 0x25, 0x35, 0xf7, 0x23, 0x04, 0x29,
+	0x04, 0x69, 0x24, 0x9f, 0x8b, 0x6e, 0x5b, 0x8a, 0x71, 0x34, 0xa2, 0x0e, 0xf9, 0xc2, 0x4a, 0x4f,
+	0x39, 0x66, 0x44, 0x13, 0x45, 0x1b, 0x76, 0x6b, 0xf3, 0x12, 0xd7, 0x9c, 0x78, 0x92, 0xdc, 0xcc,
+	0x39, 0x33, 0x07, 0xff, 0x95, 0xa0, 0x15, 0xe0, 0xac, 0x51, 0x30, 0x4a, 0xff, 0xdc, 0xb4, 0x3a,
+	0xd7, 0xf1, 0x02, 0xbf, 0x63, 0x78, 0x13, 0xbc, 0x01, 0x87, 0xbf, 0xdd, 0x9b, 0xb4, 0x17, 0xf8,
+	0x63, 0xb3, 0x02, 0x69, 0x0f, 0x63, 0x4e, 0x84, 0x50, 0x9a, 0x4c, 0x35, 0xfb, 0xf1, 0x66, 0xc7,
+	0xf3, 0x5d, 0x45, 0x5f, 0x6e, 0x25, 0x0f, 0x06, 0x1d, 0x37, 0x06, 0x17, 0x6e, 0x4b, 0xc5, 0xff,
+	0xe0, 0x76, 0x0e, 0x7b, 0x4b, 0xbd, 0x35, 0x39, 0x65, 0x54, 0x78, 0x3d, 0xf3, 0x08, 0xb6, 0x98,
+	0x7e, 0xff, 0x19, 0x08, 0xe2, 0xab, 0x3a, 0x2e, 0x5e, 0xc0, 0x81, 0xd2, 0x3d, 0x04, 0xb2, 0x8b,
+	0xb9, 0xf7, 0xb2, 0xb9, 0xf2, 0x14, 0x32, 0x4a, 0x79, 0x4f, 0x25, 0x59, 0x4f, 0x0b, 0x4d, 0xd7,
+	0x46, 0xc4, 0x5f, 0x4b, 0x9b, 0x35, 0x48, 0x71, 0x22, 0xc2, 0x9e, 0xcc, 0x26, 0x0b, 0x46, 0x69,
+	0xbb, 0x62, 0xa3, 0x55, 0x59, 0x41, 0x71, 0xb3, 0x68, 0xee, 0x1a, 0x4a, 0xca, 0x5d, 0x25, 0x72,
+	0xb5, 0xb8, 0xf8, 0x04, 0x3b, 0xaa, 0x68, 0x83, 0x78, 0xc3, 0xb5, 0xbf, 0x77, 0x79, 0xed, 0xc9,
+	0x0d, 0xd7, 0x5e, 0xbd, 0x7c, 0x9f, 0x5a, 0xc6, 0x64, 0x6a, 0x19, 0x5f, 0x53, 0xcb, 0x78, 0x9d,
+	0x59, 0x89, 0xc9, 0xcc, 0x4a, 0x7c, 0xce, 0xac, 0xc4, 0xe3, 0x49, 0x27, 0x90, 0xdd, 0xb0, 0x8d,
+	0x7c, 0xda, 0xd7, 0x29, 0xd6, 0x0f, 0x5b, 0xe0, 0x67, 0x67, 0x14, 0x85, 0xb8, 0x9d, 0x52, 0xb9,
+	0x3d, 0xfb, 0x1e, 0x00, 0xe2, 0xf7, 0x97, 0x01, 0x2f, 0x03, 0x00, 0x00,
 }
 
 func (m *EventCreateGroup) Marshal() (dAtA []byte, err error) {
@@ -647,6 +716,11 @@ func (m *EventExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Result != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ProposalId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
 		i--
@@ -655,6 +729,41 @@ func (m *EventExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventLeaveGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLeaveGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLeaveGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -761,6 +870,25 @@ func (m *EventExec) Size() (n int) {
 	if m.ProposalId != 0 {
 		n += 1 + sovEvents(uint64(m.ProposalId))
 	}
+	if m.Result != 0 {
+		n += 1 + sovEvents(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *EventLeaveGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GroupId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -1327,6 +1455,126 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= Proposal_ExecutorResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventLeaveGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventLeaveGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
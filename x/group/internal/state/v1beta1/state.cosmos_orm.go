@@ -0,0 +1,866 @@
+// Code generated by protoc-gen-go-cosmos-orm. DO NOT EDIT.
+
+package statev1beta1
+
+import (
+	context "context"
+	ormlist "github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	ormtable "github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+	ormerrors "github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type GroupInfoStore interface {
+	Insert(ctx context.Context, groupInfo *GroupInfo) error
+	InsertReturningID(ctx context.Context, groupInfo *GroupInfo) (uint64, error)
+	Update(ctx context.Context, groupInfo *GroupInfo) error
+	Save(ctx context.Context, groupInfo *GroupInfo) error
+	Delete(ctx context.Context, groupInfo *GroupInfo) error
+	Has(ctx context.Context, group_id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, group_id uint64) (*GroupInfo, error)
+	List(ctx context.Context, prefixKey GroupInfoIndexKey, opts ...ormlist.Option) (GroupInfoIterator, error)
+	ListRange(ctx context.Context, from, to GroupInfoIndexKey, opts ...ormlist.Option) (GroupInfoIterator, error)
+	DeleteBy(ctx context.Context, prefixKey GroupInfoIndexKey) error
+	DeleteRange(ctx context.Context, from, to GroupInfoIndexKey) error
+
+	doNotImplement()
+}
+
+type GroupInfoIterator struct {
+	ormtable.Iterator
+}
+
+func (i GroupInfoIterator) Value() (*GroupInfo, error) {
+	var groupInfo GroupInfo
+	err := i.UnmarshalMessage(&groupInfo)
+	return &groupInfo, err
+}
+
+type GroupInfoIndexKey interface {
+	id() uint32
+	values() []interface{}
+	groupInfoIndexKey()
+}
+
+// primary key starting index..
+type GroupInfoPrimaryKey = GroupInfoGroupIdIndexKey
+
+type GroupInfoGroupIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x GroupInfoGroupIdIndexKey) id() uint32            { return 0 }
+func (x GroupInfoGroupIdIndexKey) values() []interface{} { return x.vs }
+func (x GroupInfoGroupIdIndexKey) groupInfoIndexKey()    {}
+
+func (this GroupInfoGroupIdIndexKey) WithGroupId(group_id uint64) GroupInfoGroupIdIndexKey {
+	this.vs = []interface{}{group_id}
+	return this
+}
+
+type GroupInfoAdminIndexKey struct {
+	vs []interface{}
+}
+
+func (x GroupInfoAdminIndexKey) id() uint32            { return 1 }
+func (x GroupInfoAdminIndexKey) values() []interface{} { return x.vs }
+func (x GroupInfoAdminIndexKey) groupInfoIndexKey()    {}
+
+func (this GroupInfoAdminIndexKey) WithAdmin(admin string) GroupInfoAdminIndexKey {
+	this.vs = []interface{}{admin}
+	return this
+}
+
+type groupInfoStore struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this groupInfoStore) Insert(ctx context.Context, groupInfo *GroupInfo) error {
+	return this.table.Insert(ctx, groupInfo)
+}
+
+func (this groupInfoStore) Update(ctx context.Context, groupInfo *GroupInfo) error {
+	return this.table.Update(ctx, groupInfo)
+}
+
+func (this groupInfoStore) Save(ctx context.Context, groupInfo *GroupInfo) error {
+	return this.table.Save(ctx, groupInfo)
+}
+
+func (this groupInfoStore) Delete(ctx context.Context, groupInfo *GroupInfo) error {
+	return this.table.Delete(ctx, groupInfo)
+}
+
+func (this groupInfoStore) InsertReturningID(ctx context.Context, groupInfo *GroupInfo) (uint64, error) {
+	return this.table.InsertReturningID(ctx, groupInfo)
+}
+
+func (this groupInfoStore) Has(ctx context.Context, group_id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, group_id)
+}
+
+func (this groupInfoStore) Get(ctx context.Context, group_id uint64) (*GroupInfo, error) {
+	var groupInfo GroupInfo
+	found, err := this.table.PrimaryKey().Get(ctx, &groupInfo, group_id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &groupInfo, nil
+}
+
+func (this groupInfoStore) List(ctx context.Context, prefixKey GroupInfoIndexKey, opts ...ormlist.Option) (GroupInfoIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return GroupInfoIterator{it}, err
+}
+
+func (this groupInfoStore) ListRange(ctx context.Context, from, to GroupInfoIndexKey, opts ...ormlist.Option) (GroupInfoIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return GroupInfoIterator{it}, err
+}
+
+func (this groupInfoStore) DeleteBy(ctx context.Context, prefixKey GroupInfoIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this groupInfoStore) DeleteRange(ctx context.Context, from, to GroupInfoIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this groupInfoStore) doNotImplement() {}
+
+var _ GroupInfoStore = groupInfoStore{}
+
+func NewGroupInfoStore(db ormtable.Schema) (GroupInfoStore, error) {
+	table := db.GetTable(&GroupInfo{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&GroupInfo{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return groupInfoStore{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type GroupMemberStore interface {
+	Insert(ctx context.Context, groupMember *GroupMember) error
+	Update(ctx context.Context, groupMember *GroupMember) error
+	Save(ctx context.Context, groupMember *GroupMember) error
+	Delete(ctx context.Context, groupMember *GroupMember) error
+	Has(ctx context.Context, group_id uint64, member_address string) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, group_id uint64, member_address string) (*GroupMember, error)
+	List(ctx context.Context, prefixKey GroupMemberIndexKey, opts ...ormlist.Option) (GroupMemberIterator, error)
+	ListRange(ctx context.Context, from, to GroupMemberIndexKey, opts ...ormlist.Option) (GroupMemberIterator, error)
+	DeleteBy(ctx context.Context, prefixKey GroupMemberIndexKey) error
+	DeleteRange(ctx context.Context, from, to GroupMemberIndexKey) error
+
+	doNotImplement()
+}
+
+type GroupMemberIterator struct {
+	ormtable.Iterator
+}
+
+func (i GroupMemberIterator) Value() (*GroupMember, error) {
+	var groupMember GroupMember
+	err := i.UnmarshalMessage(&groupMember)
+	return &groupMember, err
+}
+
+type GroupMemberIndexKey interface {
+	id() uint32
+	values() []interface{}
+	groupMemberIndexKey()
+}
+
+// primary key starting index..
+type GroupMemberPrimaryKey = GroupMemberGroupIdMemberAddressIndexKey
+
+type GroupMemberGroupIdMemberAddressIndexKey struct {
+	vs []interface{}
+}
+
+func (x GroupMemberGroupIdMemberAddressIndexKey) id() uint32            { return 0 }
+func (x GroupMemberGroupIdMemberAddressIndexKey) values() []interface{} { return x.vs }
+func (x GroupMemberGroupIdMemberAddressIndexKey) groupMemberIndexKey()  {}
+
+func (this GroupMemberGroupIdMemberAddressIndexKey) WithGroupId(group_id uint64) GroupMemberGroupIdMemberAddressIndexKey {
+	this.vs = []interface{}{group_id}
+	return this
+}
+
+func (this GroupMemberGroupIdMemberAddressIndexKey) WithGroupIdMemberAddress(group_id uint64, member_address string) GroupMemberGroupIdMemberAddressIndexKey {
+	this.vs = []interface{}{group_id, member_address}
+	return this
+}
+
+type GroupMemberMemberAddressIndexKey struct {
+	vs []interface{}
+}
+
+func (x GroupMemberMemberAddressIndexKey) id() uint32            { return 1 }
+func (x GroupMemberMemberAddressIndexKey) values() []interface{} { return x.vs }
+func (x GroupMemberMemberAddressIndexKey) groupMemberIndexKey()  {}
+
+func (this GroupMemberMemberAddressIndexKey) WithMemberAddress(member_address string) GroupMemberMemberAddressIndexKey {
+	this.vs = []interface{}{member_address}
+	return this
+}
+
+type groupMemberStore struct {
+	table ormtable.Table
+}
+
+func (this groupMemberStore) Insert(ctx context.Context, groupMember *GroupMember) error {
+	return this.table.Insert(ctx, groupMember)
+}
+
+func (this groupMemberStore) Update(ctx context.Context, groupMember *GroupMember) error {
+	return this.table.Update(ctx, groupMember)
+}
+
+func (this groupMemberStore) Save(ctx context.Context, groupMember *GroupMember) error {
+	return this.table.Save(ctx, groupMember)
+}
+
+func (this groupMemberStore) Delete(ctx context.Context, groupMember *GroupMember) error {
+	return this.table.Delete(ctx, groupMember)
+}
+
+func (this groupMemberStore) Has(ctx context.Context, group_id uint64, member_address string) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, group_id, member_address)
+}
+
+func (this groupMemberStore) Get(ctx context.Context, group_id uint64, member_address string) (*GroupMember, error) {
+	var groupMember GroupMember
+	found, err := this.table.PrimaryKey().Get(ctx, &groupMember, group_id, member_address)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &groupMember, nil
+}
+
+func (this groupMemberStore) List(ctx context.Context, prefixKey GroupMemberIndexKey, opts ...ormlist.Option) (GroupMemberIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return GroupMemberIterator{it}, err
+}
+
+func (this groupMemberStore) ListRange(ctx context.Context, from, to GroupMemberIndexKey, opts ...ormlist.Option) (GroupMemberIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return GroupMemberIterator{it}, err
+}
+
+func (this groupMemberStore) DeleteBy(ctx context.Context, prefixKey GroupMemberIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this groupMemberStore) DeleteRange(ctx context.Context, from, to GroupMemberIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this groupMemberStore) doNotImplement() {}
+
+var _ GroupMemberStore = groupMemberStore{}
+
+func NewGroupMemberStore(db ormtable.Schema) (GroupMemberStore, error) {
+	table := db.GetTable(&GroupMember{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&GroupMember{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return groupMemberStore{table}, nil
+}
+
+type GroupPolicyInfoStore interface {
+	Insert(ctx context.Context, groupPolicyInfo *GroupPolicyInfo) error
+	Update(ctx context.Context, groupPolicyInfo *GroupPolicyInfo) error
+	Save(ctx context.Context, groupPolicyInfo *GroupPolicyInfo) error
+	Delete(ctx context.Context, groupPolicyInfo *GroupPolicyInfo) error
+	Has(ctx context.Context, address string) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, address string) (*GroupPolicyInfo, error)
+	List(ctx context.Context, prefixKey GroupPolicyInfoIndexKey, opts ...ormlist.Option) (GroupPolicyInfoIterator, error)
+	ListRange(ctx context.Context, from, to GroupPolicyInfoIndexKey, opts ...ormlist.Option) (GroupPolicyInfoIterator, error)
+	DeleteBy(ctx context.Context, prefixKey GroupPolicyInfoIndexKey) error
+	DeleteRange(ctx context.Context, from, to GroupPolicyInfoIndexKey) error
+
+	doNotImplement()
+}
+
+type GroupPolicyInfoIterator struct {
+	ormtable.Iterator
+}
+
+func (i GroupPolicyInfoIterator) Value() (*GroupPolicyInfo, error) {
+	var groupPolicyInfo GroupPolicyInfo
+	err := i.UnmarshalMessage(&groupPolicyInfo)
+	return &groupPolicyInfo, err
+}
+
+type GroupPolicyInfoIndexKey interface {
+	id() uint32
+	values() []interface{}
+	groupPolicyInfoIndexKey()
+}
+
+// primary key starting index..
+type GroupPolicyInfoPrimaryKey = GroupPolicyInfoAddressIndexKey
+
+type GroupPolicyInfoAddressIndexKey struct {
+	vs []interface{}
+}
+
+func (x GroupPolicyInfoAddressIndexKey) id() uint32               { return 0 }
+func (x GroupPolicyInfoAddressIndexKey) values() []interface{}    { return x.vs }
+func (x GroupPolicyInfoAddressIndexKey) groupPolicyInfoIndexKey() {}
+
+func (this GroupPolicyInfoAddressIndexKey) WithAddress(address string) GroupPolicyInfoAddressIndexKey {
+	this.vs = []interface{}{address}
+	return this
+}
+
+type GroupPolicyInfoGroupIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x GroupPolicyInfoGroupIdIndexKey) id() uint32               { return 1 }
+func (x GroupPolicyInfoGroupIdIndexKey) values() []interface{}    { return x.vs }
+func (x GroupPolicyInfoGroupIdIndexKey) groupPolicyInfoIndexKey() {}
+
+func (this GroupPolicyInfoGroupIdIndexKey) WithGroupId(group_id uint64) GroupPolicyInfoGroupIdIndexKey {
+	this.vs = []interface{}{group_id}
+	return this
+}
+
+type GroupPolicyInfoAdminIndexKey struct {
+	vs []interface{}
+}
+
+func (x GroupPolicyInfoAdminIndexKey) id() uint32               { return 2 }
+func (x GroupPolicyInfoAdminIndexKey) values() []interface{}    { return x.vs }
+func (x GroupPolicyInfoAdminIndexKey) groupPolicyInfoIndexKey() {}
+
+func (this GroupPolicyInfoAdminIndexKey) WithAdmin(admin string) GroupPolicyInfoAdminIndexKey {
+	this.vs = []interface{}{admin}
+	return this
+}
+
+type groupPolicyInfoStore struct {
+	table ormtable.Table
+}
+
+func (this groupPolicyInfoStore) Insert(ctx context.Context, groupPolicyInfo *GroupPolicyInfo) error {
+	return this.table.Insert(ctx, groupPolicyInfo)
+}
+
+func (this groupPolicyInfoStore) Update(ctx context.Context, groupPolicyInfo *GroupPolicyInfo) error {
+	return this.table.Update(ctx, groupPolicyInfo)
+}
+
+func (this groupPolicyInfoStore) Save(ctx context.Context, groupPolicyInfo *GroupPolicyInfo) error {
+	return this.table.Save(ctx, groupPolicyInfo)
+}
+
+func (this groupPolicyInfoStore) Delete(ctx context.Context, groupPolicyInfo *GroupPolicyInfo) error {
+	return this.table.Delete(ctx, groupPolicyInfo)
+}
+
+func (this groupPolicyInfoStore) Has(ctx context.Context, address string) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, address)
+}
+
+func (this groupPolicyInfoStore) Get(ctx context.Context, address string) (*GroupPolicyInfo, error) {
+	var groupPolicyInfo GroupPolicyInfo
+	found, err := this.table.PrimaryKey().Get(ctx, &groupPolicyInfo, address)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &groupPolicyInfo, nil
+}
+
+func (this groupPolicyInfoStore) List(ctx context.Context, prefixKey GroupPolicyInfoIndexKey, opts ...ormlist.Option) (GroupPolicyInfoIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return GroupPolicyInfoIterator{it}, err
+}
+
+func (this groupPolicyInfoStore) ListRange(ctx context.Context, from, to GroupPolicyInfoIndexKey, opts ...ormlist.Option) (GroupPolicyInfoIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return GroupPolicyInfoIterator{it}, err
+}
+
+func (this groupPolicyInfoStore) DeleteBy(ctx context.Context, prefixKey GroupPolicyInfoIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this groupPolicyInfoStore) DeleteRange(ctx context.Context, from, to GroupPolicyInfoIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this groupPolicyInfoStore) doNotImplement() {}
+
+var _ GroupPolicyInfoStore = groupPolicyInfoStore{}
+
+func NewGroupPolicyInfoStore(db ormtable.Schema) (GroupPolicyInfoStore, error) {
+	table := db.GetTable(&GroupPolicyInfo{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&GroupPolicyInfo{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return groupPolicyInfoStore{table}, nil
+}
+
+// singleton store
+type GroupPolicySeqStore interface {
+	Get(ctx context.Context) (*GroupPolicySeq, error)
+	Save(ctx context.Context, groupPolicySeq *GroupPolicySeq) error
+}
+
+type groupPolicySeqStore struct {
+	table ormtable.Table
+}
+
+var _ GroupPolicySeqStore = groupPolicySeqStore{}
+
+func (x groupPolicySeqStore) Get(ctx context.Context) (*GroupPolicySeq, error) {
+	groupPolicySeq := &GroupPolicySeq{}
+	_, err := x.table.Get(ctx, groupPolicySeq)
+	return groupPolicySeq, err
+}
+
+func (x groupPolicySeqStore) Save(ctx context.Context, groupPolicySeq *GroupPolicySeq) error {
+	return x.table.Save(ctx, groupPolicySeq)
+}
+
+func NewGroupPolicySeqStore(db ormtable.Schema) (GroupPolicySeqStore, error) {
+	table := db.GetTable(&GroupPolicySeq{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&GroupPolicySeq{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return &groupPolicySeqStore{table}, nil
+}
+
+type ProposalStore interface {
+	Insert(ctx context.Context, proposal *Proposal) error
+	InsertReturningID(ctx context.Context, proposal *Proposal) (uint64, error)
+	Update(ctx context.Context, proposal *Proposal) error
+	Save(ctx context.Context, proposal *Proposal) error
+	Delete(ctx context.Context, proposal *Proposal) error
+	Has(ctx context.Context, proposal_id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, proposal_id uint64) (*Proposal, error)
+	List(ctx context.Context, prefixKey ProposalIndexKey, opts ...ormlist.Option) (ProposalIterator, error)
+	ListRange(ctx context.Context, from, to ProposalIndexKey, opts ...ormlist.Option) (ProposalIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ProposalIndexKey) error
+	DeleteRange(ctx context.Context, from, to ProposalIndexKey) error
+
+	doNotImplement()
+}
+
+type ProposalIterator struct {
+	ormtable.Iterator
+}
+
+func (i ProposalIterator) Value() (*Proposal, error) {
+	var proposal Proposal
+	err := i.UnmarshalMessage(&proposal)
+	return &proposal, err
+}
+
+type ProposalIndexKey interface {
+	id() uint32
+	values() []interface{}
+	proposalIndexKey()
+}
+
+// primary key starting index..
+type ProposalPrimaryKey = ProposalProposalIdIndexKey
+
+type ProposalProposalIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProposalProposalIdIndexKey) id() uint32            { return 0 }
+func (x ProposalProposalIdIndexKey) values() []interface{} { return x.vs }
+func (x ProposalProposalIdIndexKey) proposalIndexKey()     {}
+
+func (this ProposalProposalIdIndexKey) WithProposalId(proposal_id uint64) ProposalProposalIdIndexKey {
+	this.vs = []interface{}{proposal_id}
+	return this
+}
+
+type ProposalAddressIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProposalAddressIndexKey) id() uint32            { return 1 }
+func (x ProposalAddressIndexKey) values() []interface{} { return x.vs }
+func (x ProposalAddressIndexKey) proposalIndexKey()     {}
+
+func (this ProposalAddressIndexKey) WithAddress(address string) ProposalAddressIndexKey {
+	this.vs = []interface{}{address}
+	return this
+}
+
+type ProposalProposersIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProposalProposersIndexKey) id() uint32            { return 2 }
+func (x ProposalProposersIndexKey) values() []interface{} { return x.vs }
+func (x ProposalProposersIndexKey) proposalIndexKey()     {}
+
+func (this ProposalProposersIndexKey) WithProposers(proposers string) ProposalProposersIndexKey {
+	this.vs = []interface{}{proposers}
+	return this
+}
+
+type ProposalTimeoutIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProposalTimeoutIndexKey) id() uint32            { return 3 }
+func (x ProposalTimeoutIndexKey) values() []interface{} { return x.vs }
+func (x ProposalTimeoutIndexKey) proposalIndexKey()     {}
+
+func (this ProposalTimeoutIndexKey) WithTimeout(timeout *timestamppb.Timestamp) ProposalTimeoutIndexKey {
+	this.vs = []interface{}{timeout}
+	return this
+}
+
+type proposalStore struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this proposalStore) Insert(ctx context.Context, proposal *Proposal) error {
+	return this.table.Insert(ctx, proposal)
+}
+
+func (this proposalStore) Update(ctx context.Context, proposal *Proposal) error {
+	return this.table.Update(ctx, proposal)
+}
+
+func (this proposalStore) Save(ctx context.Context, proposal *Proposal) error {
+	return this.table.Save(ctx, proposal)
+}
+
+func (this proposalStore) Delete(ctx context.Context, proposal *Proposal) error {
+	return this.table.Delete(ctx, proposal)
+}
+
+func (this proposalStore) InsertReturningID(ctx context.Context, proposal *Proposal) (uint64, error) {
+	return this.table.InsertReturningID(ctx, proposal)
+}
+
+func (this proposalStore) Has(ctx context.Context, proposal_id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, proposal_id)
+}
+
+func (this proposalStore) Get(ctx context.Context, proposal_id uint64) (*Proposal, error) {
+	var proposal Proposal
+	found, err := this.table.PrimaryKey().Get(ctx, &proposal, proposal_id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &proposal, nil
+}
+
+func (this proposalStore) List(ctx context.Context, prefixKey ProposalIndexKey, opts ...ormlist.Option) (ProposalIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ProposalIterator{it}, err
+}
+
+func (this proposalStore) ListRange(ctx context.Context, from, to ProposalIndexKey, opts ...ormlist.Option) (ProposalIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ProposalIterator{it}, err
+}
+
+func (this proposalStore) DeleteBy(ctx context.Context, prefixKey ProposalIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this proposalStore) DeleteRange(ctx context.Context, from, to ProposalIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this proposalStore) doNotImplement() {}
+
+var _ ProposalStore = proposalStore{}
+
+func NewProposalStore(db ormtable.Schema) (ProposalStore, error) {
+	table := db.GetTable(&Proposal{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Proposal{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return proposalStore{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+// singleton store
+type ProposalTallyStore interface {
+	Get(ctx context.Context) (*ProposalTally, error)
+	Save(ctx context.Context, proposalTally *ProposalTally) error
+}
+
+type proposalTallyStore struct {
+	table ormtable.Table
+}
+
+var _ ProposalTallyStore = proposalTallyStore{}
+
+func (x proposalTallyStore) Get(ctx context.Context) (*ProposalTally, error) {
+	proposalTally := &ProposalTally{}
+	_, err := x.table.Get(ctx, proposalTally)
+	return proposalTally, err
+}
+
+func (x proposalTallyStore) Save(ctx context.Context, proposalTally *ProposalTally) error {
+	return x.table.Save(ctx, proposalTally)
+}
+
+func NewProposalTallyStore(db ormtable.Schema) (ProposalTallyStore, error) {
+	table := db.GetTable(&ProposalTally{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ProposalTally{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return &proposalTallyStore{table}, nil
+}
+
+type VoteStore interface {
+	Insert(ctx context.Context, vote *Vote) error
+	Update(ctx context.Context, vote *Vote) error
+	Save(ctx context.Context, vote *Vote) error
+	Delete(ctx context.Context, vote *Vote) error
+	Has(ctx context.Context, proposal_id uint64, voter string) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, proposal_id uint64, voter string) (*Vote, error)
+	List(ctx context.Context, prefixKey VoteIndexKey, opts ...ormlist.Option) (VoteIterator, error)
+	ListRange(ctx context.Context, from, to VoteIndexKey, opts ...ormlist.Option) (VoteIterator, error)
+	DeleteBy(ctx context.Context, prefixKey VoteIndexKey) error
+	DeleteRange(ctx context.Context, from, to VoteIndexKey) error
+
+	doNotImplement()
+}
+
+type VoteIterator struct {
+	ormtable.Iterator
+}
+
+func (i VoteIterator) Value() (*Vote, error) {
+	var vote Vote
+	err := i.UnmarshalMessage(&vote)
+	return &vote, err
+}
+
+type VoteIndexKey interface {
+	id() uint32
+	values() []interface{}
+	voteIndexKey()
+}
+
+// primary key starting index..
+type VotePrimaryKey = VoteProposalIdVoterIndexKey
+
+type VoteProposalIdVoterIndexKey struct {
+	vs []interface{}
+}
+
+func (x VoteProposalIdVoterIndexKey) id() uint32            { return 0 }
+func (x VoteProposalIdVoterIndexKey) values() []interface{} { return x.vs }
+func (x VoteProposalIdVoterIndexKey) voteIndexKey()         {}
+
+func (this VoteProposalIdVoterIndexKey) WithProposalId(proposal_id uint64) VoteProposalIdVoterIndexKey {
+	this.vs = []interface{}{proposal_id}
+	return this
+}
+
+func (this VoteProposalIdVoterIndexKey) WithProposalIdVoter(proposal_id uint64, voter string) VoteProposalIdVoterIndexKey {
+	this.vs = []interface{}{proposal_id, voter}
+	return this
+}
+
+type VoteVoterIndexKey struct {
+	vs []interface{}
+}
+
+func (x VoteVoterIndexKey) id() uint32            { return 1 }
+func (x VoteVoterIndexKey) values() []interface{} { return x.vs }
+func (x VoteVoterIndexKey) voteIndexKey()         {}
+
+func (this VoteVoterIndexKey) WithVoter(voter string) VoteVoterIndexKey {
+	this.vs = []interface{}{voter}
+	return this
+}
+
+type voteStore struct {
+	table ormtable.Table
+}
+
+func (this voteStore) Insert(ctx context.Context, vote *Vote) error {
+	return this.table.Insert(ctx, vote)
+}
+
+func (this voteStore) Update(ctx context.Context, vote *Vote) error {
+	return this.table.Update(ctx, vote)
+}
+
+func (this voteStore) Save(ctx context.Context, vote *Vote) error {
+	return this.table.Save(ctx, vote)
+}
+
+func (this voteStore) Delete(ctx context.Context, vote *Vote) error {
+	return this.table.Delete(ctx, vote)
+}
+
+func (this voteStore) Has(ctx context.Context, proposal_id uint64, voter string) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, proposal_id, voter)
+}
+
+func (this voteStore) Get(ctx context.Context, proposal_id uint64, voter string) (*Vote, error) {
+	var vote Vote
+	found, err := this.table.PrimaryKey().Get(ctx, &vote, proposal_id, voter)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &vote, nil
+}
+
+func (this voteStore) List(ctx context.Context, prefixKey VoteIndexKey, opts ...ormlist.Option) (VoteIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return VoteIterator{it}, err
+}
+
+func (this voteStore) ListRange(ctx context.Context, from, to VoteIndexKey, opts ...ormlist.Option) (VoteIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return VoteIterator{it}, err
+}
+
+func (this voteStore) DeleteBy(ctx context.Context, prefixKey VoteIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this voteStore) DeleteRange(ctx context.Context, from, to VoteIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this voteStore) doNotImplement() {}
+
+var _ VoteStore = voteStore{}
+
+func NewVoteStore(db ormtable.Schema) (VoteStore, error) {
+	table := db.GetTable(&Vote{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Vote{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return voteStore{table}, nil
+}
+
+type StateStore interface {
+	GroupInfoStore() GroupInfoStore
+	GroupMemberStore() GroupMemberStore
+	GroupPolicyInfoStore() GroupPolicyInfoStore
+	GroupPolicySeqStore() GroupPolicySeqStore
+	ProposalStore() ProposalStore
+	ProposalTallyStore() ProposalTallyStore
+	VoteStore() VoteStore
+
+	doNotImplement()
+}
+
+type stateStore struct {
+	groupInfo       GroupInfoStore
+	groupMember     GroupMemberStore
+	groupPolicyInfo GroupPolicyInfoStore
+	groupPolicySeq  GroupPolicySeqStore
+	proposal        ProposalStore
+	proposalTally   ProposalTallyStore
+	vote            VoteStore
+}
+
+func (x stateStore) GroupInfoStore() GroupInfoStore {
+	return x.groupInfo
+}
+
+func (x stateStore) GroupMemberStore() GroupMemberStore {
+	return x.groupMember
+}
+
+func (x stateStore) GroupPolicyInfoStore() GroupPolicyInfoStore {
+	return x.groupPolicyInfo
+}
+
+func (x stateStore) GroupPolicySeqStore() GroupPolicySeqStore {
+	return x.groupPolicySeq
+}
+
+func (x stateStore) ProposalStore() ProposalStore {
+	return x.proposal
+}
+
+func (x stateStore) ProposalTallyStore() ProposalTallyStore {
+	return x.proposalTally
+}
+
+func (x stateStore) VoteStore() VoteStore {
+	return x.vote
+}
+
+func (stateStore) doNotImplement() {}
+
+var _ StateStore = stateStore{}
+
+func NewStateStore(db ormtable.Schema) (StateStore, error) {
+	groupInfoStore, err := NewGroupInfoStore(db)
+	if err != nil {
+		return nil, err
+	}
+
+	groupMemberStore, err := NewGroupMemberStore(db)
+	if err != nil {
+		return nil, err
+	}
+
+	groupPolicyInfoStore, err := NewGroupPolicyInfoStore(db)
+	if err != nil {
+		return nil, err
+	}
+
+	groupPolicySeqStore, err := NewGroupPolicySeqStore(db)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalStore, err := NewProposalStore(db)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalTallyStore, err := NewProposalTallyStore(db)
+	if err != nil {
+		return nil, err
+	}
+
+	voteStore, err := NewVoteStore(db)
+	if err != nil {
+		return nil, err
+	}
+
+	return stateStore{
+		groupInfoStore,
+		groupMemberStore,
+		groupPolicyInfoStore,
+		groupPolicySeqStore,
+		proposalStore,
+		proposalTallyStore,
+		voteStore,
+	}, nil
+}
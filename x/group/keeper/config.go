@@ -1,14 +1,19 @@
 package keeper
 
+import "time"
+
 // Config is a config struct used for intialising the group module to avoid using globals.
 type Config struct {
 	// MaxMetadataLen defines the max length of the metadata bytes field for various entities within the group module. Defaults to 255 if not explicitly set.
 	MaxMetadataLen uint64
+	// MaxExecutionPeriod defines the max duration after a proposal's voting period ends during which the proposal can still be executed. The proposal is pruned afterwards. Defaults to 2 weeks if not explicitly set.
+	MaxExecutionPeriod time.Duration
 }
 
 // DefaultConfig returns the default config for group.
 func DefaultConfig() Config {
 	return Config{
-		MaxMetadataLen: 255,
+		MaxMetadataLen:     255,
+		MaxExecutionPeriod: 2 * 7 * 24 * time.Hour,
 	}
 }
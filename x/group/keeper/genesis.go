@@ -4,85 +4,172 @@ import (
 	"encoding/json"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/group"
+	statev1beta1 "github.com/cosmos/cosmos-sdk/x/group/internal/state/v1beta1"
 )
 
 func (k Keeper) InitGenesis(ctx types.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState group.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
 
-	if err := k.groupTable.Import(ctx.KVStore(k.key), genesisState.Groups, genesisState.GroupSeq); err != nil {
-		panic(errors.Wrap(err, "groups"))
+	if err := k.ImportState(ctx, &genesisState); err != nil {
+		panic(err)
 	}
 
-	if err := k.groupMemberTable.Import(ctx.KVStore(k.key), genesisState.GroupMembers, 0); err != nil {
-		panic(errors.Wrap(err, "group members"))
+	return []abci.ValidatorUpdate{}
+
+}
+
+// ImportState writes the group module state described by genesisState to the
+// store. It is used by InitGenesis and by the store migrations of the module.
+func (k Keeper) ImportState(ctx types.Context, genesisState *group.GenesisState) error {
+	goCtx := types.WrapSDKContext(ctx)
+
+	groups := make([]proto.Message, len(genesisState.Groups))
+	for i, g := range genesisState.Groups {
+		var state statev1beta1.GroupInfo
+		if err := k.toState(g, &state); err != nil {
+			return errors.Wrap(err, "groups")
+		}
+		groups[i] = &state
+	}
+	if err := ormtable.ImportRows(goCtx, k.db.GetTable(&statev1beta1.GroupInfo{}), groups, genesisState.GroupSeq); err != nil {
+		return errors.Wrap(err, "groups")
 	}
 
-	if err := k.groupPolicyTable.Import(ctx.KVStore(k.key), genesisState.GroupPolicies, 0); err != nil {
-		panic(errors.Wrap(err, "group policies"))
+	groupMembers := make([]proto.Message, len(genesisState.GroupMembers))
+	for i, m := range genesisState.GroupMembers {
+		groupMembers[i] = groupMemberToState(m)
+	}
+	if err := ormtable.ImportRows(goCtx, k.db.GetTable(&statev1beta1.GroupMember{}), groupMembers, 0); err != nil {
+		return errors.Wrap(err, "group members")
 	}
 
-	if err := k.groupPolicySeq.InitVal(ctx.KVStore(k.key), genesisState.GroupPolicySeq); err != nil {
-		panic(errors.Wrap(err, "group policy account seq"))
+	groupPolicies := make([]proto.Message, len(genesisState.GroupPolicies))
+	for i, p := range genesisState.GroupPolicies {
+		var state statev1beta1.GroupPolicyInfo
+		if err := k.toState(p, &state); err != nil {
+			return errors.Wrap(err, "group policies")
+		}
+		groupPolicies[i] = &state
+	}
+	if err := ormtable.ImportRows(goCtx, k.db.GetTable(&statev1beta1.GroupPolicyInfo{}), groupPolicies, 0); err != nil {
+		return errors.Wrap(err, "group policies")
 	}
 
-	if err := k.proposalTable.Import(ctx.KVStore(k.key), genesisState.Proposals, genesisState.ProposalSeq); err != nil {
-		panic(errors.Wrap(err, "proposals"))
+	if err := k.state.GroupPolicySeqStore().Save(goCtx, &statev1beta1.GroupPolicySeq{Seq: genesisState.GroupPolicySeq}); err != nil {
+		return errors.Wrap(err, "group policy account seq")
 	}
 
-	if err := k.voteTable.Import(ctx.KVStore(k.key), genesisState.Votes, 0); err != nil {
-		panic(errors.Wrap(err, "votes"))
+	proposals := make([]proto.Message, len(genesisState.Proposals))
+	for i, p := range genesisState.Proposals {
+		var state statev1beta1.Proposal
+		if err := k.toState(p, &state); err != nil {
+			return errors.Wrap(err, "proposals")
+		}
+		proposals[i] = &state
+	}
+	if err := ormtable.ImportRows(goCtx, k.db.GetTable(&statev1beta1.Proposal{}), proposals, genesisState.ProposalSeq); err != nil {
+		return errors.Wrap(err, "proposals")
 	}
 
-	return []abci.ValidatorUpdate{}
+	votes := make([]proto.Message, len(genesisState.Votes))
+	for i, v := range genesisState.Votes {
+		var state statev1beta1.Vote
+		if err := k.toState(v, &state); err != nil {
+			return errors.Wrap(err, "votes")
+		}
+		votes[i] = &state
+	}
+	if err := ormtable.ImportRows(goCtx, k.db.GetTable(&statev1beta1.Vote{}), votes, 0); err != nil {
+		return errors.Wrap(err, "votes")
+	}
 
+	return nil
 }
 
 func (k Keeper) ExportGenesis(ctx types.Context, cdc codec.JSONCodec) *group.GenesisState {
+	goCtx := types.WrapSDKContext(ctx)
 	genesisState := group.NewGenesisState()
 
-	var groups []*group.GroupInfo
-
-	groupSeq, err := k.groupTable.Export(ctx.KVStore(k.key), &groups)
+	rows, groupSeq, err := ormtable.ExportRows(goCtx, k.db.GetTable(&statev1beta1.GroupInfo{}))
 	if err != nil {
 		panic(errors.Wrap(err, "groups"))
 	}
+	var groups []*group.GroupInfo
+	for _, row := range rows {
+		var obj group.GroupInfo
+		if err := k.fromState(row, &obj); err != nil {
+			panic(errors.Wrap(err, "groups"))
+		}
+		groups = append(groups, &obj)
+	}
 	genesisState.Groups = groups
 	genesisState.GroupSeq = groupSeq
 
-	var groupMembers []*group.GroupMember
-	_, err = k.groupMemberTable.Export(ctx.KVStore(k.key), &groupMembers)
+	rows, _, err = ormtable.ExportRows(goCtx, k.db.GetTable(&statev1beta1.GroupMember{}))
 	if err != nil {
 		panic(errors.Wrap(err, "group members"))
 	}
+	var groupMembers []*group.GroupMember
+	for _, row := range rows {
+		groupMembers = append(groupMembers, groupMemberFromState(row.(*statev1beta1.GroupMember)))
+	}
 	genesisState.GroupMembers = groupMembers
 
-	var groupPolicies []*group.GroupPolicyInfo
-	_, err = k.groupPolicyTable.Export(ctx.KVStore(k.key), &groupPolicies)
+	rows, _, err = ormtable.ExportRows(goCtx, k.db.GetTable(&statev1beta1.GroupPolicyInfo{}))
 	if err != nil {
 		panic(errors.Wrap(err, "group policies"))
 	}
+	var groupPolicies []*group.GroupPolicyInfo
+	for _, row := range rows {
+		var obj group.GroupPolicyInfo
+		if err := k.fromState(row, &obj); err != nil {
+			panic(errors.Wrap(err, "group policies"))
+		}
+		groupPolicies = append(groupPolicies, &obj)
+	}
 	genesisState.GroupPolicies = groupPolicies
-	genesisState.GroupPolicySeq = k.groupPolicySeq.CurVal(ctx.KVStore(k.key))
 
-	var proposals []*group.Proposal
-	proposalSeq, err := k.proposalTable.Export(ctx.KVStore(k.key), &proposals)
+	groupPolicySeq, err := k.state.GroupPolicySeqStore().Get(goCtx)
+	if err != nil {
+		panic(errors.Wrap(err, "group policy account seq"))
+	}
+	genesisState.GroupPolicySeq = groupPolicySeq.Seq
+
+	rows, proposalSeq, err := ormtable.ExportRows(goCtx, k.db.GetTable(&statev1beta1.Proposal{}))
 	if err != nil {
 		panic(errors.Wrap(err, "proposals"))
 	}
+	var proposals []*group.Proposal
+	for _, row := range rows {
+		var obj group.Proposal
+		if err := k.fromState(row, &obj); err != nil {
+			panic(errors.Wrap(err, "proposals"))
+		}
+		proposals = append(proposals, &obj)
+	}
 	genesisState.Proposals = proposals
 	genesisState.ProposalSeq = proposalSeq
 
-	var votes []*group.Vote
-	_, err = k.voteTable.Export(ctx.KVStore(k.key), &votes)
+	rows, _, err = ormtable.ExportRows(goCtx, k.db.GetTable(&statev1beta1.Vote{}))
 	if err != nil {
 		panic(errors.Wrap(err, "votes"))
 	}
+	var votes []*group.Vote
+	for _, row := range rows {
+		var obj group.Vote
+		if err := k.fromState(row, &obj); err != nil {
+			panic(errors.Wrap(err, "votes"))
+		}
+		votes = append(votes, &obj)
+	}
 	genesisState.Votes = votes
 
 	return genesisState
@@ -5,12 +5,14 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/group"
-	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
+	statev1beta1 "github.com/cosmos/cosmos-sdk/x/group/internal/state/v1beta1"
 )
 
 var _ group.QueryServer = Keeper{}
@@ -26,12 +28,6 @@ func (q Keeper) GroupInfo(goCtx context.Context, request *group.QueryGroupInfoRe
 	return &group.QueryGroupInfoResponse{Info: &groupInfo}, nil
 }
 
-func (q Keeper) getGroupInfo(ctx sdk.Context, id uint64) (group.GroupInfo, error) {
-	var obj group.GroupInfo
-	_, err := q.groupTable.GetOne(ctx.KVStore(q.key), id, &obj)
-	return obj, err
-}
-
 func (q Keeper) GroupPolicyInfo(goCtx context.Context, request *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	groupPolicyInfo, err := q.getGroupPolicyInfo(ctx, request.Address)
@@ -42,11 +38,6 @@ func (q Keeper) GroupPolicyInfo(goCtx context.Context, request *group.QueryGroup
 	return &group.QueryGroupPolicyInfoResponse{Info: &groupPolicyInfo}, nil
 }
 
-func (q Keeper) getGroupPolicyInfo(ctx sdk.Context, accountAddress string) (group.GroupPolicyInfo, error) {
-	var obj group.GroupPolicyInfo
-	return obj, q.groupPolicyTable.GetOne(ctx.KVStore(q.key), orm.PrimaryKey(&group.GroupPolicyInfo{Address: accountAddress}), &obj)
-}
-
 func (q Keeper) GroupMembers(goCtx context.Context, request *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	groupID := request.GroupId
@@ -56,7 +47,10 @@ func (q Keeper) GroupMembers(goCtx context.Context, request *group.QueryGroupMem
 	}
 
 	var members []*group.GroupMember
-	pageRes, err := orm.Paginate(it, request.Pagination, &members)
+	pageRes, err := readAll(it, func(msg proto.Message) error {
+		members = append(members, groupMemberFromState(msg.(*statev1beta1.GroupMember)))
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -67,8 +61,12 @@ func (q Keeper) GroupMembers(goCtx context.Context, request *group.QueryGroupMem
 	}, nil
 }
 
-func (q Keeper) getGroupMembers(ctx sdk.Context, id uint64, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return q.groupMemberByGroupIndex.GetPaginated(ctx.KVStore(q.key), id, pageRequest)
+func (q Keeper) getGroupMembers(ctx sdk.Context, id uint64, pageRequest *query.PageRequest) (ormtable.Iterator, error) {
+	pageReq, err := toPageRequest(pageRequest)
+	if err != nil {
+		return nil, err
+	}
+	return q.state.GroupMemberStore().List(sdk.WrapSDKContext(ctx), statev1beta1.GroupMemberGroupIdMemberAddressIndexKey{}.WithGroupId(id), ormlist.Paginate(pageReq))
 }
 
 func (q Keeper) GroupsByAdmin(goCtx context.Context, request *group.QueryGroupsByAdminRequest) (*group.QueryGroupsByAdminResponse, error) {
@@ -83,7 +81,11 @@ func (q Keeper) GroupsByAdmin(goCtx context.Context, request *group.QueryGroupsB
 	}
 
 	var groups []*group.GroupInfo
-	pageRes, err := orm.Paginate(it, request.Pagination, &groups)
+	pageRes, err := readAll(it, func(msg proto.Message) error {
+		var groupInfo group.GroupInfo
+		groups = append(groups, &groupInfo)
+		return q.fromState(msg, &groupInfo)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -94,8 +96,12 @@ func (q Keeper) GroupsByAdmin(goCtx context.Context, request *group.QueryGroupsB
 	}, nil
 }
 
-func (q Keeper) getGroupsByAdmin(ctx sdk.Context, admin sdk.AccAddress, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return q.groupByAdminIndex.GetPaginated(ctx.KVStore(q.key), admin.Bytes(), pageRequest)
+func (q Keeper) getGroupsByAdmin(ctx sdk.Context, admin sdk.AccAddress, pageRequest *query.PageRequest) (ormtable.Iterator, error) {
+	pageReq, err := toPageRequest(pageRequest)
+	if err != nil {
+		return nil, err
+	}
+	return q.state.GroupInfoStore().List(sdk.WrapSDKContext(ctx), statev1beta1.GroupInfoAdminIndexKey{}.WithAdmin(admin.String()), ormlist.Paginate(pageReq))
 }
 
 func (q Keeper) GroupPoliciesByGroup(goCtx context.Context, request *group.QueryGroupPoliciesByGroupRequest) (*group.QueryGroupPoliciesByGroupResponse, error) {
@@ -106,8 +112,7 @@ func (q Keeper) GroupPoliciesByGroup(goCtx context.Context, request *group.Query
 		return nil, err
 	}
 
-	var policies []*group.GroupPolicyInfo
-	pageRes, err := orm.Paginate(it, request.Pagination, &policies)
+	policies, pageRes, err := q.readGroupPolicies(it)
 	if err != nil {
 		return nil, err
 	}
@@ -118,8 +123,12 @@ func (q Keeper) GroupPoliciesByGroup(goCtx context.Context, request *group.Query
 	}, nil
 }
 
-func (q Keeper) getGroupPoliciesByGroup(ctx sdk.Context, id uint64, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return q.groupPolicyByGroupIndex.GetPaginated(ctx.KVStore(q.key), id, pageRequest)
+func (q Keeper) getGroupPoliciesByGroup(ctx sdk.Context, id uint64, pageRequest *query.PageRequest) (ormtable.Iterator, error) {
+	pageReq, err := toPageRequest(pageRequest)
+	if err != nil {
+		return nil, err
+	}
+	return q.state.GroupPolicyInfoStore().List(sdk.WrapSDKContext(ctx), statev1beta1.GroupPolicyInfoGroupIdIndexKey{}.WithGroupId(id), ormlist.Paginate(pageReq))
 }
 
 func (q Keeper) GroupPoliciesByAdmin(goCtx context.Context, request *group.QueryGroupPoliciesByAdminRequest) (*group.QueryGroupPoliciesByAdminResponse, error) {
@@ -133,8 +142,7 @@ func (q Keeper) GroupPoliciesByAdmin(goCtx context.Context, request *group.Query
 		return nil, err
 	}
 
-	var policies []*group.GroupPolicyInfo
-	pageRes, err := orm.Paginate(it, request.Pagination, &policies)
+	policies, pageRes, err := q.readGroupPolicies(it)
 	if err != nil {
 		return nil, err
 	}
@@ -145,8 +153,22 @@ func (q Keeper) GroupPoliciesByAdmin(goCtx context.Context, request *group.Query
 	}, nil
 }
 
-func (q Keeper) getGroupPoliciesByAdmin(ctx sdk.Context, admin sdk.AccAddress, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return q.groupPolicyByAdminIndex.GetPaginated(ctx.KVStore(q.key), admin.Bytes(), pageRequest)
+func (q Keeper) getGroupPoliciesByAdmin(ctx sdk.Context, admin sdk.AccAddress, pageRequest *query.PageRequest) (ormtable.Iterator, error) {
+	pageReq, err := toPageRequest(pageRequest)
+	if err != nil {
+		return nil, err
+	}
+	return q.state.GroupPolicyInfoStore().List(sdk.WrapSDKContext(ctx), statev1beta1.GroupPolicyInfoAdminIndexKey{}.WithAdmin(admin.String()), ormlist.Paginate(pageReq))
+}
+
+func (q Keeper) readGroupPolicies(it ormtable.Iterator) ([]*group.GroupPolicyInfo, *query.PageResponse, error) {
+	var policies []*group.GroupPolicyInfo
+	pageRes, err := readAll(it, func(msg proto.Message) error {
+		var policy group.GroupPolicyInfo
+		policies = append(policies, &policy)
+		return q.fromState(msg, &policy)
+	})
+	return policies, pageRes, err
 }
 
 func (q Keeper) Proposal(goCtx context.Context, request *group.QueryProposalRequest) (*group.QueryProposalResponse, error) {
@@ -172,7 +194,11 @@ func (q Keeper) ProposalsByGroupPolicy(goCtx context.Context, request *group.Que
 	}
 
 	var proposals []*group.Proposal
-	pageRes, err := orm.Paginate(it, request.Pagination, &proposals)
+	pageRes, err := readAll(it, func(msg proto.Message) error {
+		var proposal group.Proposal
+		proposals = append(proposals, &proposal)
+		return q.fromState(msg, &proposal)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -183,16 +209,12 @@ func (q Keeper) ProposalsByGroupPolicy(goCtx context.Context, request *group.Que
 	}, nil
 }
 
-func (q Keeper) getProposalsByGroupPolicy(ctx sdk.Context, account sdk.AccAddress, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return q.proposalByGroupPolicyIndex.GetPaginated(ctx.KVStore(q.key), account.Bytes(), pageRequest)
-}
-
-func (q Keeper) getProposal(ctx sdk.Context, proposalID uint64) (group.Proposal, error) {
-	var p group.Proposal
-	if _, err := q.proposalTable.GetOne(ctx.KVStore(q.key), proposalID, &p); err != nil {
-		return group.Proposal{}, sdkerrors.Wrap(err, "load proposal")
+func (q Keeper) getProposalsByGroupPolicy(ctx sdk.Context, account sdk.AccAddress, pageRequest *query.PageRequest) (ormtable.Iterator, error) {
+	pageReq, err := toPageRequest(pageRequest)
+	if err != nil {
+		return nil, err
 	}
-	return p, nil
+	return q.state.ProposalStore().List(sdk.WrapSDKContext(ctx), statev1beta1.ProposalAddressIndexKey{}.WithAddress(account.String()), ormlist.Paginate(pageReq))
 }
 
 func (q Keeper) VoteByProposalVoter(goCtx context.Context, request *group.QueryVoteByProposalVoterRequest) (*group.QueryVoteByProposalVoterResponse, error) {
@@ -219,8 +241,7 @@ func (q Keeper) VotesByProposal(goCtx context.Context, request *group.QueryVotes
 		return nil, err
 	}
 
-	var votes []*group.Vote
-	pageRes, err := orm.Paginate(it, request.Pagination, &votes)
+	votes, pageRes, err := q.readVotes(it)
 	if err != nil {
 		return nil, err
 	}
@@ -242,8 +263,7 @@ func (q Keeper) VotesByVoter(goCtx context.Context, request *group.QueryVotesByV
 		return nil, err
 	}
 
-	var votes []*group.Vote
-	pageRes, err := orm.Paginate(it, request.Pagination, &votes)
+	votes, pageRes, err := q.readVotes(it)
 	if err != nil {
 		return nil, err
 	}
@@ -265,21 +285,27 @@ func (q Keeper) GroupsByMember(goCtx context.Context, request *group.QueryGroups
 		return nil, err
 	}
 
-	iter, err := q.groupMemberByMemberIndex.GetPaginated(ctx.KVStore(q.key), member.Bytes(), request.Pagination)
+	pageReq, err := toPageRequest(request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	iter, err := q.state.GroupMemberStore().List(goCtx, statev1beta1.GroupMemberMemberAddressIndexKey{}.WithMemberAddress(member.String()), ormlist.Paginate(pageReq))
 	if err != nil {
 		return nil, err
 	}
-	defer iter.Close()
 
-	var members []*group.GroupMember
-	pageRes, err := orm.Paginate(iter, request.Pagination, &members)
+	var groupIDs []uint64
+	pageRes, err := readAll(iter, func(msg proto.Message) error {
+		groupIDs = append(groupIDs, msg.(*statev1beta1.GroupMember).GroupId)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	var groups []*group.GroupInfo
-	for _, gm := range members {
-		groupInfo, err := q.getGroupInfo(ctx, gm.GroupId)
+	for _, groupID := range groupIDs {
+		groupInfo, err := q.getGroupInfo(ctx, groupID)
 		if err != nil {
 			return nil, err
 		}
@@ -292,15 +318,28 @@ func (q Keeper) GroupsByMember(goCtx context.Context, request *group.QueryGroups
 	}, nil
 }
 
-func (q Keeper) getVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (group.Vote, error) {
-	var v group.Vote
-	return v, q.voteTable.GetOne(ctx.KVStore(q.key), orm.PrimaryKey(&group.Vote{ProposalId: proposalID, Voter: voter.String()}), &v)
+func (q Keeper) getVotesByProposal(ctx sdk.Context, proposalID uint64, pageRequest *query.PageRequest) (ormtable.Iterator, error) {
+	pageReq, err := toPageRequest(pageRequest)
+	if err != nil {
+		return nil, err
+	}
+	return q.state.VoteStore().List(sdk.WrapSDKContext(ctx), statev1beta1.VoteProposalIdVoterIndexKey{}.WithProposalId(proposalID), ormlist.Paginate(pageReq))
 }
 
-func (q Keeper) getVotesByProposal(ctx sdk.Context, proposalID uint64, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return q.voteByProposalIndex.GetPaginated(ctx.KVStore(q.key), proposalID, pageRequest)
+func (q Keeper) getVotesByVoter(ctx sdk.Context, voter sdk.AccAddress, pageRequest *query.PageRequest) (ormtable.Iterator, error) {
+	pageReq, err := toPageRequest(pageRequest)
+	if err != nil {
+		return nil, err
+	}
+	return q.state.VoteStore().List(sdk.WrapSDKContext(ctx), statev1beta1.VoteVoterIndexKey{}.WithVoter(voter.String()), ormlist.Paginate(pageReq))
 }
 
-func (q Keeper) getVotesByVoter(ctx sdk.Context, voter sdk.AccAddress, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return q.voteByVoterIndex.GetPaginated(ctx.KVStore(q.key), voter.Bytes(), pageRequest)
+func (q Keeper) readVotes(it ormtable.Iterator) ([]*group.Vote, *query.PageResponse, error) {
+	var votes []*group.Vote
+	pageRes, err := readAll(it, func(msg proto.Message) error {
+		var vote group.Vote
+		votes = append(votes, &vote)
+		return q.fromState(msg, &vote)
+	})
+	return votes, pageRes, err
 }
@@ -2,14 +2,10 @@ package keeper
 
 import (
 	"fmt"
-	"math"
 
-	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
-	"github.com/cosmos/cosmos-sdk/x/group/errors"
 	groupmath "github.com/cosmos/cosmos-sdk/x/group/internal/math"
-	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
 )
 
 const (
@@ -33,7 +29,7 @@ func TallyVotesInvariant(keeper Keeper) sdk.Invariant {
 		}
 		prevCtx, _ := ctx.CacheContext()
 		prevCtx = prevCtx.WithBlockHeight(ctx.BlockHeight() - 1)
-		msg, broken := TallyVotesInvariantHelper(ctx, prevCtx, keeper.key, keeper.proposalTable)
+		msg, broken := TallyVotesInvariantHelper(ctx, prevCtx, keeper)
 		return sdk.FormatInvariant(group.ModuleName, votesInvariant, msg), broken
 	}
 }
@@ -41,7 +37,7 @@ func TallyVotesInvariant(keeper Keeper) sdk.Invariant {
 // GroupTotalWeightInvariant checks that group's TotalWeight must be equal to the sum of its members.
 func GroupTotalWeightInvariant(keeper Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		msg, broken := GroupTotalWeightInvariantHelper(ctx, keeper.key, keeper.groupTable, keeper.groupMemberByGroupIndex)
+		msg, broken := GroupTotalWeightInvariantHelper(ctx, keeper)
 		return sdk.FormatInvariant(group.ModuleName, weightInvariant, msg), broken
 	}
 }
@@ -49,50 +45,42 @@ func GroupTotalWeightInvariant(keeper Keeper) sdk.Invariant {
 // TallyVotesSumInvariant checks that proposal VoteState must correspond to the vote choice.
 func TallyVotesSumInvariant(keeper Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		msg, broken := TallyVotesSumInvariantHelper(ctx, keeper.key, keeper.groupTable, keeper.proposalTable, keeper.groupMemberTable, keeper.voteByProposalIndex, keeper.groupPolicyTable)
+		msg, broken := TallyVotesSumInvariantHelper(ctx, keeper)
 		return sdk.FormatInvariant(group.ModuleName, votesSumInvariant, msg), broken
 	}
 }
 
-func TallyVotesInvariantHelper(ctx sdk.Context, prevCtx sdk.Context, key storetypes.StoreKey, proposalTable orm.AutoUInt64Table) (string, bool) {
+func TallyVotesInvariantHelper(ctx sdk.Context, prevCtx sdk.Context, keeper Keeper) (string, bool) {
 
 	var msg string
 	var broken bool
 
-	prevIt, err := proposalTable.PrefixScan(prevCtx.KVStore(key), 1, math.MaxUint64)
-	if err != nil {
-		msg += fmt.Sprintf("PrefixScan failure on proposal table at block height %d\n%v\n", prevCtx.BlockHeight(), err)
-		return msg, broken
-	}
-
-	curIt, err := proposalTable.PrefixScan(ctx.KVStore(key), 1, math.MaxUint64)
-	if err != nil {
-		msg += fmt.Sprintf("PrefixScan failure on proposal table at block height %d\n%v\n", ctx.BlockHeight(), err)
-		return msg, broken
-	}
-
-	var curProposals []*group.Proposal
-	_, err = orm.ReadAll(curIt, &curProposals)
+	curProposals, err := keeper.allProposals(ctx)
 	if err != nil {
 		msg += fmt.Sprintf("error while getting all the proposals at block height %d\n%v\n", ctx.BlockHeight(), err)
 		return msg, broken
 	}
 
-	var prevProposals []*group.Proposal
-	_, err = orm.ReadAll(prevIt, &prevProposals)
+	prevProposals, err := keeper.allProposals(prevCtx)
 	if err != nil {
 		msg += fmt.Sprintf("error while getting all the proposals at block height %d\n%v\n", prevCtx.BlockHeight(), err)
 		return msg, broken
 	}
 
+	// Proposals may have been pruned since the previous block.
+	curProposalsByID := make(map[uint64]*group.Proposal, len(curProposals))
+	for _, p := range curProposals {
+		curProposalsByID[p.ProposalId] = p
+	}
+
 	for i := 0; i < len(prevProposals); i++ {
-		if prevProposals[i].ProposalId == curProposals[i].ProposalId {
+		if curProposal, ok := curProposalsByID[prevProposals[i].ProposalId]; ok {
 			prevYesCount, err := prevProposals[i].VoteState.GetYesCount()
 			if err != nil {
 				msg += fmt.Sprintf("error while getting yes votes weight of proposal at block height %d\n%v\n", prevCtx.BlockHeight(), err)
 				return msg, broken
 			}
-			curYesCount, err := curProposals[i].VoteState.GetYesCount()
+			curYesCount, err := curProposal.VoteState.GetYesCount()
 			if err != nil {
 				msg += fmt.Sprintf("error while getting yes votes weight of proposal at block height %d\n%v\n", ctx.BlockHeight(), err)
 				return msg, broken
@@ -102,7 +90,7 @@ func TallyVotesInvariantHelper(ctx sdk.Context, prevCtx sdk.Context, key storety
 				msg += fmt.Sprintf("error while getting no votes weight of proposal at block height %d\n%v\n", prevCtx.BlockHeight(), err)
 				return msg, broken
 			}
-			curNoCount, err := curProposals[i].VoteState.GetNoCount()
+			curNoCount, err := curProposal.VoteState.GetNoCount()
 			if err != nil {
 				msg += fmt.Sprintf("error while getting no votes weight of proposal at block height %d\n%v\n", ctx.BlockHeight(), err)
 				return msg, broken
@@ -112,7 +100,7 @@ func TallyVotesInvariantHelper(ctx sdk.Context, prevCtx sdk.Context, key storety
 				msg += fmt.Sprintf("error while getting abstain votes weight of proposal at block height %d\n%v\n", prevCtx.BlockHeight(), err)
 				return msg, broken
 			}
-			curAbstainCount, err := curProposals[i].VoteState.GetAbstainCount()
+			curAbstainCount, err := curProposal.VoteState.GetAbstainCount()
 			if err != nil {
 				msg += fmt.Sprintf("error while getting abstain votes weight of proposal at block height %d\n%v\n", ctx.BlockHeight(), err)
 				return msg, broken
@@ -122,7 +110,7 @@ func TallyVotesInvariantHelper(ctx sdk.Context, prevCtx sdk.Context, key storety
 				msg += fmt.Sprintf("error while getting veto votes weight of proposal at block height %d\n%v\n", prevCtx.BlockHeight(), err)
 				return msg, broken
 			}
-			curVetoCount, err := curProposals[i].VoteState.GetVetoCount()
+			curVetoCount, err := curProposal.VoteState.GetVetoCount()
 			if err != nil {
 				msg += fmt.Sprintf("error while getting veto votes weight of proposal at block height %d\n%v\n", ctx.BlockHeight(), err)
 				return msg, broken
@@ -137,43 +125,30 @@ func TallyVotesInvariantHelper(ctx sdk.Context, prevCtx sdk.Context, key storety
 	return msg, broken
 }
 
-func GroupTotalWeightInvariantHelper(ctx sdk.Context, key storetypes.StoreKey, groupTable orm.AutoUInt64Table, groupMemberByGroupIndex orm.Index) (string, bool) {
+func GroupTotalWeightInvariantHelper(ctx sdk.Context, keeper Keeper) (string, bool) {
 
 	var msg string
 	var broken bool
 
-	var groupInfo group.GroupInfo
-	var groupMember group.GroupMember
-
-	groupIt, err := groupTable.PrefixScan(ctx.KVStore(key), 1, math.MaxUint64)
+	groups, err := keeper.allGroups(ctx)
 	if err != nil {
-		msg += fmt.Sprintf("PrefixScan failure on group table\n%v\n", err)
+		msg += fmt.Sprintf("error while getting all the groups\n%v\n", err)
 		return msg, broken
 	}
-	defer groupIt.Close()
 
-	for {
+	for _, groupInfo := range groups {
 		membersWeight, err := groupmath.NewNonNegativeDecFromString("0")
 		if err != nil {
 			msg += fmt.Sprintf("error while parsing positive dec zero for group member\n%v\n", err)
 			return msg, broken
 		}
-		_, err = groupIt.LoadNext(&groupInfo)
-		if errors.ErrORMIteratorDone.Is(err) {
-			break
-		}
-		memIt, err := groupMemberByGroupIndex.Get(ctx.KVStore(key), groupInfo.GroupId)
+		groupMembers, err := keeper.groupMembers(ctx, groupInfo.GroupId)
 		if err != nil {
-			msg += fmt.Sprintf("error while returning group member iterator for group with ID %d\n%v\n", groupInfo.GroupId, err)
+			msg += fmt.Sprintf("error while getting the members of group with ID %d\n%v\n", groupInfo.GroupId, err)
 			return msg, broken
 		}
-		defer memIt.Close()
 
-		for {
-			_, err = memIt.LoadNext(&groupMember)
-			if errors.ErrORMIteratorDone.Is(err) {
-				break
-			}
+		for _, groupMember := range groupMembers {
 			curMemWeight, err := groupmath.NewNonNegativeDecFromString(groupMember.GetMember().GetWeight())
 			if err != nil {
 				msg += fmt.Sprintf("error while parsing non-nengative decimal for group member %s\n%v\n", groupMember.Member.Address, err)
@@ -200,25 +175,21 @@ func GroupTotalWeightInvariantHelper(ctx sdk.Context, key storetypes.StoreKey, g
 	return msg, broken
 }
 
-func TallyVotesSumInvariantHelper(ctx sdk.Context, key storetypes.StoreKey, groupTable orm.AutoUInt64Table, proposalTable orm.AutoUInt64Table, groupMemberTable orm.PrimaryKeyTable, voteByProposalIndex orm.Index, groupPolicyTable orm.PrimaryKeyTable) (string, bool) {
+func TallyVotesSumInvariantHelper(ctx sdk.Context, keeper Keeper) (string, bool) {
 	var msg string
 	var broken bool
 
-	var groupInfo group.GroupInfo
-	var proposal group.Proposal
-	var groupPolicy group.GroupPolicyInfo
-	var groupMem group.GroupMember
-	var vote group.Vote
-
-	proposalIt, err := proposalTable.PrefixScan(ctx.KVStore(key), 1, math.MaxUint64)
+	proposals, err := keeper.allProposals(ctx)
 	if err != nil {
-		fmt.Println(err)
-		msg += fmt.Sprintf("PrefixScan failure on proposal table\n%v\n", err)
+		msg += fmt.Sprintf("error while getting all the proposals\n%v\n", err)
 		return msg, broken
 	}
-	defer proposalIt.Close()
 
-	for {
+	for _, proposal := range proposals {
+		// The votes of a proposal are pruned at the end of its voting period.
+		if !proposal.Timeout.After(ctx.BlockTime()) {
+			continue
+		}
 
 		totalVotingWeight, err := groupmath.NewNonNegativeDecFromString("0")
 		if err != nil {
@@ -246,12 +217,7 @@ func TallyVotesSumInvariantHelper(ctx sdk.Context, key storetypes.StoreKey, grou
 			return msg, broken
 		}
 
-		_, err = proposalIt.LoadNext(&proposal)
-		if errors.ErrORMIteratorDone.Is(err) {
-			break
-		}
-
-		err = groupPolicyTable.GetOne(ctx.KVStore(key), orm.PrimaryKey(&group.GroupPolicyInfo{Address: proposal.Address}), &groupPolicy)
+		groupPolicy, err := keeper.getGroupPolicyInfo(ctx, proposal.Address)
 		if err != nil {
 			msg += fmt.Sprintf("group policy not found for address: %s\n%v\n", proposal.Address, err)
 			return msg, broken
@@ -262,7 +228,7 @@ func TallyVotesSumInvariantHelper(ctx sdk.Context, key storetypes.StoreKey, grou
 			return msg, broken
 		}
 
-		_, err = groupTable.GetOne(ctx.KVStore(key), groupPolicy.GroupId, &groupInfo)
+		groupInfo, err := keeper.getGroupInfo(ctx, groupPolicy.GroupId)
 		if err != nil {
 			msg += fmt.Sprintf("group info not found for group id %d\n%v\n", groupPolicy.GroupId, err)
 			return msg, broken
@@ -273,20 +239,16 @@ func TallyVotesSumInvariantHelper(ctx sdk.Context, key storetypes.StoreKey, grou
 			return msg, broken
 		}
 
-		voteIt, err := voteByProposalIndex.Get(ctx.KVStore(key), proposal.ProposalId)
+		votes, err := keeper.proposalVotes(ctx, proposal.ProposalId)
 		if err != nil {
-			msg += fmt.Sprintf("error while returning vote iterator for proposal with ID %d\n%v\n", proposal.ProposalId, err)
+			msg += fmt.Sprintf("error while getting the votes of proposal with ID %d\n%v\n", proposal.ProposalId, err)
 			return msg, broken
 		}
-		defer voteIt.Close()
-
-		for {
-			_, err := voteIt.LoadNext(&vote)
-			if errors.ErrORMIteratorDone.Is(err) {
-				break
-			}
 
-			err = groupMemberTable.GetOne(ctx.KVStore(key), orm.PrimaryKey(&group.GroupMember{GroupId: groupPolicy.GroupId, Member: &group.Member{Address: vote.Voter}}), &groupMem)
+		var vote group.Vote
+		for _, v := range votes {
+			vote = *v
+			groupMem, err := keeper.getGroupMember(ctx, groupPolicy.GroupId, vote.Voter)
 			if err != nil {
 				msg += fmt.Sprintf("group member not found with group ID %d and group member %s\n%v\n", groupPolicy.GroupId, vote.Voter, err)
 				return msg, broken
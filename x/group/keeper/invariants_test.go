@@ -18,7 +18,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
-	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
 	"github.com/cosmos/cosmos-sdk/x/group/keeper"
 )
 
@@ -26,8 +25,7 @@ type invariantTestSuite struct {
 	suite.Suite
 
 	ctx       sdk.Context
-	cdc       *codec.ProtoCodec
-	key       *storetypes.KVStoreKey
+	keeper    keeper.Keeper
 	blockTime time.Time
 }
 
@@ -47,21 +45,16 @@ func (s *invariantTestSuite) SetupSuite() {
 	sdkCtx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
 
 	s.ctx = sdkCtx
-	s.cdc = cdc
-	s.key = key
+	s.keeper = keeper.NewKeeper(key, cdc, nil, nil, keeper.DefaultConfig())
 
 }
 
 func (s *invariantTestSuite) TestTallyVotesInvariant() {
 	sdkCtx, _ := s.ctx.CacheContext()
-	curCtx, cdc, key := sdkCtx, s.cdc, s.key
+	curCtx, k := sdkCtx, s.keeper
 	prevCtx, _ := curCtx.CacheContext()
 	prevCtx = prevCtx.WithBlockHeight(curCtx.BlockHeight() - 1)
 
-	// Proposal Table
-	proposalTable, err := orm.NewAutoUInt64Table([2]byte{keeper.ProposalTablePrefix}, keeper.ProposalTableSeqPrefix, &group.Proposal{}, cdc)
-	s.Require().NoError(err)
-
 	_, _, addr1 := testdata.KeyTestPubAddr()
 	_, _, addr2 := testdata.KeyTestPubAddr()
 
@@ -225,33 +218,19 @@ func (s *invariantTestSuite) TestTallyVotesInvariant() {
 		cachePrevCtx, _ := prevCtx.CacheContext()
 		cacheCurCtx, _ := curCtx.CacheContext()
 
-		_, err = proposalTable.Create(cachePrevCtx.KVStore(key), prevProposal)
+		err := k.ImportState(cachePrevCtx, &group.GenesisState{ProposalSeq: 1, Proposals: []*group.Proposal{prevProposal}})
 		s.Require().NoError(err)
-		_, err = proposalTable.Create(cacheCurCtx.KVStore(key), curProposal)
+		err = k.ImportState(cacheCurCtx, &group.GenesisState{ProposalSeq: 1, Proposals: []*group.Proposal{curProposal}})
 		s.Require().NoError(err)
 
-		_, broken := keeper.TallyVotesInvariantHelper(cacheCurCtx, cachePrevCtx, key, *proposalTable)
+		_, broken := keeper.TallyVotesInvariantHelper(cacheCurCtx, cachePrevCtx, k)
 		s.Require().Equal(spec.expBroken, broken)
 	}
 }
 
 func (s *invariantTestSuite) TestGroupTotalWeightInvariant() {
 	sdkCtx, _ := s.ctx.CacheContext()
-	curCtx, cdc, key := sdkCtx, s.cdc, s.key
-
-	// Group Table
-	groupTable, err := orm.NewAutoUInt64Table([2]byte{keeper.GroupTablePrefix}, keeper.GroupTableSeqPrefix, &group.GroupInfo{}, cdc)
-	s.Require().NoError(err)
-
-	// Group Member Table
-	groupMemberTable, err := orm.NewPrimaryKeyTable([2]byte{keeper.GroupMemberTablePrefix}, &group.GroupMember{}, cdc)
-	s.Require().NoError(err)
-
-	groupMemberByGroupIndex, err := orm.NewIndex(groupMemberTable, keeper.GroupMemberByGroupIndexPrefix, func(val interface{}) ([]interface{}, error) {
-		group := val.(*group.GroupMember).GroupId
-		return []interface{}{group}, nil
-	}, group.GroupMember{}.GroupId)
-	s.Require().NoError(err)
+	curCtx, k := sdkCtx, s.keeper
 
 	_, _, addr1 := testdata.KeyTestPubAddr()
 	_, _, addr2 := testdata.KeyTestPubAddr()
@@ -319,15 +298,14 @@ func (s *invariantTestSuite) TestGroupTotalWeightInvariant() {
 		groupsInfo := spec.groupsInfo
 		groupMembers := spec.groupMembers
 
-		_, err := groupTable.Create(cacheCurCtx.KVStore(key), groupsInfo)
+		err := k.ImportState(cacheCurCtx, &group.GenesisState{
+			GroupSeq:     1,
+			Groups:       []*group.GroupInfo{groupsInfo},
+			GroupMembers: groupMembers,
+		})
 		s.Require().NoError(err)
 
-		for i := 0; i < len(groupMembers); i++ {
-			err := groupMemberTable.Create(cacheCurCtx.KVStore(key), groupMembers[i])
-			s.Require().NoError(err)
-		}
-
-		_, broken := keeper.GroupTotalWeightInvariantHelper(cacheCurCtx, key, *groupTable, groupMemberByGroupIndex)
+		_, broken := keeper.GroupTotalWeightInvariantHelper(cacheCurCtx, k)
 		s.Require().Equal(spec.expBroken, broken)
 
 	}
@@ -335,32 +313,7 @@ func (s *invariantTestSuite) TestGroupTotalWeightInvariant() {
 
 func (s *invariantTestSuite) TestTallyVotesSumInvariant() {
 	sdkCtx, _ := s.ctx.CacheContext()
-	curCtx, cdc, key := sdkCtx, s.cdc, s.key
-
-	// Group Table
-	groupTable, err := orm.NewAutoUInt64Table([2]byte{keeper.GroupTablePrefix}, keeper.GroupTableSeqPrefix, &group.GroupInfo{}, cdc)
-	s.Require().NoError(err)
-
-	// Group Policy Table
-	groupPolicyTable, err := orm.NewPrimaryKeyTable([2]byte{keeper.GroupPolicyTablePrefix}, &group.GroupPolicyInfo{}, cdc)
-	s.Require().NoError(err)
-
-	// Group Member Table
-	groupMemberTable, err := orm.NewPrimaryKeyTable([2]byte{keeper.GroupMemberTablePrefix}, &group.GroupMember{}, cdc)
-	s.Require().NoError(err)
-
-	// Proposal Table
-	proposalTable, err := orm.NewAutoUInt64Table([2]byte{keeper.ProposalTablePrefix}, keeper.ProposalTableSeqPrefix, &group.Proposal{}, cdc)
-	s.Require().NoError(err)
-
-	// Vote Table
-	voteTable, err := orm.NewPrimaryKeyTable([2]byte{keeper.VoteTablePrefix}, &group.Vote{}, cdc)
-	s.Require().NoError(err)
-
-	voteByProposalIndex, err := orm.NewIndex(voteTable, keeper.VoteByProposalIndexPrefix, func(value interface{}) ([]interface{}, error) {
-		return []interface{}{value.(*group.Vote).ProposalId}, nil
-	}, group.Vote{}.ProposalId)
-	s.Require().NoError(err)
+	curCtx, k := sdkCtx, s.keeper
 
 	_, _, adminAddr := testdata.KeyTestPubAddr()
 	_, _, addr1 := testdata.KeyTestPubAddr()
@@ -558,28 +511,21 @@ func (s *invariantTestSuite) TestTallyVotesSumInvariant() {
 		groupMembers := spec.groupMembers
 		votes := spec.votes
 
-		_, err := groupTable.Create(cacheCurCtx.KVStore(key), groupsInfo)
-		s.Require().NoError(err)
-
-		err = groupPolicy.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Second))
-		s.Require().NoError(err)
-		err = groupPolicyTable.Create(cacheCurCtx.KVStore(key), groupPolicy)
+		err := groupPolicy.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Second, 0))
 		s.Require().NoError(err)
 
-		for i := 0; i < len(groupMembers); i++ {
-			err = groupMemberTable.Create(cacheCurCtx.KVStore(key), groupMembers[i])
-			s.Require().NoError(err)
-		}
-
-		_, err = proposalTable.Create(cacheCurCtx.KVStore(key), proposal)
+		err = k.ImportState(cacheCurCtx, &group.GenesisState{
+			GroupSeq:      1,
+			Groups:        []*group.GroupInfo{groupsInfo},
+			GroupMembers:  groupMembers,
+			GroupPolicies: []*group.GroupPolicyInfo{groupPolicy},
+			ProposalSeq:   1,
+			Proposals:     []*group.Proposal{proposal},
+			Votes:         votes,
+		})
 		s.Require().NoError(err)
 
-		for i := 0; i < len(votes); i++ {
-			err = voteTable.Create(cacheCurCtx.KVStore(key), votes[i])
-			s.Require().NoError(err)
-		}
-
-		_, broken := keeper.TallyVotesSumInvariantHelper(cacheCurCtx, key, *groupTable, *proposalTable, *groupMemberTable, voteByProposalIndex, *groupPolicyTable)
+		_, broken := keeper.TallyVotesSumInvariantHelper(cacheCurCtx, k)
 		s.Require().Equal(spec.expBroken, broken)
 	}
 }
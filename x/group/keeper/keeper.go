@@ -2,75 +2,44 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/ormstore"
 	authmiddleware "github.com/cosmos/cosmos-sdk/x/auth/middleware"
 	"github.com/cosmos/cosmos-sdk/x/group"
-	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
+	statev1beta1 "github.com/cosmos/cosmos-sdk/x/group/internal/state/v1beta1"
 )
 
-const (
-	// Group Table
-	GroupTablePrefix        byte = 0x0
-	GroupTableSeqPrefix     byte = 0x1
-	GroupByAdminIndexPrefix byte = 0x2
-
-	// Group Member Table
-	GroupMemberTablePrefix         byte = 0x10
-	GroupMemberByGroupIndexPrefix  byte = 0x11
-	GroupMemberByMemberIndexPrefix byte = 0x12
-
-	// Group Policy Table
-	GroupPolicyTablePrefix        byte = 0x20
-	GroupPolicyTableSeqPrefix     byte = 0x21
-	GroupPolicyByGroupIndexPrefix byte = 0x22
-	GroupPolicyByAdminIndexPrefix byte = 0x23
-
-	// Proposal Table
-	ProposalTablePrefix              byte = 0x30
-	ProposalTableSeqPrefix           byte = 0x31
-	ProposalByGroupPolicyIndexPrefix byte = 0x32
-	ProposalByProposerIndexPrefix    byte = 0x33
-
-	// Vote Table
-	VoteTablePrefix           byte = 0x40
-	VoteByProposalIndexPrefix byte = 0x41
-	VoteByVoterIndexPrefix    byte = 0x42
-)
+// GroupPolicyTablePrefix is the derivation key of the module account from
+// which the group policy account addresses are derived. It is the key prefix
+// of the group policy table of the first version of the module state.
+const GroupPolicyTablePrefix byte = 0x20
+
+// ModuleSchema is the ORM schema of the group module state.
+var ModuleSchema = ormdb.ModuleSchema{
+	FileDescriptors: map[uint32]protoreflect.FileDescriptor{
+		1: statev1beta1.File_cosmos_group_state_v1beta1_state_proto,
+	},
+}
 
 type Keeper struct {
 	key storetypes.StoreKey
+	cdc codec.Codec
 
 	accKeeper group.AccountKeeper
 
-	// Group Table
-	groupTable        orm.AutoUInt64Table
-	groupByAdminIndex orm.Index
-
-	// Group Member Table
-	groupMemberTable         orm.PrimaryKeyTable
-	groupMemberByGroupIndex  orm.Index
-	groupMemberByMemberIndex orm.Index
-
-	// Group Policy Table
-	groupPolicySeq          orm.Sequence
-	groupPolicyTable        orm.PrimaryKeyTable
-	groupPolicyByGroupIndex orm.Index
-	groupPolicyByAdminIndex orm.Index
-
-	// Proposal Table
-	proposalTable              orm.AutoUInt64Table
-	proposalByGroupPolicyIndex orm.Index
-	proposalByProposerIndex    orm.Index
-
-	// Vote Table
-	voteTable           orm.PrimaryKeyTable
-	voteByProposalIndex orm.Index
-	voteByVoterIndex    orm.Index
+	db    ormdb.ModuleDB
+	state statev1beta1.StateStore
 
 	router *authmiddleware.MsgServiceRouter
 
@@ -80,150 +49,162 @@ type Keeper struct {
 func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router *authmiddleware.MsgServiceRouter, accKeeper group.AccountKeeper, config Config) Keeper {
 	k := Keeper{
 		key:       storeKey,
+		cdc:       cdc,
 		router:    router,
 		accKeeper: accKeeper,
 	}
 
-	groupTable, err := orm.NewAutoUInt64Table([2]byte{GroupTablePrefix}, GroupTableSeqPrefix, &group.GroupInfo{}, cdc)
+	db, err := ormdb.NewModuleDB(ModuleSchema, ormstore.ModuleDBOptions(storeKey))
 	if err != nil {
 		panic(err.Error())
 	}
-	k.groupByAdminIndex, err = orm.NewIndex(groupTable, GroupByAdminIndexPrefix, func(val interface{}) ([]interface{}, error) {
-		addr, err := sdk.AccAddressFromBech32(val.(*group.GroupInfo).Admin)
-		if err != nil {
-			return nil, err
-		}
-		return []interface{}{addr.Bytes()}, nil
-	}, []byte{})
+	k.db = db
+
+	k.state, err = statev1beta1.NewStateStore(db)
 	if err != nil {
 		panic(err.Error())
 	}
-	k.groupTable = *groupTable
 
-	// Group Member Table
-	groupMemberTable, err := orm.NewPrimaryKeyTable([2]byte{GroupMemberTablePrefix}, &group.GroupMember{}, cdc)
-	if err != nil {
-		panic(err.Error())
+	if config.MaxMetadataLen == 0 {
+		config.MaxMetadataLen = DefaultConfig().MaxMetadataLen
 	}
-	k.groupMemberByGroupIndex, err = orm.NewIndex(groupMemberTable, GroupMemberByGroupIndexPrefix, func(val interface{}) ([]interface{}, error) {
-		group := val.(*group.GroupMember).GroupId
-		return []interface{}{group}, nil
-	}, group.GroupMember{}.GroupId)
-	if err != nil {
-		panic(err.Error())
+	if config.MaxExecutionPeriod == 0 {
+		config.MaxExecutionPeriod = DefaultConfig().MaxExecutionPeriod
 	}
-	k.groupMemberByMemberIndex, err = orm.NewIndex(groupMemberTable, GroupMemberByMemberIndexPrefix, func(val interface{}) ([]interface{}, error) {
-		memberAddr := val.(*group.GroupMember).Member.Address
-		addr, err := sdk.AccAddressFromBech32(memberAddr)
-		if err != nil {
-			return nil, err
-		}
-		return []interface{}{addr.Bytes()}, nil
-	}, []byte{})
+	k.config = config
+
+	return k
+
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", group.ModuleName))
+}
+
+// MaxMetadataLength returns the max length of the metadata bytes field for various entities within the group module.
+func (k Keeper) MaxMetadataLength() uint64 { return k.config.MaxMetadataLen }
+
+// MaxExecutionPeriod returns the max duration after a proposal's voting period ends during which the proposal can be executed.
+func (k Keeper) MaxExecutionPeriod() time.Duration { return k.config.MaxExecutionPeriod }
+
+// GetGroupSequence returns the current value of the group table sequence,
+// which is the ID of the last created group as groups are never deleted.
+func (k Keeper) GetGroupSequence(ctx sdk.Context) uint64 {
+	it, err := k.state.GroupInfoStore().List(sdk.WrapSDKContext(ctx), statev1beta1.GroupInfoGroupIdIndexKey{}, ormlist.Reverse())
 	if err != nil {
-		panic(err.Error())
+		panic(err)
 	}
-	k.groupMemberTable = *groupMemberTable
+	defer it.Close()
 
-	// Group Policy Table
-	k.groupPolicySeq = orm.NewSequence(GroupPolicyTableSeqPrefix)
-	groupPolicyTable, err := orm.NewPrimaryKeyTable([2]byte{GroupPolicyTablePrefix}, &group.GroupPolicyInfo{}, cdc)
-	if err != nil {
-		panic(err.Error())
+	if !it.Next() {
+		return 0
 	}
-	k.groupPolicyByGroupIndex, err = orm.NewIndex(groupPolicyTable, GroupPolicyByGroupIndexPrefix, func(value interface{}) ([]interface{}, error) {
-		return []interface{}{value.(*group.GroupPolicyInfo).GroupId}, nil
-	}, group.GroupPolicyInfo{}.GroupId)
+	g, err := it.Value()
 	if err != nil {
-		panic(err.Error())
+		panic(err)
 	}
-	k.groupPolicyByAdminIndex, err = orm.NewIndex(groupPolicyTable, GroupPolicyByAdminIndexPrefix, func(value interface{}) ([]interface{}, error) {
-		admin := value.(*group.GroupPolicyInfo).Admin
-		addr, err := sdk.AccAddressFromBech32(admin)
-		if err != nil {
-			return nil, err
-		}
-		return []interface{}{addr.Bytes()}, nil
-	}, []byte{})
+	return g.GroupId
+}
+
+// TallyProposalsAtVPEnd iterates over the proposals whose voting period has
+// ended since the last call. Submitted proposals are tallied and their final
+// tally is kept in the proposal vote state, while their votes are pruned.
+// Proposals that were aborted or withdrawn are pruned with their votes. An
+// error processing a proposal is logged and the proposal is skipped.
+func (k Keeper) TallyProposalsAtVPEnd(ctx sdk.Context) error {
+	goCtx := sdk.WrapSDKContext(ctx)
+	tally, err := k.state.ProposalTallyStore().Get(goCtx)
 	if err != nil {
-		panic(err.Error())
+		return err
 	}
-	k.groupPolicyTable = *groupPolicyTable
 
-	// Proposal Table
-	proposalTable, err := orm.NewAutoUInt64Table([2]byte{ProposalTablePrefix}, ProposalTableSeqPrefix, &group.Proposal{}, cdc)
+	proposals, err := k.proposalsByTimeout(ctx, tally.LastTimeout, ctx.BlockTime())
 	if err != nil {
-		panic(err.Error())
+		return err
 	}
-	k.proposalByGroupPolicyIndex, err = orm.NewIndex(proposalTable, ProposalByGroupPolicyIndexPrefix, func(value interface{}) ([]interface{}, error) {
-		account := value.(*group.Proposal).Address
-		addr, err := sdk.AccAddressFromBech32(account)
-		if err != nil {
-			return nil, err
+	if len(proposals) == 0 {
+		return nil
+	}
+
+	for _, proposal := range proposals {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.processProposalAtVPEnd(cacheCtx, proposal); err != nil {
+			k.Logger(ctx).Error("failed to process proposal at the end of its voting period",
+				"proposal", proposal.ProposalId, "err", err)
+			continue
 		}
-		return []interface{}{addr.Bytes()}, nil
-	}, []byte{})
-	if err != nil {
-		panic(err.Error())
+		writeCache()
 	}
-	k.proposalByProposerIndex, err = orm.NewIndex(proposalTable, ProposalByProposerIndexPrefix, func(value interface{}) ([]interface{}, error) {
-		proposers := value.(*group.Proposal).Proposers
-		r := make([]interface{}, len(proposers))
-		for i := range proposers {
-			addr, err := sdk.AccAddressFromBech32(proposers[i])
-			if err != nil {
-				return nil, err
-			}
-			r[i] = addr.Bytes()
+
+	// Proposals are ordered by timeout, so that the last one has the greatest.
+	tally.LastTimeout = timestamppb.New(proposals[len(proposals)-1].Timeout)
+	return k.state.ProposalTallyStore().Save(goCtx, tally)
+}
+
+// processProposalAtVPEnd tallies or prunes a proposal whose voting period has
+// ended, depending on its status.
+func (k Keeper) processProposalAtVPEnd(ctx sdk.Context, proposal *group.Proposal) error {
+	switch proposal.Status {
+	case group.ProposalStatusAborted, group.ProposalStatusWithdrawn:
+		return k.pruneProposal(ctx, proposal.ProposalId)
+	case group.ProposalStatusSubmitted:
+		if err := k.tallyAtVPEnd(ctx, proposal); err != nil {
+			return sdkerrors.Wrapf(err, "tally proposal %d", proposal.ProposalId)
 		}
-		return r, nil
-	}, []byte{})
-	if err != nil {
-		panic(err.Error())
+		if proposal.Status == group.ProposalStatusAborted {
+			return k.pruneProposal(ctx, proposal.ProposalId)
+		}
+		if err := k.updateProposal(ctx, proposal); err != nil {
+			return err
+		}
+		return k.pruneVotes(ctx, proposal.ProposalId)
+	default:
+		// Proposals closed before the end of their voting period can't
+		// receive votes anymore.
+		return k.pruneVotes(ctx, proposal.ProposalId)
 	}
-	k.proposalTable = *proposalTable
+}
 
-	// Vote Table
-	voteTable, err := orm.NewPrimaryKeyTable([2]byte{VoteTablePrefix}, &group.Vote{}, cdc)
+// tallyAtVPEnd computes the final result of a submitted proposal at the end
+// of its voting period. The proposal is aborted if its group or group policy
+// has been modified since its submission.
+func (k Keeper) tallyAtVPEnd(ctx sdk.Context, proposal *group.Proposal) error {
+	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.Address)
 	if err != nil {
-		panic(err.Error())
+		return sdkerrors.Wrap(err, "load group policy")
 	}
-	k.voteByProposalIndex, err = orm.NewIndex(voteTable, VoteByProposalIndexPrefix, func(value interface{}) ([]interface{}, error) {
-		return []interface{}{value.(*group.Vote).ProposalId}, nil
-	}, group.Vote{}.ProposalId)
+	electorate, err := k.getGroupInfo(ctx, policyInfo.GroupId)
 	if err != nil {
-		panic(err.Error())
+		return sdkerrors.Wrap(err, "load group")
 	}
-	k.voteByVoterIndex, err = orm.NewIndex(voteTable, VoteByVoterIndexPrefix, func(value interface{}) ([]interface{}, error) {
-		addr, err := sdk.AccAddressFromBech32(value.(*group.Vote).Voter)
-		if err != nil {
-			return nil, err
-		}
-		return []interface{}{addr.Bytes()}, nil
-	}, []byte{})
-	if err != nil {
-		panic(err.Error())
-	}
-	k.voteTable = *voteTable
 
-	if config.MaxMetadataLen == 0 {
-		config.MaxMetadataLen = DefaultConfig().MaxMetadataLen
+	if proposal.GroupPolicyVersion != policyInfo.Version || proposal.GroupVersion != electorate.Version {
+		proposal.Result = group.ProposalResultUnfinalized
+		proposal.Status = group.ProposalStatusAborted
+		return nil
 	}
-	k.config = config
-
-	return k
 
+	return doTally(ctx, proposal, electorate, policyInfo)
 }
 
-// Logger returns a module-specific logger.
-func (k Keeper) Logger(ctx sdk.Context) log.Logger {
-	return ctx.Logger().With("module", fmt.Sprintf("x/%s", group.ModuleName))
-}
+// PruneProposals prunes the proposals whose voting period ended more than
+// MaxExecutionPeriod ago, whatever their status. An error pruning a proposal
+// is logged and the proposal is skipped.
+func (k Keeper) PruneProposals(ctx sdk.Context) error {
+	proposals, err := k.proposalsByTimeout(ctx, nil, ctx.BlockTime().Add(-k.config.MaxExecutionPeriod))
+	if err != nil {
+		return err
+	}
 
-// MaxMetadataLength returns the max length of the metadata bytes field for various entities within the group module.
-func (k Keeper) MaxMetadataLength() uint64 { return k.config.MaxMetadataLen }
+	for _, proposal := range proposals {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.pruneProposal(cacheCtx, proposal.ProposalId); err != nil {
+			k.Logger(ctx).Error("failed to prune proposal", "proposal", proposal.ProposalId, "err", err)
+			continue
+		}
+		writeCache()
+	}
 
-// GetGroupSequence returns the current value of the group table sequence
-func (k Keeper) GetGroupSequence(ctx sdk.Context) uint64 {
-	return k.groupTable.Sequence().CurVal(ctx.KVStore(k.key))
+	return nil
 }
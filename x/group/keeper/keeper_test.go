@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
@@ -62,6 +63,7 @@ func (s *TestSuite) SetupTest() {
 	policy := group.NewThresholdDecisionPolicy(
 		"2",
 		time.Second,
+		0,
 	)
 	policyReq := &group.MsgCreateGroupPolicy{
 		Admin:    s.addrs[0].String(),
@@ -199,11 +201,7 @@ func (s *TestSuite) TestCreateGroup() {
 			s.Require().Equal(len(members), len(loadedMembers))
 			// we reorder members by address to be able to compare them
 			sort.Slice(members, func(i, j int) bool {
-				addri, err := sdk.AccAddressFromBech32(members[i].Address)
-				s.Require().NoError(err)
-				addrj, err := sdk.AccAddressFromBech32(members[j].Address)
-				s.Require().NoError(err)
-				return bytes.Compare(addri, addrj) < 0
+				return members[i].Address < members[j].Address
 			})
 			for i := range loadedMembers {
 				s.Assert().Equal(members[i].Metadata, loadedMembers[i].Member.Metadata)
@@ -689,11 +687,7 @@ func (s *TestSuite) TestUpdateGroupMembers() {
 			s.Require().Equal(len(spec.expMembers), len(loadedMembers))
 			// we reorder group members by address to be able to compare them
 			sort.Slice(spec.expMembers, func(i, j int) bool {
-				addri, err := sdk.AccAddressFromBech32(spec.expMembers[i].Member.Address)
-				s.Require().NoError(err)
-				addrj, err := sdk.AccAddressFromBech32(spec.expMembers[j].Member.Address)
-				s.Require().NoError(err)
-				return bytes.Compare(addri, addrj) < 0
+				return spec.expMembers[i].Member.Address < spec.expMembers[j].Member.Address
 			})
 			for i := range loadedMembers {
 				s.Assert().Equal(spec.expMembers[i].Member.Metadata, loadedMembers[i].Member.Metadata)
@@ -732,6 +726,7 @@ func (s *TestSuite) TestCreateGroupPolicy() {
 			policy: group.NewThresholdDecisionPolicy(
 				"1",
 				time.Second,
+				0,
 			),
 		},
 		"decision policy threshold > total group weight": {
@@ -743,6 +738,33 @@ func (s *TestSuite) TestCreateGroupPolicy() {
 			policy: group.NewThresholdDecisionPolicy(
 				"10",
 				time.Second,
+				0,
+			),
+		},
+		"percentage decision policy": {
+			req: &group.MsgCreateGroupPolicy{
+				Admin:    addr1.String(),
+				Metadata: nil,
+				GroupId:  myGroupID,
+			},
+			policy: group.NewPercentageDecisionPolicy(
+				"0.5",
+				time.Second,
+				0,
+			),
+		},
+		"quorum decision policy": {
+			req: &group.MsgCreateGroupPolicy{
+				Admin:    addr1.String(),
+				Metadata: nil,
+				GroupId:  myGroupID,
+			},
+			policy: group.NewQuorumDecisionPolicy(
+				"0.4",
+				"0.5",
+				"0.334",
+				time.Second,
+				time.Second,
 			),
 		},
 		"group id does not exists": {
@@ -754,6 +776,7 @@ func (s *TestSuite) TestCreateGroupPolicy() {
 			policy: group.NewThresholdDecisionPolicy(
 				"1",
 				time.Second,
+				0,
 			),
 			expErr: true,
 		},
@@ -766,6 +789,7 @@ func (s *TestSuite) TestCreateGroupPolicy() {
 			policy: group.NewThresholdDecisionPolicy(
 				"1",
 				time.Second,
+				0,
 			),
 			expErr: true,
 		},
@@ -778,6 +802,20 @@ func (s *TestSuite) TestCreateGroupPolicy() {
 			policy: group.NewThresholdDecisionPolicy(
 				"1",
 				time.Second,
+				0,
+			),
+			expErr: true,
+		},
+		"min execution period after max execution period": {
+			req: &group.MsgCreateGroupPolicy{
+				Admin:    addr1.String(),
+				Metadata: nil,
+				GroupId:  myGroupID,
+			},
+			policy: group.NewThresholdDecisionPolicy(
+				"1",
+				time.Second,
+				time.Second+s.keeper.MaxExecutionPeriod()+time.Nanosecond,
 			),
 			expErr: true,
 		},
@@ -806,7 +844,7 @@ func (s *TestSuite) TestCreateGroupPolicy() {
 			s.Assert().Equal(spec.req.Admin, groupPolicy.Admin)
 			s.Assert().Equal(spec.req.Metadata, groupPolicy.Metadata)
 			s.Assert().Equal(uint64(1), groupPolicy.Version)
-			s.Assert().Equal(spec.policy.(*group.ThresholdDecisionPolicy), groupPolicy.GetDecisionPolicy())
+			s.Assert().Equal(spec.policy, groupPolicy.GetDecisionPolicy())
 		})
 	}
 }
@@ -1009,6 +1047,19 @@ func (s *TestSuite) TestUpdateGroupPolicyDecisionPolicy() {
 			expGroupPolicy: &group.GroupPolicyInfo{},
 			expErr:         true,
 		},
+		"min execution period after max execution period": {
+			req: &group.MsgUpdateGroupPolicyDecisionPolicy{
+				Admin:   admin.String(),
+				Address: groupPolicyAddr,
+			},
+			policy: group.NewThresholdDecisionPolicy(
+				"2",
+				time.Second,
+				time.Second+s.keeper.MaxExecutionPeriod()+time.Nanosecond,
+			),
+			expGroupPolicy: &group.GroupPolicyInfo{},
+			expErr:         true,
+		},
 		"correct data": {
 			req: &group.MsgUpdateGroupPolicyDecisionPolicy{
 				Admin:   admin.String(),
@@ -1017,6 +1068,7 @@ func (s *TestSuite) TestUpdateGroupPolicyDecisionPolicy() {
 			policy: group.NewThresholdDecisionPolicy(
 				"2",
 				time.Duration(2)*time.Second,
+				0,
 			),
 			expGroupPolicy: &group.GroupPolicyInfo{
 				Admin:          admin.String(),
@@ -1071,10 +1123,12 @@ func (s *TestSuite) TestGroupPoliciesByAdminOrGroup() {
 		group.NewThresholdDecisionPolicy(
 			"1",
 			time.Second,
+			0,
 		),
 		group.NewThresholdDecisionPolicy(
 			"10",
 			time.Second,
+			0,
 		),
 	}
 
@@ -1168,6 +1222,7 @@ func (s *TestSuite) TestCreateProposal() {
 	policy := group.NewThresholdDecisionPolicy(
 		"100",
 		time.Second,
+		0,
 	)
 	err := policyReq.SetDecisionPolicy(policy)
 	s.Require().NoError(err)
@@ -1342,6 +1397,16 @@ func (s *TestSuite) TestCreateProposal() {
 			s.Require().NoError(err)
 			id := res.ProposalId
 
+			if spec.expProposal.ExecutorResult == group.ProposalExecutorResultSuccess {
+				// then proposal is pruned after successful execution
+				_, err = s.keeper.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: id})
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), "load proposal: not found")
+
+				spec.postRun(s.sdkCtx)
+				return
+			}
+
 			// then all data persisted
 			proposalRes, err := s.keeper.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: id})
 			s.Require().NoError(err)
@@ -1480,6 +1545,7 @@ func (s *TestSuite) TestVote() {
 	policy := group.NewThresholdDecisionPolicy(
 		"2",
 		time.Duration(2),
+		0,
 	)
 	policyReq := &group.MsgCreateGroupPolicy{
 		Admin:    addr1.String(),
@@ -1850,7 +1916,20 @@ func (s *TestSuite) TestVote() {
 			}
 			s.Require().NoError(err)
 
-			s.Require().NoError(err)
+			if spec.expExecutorResult == group.ProposalExecutorResultSuccess {
+				// proposal and votes are pruned after successful execution
+				_, err = s.keeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: spec.req.ProposalId})
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), "load proposal: not found")
+
+				votesRes, err := s.keeper.VotesByProposal(ctx, &group.QueryVotesByProposalRequest{ProposalId: spec.req.ProposalId})
+				s.Require().NoError(err)
+				s.Require().Empty(votesRes.Votes)
+
+				spec.postRun(sdkCtx)
+				return
+			}
+
 			// vote is stored and all data persisted
 			res, err := s.keeper.VoteByProposalVoter(ctx, &group.QueryVoteByProposalVoterRequest{
 				ProposalId: spec.req.ProposalId,
@@ -1912,6 +1991,7 @@ func (s *TestSuite) TestExecProposal() {
 	addrs := s.addrs
 	addr1 := addrs[0]
 	addr2 := addrs[1]
+	addr3 := addrs[2]
 
 	msgSend1 := &banktypes.MsgSend{
 		FromAddress: s.groupPolicyAddr.String(),
@@ -1960,6 +2040,27 @@ func (s *TestSuite) TestExecProposal() {
 			expFromBalances:   sdk.NewInt64Coin("test", 9800),
 			expToBalances:     sdk.NewInt64Coin("test", 200),
 		},
+		"proposal with authz MsgExec executed when accepted": {
+			setupProposal: func(ctx context.Context) uint64 {
+				sdkCtx := sdk.UnwrapSDKContext(ctx)
+				s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, sdkCtx, addr3, sdk.Coins{sdk.NewInt64Coin("test", 100)}))
+				err := s.app.AuthzKeeper.SaveGrant(sdkCtx, s.groupPolicyAddr, addr3, authz.NewGenericAuthorization(sdk.MsgTypeURL(msgSend1)), s.blockTime.Add(time.Hour))
+				s.Require().NoError(err)
+
+				msgExec := authz.NewMsgExec(s.groupPolicyAddr, []sdk.Msg{&banktypes.MsgSend{
+					FromAddress: addr3.String(),
+					ToAddress:   addr2.String(),
+					Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+				}})
+				return createProposalAndVote(ctx, s, []sdk.Msg{&msgExec}, proposers, group.Choice_CHOICE_YES)
+			},
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultAccepted,
+			expExecutorResult: group.ProposalExecutorResultSuccess,
+			expBalance:        true,
+			expFromBalances:   sdk.NewInt64Coin("test", 10000),
+			expToBalances:     sdk.NewInt64Coin("test", 100),
+		},
 		"proposal not executed when rejected": {
 			setupProposal: func(ctx context.Context) uint64 {
 				msgs := []sdk.Msg{msgSend1}
@@ -2043,12 +2144,8 @@ func (s *TestSuite) TestExecProposal() {
 				s.Require().NoError(err)
 				return myProposalID
 			},
-			expProposalStatus: group.ProposalStatusClosed,
-			expProposalResult: group.ProposalResultAccepted,
-			expExecutorResult: group.ProposalExecutorResultSuccess,
-			expBalance:        true,
-			expFromBalances:   sdk.NewInt64Coin("test", 9900),
-			expToBalances:     sdk.NewInt64Coin("test", 100),
+			// the proposal was pruned after its successful execution
+			expErr: true,
 		},
 		"rollback all msg updates on failure": {
 			setupProposal: func(ctx context.Context) uint64 {
@@ -2059,6 +2156,29 @@ func (s *TestSuite) TestExecProposal() {
 			expProposalResult: group.ProposalResultAccepted,
 			expExecutorResult: group.ProposalExecutorResultFailure,
 		},
+		"proposal not executed before the min execution period": {
+			setupProposal: func(ctx context.Context) uint64 {
+				setMinExecutionPeriod(ctx, s, time.Minute)
+				msgs := []sdk.Msg{msgSend1}
+				return createProposalAndVote(ctx, s, msgs, proposers, group.Choice_CHOICE_YES)
+			},
+			srcBlockTime: s.blockTime.Add(time.Minute).Add(-time.Millisecond),
+			expErr:       true,
+		},
+		"proposal executed after the min execution period": {
+			setupProposal: func(ctx context.Context) uint64 {
+				setMinExecutionPeriod(ctx, s, time.Minute)
+				msgs := []sdk.Msg{msgSend1}
+				return createProposalAndVote(ctx, s, msgs, proposers, group.Choice_CHOICE_YES)
+			},
+			srcBlockTime:      s.blockTime.Add(time.Minute),
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultAccepted,
+			expExecutorResult: group.ProposalExecutorResultSuccess,
+			expBalance:        true,
+			expFromBalances:   sdk.NewInt64Coin("test", 9900),
+			expToBalances:     sdk.NewInt64Coin("test", 100),
+		},
 		"executable when failed before": {
 			setupProposal: func(ctx context.Context) uint64 {
 				msgs := []sdk.Msg{msgSend2}
@@ -2095,22 +2215,29 @@ func (s *TestSuite) TestExecProposal() {
 			}
 			s.Require().NoError(err)
 
-			// and proposal is updated
-			res, err := s.keeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
-			s.Require().NoError(err)
-			proposal := res.Proposal
+			if spec.expExecutorResult == group.ProposalExecutorResultSuccess {
+				// then proposal is pruned after successful execution
+				_, err := s.keeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), "load proposal: not found")
+			} else {
+				// and proposal is updated
+				res, err := s.keeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+				s.Require().NoError(err)
+				proposal := res.Proposal
 
-			exp := group.Proposal_Result_name[int32(spec.expProposalResult)]
-			got := group.Proposal_Result_name[int32(proposal.Result)]
-			s.Assert().Equal(exp, got)
+				exp := group.Proposal_Result_name[int32(spec.expProposalResult)]
+				got := group.Proposal_Result_name[int32(proposal.Result)]
+				s.Assert().Equal(exp, got)
 
-			exp = group.Proposal_Status_name[int32(spec.expProposalStatus)]
-			got = group.Proposal_Status_name[int32(proposal.Status)]
-			s.Assert().Equal(exp, got)
+				exp = group.Proposal_Status_name[int32(spec.expProposalStatus)]
+				got = group.Proposal_Status_name[int32(proposal.Status)]
+				s.Assert().Equal(exp, got)
 
-			exp = group.Proposal_ExecutorResult_name[int32(spec.expExecutorResult)]
-			got = group.Proposal_ExecutorResult_name[int32(proposal.ExecutorResult)]
-			s.Assert().Equal(exp, got)
+				exp = group.Proposal_ExecutorResult_name[int32(spec.expExecutorResult)]
+				got = group.Proposal_ExecutorResult_name[int32(proposal.ExecutorResult)]
+				s.Assert().Equal(exp, got)
+			}
 
 			if spec.expBalance {
 				fromBalances := s.app.BankKeeper.GetAllBalances(sdkCtx, s.groupPolicyAddr)
@@ -2122,6 +2249,215 @@ func (s *TestSuite) TestExecProposal() {
 	}
 }
 
+func (s *TestSuite) TestTallyProposalsAtVPEnd() {
+	addrs := s.addrs
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyAddr.String(),
+		ToAddress:   addrs[5].String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	msgs := []sdk.Msg{msgSend}
+
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	// not enough weight to reach the threshold before the timeout
+	rejectedID := createProposalAndVote(ctx, s, msgs, []string{addrs[4].String()}, group.Choice_CHOICE_YES)
+	// accepted as soon as the vote is cast
+	acceptedID := createProposalAndVote(ctx, s, msgs, []string{addrs[1].String()}, group.Choice_CHOICE_YES)
+	withdrawnID := createProposal(ctx, s, msgs, []string{addrs[4].String()})
+	_, err := s.keeper.WithdrawProposal(ctx, &group.MsgWithdrawProposal{
+		ProposalId: withdrawnID,
+		Address:    addrs[4].String(),
+	})
+	s.Require().NoError(err)
+
+	// proposals are left untouched during their voting period
+	s.Require().NoError(s.keeper.TallyProposalsAtVPEnd(sdkCtx))
+	res, err := s.keeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: rejectedID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.ProposalStatusSubmitted, res.Proposal.Status)
+	votesRes, err := s.keeper.VotesByProposal(ctx, &group.QueryVotesByProposalRequest{ProposalId: rejectedID})
+	s.Require().NoError(err)
+	s.Require().Len(votesRes.Votes, 1)
+
+	// at the end of the voting period
+	sdkCtx = sdkCtx.WithBlockTime(s.blockTime.Add(time.Second))
+	ctx = sdk.WrapSDKContext(sdkCtx)
+	s.Require().NoError(s.keeper.TallyProposalsAtVPEnd(sdkCtx))
+
+	res, err = s.keeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: rejectedID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.ProposalStatusClosed, res.Proposal.Status)
+	s.Require().Equal(group.ProposalResultRejected, res.Proposal.Result)
+	s.Require().Equal(group.Tally{YesCount: "1", NoCount: "0", AbstainCount: "0", VetoCount: "0"}, res.Proposal.VoteState)
+
+	res, err = s.keeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: acceptedID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.ProposalStatusClosed, res.Proposal.Status)
+	s.Require().Equal(group.ProposalResultAccepted, res.Proposal.Result)
+	s.Require().Equal(group.Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"}, res.Proposal.VoteState)
+
+	_, err = s.keeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: withdrawnID})
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "load proposal: not found")
+
+	// votes are pruned
+	for _, id := range []uint64{rejectedID, acceptedID, withdrawnID} {
+		votesRes, err = s.keeper.VotesByProposal(ctx, &group.QueryVotesByProposalRequest{ProposalId: id})
+		s.Require().NoError(err)
+		s.Require().Empty(votesRes.Votes)
+	}
+
+	// proposals are processed once, the gas consumed in the next blocks
+	// doesn't depend on the proposals already processed
+	tallyGas := func(sdkCtx sdk.Context) uint64 {
+		sdkCtx = sdkCtx.WithBlockTime(s.blockTime.Add(time.Hour)).WithGasMeter(sdk.NewInfiniteGasMeter())
+		s.Require().NoError(s.keeper.TallyProposalsAtVPEnd(sdkCtx))
+		return sdkCtx.GasMeter().GasConsumed()
+	}
+	otherCtx, _ := s.sdkCtx.CacheContext()
+	createProposalAndVote(sdk.WrapSDKContext(otherCtx), s, msgs, []string{addrs[1].String()}, group.Choice_CHOICE_YES)
+	s.Require().NoError(s.keeper.TallyProposalsAtVPEnd(otherCtx.WithBlockTime(s.blockTime.Add(time.Second))))
+	s.Require().Equal(tallyGas(otherCtx), tallyGas(sdkCtx))
+}
+
+func (s *TestSuite) TestPruneProposals() {
+	addrs := s.addrs
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyAddr.String(),
+		ToAddress:   addrs[5].String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	proposalID := createProposalAndVote(ctx, s, []sdk.Msg{msgSend}, []string{addrs[1].String()}, group.Choice_CHOICE_YES)
+
+	// the proposal can still be executed during the max execution period
+	votingPeriodEnd := s.blockTime.Add(time.Second)
+	sdkCtx = sdkCtx.WithBlockTime(votingPeriodEnd.Add(s.keeper.MaxExecutionPeriod()).Add(-time.Nanosecond))
+	ctx = sdk.WrapSDKContext(sdkCtx)
+	s.Require().NoError(s.keeper.PruneProposals(sdkCtx))
+	_, err := s.keeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+
+	// and is pruned afterwards
+	sdkCtx = sdkCtx.WithBlockTime(votingPeriodEnd.Add(s.keeper.MaxExecutionPeriod()))
+	ctx = sdk.WrapSDKContext(sdkCtx)
+	s.Require().NoError(s.keeper.PruneProposals(sdkCtx))
+	_, err = s.keeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "load proposal: not found")
+
+	_, err = s.keeper.Exec(ctx, &group.MsgExec{Signer: addrs[1].String(), ProposalId: proposalID})
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestLeaveGroup() {
+	addrs := s.addrs
+	admin := addrs[0]
+	member1 := addrs[1]
+	member2 := addrs[2]
+	member3 := addrs[3]
+	nonMember := addrs[5]
+
+	members := []group.Member{
+		{Address: member1.String(), Weight: "1"},
+		{Address: member2.String(), Weight: "2"},
+		{Address: member3.String(), Weight: "3"},
+	}
+	groupRes, err := s.keeper.CreateGroup(s.ctx, &group.MsgCreateGroup{
+		Admin:   admin.String(),
+		Members: members,
+	})
+	s.Require().NoError(err)
+	groupID := groupRes.GroupId
+
+	policies := []group.DecisionPolicy{
+		group.NewThresholdDecisionPolicy("4", time.Hour, 0),
+		group.NewPercentageDecisionPolicy("0.5", time.Hour, 0),
+	}
+	for _, policy := range policies {
+		policyReq := &group.MsgCreateGroupPolicy{
+			Admin:   admin.String(),
+			GroupId: groupID,
+		}
+		s.Require().NoError(policyReq.SetDecisionPolicy(policy))
+		_, err = s.keeper.CreateGroupPolicy(s.ctx, policyReq)
+		s.Require().NoError(err)
+	}
+
+	specs := map[string]struct {
+		req            *group.MsgLeaveGroup
+		expErr         bool
+		errMsg         string
+		expTotalWeight string
+	}{
+		"group not found": {
+			req: &group.MsgLeaveGroup{
+				GroupId: 100,
+				Address: member1.String(),
+			},
+			expErr: true,
+			errMsg: "group: not found",
+		},
+		"member not part of the group": {
+			req: &group.MsgLeaveGroup{
+				GroupId: groupID,
+				Address: nonMember.String(),
+			},
+			expErr: true,
+			errMsg: "group member: not found",
+		},
+		"decision policy becomes invalid": {
+			req: &group.MsgLeaveGroup{
+				GroupId: groupID,
+				Address: member3.String(),
+			},
+			expErr: true,
+			errMsg: "should not be greater than the total group weight",
+		},
+		"valid testcase": {
+			req: &group.MsgLeaveGroup{
+				GroupId: groupID,
+				Address: member1.String(),
+			},
+			expTotalWeight: "5",
+		},
+	}
+
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			sdkCtx, _ := s.sdkCtx.CacheContext()
+			ctx := sdk.WrapSDKContext(sdkCtx)
+			_, err := s.keeper.LeaveGroup(ctx, spec.req)
+			if spec.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), spec.errMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			res, err := s.keeper.GroupInfo(ctx, &group.QueryGroupInfoRequest{GroupId: groupID})
+			s.Require().NoError(err)
+			s.Require().Equal(spec.expTotalWeight, res.Info.TotalWeight)
+			s.Require().Equal(uint64(2), res.Info.Version)
+
+			membersRes, err := s.keeper.GroupMembers(ctx, &group.QueryGroupMembersRequest{GroupId: groupID})
+			s.Require().NoError(err)
+			s.Require().Len(membersRes.Members, 2)
+			for _, m := range membersRes.Members {
+				s.Require().NotEqual(spec.req.Address, m.Member.Address)
+			}
+
+			events := sdkCtx.EventManager().ABCIEvents()
+			s.Require().Equal("cosmos.group.v1beta1.EventLeaveGroup", events[len(events)-1].Type)
+		})
+	}
+}
+
 func createProposal(
 	ctx context.Context, s *TestSuite, msgs []sdk.Msg,
 	proposers []string) uint64 {
@@ -2153,6 +2489,17 @@ func createProposalAndVote(
 	return myProposalID
 }
 
+func setMinExecutionPeriod(ctx context.Context, s *TestSuite, minExecutionPeriod time.Duration) {
+	req, err := group.NewMsgUpdateGroupPolicyDecisionPolicyRequest(
+		s.addrs[0],
+		s.groupPolicyAddr,
+		group.NewThresholdDecisionPolicy("2", time.Second, minExecutionPeriod),
+	)
+	s.Require().NoError(err)
+	_, err = s.keeper.UpdateGroupPolicyDecisionPolicy(ctx, req)
+	s.Require().NoError(err)
+}
+
 func createGroupAndGroupPolicy(
 	admin sdk.AccAddress,
 	s *TestSuite,
@@ -2174,6 +2521,7 @@ func createGroupAndGroupPolicy(
 	policy := group.NewThresholdDecisionPolicy(
 		"1",
 		time.Second,
+		0,
 	)
 	err = groupPolicy.SetDecisionPolicy(policy)
 	s.Require().NoError(err)
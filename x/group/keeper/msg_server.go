@@ -15,7 +15,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/cosmos/cosmos-sdk/x/group/errors"
 	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
-	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
 )
 
 var _ group.MsgServer = Keeper{}
@@ -58,16 +57,15 @@ func (k Keeper) CreateGroup(goCtx context.Context, req *group.MsgCreateGroup) (*
 		}
 	}
 
-	// Create a new group in the groupTable.
+	// Create a new group in the GroupInfo table.
 	groupInfo := &group.GroupInfo{
-		GroupId:     k.groupTable.Sequence().PeekNextVal(ctx.KVStore(k.key)),
 		Admin:       admin,
 		Metadata:    metadata,
 		Version:     1,
 		TotalWeight: totalWeight.String(),
 		CreatedAt:   ctx.BlockTime(),
 	}
-	groupID, err := k.groupTable.Create(ctx.KVStore(k.key), groupInfo)
+	groupID, err := k.createGroupInfo(ctx, groupInfo)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "could not create group")
 	}
@@ -75,7 +73,7 @@ func (k Keeper) CreateGroup(goCtx context.Context, req *group.MsgCreateGroup) (*
 	// Create new group members in the groupMemberTable.
 	for i := range members.Members {
 		m := members.Members[i]
-		err := k.groupMemberTable.Create(ctx.KVStore(k.key), &group.GroupMember{
+		err := k.createGroupMember(ctx, &group.GroupMember{
 			GroupId: groupID,
 			Member: &group.Member{
 				Address:  m.Address,
@@ -118,8 +116,8 @@ func (k Keeper) UpdateGroupMembers(goCtx context.Context, req *group.MsgUpdateGr
 
 			// Checking if the group member is already part of the group
 			var found bool
-			var prevGroupMember group.GroupMember
-			switch err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&groupMember), &prevGroupMember); {
+			prevGroupMember, err := k.getGroupMember(ctx, groupMember.GroupId, groupMember.Member.Address)
+			switch {
 			case err == nil:
 				found = true
 			case sdkerrors.ErrNotFound.Is(err):
@@ -152,7 +150,7 @@ func (k Keeper) UpdateGroupMembers(goCtx context.Context, req *group.MsgUpdateGr
 				}
 
 				// Delete group member in the groupMemberTable.
-				if err := k.groupMemberTable.Delete(ctx.KVStore(k.key), &groupMember); err != nil {
+				if err := k.deleteGroupMember(ctx, &groupMember); err != nil {
 					return sdkerrors.Wrap(err, "delete member")
 				}
 				continue
@@ -169,11 +167,11 @@ func (k Keeper) UpdateGroupMembers(goCtx context.Context, req *group.MsgUpdateGr
 					return err
 				}
 				// Save updated group member in the groupMemberTable.
-				if err := k.groupMemberTable.Update(ctx.KVStore(k.key), &groupMember); err != nil {
+				if err := k.updateGroupMember(ctx, &groupMember); err != nil {
 					return sdkerrors.Wrap(err, "add member")
 				}
 				// else handle create.
-			} else if err := k.groupMemberTable.Create(ctx.KVStore(k.key), &groupMember); err != nil {
+			} else if err := k.createGroupMember(ctx, &groupMember); err != nil {
 				return sdkerrors.Wrap(err, "add member")
 			}
 			// In both cases (handle + update), we need to add the new member's weight to the group total weight.
@@ -182,10 +180,10 @@ func (k Keeper) UpdateGroupMembers(goCtx context.Context, req *group.MsgUpdateGr
 				return err
 			}
 		}
-		// Update group in the groupTable.
+		// Update group in the GroupInfo table.
 		g.TotalWeight = totalWeight.String()
 		g.Version++
-		return k.groupTable.Update(ctx.KVStore(k.key), g.GroupId, g)
+		return k.updateGroupInfo(ctx, g)
 	}
 
 	err := k.doUpdateGroup(ctx, req, action, "members updated")
@@ -202,7 +200,7 @@ func (k Keeper) UpdateGroupAdmin(goCtx context.Context, req *group.MsgUpdateGrou
 		g.Admin = req.NewAdmin
 		g.Version++
 
-		return k.groupTable.Update(ctx.KVStore(k.key), g.GroupId, g)
+		return k.updateGroupInfo(ctx, g)
 	}
 
 	err := k.doUpdateGroup(ctx, req, action, "admin updated")
@@ -218,7 +216,7 @@ func (k Keeper) UpdateGroupMetadata(goCtx context.Context, req *group.MsgUpdateG
 	action := func(g *group.GroupInfo) error {
 		g.Metadata = req.Metadata
 		g.Version++
-		return k.groupTable.Update(ctx.KVStore(k.key), g.GroupId, g)
+		return k.updateGroupInfo(ctx, g)
 	}
 
 	if err := k.assertMetadataLength(req.Metadata, "group metadata"); err != nil {
@@ -246,6 +244,9 @@ func (k Keeper) CreateGroupPolicy(goCtx context.Context, req *group.MsgCreateGro
 	if err := k.assertMetadataLength(metadata, "group policy metadata"); err != nil {
 		return nil, err
 	}
+	if err := group.ValidateWindows(policy, k.config.MaxExecutionPeriod); err != nil {
+		return nil, sdkerrors.Wrap(err, "decision policy")
+	}
 
 	g, err := k.getGroupInfo(ctx, groupID)
 	if err != nil {
@@ -264,7 +265,10 @@ func (k Keeper) CreateGroupPolicy(goCtx context.Context, req *group.MsgCreateGro
 	var accountAddr sdk.AccAddress
 	// loop here in the rare case of a collision
 	for {
-		nextAccVal := k.groupPolicySeq.NextVal(ctx.KVStore(k.key))
+		nextAccVal, err := k.nextGroupPolicySeq(ctx)
+		if err != nil {
+			return nil, err
+		}
 		var buf = make([]byte, 8)
 		binary.BigEndian.PutUint64(buf, nextAccVal)
 
@@ -299,7 +303,7 @@ func (k Keeper) CreateGroupPolicy(goCtx context.Context, req *group.MsgCreateGro
 		return nil, err
 	}
 
-	if err := k.groupPolicyTable.Create(ctx.KVStore(k.key), &groupPolicy); err != nil {
+	if err := k.createGroupPolicyInfo(ctx, &groupPolicy); err != nil {
 		return nil, sdkerrors.Wrap(err, "could not create group policy")
 	}
 
@@ -316,7 +320,7 @@ func (k Keeper) UpdateGroupPolicyAdmin(goCtx context.Context, req *group.MsgUpda
 	action := func(groupPolicy *group.GroupPolicyInfo) error {
 		groupPolicy.Admin = req.NewAdmin
 		groupPolicy.Version++
-		return k.groupPolicyTable.Update(ctx.KVStore(k.key), groupPolicy)
+		return k.updateGroupPolicyInfo(ctx, groupPolicy)
 	}
 
 	err := k.doUpdateGroupPolicy(ctx, req.Address, req.Admin, action, "group policy admin updated")
@@ -330,6 +334,9 @@ func (k Keeper) UpdateGroupPolicyAdmin(goCtx context.Context, req *group.MsgUpda
 func (k Keeper) UpdateGroupPolicyDecisionPolicy(goCtx context.Context, req *group.MsgUpdateGroupPolicyDecisionPolicy) (*group.MsgUpdateGroupPolicyDecisionPolicyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	policy := req.GetDecisionPolicy()
+	if err := group.ValidateWindows(policy, k.config.MaxExecutionPeriod); err != nil {
+		return nil, sdkerrors.Wrap(err, "decision policy")
+	}
 
 	action := func(groupPolicy *group.GroupPolicyInfo) error {
 		err := groupPolicy.SetDecisionPolicy(policy)
@@ -338,7 +345,7 @@ func (k Keeper) UpdateGroupPolicyDecisionPolicy(goCtx context.Context, req *grou
 		}
 
 		groupPolicy.Version++
-		return k.groupPolicyTable.Update(ctx.KVStore(k.key), groupPolicy)
+		return k.updateGroupPolicyInfo(ctx, groupPolicy)
 	}
 
 	err := k.doUpdateGroupPolicy(ctx, req.Address, req.Admin, action, "group policy's decision policy updated")
@@ -356,7 +363,7 @@ func (k Keeper) UpdateGroupPolicyMetadata(goCtx context.Context, req *group.MsgU
 	action := func(groupPolicy *group.GroupPolicyInfo) error {
 		groupPolicy.Metadata = metadata
 		groupPolicy.Version++
-		return k.groupPolicyTable.Update(ctx.KVStore(k.key), groupPolicy)
+		return k.updateGroupPolicyInfo(ctx, groupPolicy)
 	}
 
 	if err := k.assertMetadataLength(metadata, "group policy metadata"); err != nil {
@@ -397,7 +404,11 @@ func (k Keeper) CreateProposal(goCtx context.Context, req *group.MsgCreatePropos
 
 	// Only members of the group can submit a new proposal.
 	for i := range proposers {
-		if !k.groupMemberTable.Has(ctx.KVStore(k.key), orm.PrimaryKey(&group.GroupMember{GroupId: g.GroupId, Member: &group.Member{Address: proposers[i]}})) {
+		found, err := k.hasGroupMember(ctx, g.GroupId, proposers[i])
+		if err != nil {
+			return nil, err
+		}
+		if !found {
 			return nil, sdkerrors.Wrapf(errors.ErrUnauthorized, "not in group: %s", proposers[i])
 		}
 	}
@@ -424,7 +435,6 @@ func (k Keeper) CreateProposal(goCtx context.Context, req *group.MsgCreatePropos
 	window := timeout
 
 	m := &group.Proposal{
-		ProposalId:         k.proposalTable.Sequence().PeekNextVal(ctx.KVStore(k.key)),
 		Address:            req.Address,
 		Metadata:           metadata,
 		Proposers:          proposers,
@@ -446,7 +456,7 @@ func (k Keeper) CreateProposal(goCtx context.Context, req *group.MsgCreatePropos
 		return nil, sdkerrors.Wrap(err, "create proposal")
 	}
 
-	id, err := k.proposalTable.Create(ctx.KVStore(k.key), m)
+	id, err := k.createProposal(ctx, m)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "create proposal")
 	}
@@ -470,15 +480,18 @@ func (k Keeper) CreateProposal(goCtx context.Context, req *group.MsgCreatePropos
 				return &group.MsgCreateProposalResponse{ProposalId: id}, sdkerrors.Wrap(err, "The proposal was created but failed on vote")
 			}
 		}
-		// Then try to execute the proposal
-		_, err = k.Exec(sdk.WrapSDKContext(ctx), &group.MsgExec{
-			ProposalId: id,
-			// We consider the first proposer as the MsgExecRequest signer
-			// but that could be revisited (eg using the group policy)
-			Signer: proposers[0],
-		})
-		if err != nil {
-			return &group.MsgCreateProposalResponse{ProposalId: id}, sdkerrors.Wrap(err, "The proposal was created but failed on exec")
+		// Then try to execute the proposal, unless the policy requires
+		// waiting for a minimum execution period.
+		if checkMinExecutionPeriod(ctx, *m, policy) == nil {
+			_, err = k.Exec(sdk.WrapSDKContext(ctx), &group.MsgExec{
+				ProposalId: id,
+				// We consider the first proposer as the MsgExecRequest signer
+				// but that could be revisited (eg using the group policy)
+				Signer: proposers[0],
+			})
+			if err != nil {
+				return &group.MsgCreateProposalResponse{ProposalId: id}, sdkerrors.Wrap(err, "The proposal was created but failed on exec")
+			}
 		}
 	}
 
@@ -506,7 +519,7 @@ func (k Keeper) WithdrawProposal(goCtx context.Context, req *group.MsgWithdrawPr
 	}
 
 	storeUpdates := func() (*group.MsgWithdrawProposalResponse, error) {
-		if err := k.proposalTable.Update(ctx.KVStore(k.key), id, &proposal); err != nil {
+		if err := k.updateProposal(ctx, &proposal); err != nil {
 			return nil, err
 		}
 		return &group.MsgWithdrawProposalResponse{}, nil
@@ -598,8 +611,8 @@ func (k Keeper) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgVoteR
 
 	// Count and store votes.
 	voterAddr := req.Voter
-	voter := group.GroupMember{GroupId: electorate.GroupId, Member: &group.Member{Address: voterAddr}}
-	if err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&voter), &voter); err != nil {
+	voter, err := k.getGroupMember(ctx, electorate.GroupId, voterAddr)
+	if err != nil {
 		return nil, sdkerrors.Wrapf(err, "address: %s", voterAddr)
 	}
 	newVote := group.Vote{
@@ -615,7 +628,7 @@ func (k Keeper) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgVoteR
 
 	// The ORM will return an error if the vote already exists,
 	// making sure than a voter hasn't already voted.
-	if err := k.voteTable.Create(ctx.KVStore(k.key), &newVote); err != nil {
+	if err := k.createVote(ctx, &newVote); err != nil {
 		return nil, sdkerrors.Wrap(err, "store vote")
 	}
 
@@ -624,7 +637,7 @@ func (k Keeper) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgVoteR
 		return nil, err
 	}
 
-	if err = k.proposalTable.Update(ctx.KVStore(k.key), id, &proposal); err != nil {
+	if err = k.updateProposal(ctx, &proposal); err != nil {
 		return nil, err
 	}
 
@@ -633,8 +646,9 @@ func (k Keeper) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgVoteR
 		return nil, err
 	}
 
-	// Try to execute proposal immediately
-	if req.Exec == group.Exec_EXEC_TRY {
+	// Try to execute proposal immediately, unless the policy requires waiting
+	// for a minimum execution period.
+	if req.Exec == group.Exec_EXEC_TRY && checkMinExecutionPeriod(ctx, proposal, policyInfo.GetDecisionPolicy()) == nil {
 		_, err = k.Exec(sdk.WrapSDKContext(ctx), &group.MsgExec{
 			ProposalId: id,
 			Signer:     voterAddr,
@@ -671,6 +685,18 @@ func doTally(ctx sdk.Context, p *group.Proposal, electorate group.GroupInfo, pol
 	return nil
 }
 
+// checkMinExecutionPeriod returns an error if the minimum execution period of
+// the decision policy hasn't elapsed since the proposal submission.
+func checkMinExecutionPeriod(ctx sdk.Context, p group.Proposal, policy group.DecisionPolicy) error {
+	if policy == nil {
+		return sdkerrors.Wrap(errors.ErrEmpty, "nil policy")
+	}
+	if earliest := p.SubmittedAt.Add(policy.GetMinExecutionPeriod()); ctx.BlockTime().Before(earliest) {
+		return sdkerrors.Wrapf(errors.ErrInvalid, "must wait until %s to execute proposal %d", earliest, p.ProposalId)
+	}
+	return nil
+}
+
 // Exec executes the messages from a proposal.
 func (k Keeper) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgExecResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -691,7 +717,7 @@ func (k Keeper) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgExecR
 	}
 
 	storeUpdates := func() (*group.MsgExecResponse, error) {
-		if err := k.proposalTable.Update(ctx.KVStore(k.key), id, &proposal); err != nil {
+		if err := k.updateProposal(ctx, &proposal); err != nil {
 			return nil, err
 		}
 		return &group.MsgExecResponse{}, nil
@@ -723,6 +749,10 @@ func (k Keeper) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgExecR
 
 	// Execute proposal payload.
 	if proposal.Status == group.ProposalStatusClosed && proposal.Result == group.ProposalResultAccepted && proposal.ExecutorResult != group.ProposalExecutorResultSuccess {
+		if err := checkMinExecutionPeriod(ctx, proposal, policyInfo.GetDecisionPolicy()); err != nil {
+			return nil, err
+		}
+
 		logger := ctx.Logger().With("module", fmt.Sprintf("x/%s", group.ModuleName))
 		// Caching context so that we don't update the store in case of failure.
 		ctx, flush := ctx.CacheContext()
@@ -742,13 +772,21 @@ func (k Keeper) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgExecR
 		}
 	}
 
-	// Update proposal in proposalTable
-	res, err := storeUpdates()
-	if err != nil {
+	// Prune the proposal once it has been successfully executed, otherwise
+	// update it in proposalTable.
+	res := &group.MsgExecResponse{}
+	if proposal.ExecutorResult == group.ProposalExecutorResultSuccess {
+		if err := k.pruneProposal(ctx, id); err != nil {
+			return nil, err
+		}
+	} else if res, err = storeUpdates(); err != nil {
 		return nil, err
 	}
 
-	err = ctx.EventManager().EmitTypedEvent(&group.EventExec{ProposalId: id})
+	err = ctx.EventManager().EmitTypedEvent(&group.EventExec{
+		ProposalId: id,
+		Result:     proposal.ExecutorResult,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -756,6 +794,90 @@ func (k Keeper) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgExecR
 	return res, nil
 }
 
+// LeaveGroup implements the MsgServer/LeaveGroup method.
+func (k Keeper) LeaveGroup(goCtx context.Context, req *group.MsgLeaveGroup) (*group.MsgLeaveGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "member")
+	}
+
+	groupInfo, err := k.getGroupInfo(ctx, req.GroupId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "group")
+	}
+
+	groupWeight, err := math.NewNonNegativeDecFromString(groupInfo.TotalWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	gm, err := k.getGroupMember(ctx, req.GroupId, req.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "group member")
+	}
+
+	memberWeight, err := math.NewPositiveDecFromString(gm.Member.Weight)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedWeight, err := math.SubNonNegative(groupWeight, memberWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	// Delete group member in the groupMemberTable.
+	if err := k.deleteGroupMember(ctx, &gm); err != nil {
+		return nil, sdkerrors.Wrap(err, "group member")
+	}
+
+	// Update group weight
+	groupInfo.TotalWeight = updatedWeight.String()
+	groupInfo.Version++
+
+	// Every decision policy of the group must still be valid with the new total weight.
+	if err := k.validateDecisionPolicies(ctx, groupInfo); err != nil {
+		return nil, err
+	}
+
+	if err := k.updateGroupInfo(ctx, &groupInfo); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&group.EventLeaveGroup{
+		GroupId: req.GroupId,
+		Address: req.Address,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgLeaveGroupResponse{}, nil
+}
+
+// validateDecisionPolicies checks that the decision policies of all the group
+// policies of the given group are valid against the group.
+func (k Keeper) validateDecisionPolicies(ctx sdk.Context, g group.GroupInfo) error {
+	policies, err := k.groupPolicies(ctx, g.GroupId)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group policies")
+	}
+
+	for _, p := range policies {
+		policy := p.GetDecisionPolicy()
+		if policy == nil {
+			return sdkerrors.Wrapf(errors.ErrInvalid, "group policy %s has no decision policy", p.Address)
+		}
+		if err := policy.Validate(g); err != nil {
+			return sdkerrors.Wrapf(err, "decision policy of group policy %s", p.Address)
+		}
+	}
+
+	return nil
+}
+
 type authNGroupReq interface {
 	GetGroupID() uint64
 	GetAdmin() string
@@ -0,0 +1,365 @@
+package keeper
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	queryv1beta1 "github.com/cosmos/cosmos-sdk/api/cosmos/base/query/v1beta1"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	statev1beta1 "github.com/cosmos/cosmos-sdk/x/group/internal/state/v1beta1"
+)
+
+// toState converts a value of the group package to the ORM table message
+// storing it, both having the same encoding.
+func (k Keeper) toState(v codec.ProtoMarshaler, state proto.Message) error {
+	bz, err := k.cdc.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(bz, state)
+}
+
+// fromState converts an ORM table message to the value of the group package
+// it stores, both having the same encoding.
+func (k Keeper) fromState(state proto.Message, v codec.ProtoMarshaler) error {
+	bz, err := proto.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return k.cdc.Unmarshal(bz, v)
+}
+
+// groupMemberToState converts a group member to the GroupMember table
+// message, which stores the member fields inline.
+func groupMemberToState(m *group.GroupMember) *statev1beta1.GroupMember {
+	return &statev1beta1.GroupMember{
+		GroupId:       m.GroupId,
+		MemberAddress: m.Member.Address,
+		Weight:        m.Member.Weight,
+		Metadata:      m.Member.Metadata,
+		AddedAt:       timestamppb.New(m.Member.AddedAt),
+	}
+}
+
+// groupMemberFromState converts a GroupMember table message to a group member.
+func groupMemberFromState(m *statev1beta1.GroupMember) *group.GroupMember {
+	return &group.GroupMember{
+		GroupId: m.GroupId,
+		Member: &group.Member{
+			Address:  m.MemberAddress,
+			Weight:   m.Weight,
+			Metadata: m.Metadata,
+			AddedAt:  m.AddedAt.AsTime(),
+		},
+	}
+}
+
+// notFound converts the not found errors of the ORM to sdkerrors.ErrNotFound.
+func notFound(err error) error {
+	if ormerrors.IsNotFound(err) {
+		return sdkerrors.ErrNotFound
+	}
+	return err
+}
+
+func (k Keeper) getGroupInfo(ctx sdk.Context, id uint64) (group.GroupInfo, error) {
+	var obj group.GroupInfo
+	state, err := k.state.GroupInfoStore().Get(sdk.WrapSDKContext(ctx), id)
+	if err != nil {
+		return obj, notFound(err)
+	}
+	return obj, k.fromState(state, &obj)
+}
+
+// createGroupInfo stores a new group with the next group ID and returns it.
+func (k Keeper) createGroupInfo(ctx sdk.Context, g *group.GroupInfo) (uint64, error) {
+	var state statev1beta1.GroupInfo
+	if err := k.toState(g, &state); err != nil {
+		return 0, err
+	}
+	return k.state.GroupInfoStore().InsertReturningID(sdk.WrapSDKContext(ctx), &state)
+}
+
+func (k Keeper) updateGroupInfo(ctx sdk.Context, g *group.GroupInfo) error {
+	var state statev1beta1.GroupInfo
+	if err := k.toState(g, &state); err != nil {
+		return err
+	}
+	return k.state.GroupInfoStore().Update(sdk.WrapSDKContext(ctx), &state)
+}
+
+func (k Keeper) getGroupMember(ctx sdk.Context, groupID uint64, address string) (group.GroupMember, error) {
+	state, err := k.state.GroupMemberStore().Get(sdk.WrapSDKContext(ctx), groupID, address)
+	if err != nil {
+		return group.GroupMember{}, notFound(err)
+	}
+	return *groupMemberFromState(state), nil
+}
+
+func (k Keeper) hasGroupMember(ctx sdk.Context, groupID uint64, address string) (bool, error) {
+	return k.state.GroupMemberStore().Has(sdk.WrapSDKContext(ctx), groupID, address)
+}
+
+func (k Keeper) createGroupMember(ctx sdk.Context, m *group.GroupMember) error {
+	return k.state.GroupMemberStore().Insert(sdk.WrapSDKContext(ctx), groupMemberToState(m))
+}
+
+func (k Keeper) updateGroupMember(ctx sdk.Context, m *group.GroupMember) error {
+	return k.state.GroupMemberStore().Update(sdk.WrapSDKContext(ctx), groupMemberToState(m))
+}
+
+func (k Keeper) deleteGroupMember(ctx sdk.Context, m *group.GroupMember) error {
+	return k.state.GroupMemberStore().Delete(sdk.WrapSDKContext(ctx), groupMemberToState(m))
+}
+
+func (k Keeper) getGroupPolicyInfo(ctx sdk.Context, accountAddress string) (group.GroupPolicyInfo, error) {
+	var obj group.GroupPolicyInfo
+	state, err := k.state.GroupPolicyInfoStore().Get(sdk.WrapSDKContext(ctx), accountAddress)
+	if err != nil {
+		return obj, notFound(err)
+	}
+	return obj, k.fromState(state, &obj)
+}
+
+func (k Keeper) createGroupPolicyInfo(ctx sdk.Context, p *group.GroupPolicyInfo) error {
+	var state statev1beta1.GroupPolicyInfo
+	if err := k.toState(p, &state); err != nil {
+		return err
+	}
+	return k.state.GroupPolicyInfoStore().Insert(sdk.WrapSDKContext(ctx), &state)
+}
+
+func (k Keeper) updateGroupPolicyInfo(ctx sdk.Context, p *group.GroupPolicyInfo) error {
+	var state statev1beta1.GroupPolicyInfo
+	if err := k.toState(p, &state); err != nil {
+		return err
+	}
+	return k.state.GroupPolicyInfoStore().Update(sdk.WrapSDKContext(ctx), &state)
+}
+
+// nextGroupPolicySeq increments the group policy sequence and returns its
+// new value.
+func (k Keeper) nextGroupPolicySeq(ctx sdk.Context) (uint64, error) {
+	goCtx := sdk.WrapSDKContext(ctx)
+	seq, err := k.state.GroupPolicySeqStore().Get(goCtx)
+	if err != nil {
+		return 0, err
+	}
+	seq.Seq++
+	return seq.Seq, k.state.GroupPolicySeqStore().Save(goCtx, seq)
+}
+
+func (k Keeper) getProposal(ctx sdk.Context, proposalID uint64) (group.Proposal, error) {
+	var p group.Proposal
+	state, err := k.state.ProposalStore().Get(sdk.WrapSDKContext(ctx), proposalID)
+	if err != nil {
+		return p, sdkerrors.Wrap(notFound(err), "load proposal")
+	}
+	return p, k.fromState(state, &p)
+}
+
+// createProposal stores a new proposal with the next proposal ID and returns
+// it.
+func (k Keeper) createProposal(ctx sdk.Context, p *group.Proposal) (uint64, error) {
+	var state statev1beta1.Proposal
+	if err := k.toState(p, &state); err != nil {
+		return 0, err
+	}
+	return k.state.ProposalStore().InsertReturningID(sdk.WrapSDKContext(ctx), &state)
+}
+
+func (k Keeper) updateProposal(ctx sdk.Context, p *group.Proposal) error {
+	var state statev1beta1.Proposal
+	if err := k.toState(p, &state); err != nil {
+		return err
+	}
+	return k.state.ProposalStore().Update(sdk.WrapSDKContext(ctx), &state)
+}
+
+// pruneProposal deletes a proposal and its votes.
+func (k Keeper) pruneProposal(ctx sdk.Context, proposalID uint64) error {
+	if err := k.pruneVotes(ctx, proposalID); err != nil {
+		return err
+	}
+	return k.state.ProposalStore().DeleteBy(sdk.WrapSDKContext(ctx), statev1beta1.ProposalProposalIdIndexKey{}.WithProposalId(proposalID))
+}
+
+// proposalsByTimeout returns the proposals whose timeout is after the given
+// start, if not nil, and before or equal to the given end time, ordered by
+// timeout.
+func (k Keeper) proposalsByTimeout(ctx sdk.Context, start *timestamppb.Timestamp, endTime time.Time) ([]*group.Proposal, error) {
+	var (
+		it  statev1beta1.ProposalIterator
+		err error
+	)
+	if start == nil {
+		it, err = k.state.ProposalStore().List(sdk.WrapSDKContext(ctx), statev1beta1.ProposalTimeoutIndexKey{})
+	} else {
+		// Ranges are inclusive, while timestamps have a nanosecond precision.
+		from := timestamppb.New(start.AsTime().Add(time.Nanosecond))
+		it, err = k.state.ProposalStore().ListRange(sdk.WrapSDKContext(ctx),
+			statev1beta1.ProposalTimeoutIndexKey{}.WithTimeout(from),
+			statev1beta1.ProposalTimeoutIndexKey{}.WithTimeout(timestamppb.New(endTime)))
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var proposals []*group.Proposal
+	for it.Next() {
+		state, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		if state.Timeout.AsTime().After(endTime) {
+			break
+		}
+		var proposal group.Proposal
+		if err := k.fromState(state, &proposal); err != nil {
+			return nil, err
+		}
+		proposals = append(proposals, &proposal)
+	}
+	return proposals, nil
+}
+
+func (k Keeper) getVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (group.Vote, error) {
+	var v group.Vote
+	state, err := k.state.VoteStore().Get(sdk.WrapSDKContext(ctx), proposalID, voter.String())
+	if err != nil {
+		return v, notFound(err)
+	}
+	return v, k.fromState(state, &v)
+}
+
+func (k Keeper) createVote(ctx sdk.Context, v *group.Vote) error {
+	var state statev1beta1.Vote
+	if err := k.toState(v, &state); err != nil {
+		return err
+	}
+	return k.state.VoteStore().Insert(sdk.WrapSDKContext(ctx), &state)
+}
+
+// pruneVotes deletes all the votes of a proposal.
+func (k Keeper) pruneVotes(ctx sdk.Context, proposalID uint64) error {
+	return k.state.VoteStore().DeleteBy(sdk.WrapSDKContext(ctx), statev1beta1.VoteProposalIdVoterIndexKey{}.WithProposalId(proposalID))
+}
+
+// readAll reads the messages of an ORM iterator and passes them to fn. It
+// closes the iterator and returns the page response of the iterator, if any.
+func readAll(it ormtable.Iterator, fn func(proto.Message) error) (*query.PageResponse, error) {
+	defer it.Close()
+
+	for it.Next() {
+		msg, err := it.GetMessage()
+		if err != nil {
+			return nil, err
+		}
+		if err := fn(msg); err != nil {
+			return nil, err
+		}
+	}
+
+	pageRes := it.PageResponse()
+	if pageRes == nil {
+		return nil, nil
+	}
+	return &query.PageResponse{NextKey: pageRes.NextKey, Total: pageRes.Total}, nil
+}
+
+// toPageRequest converts a page request to the ORM page request type. Like
+// query.Paginate, it uses query.DefaultLimit and counts the total number of
+// results if the limit isn't set.
+func toPageRequest(pageRequest *query.PageRequest) (*queryv1beta1.PageRequest, error) {
+	if pageRequest == nil {
+		pageRequest = &query.PageRequest{}
+	}
+
+	if pageRequest.Offset > 0 && pageRequest.Key != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "either offset or key is expected, got both")
+	}
+
+	res := &queryv1beta1.PageRequest{
+		Key:        pageRequest.Key,
+		Offset:     pageRequest.Offset,
+		Limit:      pageRequest.Limit,
+		CountTotal: pageRequest.CountTotal,
+		Reverse:    pageRequest.Reverse,
+	}
+	if res.Limit == 0 {
+		res.Limit = query.DefaultLimit
+		res.CountTotal = true
+	}
+	return res, nil
+}
+
+func (k Keeper) allGroups(ctx sdk.Context) ([]*group.GroupInfo, error) {
+	it, err := k.state.GroupInfoStore().List(sdk.WrapSDKContext(ctx), statev1beta1.GroupInfoGroupIdIndexKey{})
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []*group.GroupInfo
+	_, err = readAll(it, func(msg proto.Message) error {
+		var groupInfo group.GroupInfo
+		groups = append(groups, &groupInfo)
+		return k.fromState(msg, &groupInfo)
+	})
+	return groups, err
+}
+
+func (k Keeper) groupMembers(ctx sdk.Context, groupID uint64) ([]*group.GroupMember, error) {
+	it, err := k.state.GroupMemberStore().List(sdk.WrapSDKContext(ctx), statev1beta1.GroupMemberGroupIdMemberAddressIndexKey{}.WithGroupId(groupID))
+	if err != nil {
+		return nil, err
+	}
+
+	var members []*group.GroupMember
+	_, err = readAll(it, func(msg proto.Message) error {
+		members = append(members, groupMemberFromState(msg.(*statev1beta1.GroupMember)))
+		return nil
+	})
+	return members, err
+}
+
+func (k Keeper) groupPolicies(ctx sdk.Context, groupID uint64) ([]*group.GroupPolicyInfo, error) {
+	it, err := k.state.GroupPolicyInfoStore().List(sdk.WrapSDKContext(ctx), statev1beta1.GroupPolicyInfoGroupIdIndexKey{}.WithGroupId(groupID))
+	if err != nil {
+		return nil, err
+	}
+	policies, _, err := k.readGroupPolicies(it)
+	return policies, err
+}
+
+func (k Keeper) allProposals(ctx sdk.Context) ([]*group.Proposal, error) {
+	it, err := k.state.ProposalStore().List(sdk.WrapSDKContext(ctx), statev1beta1.ProposalProposalIdIndexKey{})
+	if err != nil {
+		return nil, err
+	}
+
+	var proposals []*group.Proposal
+	_, err = readAll(it, func(msg proto.Message) error {
+		var proposal group.Proposal
+		proposals = append(proposals, &proposal)
+		return k.fromState(msg, &proposal)
+	})
+	return proposals, err
+}
+
+func (k Keeper) proposalVotes(ctx sdk.Context, proposalID uint64) ([]*group.Vote, error) {
+	it, err := k.state.VoteStore().List(sdk.WrapSDKContext(ctx), statev1beta1.VoteProposalIdVoterIndexKey{}.WithProposalId(proposalID))
+	if err != nil {
+		return nil, err
+	}
+	votes, _, err := k.readVotes(it)
+	return votes, err
+}
@@ -0,0 +1,55 @@
+package v046
+
+// Key prefixes of the tables, sequences and indexes stored by the first
+// version of the group module state, which used a KV store mapper specific to
+// the group module. Table rows are stored under the key {prefix, 0x00} followed
+// by their primary key and sequences under the key {prefix, 0x01}.
+const (
+	// Group Table
+	GroupTablePrefix        byte = 0x0
+	GroupTableSeqPrefix     byte = 0x1
+	GroupByAdminIndexPrefix byte = 0x2
+
+	// Group Member Table
+	GroupMemberTablePrefix         byte = 0x10
+	GroupMemberByGroupIndexPrefix  byte = 0x11
+	GroupMemberByMemberIndexPrefix byte = 0x12
+
+	// Group Policy Table
+	GroupPolicyTablePrefix        byte = 0x20
+	GroupPolicyTableSeqPrefix     byte = 0x21
+	GroupPolicyByGroupIndexPrefix byte = 0x22
+	GroupPolicyByAdminIndexPrefix byte = 0x23
+
+	// Proposal Table
+	ProposalTablePrefix              byte = 0x30
+	ProposalTableSeqPrefix           byte = 0x31
+	ProposalByGroupPolicyIndexPrefix byte = 0x32
+	ProposalByProposerIndexPrefix    byte = 0x33
+
+	// Vote Table
+	VoteTablePrefix           byte = 0x40
+	VoteByProposalIndexPrefix byte = 0x41
+	VoteByVoterIndexPrefix    byte = 0x42
+)
+
+// prefixes are all the key prefixes of the first version of the group module
+// state.
+var prefixes = []byte{
+	GroupTablePrefix, GroupTableSeqPrefix, GroupByAdminIndexPrefix,
+	GroupMemberTablePrefix, GroupMemberByGroupIndexPrefix, GroupMemberByMemberIndexPrefix,
+	GroupPolicyTablePrefix, GroupPolicyTableSeqPrefix, GroupPolicyByGroupIndexPrefix, GroupPolicyByAdminIndexPrefix,
+	ProposalTablePrefix, ProposalTableSeqPrefix, ProposalByGroupPolicyIndexPrefix, ProposalByProposerIndexPrefix,
+	VoteTablePrefix, VoteByProposalIndexPrefix, VoteByVoterIndexPrefix,
+}
+
+// tableKey returns the key prefix of the rows of the table with the provided
+// prefix.
+func tableKey(prefix byte) []byte {
+	return []byte{prefix, 0x00}
+}
+
+// sequenceKey returns the key of the sequence with the provided prefix.
+func sequenceKey(prefix byte) []byte {
+	return []byte{prefix, 0x01}
+}
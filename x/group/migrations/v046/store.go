@@ -0,0 +1,127 @@
+package v046
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// MigrateStore performs in-place store migrations from version 1 to 2 of the
+// group module. The migration moves the group module state to the tables of
+// the ORM (see orm/model/ormdb): the previous tables and sequences are read
+// into a genesis state, their entries are deleted and the genesis state is
+// written back to the store with importState.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, importState func(sdk.Context, *group.GenesisState) error) error {
+	store := ctx.KVStore(storeKey)
+	genesisState, err := readState(store, cdc)
+	if err != nil {
+		return err
+	}
+
+	// the ORM tables are stored under prefixes which were used by the previous
+	// tables and sequences, so they must be deleted first
+	for _, p := range prefixes {
+		deletePrefix(prefix.NewStore(store, []byte{p}))
+	}
+
+	return importState(ctx, genesisState)
+}
+
+// readState reads the tables and sequences of the previous version of the
+// state.
+func readState(store sdk.KVStore, cdc codec.BinaryCodec) (*group.GenesisState, error) {
+	genesisState := group.NewGenesisState()
+
+	err := readTable(store, cdc, GroupTablePrefix, func() codec.ProtoMarshaler {
+		g := &group.GroupInfo{}
+		genesisState.Groups = append(genesisState.Groups, g)
+		return g
+	})
+	if err != nil {
+		return nil, err
+	}
+	genesisState.GroupSeq = readSequence(store, GroupTableSeqPrefix)
+
+	err = readTable(store, cdc, GroupMemberTablePrefix, func() codec.ProtoMarshaler {
+		m := &group.GroupMember{}
+		genesisState.GroupMembers = append(genesisState.GroupMembers, m)
+		return m
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = readTable(store, cdc, GroupPolicyTablePrefix, func() codec.ProtoMarshaler {
+		p := &group.GroupPolicyInfo{}
+		genesisState.GroupPolicies = append(genesisState.GroupPolicies, p)
+		return p
+	})
+	if err != nil {
+		return nil, err
+	}
+	genesisState.GroupPolicySeq = readSequence(store, GroupPolicyTableSeqPrefix)
+
+	err = readTable(store, cdc, ProposalTablePrefix, func() codec.ProtoMarshaler {
+		p := &group.Proposal{}
+		genesisState.Proposals = append(genesisState.Proposals, p)
+		return p
+	})
+	if err != nil {
+		return nil, err
+	}
+	genesisState.ProposalSeq = readSequence(store, ProposalTableSeqPrefix)
+
+	err = readTable(store, cdc, VoteTablePrefix, func() codec.ProtoMarshaler {
+		v := &group.Vote{}
+		genesisState.Votes = append(genesisState.Votes, v)
+		return v
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return genesisState, nil
+}
+
+// readTable unmarshals the rows of the table with the provided prefix into
+// the values returned by next.
+func readTable(store sdk.KVStore, cdc codec.BinaryCodec, tablePrefix byte, next func() codec.ProtoMarshaler) error {
+	iter := prefix.NewStore(store, tableKey(tablePrefix)).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if err := cdc.Unmarshal(iter.Value(), next()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readSequence returns the current value of the sequence with the provided
+// prefix.
+func readSequence(store sdk.KVStore, seqPrefix byte) uint64 {
+	bz := store.Get(sequenceKey(seqPrefix))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func deletePrefix(store prefix.Store) {
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,132 @@
+package v046_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	v046 "github.com/cosmos/cosmos-sdk/x/group/migrations/v046"
+)
+
+// setRow stores a row of a table of the previous version of the state.
+func setRow(t *testing.T, store sdk.KVStore, cdc codec.Codec, tablePrefix byte, pk []byte, row codec.ProtoMarshaler) {
+	bz, err := cdc.Marshal(row)
+	require.NoError(t, err)
+	store.Set(append([]byte{tablePrefix, 0x00}, pk...), bz)
+}
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	cdc := encCfg.Codec
+	groupKey := sdk.NewKVStoreKey(group.StoreKey)
+	ctx := testutil.DefaultContext(groupKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(groupKey)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	_, _, admin := testdata.KeyTestPubAddr()
+	_, _, member1 := testdata.KeyTestPubAddr()
+	_, _, member2 := testdata.KeyTestPubAddr()
+	_, _, policyAddr := testdata.KeyTestPubAddr()
+
+	groups := []*group.GroupInfo{
+		{GroupId: 1, Admin: admin.String(), Metadata: []byte("group 1"), Version: 2, TotalWeight: "1", CreatedAt: now},
+		{GroupId: 2, Admin: admin.String(), Version: 1, TotalWeight: "2", CreatedAt: now},
+	}
+	members := []*group.GroupMember{
+		{GroupId: 1, Member: &group.Member{Address: member1.String(), Weight: "1", AddedAt: now}},
+		{GroupId: 2, Member: &group.Member{Address: member2.String(), Weight: "2", Metadata: []byte("member"), AddedAt: now}},
+	}
+	policy := &group.GroupPolicyInfo{Address: policyAddr.String(), GroupId: 1, Admin: admin.String(), Version: 1, CreatedAt: now}
+	require.NoError(t, policy.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Second, 0)))
+	proposals := []*group.Proposal{
+		{
+			ProposalId: 1, Address: policyAddr.String(), Proposers: []string{member1.String()}, SubmittedAt: now,
+			GroupVersion: 2, GroupPolicyVersion: 1, Status: group.ProposalStatusClosed, Result: group.ProposalResultAccepted,
+			VoteState: group.Tally{YesCount: "1", NoCount: "0", AbstainCount: "0", VetoCount: "0"}, Timeout: now.Add(time.Hour),
+			ExecutorResult: group.ProposalExecutorResultSuccess,
+		},
+		{
+			ProposalId: 2, Address: policyAddr.String(), Proposers: []string{member1.String()}, SubmittedAt: now,
+			GroupVersion: 2, GroupPolicyVersion: 1, Status: group.ProposalStatusSubmitted, Result: group.ProposalResultUnfinalized,
+			VoteState: group.Tally{YesCount: "0", NoCount: "1", AbstainCount: "0", VetoCount: "0"}, Timeout: now.Add(time.Hour),
+			ExecutorResult: group.ProposalExecutorResultNotRun,
+		},
+	}
+	votes := []*group.Vote{
+		{ProposalId: 1, Voter: member1.String(), Choice: group.Choice_CHOICE_YES, SubmittedAt: now},
+		{ProposalId: 2, Voter: member1.String(), Choice: group.Choice_CHOICE_NO, Metadata: []byte("vote"), SubmittedAt: now},
+	}
+
+	// write the state with the key layout of the previous version of the module
+	for _, g := range groups {
+		setRow(t, store, cdc, v046.GroupTablePrefix, sdk.Uint64ToBigEndian(g.GroupId), g)
+		store.Set(append([]byte{v046.GroupByAdminIndexPrefix}, admin...), []byte{})
+	}
+	store.Set([]byte{v046.GroupTableSeqPrefix, 0x01}, sdk.Uint64ToBigEndian(2))
+	for i, m := range members {
+		addr := []sdk.AccAddress{member1, member2}[i]
+		setRow(t, store, cdc, v046.GroupMemberTablePrefix, append(sdk.Uint64ToBigEndian(m.GroupId), addr...), m)
+		store.Set(append([]byte{v046.GroupMemberByGroupIndexPrefix}, sdk.Uint64ToBigEndian(m.GroupId)...), []byte{})
+		store.Set(append([]byte{v046.GroupMemberByMemberIndexPrefix}, addr...), []byte{})
+	}
+	setRow(t, store, cdc, v046.GroupPolicyTablePrefix, policyAddr, policy)
+	store.Set([]byte{v046.GroupPolicyTableSeqPrefix, 0x01}, sdk.Uint64ToBigEndian(5))
+	store.Set(append([]byte{v046.GroupPolicyByAdminIndexPrefix}, admin...), []byte{})
+	for _, p := range proposals {
+		setRow(t, store, cdc, v046.ProposalTablePrefix, sdk.Uint64ToBigEndian(p.ProposalId), p)
+		store.Set(append([]byte{v046.ProposalByGroupPolicyIndexPrefix}, policyAddr...), []byte{})
+	}
+	store.Set([]byte{v046.ProposalTableSeqPrefix, 0x01}, sdk.Uint64ToBigEndian(3))
+	for _, v := range votes {
+		setRow(t, store, cdc, v046.VoteTablePrefix, append(sdk.Uint64ToBigEndian(v.ProposalId), member1...), v)
+		store.Set(append([]byte{v046.VoteByVoterIndexPrefix}, member1...), []byte{})
+	}
+
+	k := keeper.NewKeeper(groupKey, cdc, nil, nil, keeper.DefaultConfig())
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	// all the entries of the store are ORM entries
+	db, err := ormdb.NewModuleDB(keeper.ModuleSchema, ormdb.ModuleDBOptions{})
+	require.NoError(t, err)
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		_, err := db.DecodeEntry(iter.Key(), iter.Value())
+		require.NoError(t, err, "key %X", iter.Key())
+	}
+	iter.Close()
+
+	expected := &group.GenesisState{
+		GroupSeq:       2,
+		Groups:         groups,
+		GroupMembers:   members,
+		GroupPolicySeq: 5,
+		GroupPolicies:  []*group.GroupPolicyInfo{policy},
+		ProposalSeq:    3,
+		Proposals:      proposals,
+		Votes:          votes,
+	}
+	require.JSONEq(t, string(cdc.MustMarshalJSON(expected)), string(cdc.MustMarshalJSON(k.ExportGenesis(ctx, cdc))))
+
+	// the migrated state is indexed
+	goCtx := sdk.WrapSDKContext(ctx)
+	groupsRes, err := k.GroupsByAdmin(goCtx, &group.QueryGroupsByAdminRequest{Admin: admin.String()})
+	require.NoError(t, err)
+	require.Len(t, groupsRes.Groups, 2)
+	memberRes, err := k.GroupsByMember(goCtx, &group.QueryGroupsByMemberRequest{Address: member2.String()})
+	require.NoError(t, err)
+	require.Len(t, memberRes.Groups, 1)
+	require.Equal(t, uint64(2), memberRes.Groups[0].GroupId)
+	votesRes, err := k.VotesByVoter(goCtx, &group.QueryVotesByVoterRequest{Voter: member1.String()})
+	require.NoError(t, err)
+	require.Len(t, votesRes.Votes, 2)
+	require.Equal(t, uint64(2), k.GetGroupSequence(ctx))
+}
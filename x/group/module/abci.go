@@ -0,0 +1,24 @@
+package module
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+)
+
+// EndBlocker called at every block, tallies the proposals whose voting period
+// has ended and prunes the proposals whose execution period has ended.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(group.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if err := k.TallyProposalsAtVPEnd(ctx); err != nil {
+		k.Logger(ctx).Error("failed to tally proposals", "err", err)
+	}
+
+	if err := k.PruneProposals(ctx); err != nil {
+		k.Logger(ctx).Error("failed to prune proposals", "err", err)
+	}
+}
@@ -384,7 +384,7 @@ func TestMsgCreateGroupPolicy(t *testing.T) {
 		{
 			"invalid threshold policy",
 			func() *group.MsgCreateGroupPolicy {
-				policy := group.NewThresholdDecisionPolicy("-1", time.Second)
+				policy := group.NewThresholdDecisionPolicy("-1", time.Second, 0)
 				req, err := group.NewMsgCreateGroupPolicy(admin, 1, []byte("metadata"), policy)
 				require.NoError(t, err)
 				return req
@@ -395,7 +395,7 @@ func TestMsgCreateGroupPolicy(t *testing.T) {
 		{
 			"valid test case",
 			func() *group.MsgCreateGroupPolicy {
-				policy := group.NewThresholdDecisionPolicy("1", time.Second)
+				policy := group.NewThresholdDecisionPolicy("1", time.Second, 0)
 				req, err := group.NewMsgCreateGroupPolicy(admin, 1, []byte("metadata"), policy)
 				require.NoError(t, err)
 				return req
@@ -421,11 +421,11 @@ func TestMsgCreateGroupPolicy(t *testing.T) {
 }
 
 func TestMsgUpdateGroupPolicyDecisionPolicy(t *testing.T) {
-	validPolicy := group.NewThresholdDecisionPolicy("1", time.Second)
+	validPolicy := group.NewThresholdDecisionPolicy("1", time.Second, 0)
 	msg1, err := group.NewMsgUpdateGroupPolicyDecisionPolicyRequest(admin, member1, validPolicy)
 	require.NoError(t, err)
 
-	invalidPolicy := group.NewThresholdDecisionPolicy("-1", time.Second)
+	invalidPolicy := group.NewThresholdDecisionPolicy("-1", time.Second, 0)
 	msg2, err := group.NewMsgUpdateGroupPolicyDecisionPolicyRequest(admin, member2, invalidPolicy)
 	require.NoError(t, err)
 
@@ -817,3 +817,51 @@ func TestMsgExec(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgLeaveGroup(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *group.MsgLeaveGroup
+		expErr bool
+		errMsg string
+	}{
+		{
+			"invalid member address",
+			&group.MsgLeaveGroup{
+				Address: "member",
+			},
+			true,
+			"group member: decoding bech32 failed",
+		},
+		{
+			"group id is required",
+			&group.MsgLeaveGroup{
+				Address: admin.String(),
+			},
+			true,
+			"group id: value is empty",
+		},
+		{
+			"valid testcase",
+			&group.MsgLeaveGroup{
+				Address: admin.String(),
+				GroupId: 1,
+			},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := tc.msg
+			err := msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, msg.Type(), sdk.MsgTypeURL(&group.MsgLeaveGroup{}))
+			}
+		})
+	}
+}
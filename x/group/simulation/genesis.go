@@ -56,7 +56,7 @@ func getGroupPolicies(r *rand.Rand, simState *module.SimulationState) []*group.G
 	groupPolicies := make([]*group.GroupPolicyInfo, 3)
 	for i := 0; i < 3; i++ {
 		acc, _ := simtypes.RandomAcc(r, simState.Accounts)
-		any, err := codectypes.NewAnyWithValue(group.NewThresholdDecisionPolicy("10", time.Second*time.Duration(1)))
+		any, err := codectypes.NewAnyWithValue(group.NewThresholdDecisionPolicy("10", time.Second*time.Duration(1), 0))
 		if err != nil {
 			panic(err)
 		}
@@ -72,13 +72,12 @@ func getGroupPolicies(r *rand.Rand, simState *module.SimulationState) []*group.G
 	return groupPolicies
 }
 
-func getProposals(r *rand.Rand, simState *module.SimulationState) []*group.Proposal {
+func getProposals(r *rand.Rand, simState *module.SimulationState, groupPolicies []*group.GroupPolicyInfo) []*group.Proposal {
 	proposals := make([]*group.Proposal, 3)
 	proposers := []string{simState.Accounts[0].Address.String(), simState.Accounts[1].Address.String()}
 	for i := 0; i < 3; i++ {
-		from, _ := simtypes.RandomAcc(r, simState.Accounts)
 		to, _ := simtypes.RandomAcc(r, simState.Accounts)
-		fromAddr := from.Address.String()
+		fromAddr := groupPolicies[i].Address
 
 		submittedAt := time.Unix(0, 0)
 		timeout := submittedAt.Add(time.Second * 1000).UTC()
@@ -87,8 +86,8 @@ func getProposals(r *rand.Rand, simState *module.SimulationState) []*group.Propo
 			ProposalId:         uint64(i + 1),
 			Proposers:          proposers,
 			Address:            fromAddr,
-			GroupVersion:       uint64(i + 1),
-			GroupPolicyVersion: uint64(i + 1),
+			GroupVersion:       1,
+			GroupPolicyVersion: 1,
 			Status:             group.ProposalStatusSubmitted,
 			Result:             group.ProposalResultAccepted,
 			VoteState: group.Tally{
@@ -174,7 +173,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var proposals []*group.Proposal
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, GroupProposals, &proposals, simState.Rand,
-		func(r *rand.Rand) { proposals = getProposals(r, simState) },
+		func(r *rand.Rand) { proposals = getProposals(r, simState, groupPolicies) },
 	)
 
 	// votes
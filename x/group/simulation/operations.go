@@ -15,6 +15,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
 	"github.com/cosmos/cosmos-sdk/x/group/keeper"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 
@@ -37,6 +38,7 @@ var (
 	TypeMsgWithdrawProposal                = sdk.MsgTypeURL(&group.MsgWithdrawProposal{})
 	TypeMsgVote                            = sdk.MsgTypeURL(&group.MsgVote{})
 	TypeMsgExec                            = sdk.MsgTypeURL(&group.MsgExec{})
+	TypeMsgLeaveGroup                      = sdk.MsgTypeURL(&group.MsgLeaveGroup{})
 )
 
 // Simulation operation weights constants
@@ -53,6 +55,7 @@ const (
 	OpMsgWithdrawProposal                = "op_weight_msg_withdraw_proposal"
 	OpMsgVote                            = "op_weight_msg_vote"
 	OpMsgExec                            = "op_weight_msg_exec"
+	OpMsgLeaveGroup                      = "op_weight_msg_leave_group"
 )
 
 // If update group or group policy txn's executed, `SimulateMsgVote` & `SimulateMsgExec` txn's returns `noOp`.
@@ -70,6 +73,7 @@ const (
 	WeightMsgUpdateGroupPolicyDecisionPolicy = 5
 	WeightMsgUpdateGroupPolicyMetadata       = 5
 	WeightMsgWithdrawProposal                = 20
+	WeightMsgLeaveGroup                      = 5
 )
 
 const GroupMemberWeight = 40
@@ -91,6 +95,7 @@ func WeightedOperations(
 		weightMsgVote                            int
 		weightMsgExec                            int
 		weightMsgWithdrawProposal                int
+		weightMsgLeaveGroup                      int
 	)
 
 	appParams.GetOrGenerate(cdc, OpMsgCreateGroup, &weightMsgCreateGroup, nil,
@@ -153,6 +158,11 @@ func WeightedOperations(
 			weightMsgWithdrawProposal = WeightMsgWithdrawProposal
 		},
 	)
+	appParams.GetOrGenerate(cdc, OpMsgLeaveGroup, &weightMsgLeaveGroup, nil,
+		func(_ *rand.Rand) {
+			weightMsgLeaveGroup = WeightMsgLeaveGroup
+		},
+	)
 
 	// create two proposals for weightedOperations
 	var createProposalOps simulation.WeightedOperations
@@ -211,6 +221,10 @@ func WeightedOperations(
 			weightMsgUpdateGroupPolicyMetadata,
 			SimulateMsgUpdateGroupPolicyMetadata(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgLeaveGroup,
+			SimulateMsgLeaveGroup(ak, bk, k),
+		),
 	}
 
 	return append(wPreCreateProposalOps, append(createProposalOps, wPostCreateProposalOps...)...)
@@ -287,10 +301,7 @@ func SimulateMsgCreateGroupPolicy(ak group.AccountKeeper, bk group.BankKeeper, k
 			acc.Address,
 			groupID,
 			[]byte(simtypes.RandStringOfLength(r, 10)),
-			&group.ThresholdDecisionPolicy{
-				Threshold: "20",
-				Timeout:   time.Second * time.Duration(30*24*60*60),
-			},
+			randomDecisionPolicy(r, time.Second*time.Duration(30*24*60*60), 0),
 		)
 		if err != nil {
 			return simtypes.NoOpMsg(group.ModuleName, TypeMsgCreateGroupPolicy, err.Error()), nil, err
@@ -641,10 +652,9 @@ func SimulateMsgUpdateGroupPolicyDecisionPolicy(ak group.AccountKeeper,
 			return simtypes.NoOpMsg(group.ModuleName, TypeMsgUpdateGroupPolicyDecisionPolicy, fmt.Sprintf("fail to decide bech32 address: %s", err.Error())), nil, nil
 		}
 
-		msg, err := group.NewMsgUpdateGroupPolicyDecisionPolicyRequest(acc.Address, groupPolicyBech32, &group.ThresholdDecisionPolicy{
-			Threshold: fmt.Sprintf("%d", simtypes.RandIntBetween(r, 1, 20)),
-			Timeout:   time.Second * time.Duration(simtypes.RandIntBetween(r, 100, 1000)),
-		})
+		timeout := time.Second * time.Duration(simtypes.RandIntBetween(r, 100, 1000))
+		minExecutionPeriod := time.Second * time.Duration(simtypes.RandIntBetween(r, 0, 10))
+		msg, err := group.NewMsgUpdateGroupPolicyDecisionPolicyRequest(acc.Address, groupPolicyBech32, randomDecisionPolicy(r, timeout, minExecutionPeriod))
 		if err != nil {
 			return simtypes.NoOpMsg(group.ModuleName, TypeMsgUpdateGroupPolicyDecisionPolicy, err.Error()), nil, err
 		}
@@ -979,8 +989,9 @@ func SimulateMsgExec(ak group.AccountKeeper,
 
 		proposalID := -1
 
+		minExecutionPeriod := groupPolicy.GetDecisionPolicy().GetMinExecutionPeriod()
 		for _, proposal := range proposals {
-			if proposal.Status == group.ProposalStatusClosed {
+			if proposal.Status == group.ProposalStatusClosed && !sdkCtx.BlockTime().Before(proposal.SubmittedAt.Add(minExecutionPeriod)) {
 				proposalID = int(proposal.ProposalId)
 				break
 			}
@@ -1022,6 +1033,114 @@ func SimulateMsgExec(ak group.AccountKeeper,
 	}
 }
 
+// SimulateMsgLeaveGroup generates a MsgLeaveGroup with random values
+func SimulateMsgLeaveGroup(ak group.AccountKeeper, bk group.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, sdkCtx sdk.Context, accounts []simtypes.Account, chainID string) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		groupInfo, _, _, err := randomGroup(r, k, ak, sdkCtx, accounts)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgLeaveGroup, ""), nil, err
+		}
+		if groupInfo == nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgLeaveGroup, "no group found"), nil, nil
+		}
+
+		ctx := sdk.WrapSDKContext(sdkCtx)
+		membersRes, err := k.GroupMembers(ctx, &group.QueryGroupMembersRequest{GroupId: groupInfo.GroupId})
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgLeaveGroup, "fail to query group members"), nil, err
+		}
+		n := randIntInRange(r, len(membersRes.Members))
+		if n < 0 {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgLeaveGroup, "no group member found"), nil, nil
+		}
+		member := membersRes.Members[n].Member
+		idx := findAccount(accounts, member.Address)
+		if idx < 0 {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgLeaveGroup, "group member account not found"), nil, nil
+		}
+		acc := accounts[idx]
+		account := ak.GetAccount(sdkCtx, acc.Address)
+
+		// Return a no-op if leaving the group would invalidate one of its decision policies
+		totalWeight, err := math.NewNonNegativeDecFromString(groupInfo.TotalWeight)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgLeaveGroup, ""), nil, err
+		}
+		memberWeight, err := math.NewNonNegativeDecFromString(member.Weight)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgLeaveGroup, ""), nil, err
+		}
+		newWeight, err := math.SubNonNegative(totalWeight, memberWeight)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgLeaveGroup, ""), nil, err
+		}
+		updatedGroup := *groupInfo
+		updatedGroup.TotalWeight = newWeight.String()
+
+		policiesRes, err := k.GroupPoliciesByGroup(ctx, &group.QueryGroupPoliciesByGroupRequest{GroupId: groupInfo.GroupId})
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgLeaveGroup, "fail to query group policies"), nil, err
+		}
+		for _, policyInfo := range policiesRes.GroupPolicies {
+			if err := policyInfo.GetDecisionPolicy().Validate(updatedGroup); err != nil {
+				return simtypes.NoOpMsg(group.ModuleName, TypeMsgLeaveGroup, "decision policy would become invalid"), nil, nil
+			}
+		}
+
+		spendableCoins := bk.SpendableCoins(sdkCtx, account.GetAddress())
+		fees, err := simtypes.RandomFees(r, sdkCtx, spendableCoins)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgLeaveGroup, "fee error"), nil, err
+		}
+
+		msg := group.MsgLeaveGroup{
+			Address: acc.Address.String(),
+			GroupId: groupInfo.GroupId,
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{&msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			acc.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgLeaveGroup, "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.SimDeliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(&msg, true, "", nil), nil, err
+	}
+}
+
+// randomDecisionPolicy returns a threshold, percentage or quorum decision
+// policy with the given windows and random values.
+func randomDecisionPolicy(r *rand.Rand, timeout, minExecutionPeriod time.Duration) group.DecisionPolicy {
+	switch r.Intn(3) {
+	case 0:
+		return group.NewThresholdDecisionPolicy(fmt.Sprintf("%d", simtypes.RandIntBetween(r, 1, 20)), timeout, minExecutionPeriod)
+	case 1:
+		return group.NewPercentageDecisionPolicy(randomFraction(r), timeout, minExecutionPeriod)
+	default:
+		return group.NewQuorumDecisionPolicy(randomFraction(r), randomFraction(r), randomFraction(r), timeout, minExecutionPeriod)
+	}
+}
+
+// randomFraction returns a random decimal string between 0.01 and 1.
+func randomFraction(r *rand.Rand) string {
+	return fmt.Sprintf("%.2f", float64(simtypes.RandIntBetween(r, 1, 101))/100)
+}
+
 func randomGroup(r *rand.Rand, k keeper.Keeper, ak group.AccountKeeper,
 	ctx sdk.Context, accounts []simtypes.Account) (groupInfo *group.GroupInfo, acc simtypes.Account, account authtypes.AccountI, err error) {
 	groupID := k.GetGroupSequence(ctx)
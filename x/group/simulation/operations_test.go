@@ -62,6 +62,8 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 		{simulation.WeightMsgUpdateGroupPolicyAdmin, group.MsgUpdateGroupPolicyAdmin{}.Route(), simulation.TypeMsgUpdateGroupPolicyAdmin},
 		{simulation.WeightMsgUpdateGroupPolicyDecisionPolicy, group.MsgUpdateGroupPolicyDecisionPolicy{}.Route(), simulation.TypeMsgUpdateGroupPolicyDecisionPolicy},
 		{simulation.WeightMsgUpdateGroupPolicyMetadata, group.MsgUpdateGroupPolicyMetadata{}.Route(), simulation.TypeMsgUpdateGroupPolicyMetadata},
+		// the only member can't leave the group without invalidating its decision policy
+		{simulation.WeightMsgLeaveGroup, group.ModuleName, simulation.TypeMsgLeaveGroup},
 	}
 
 	for i, w := range weightedOps {
@@ -190,7 +192,7 @@ func (suite *SimTestSuite) TestSimulateCreateProposal() {
 		GroupId:  groupRes.GroupId,
 		Metadata: nil,
 	}
-	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Hour))
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Hour, 0))
 	suite.Require().NoError(err)
 	groupPolicyRes, err := suite.app.GroupKeeper.CreateGroupPolicy(ctx, accountReq)
 	suite.Require().NoError(err)
@@ -245,7 +247,7 @@ func (suite *SimTestSuite) TestWithdrawProposal() {
 		GroupId:  groupRes.GroupId,
 		Metadata: nil,
 	}
-	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Hour))
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Hour, 0))
 	suite.Require().NoError(err)
 	groupPolicyRes, err := suite.app.GroupKeeper.CreateGroupPolicy(ctx, accountReq)
 	suite.Require().NoError(err)
@@ -312,7 +314,7 @@ func (suite *SimTestSuite) TestSimulateVote() {
 		GroupId:  groupRes.GroupId,
 		Metadata: nil,
 	}
-	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Hour))
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Hour, 0))
 	suite.Require().NoError(err)
 	groupPolicyRes, err := suite.app.GroupKeeper.CreateGroupPolicy(ctx, accountReq)
 	suite.Require().NoError(err)
@@ -379,7 +381,7 @@ func (suite *SimTestSuite) TestSimulateExec() {
 		GroupId:  groupRes.GroupId,
 		Metadata: nil,
 	}
-	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Hour))
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Hour, 0))
 	suite.Require().NoError(err)
 	groupPolicyRes, err := suite.app.GroupKeeper.CreateGroupPolicy(ctx, accountReq)
 	suite.Require().NoError(err)
@@ -425,6 +427,78 @@ func (suite *SimTestSuite) TestSimulateExec() {
 	suite.Require().Len(futureOperations, 0)
 }
 
+func (suite *SimTestSuite) TestSimulateLeaveGroup() {
+	// setup 2 accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+	admin := accounts[0]
+	member := accounts[1]
+
+	// setup a group with the member only
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	groupRes, err := suite.app.GroupKeeper.CreateGroup(ctx,
+		&group.MsgCreateGroup{
+			Admin: admin.Address.String(),
+			Members: []group.Member{
+				{
+					Address: member.Address.String(),
+					Weight:  "1",
+				},
+			},
+		},
+	)
+	suite.Require().NoError(err)
+
+	// setup a group policy that stays valid without members
+	accountReq := &group.MsgCreateGroupPolicy{
+		Admin:   admin.Address.String(),
+		GroupId: groupRes.GroupId,
+	}
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Hour, 0))
+	suite.Require().NoError(err)
+	_, err = suite.app.GroupKeeper.CreateGroupPolicy(ctx, accountReq)
+	suite.Require().NoError(err)
+
+	// begin a new block
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+
+	// execute operation: the threshold policy would exceed the new total weight
+	op := simulation.SimulateMsgLeaveGroup(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.GroupKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+	suite.Require().False(operationMsg.OK)
+	suite.Require().Len(futureOperations, 0)
+
+	// add a second member so that the first one can leave
+	_, err = suite.app.GroupKeeper.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembers{
+		Admin:   admin.Address.String(),
+		GroupId: groupRes.GroupId,
+		MemberUpdates: []group.Member{
+			{
+				Address: admin.Address.String(),
+				Weight:  "1",
+			},
+		},
+	})
+	suite.Require().NoError(err)
+
+	operationMsg, futureOperations, err = op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg group.MsgLeaveGroup
+	err = group.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().NoError(err)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(groupRes.GroupId, msg.GroupId)
+	suite.Require().Len(futureOperations, 0)
+}
+
 func (suite *SimTestSuite) TestSimulateUpdateGroupAdmin() {
 	// setup 1 account
 	s := rand.NewSource(1)
@@ -579,7 +653,7 @@ func (suite *SimTestSuite) TestSimulateUpdateGroupPolicyAdmin() {
 		GroupId:  groupRes.GroupId,
 		Metadata: nil,
 	}
-	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Hour))
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Hour, 0))
 	suite.Require().NoError(err)
 	groupPolicyRes, err := suite.app.GroupKeeper.CreateGroupPolicy(ctx, accountReq)
 	suite.Require().NoError(err)
@@ -633,7 +707,7 @@ func (suite *SimTestSuite) TestSimulateUpdateGroupPolicyDecisionPolicy() {
 		GroupId:  groupRes.GroupId,
 		Metadata: nil,
 	}
-	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Hour))
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Hour, 0))
 	suite.Require().NoError(err)
 	groupPolicyRes, err := suite.app.GroupKeeper.CreateGroupPolicy(ctx, accountReq)
 	suite.Require().NoError(err)
@@ -687,7 +761,7 @@ func (suite *SimTestSuite) TestSimulateUpdateGroupPolicyMetadata() {
 		GroupId:  groupRes.GroupId,
 		Metadata: nil,
 	}
-	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Hour))
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Hour, 0))
 	suite.Require().NoError(err)
 	groupPolicyRes, err := suite.app.GroupKeeper.CreateGroupPolicy(ctx, accountReq)
 	suite.Require().NoError(err)
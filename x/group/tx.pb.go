@@ -1254,6 +1254,98 @@ func (m *MsgExecResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExecResponse proto.InternalMessageInfo
 
+// MsgLeaveGroup is the Msg/LeaveGroup request type.
+type MsgLeaveGroup struct {
+	// address is the account address of the group member.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (m *MsgLeaveGroup) Reset()         { *m = MsgLeaveGroup{} }
+func (m *MsgLeaveGroup) String() string { return proto.CompactTextString(m) }
+func (*MsgLeaveGroup) ProtoMessage()    {}
+func (*MsgLeaveGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da0de9d603d844fb, []int{24}
+}
+func (m *MsgLeaveGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLeaveGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLeaveGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLeaveGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLeaveGroup.Merge(m, src)
+}
+func (m *MsgLeaveGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLeaveGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLeaveGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLeaveGroup proto.InternalMessageInfo
+
+func (m *MsgLeaveGroup) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgLeaveGroup) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+// MsgLeaveGroupResponse is the Msg/LeaveGroup response type.
+type MsgLeaveGroupResponse struct {
+}
+
+func (m *MsgLeaveGroupResponse) Reset()         { *m = MsgLeaveGroupResponse{} }
+func (m *MsgLeaveGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLeaveGroupResponse) ProtoMessage()    {}
+func (*MsgLeaveGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da0de9d603d844fb, []int{25}
+}
+func (m *MsgLeaveGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLeaveGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLeaveGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLeaveGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLeaveGroupResponse.Merge(m, src)
+}
+func (m *MsgLeaveGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLeaveGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLeaveGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLeaveGroupResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.group.v1beta1.Exec", Exec_name, Exec_value)
 	proto.RegisterType((*MsgCreateGroup)(nil), "cosmos.group.v1beta1.MsgCreateGroup")
@@ -1280,80 +1372,84 @@ func init() {
 	proto.RegisterType((*MsgVoteResponse)(nil), "cosmos.group.v1beta1.MsgVoteResponse")
 	proto.RegisterType((*MsgExec)(nil), "cosmos.group.v1beta1.MsgExec")
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.group.v1beta1.MsgExecResponse")
+	proto.RegisterType((*MsgLeaveGroup)(nil), "cosmos.group.v1beta1.MsgLeaveGroup")
+	proto.RegisterType((*MsgLeaveGroupResponse)(nil), "cosmos.group.v1beta1.MsgLeaveGroupResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1beta1/tx.proto", fileDescriptor_da0de9d603d844fb) }
 
 var fileDescriptor_da0de9d603d844fb = []byte{
-	// 1077 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xc4, 0x6e, 0x3e, 0x9e, 0x8b, 0x9b, 0x6c, 0x4d, 0x71, 0x96, 0xc4, 0x36, 0x26, 0x80,
-	0x31, 0xc9, 0x2e, 0x71, 0x8a, 0x40, 0x56, 0x85, 0x48, 0x52, 0x83, 0x2c, 0x61, 0x14, 0xb6, 0x94,
-	0xaf, 0x8b, 0xb5, 0xf6, 0x0e, 0x9b, 0x2d, 0xb1, 0x67, 0xe5, 0xd9, 0x7c, 0xf8, 0xda, 0x13, 0x52,
-	0x2f, 0xfc, 0x03, 0x48, 0x48, 0x48, 0x1c, 0x38, 0x71, 0xe8, 0x85, 0x2b, 0xa7, 0x8a, 0x53, 0xc5,
-	0x89, 0x13, 0xaa, 0x92, 0x03, 0x48, 0x1c, 0xfa, 0x2f, 0x20, 0xcf, 0xec, 0x4e, 0xbc, 0xf1, 0xae,
-	0xbd, 0xb6, 0xa2, 0x9c, 0x92, 0xd9, 0xf9, 0xbd, 0xf7, 0x7e, 0xbf, 0x79, 0x6f, 0xe6, 0x3d, 0x19,
-	0x56, 0x5b, 0x84, 0xb6, 0x09, 0x55, 0xcd, 0x2e, 0x39, 0xb4, 0xd5, 0xa3, 0xcd, 0x26, 0x76, 0xf4,
-	0x4d, 0xd5, 0x39, 0x51, 0xec, 0x2e, 0x71, 0x88, 0x94, 0xe6, 0xdb, 0x0a, 0xdb, 0x56, 0xdc, 0x6d,
-	0x39, 0x6d, 0x12, 0x93, 0x30, 0x80, 0xda, 0xff, 0x8f, 0x63, 0xe5, 0x65, 0x8e, 0x6d, 0xf0, 0x0d,
-	0xd7, 0xd0, 0xdd, 0x32, 0x09, 0x31, 0x0f, 0xb0, 0xca, 0x56, 0xcd, 0xc3, 0x6f, 0x54, 0xbd, 0xd3,
-	0x73, 0xb7, 0xf2, 0xc1, 0x04, 0x7a, 0x36, 0xf6, 0x8c, 0x5f, 0x72, 0x11, 0x6d, 0x6a, 0xaa, 0x47,
-	0x9b, 0xfd, 0x3f, 0x7c, 0xa3, 0xf0, 0x33, 0x82, 0x54, 0x9d, 0x9a, 0xbb, 0x5d, 0xac, 0x3b, 0xf8,
-	0xa3, 0xbe, 0xbd, 0xa4, 0xc0, 0x35, 0xdd, 0x68, 0x5b, 0x9d, 0x0c, 0xca, 0xa3, 0xe2, 0xc2, 0x4e,
-	0xe6, 0xcf, 0xc7, 0x1b, 0x9e, 0x84, 0x6d, 0xc3, 0xe8, 0x62, 0x4a, 0xef, 0x39, 0x5d, 0xab, 0x63,
-	0x6a, 0x1c, 0x26, 0xdd, 0x81, 0xb9, 0x36, 0x6e, 0x37, 0x71, 0x97, 0x66, 0x66, 0xf2, 0xf1, 0x62,
-	0xb2, 0xbc, 0xa2, 0x04, 0x29, 0x56, 0xea, 0x0c, 0xb4, 0x93, 0x78, 0xf2, 0x77, 0x2e, 0xa6, 0x79,
-	0x26, 0x92, 0x0c, 0xf3, 0x6d, 0xec, 0xe8, 0x86, 0xee, 0xe8, 0x99, 0x78, 0x1e, 0x15, 0xaf, 0x6b,
-	0x62, 0x5d, 0x81, 0x87, 0xff, 0xfc, 0x5a, 0xe2, 0x51, 0x0a, 0x5b, 0x70, 0xcb, 0xcf, 0x53, 0xc3,
-	0xd4, 0x26, 0x1d, 0x8a, 0xa5, 0x65, 0x98, 0x67, 0x81, 0x1a, 0x96, 0xc1, 0x28, 0x27, 0xb4, 0x39,
-	0xb6, 0xae, 0x19, 0x85, 0xdf, 0x10, 0xbc, 0x58, 0xa7, 0xe6, 0x7d, 0xdb, 0xf0, 0xac, 0xea, 0x6e,
-	0xd8, 0x49, 0x45, 0x0e, 0x06, 0x99, 0xf1, 0x05, 0x91, 0x6a, 0x90, 0xe2, 0x62, 0x1a, 0x87, 0x2c,
-	0x0e, 0xcd, 0xc4, 0x23, 0x1f, 0xc3, 0x0b, 0xdc, 0x92, 0x13, 0xa4, 0x3e, 0xc1, 0x39, 0x58, 0x0d,
-	0xa4, 0xee, 0xe9, 0x2e, 0xfc, 0x84, 0xe0, 0xa6, 0x1f, 0xb1, 0xcd, 0xa8, 0x5e, 0xa2, 0xb4, 0x77,
-	0x60, 0xa1, 0x83, 0x8f, 0x1b, 0xdc, 0x5d, 0x7c, 0x8c, 0xbb, 0xf9, 0x0e, 0x3e, 0x66, 0x0c, 0x7c,
-	0x32, 0x56, 0xe1, 0xe5, 0x00, 0x92, 0x42, 0xc4, 0x23, 0xc4, 0xf2, 0xea, 0x93, 0xc9, 0xb3, 0x7f,
-	0x99, 0x3a, 0xa2, 0x16, 0x59, 0x1e, 0xb2, 0xc1, 0x64, 0x04, 0xdf, 0x67, 0x08, 0xd2, 0xfe, 0x3a,
-	0xdc, 0x23, 0x07, 0x56, 0xab, 0x77, 0x45, 0x6c, 0xa5, 0x4f, 0xe1, 0x86, 0x81, 0x5b, 0x16, 0xb5,
-	0x48, 0xa7, 0x61, 0xb3, 0xc8, 0x99, 0x44, 0x1e, 0x15, 0x93, 0xe5, 0xb4, 0xc2, 0xdf, 0x07, 0xc5,
-	0x7b, 0x1f, 0x94, 0xed, 0x4e, 0x6f, 0x47, 0xfa, 0xe3, 0xf1, 0x46, 0xea, 0xae, 0x6b, 0xc0, 0x99,
-	0x6a, 0x29, 0xc3, 0xb7, 0xae, 0xa4, 0xbe, 0xfb, 0x31, 0x17, 0x1b, 0x38, 0x04, 0x0d, 0x56, 0x82,
-	0x14, 0x8a, 0xfb, 0x56, 0x86, 0x39, 0x9d, 0x2b, 0x1a, 0xab, 0xd5, 0x03, 0x16, 0x7e, 0x47, 0xb0,
-	0xec, 0x3f, 0x59, 0xee, 0x74, 0xba, 0x8a, 0x1d, 0x60, 0x30, 0x13, 0x91, 0xc1, 0x65, 0x94, 0xf2,
-	0xab, 0xf0, 0x4a, 0xa8, 0x06, 0x51, 0x20, 0xff, 0x21, 0x28, 0x04, 0xa1, 0xfc, 0x49, 0xb8, 0x12,
-	0xc9, 0x01, 0xb5, 0x12, 0xbf, 0xe4, 0x5a, 0x59, 0x87, 0xd2, 0x78, 0xb1, 0xe2, 0x6c, 0x7e, 0x41,
-	0xac, 0xb4, 0x86, 0xe0, 0x53, 0x5f, 0xf9, 0x69, 0x4e, 0x25, 0xea, 0x5b, 0xf0, 0x3a, 0xac, 0x8d,
-	0xe2, 0x2a, 0x44, 0x3d, 0x47, 0xb0, 0x24, 0xee, 0xcb, 0x5e, 0x97, 0xd8, 0x84, 0xea, 0x07, 0xd3,
-	0x5c, 0x12, 0x69, 0x05, 0x16, 0x6c, 0x66, 0xef, 0xb5, 0xd2, 0x05, 0xed, 0xfc, 0xc3, 0xc8, 0x57,
-	0xa1, 0x08, 0x89, 0x36, 0x35, 0x69, 0x26, 0xc1, 0x1a, 0x4f, 0x60, 0x7a, 0x35, 0x86, 0x90, 0x14,
-	0x48, 0xe0, 0x13, 0xdc, 0xca, 0x5c, 0xcb, 0xa3, 0x62, 0xaa, 0x2c, 0x07, 0xb7, 0xa8, 0xea, 0x09,
-	0x6e, 0x69, 0x0c, 0x57, 0x91, 0xbc, 0x84, 0x9f, 0x33, 0x29, 0xdc, 0x61, 0x77, 0xd9, 0x2f, 0x58,
-	0xbc, 0x0e, 0x39, 0x48, 0xda, 0xee, 0xb7, 0xf3, 0x86, 0x0c, 0xde, 0xa7, 0x9a, 0x51, 0x78, 0xc0,
-	0xba, 0xd6, 0x17, 0x96, 0xb3, 0x6f, 0x74, 0xf5, 0x63, 0x71, 0x60, 0xe3, 0xec, 0xa6, 0xc9, 0xb5,
-	0xdb, 0x7c, 0x2e, 0xc6, 0x12, 0xa9, 0xfb, 0x17, 0xc1, 0x5c, 0x9d, 0x9a, 0x9f, 0x13, 0x67, 0x3c,
-	0xef, 0x7e, 0x6d, 0x1e, 0x11, 0x07, 0x77, 0xc7, 0x46, 0xe7, 0x30, 0xe9, 0x36, 0xcc, 0xb6, 0xf6,
-	0x89, 0xd5, 0xc2, 0x2c, 0x5b, 0xa9, 0xb0, 0x71, 0x60, 0x97, 0x61, 0x34, 0x17, 0xeb, 0xcb, 0x72,
-	0xe2, 0x42, 0x96, 0x27, 0xcd, 0x1d, 0xaf, 0x66, 0xc6, 0xa6, 0xb0, 0x04, 0x37, 0x5c, 0xa5, 0x42,
-	0xbd, 0xc5, 0xc4, 0xf7, 0xf1, 0xe3, 0xc5, 0xbf, 0x0d, 0xb3, 0xd4, 0x32, 0x3b, 0x11, 0xd4, 0xbb,
-	0xb8, 0x4a, 0xb2, 0x1f, 0xdc, 0x5d, 0xb8, 0xd1, 0x19, 0x35, 0x37, 0x7a, 0xa9, 0x04, 0x09, 0x16,
-	0x3a, 0x0d, 0x8b, 0xd5, 0x2f, 0xab, 0xbb, 0x8d, 0xfb, 0x9f, 0xdc, 0xdb, 0xab, 0xee, 0xd6, 0x3e,
-	0xac, 0x55, 0xef, 0x2e, 0xc6, 0xa4, 0xeb, 0x30, 0xcf, 0xbe, 0x7e, 0xa6, 0x7d, 0xb5, 0x88, 0xca,
-	0xcf, 0x01, 0xe2, 0x75, 0x6a, 0x4a, 0x3a, 0x24, 0x07, 0x07, 0xd5, 0xb5, 0x90, 0x01, 0xcb, 0xd7,
-	0xbc, 0xe4, 0xf5, 0x28, 0x28, 0x51, 0xbe, 0x47, 0x20, 0x05, 0x4c, 0x8b, 0x6f, 0x85, 0xfa, 0x18,
-	0x06, 0xcb, 0x5b, 0x13, 0x80, 0x45, 0x5c, 0x1b, 0x16, 0x87, 0x06, 0xb9, 0x37, 0xa3, 0x38, 0x62,
-	0x50, 0x79, 0x33, 0x32, 0x54, 0x44, 0xec, 0xc1, 0xcd, 0xa0, 0xa9, 0x6b, 0x3d, 0x1a, 0x7b, 0x8e,
-	0x96, 0x6f, 0x4f, 0x82, 0x16, 0xa1, 0x29, 0x2c, 0x0d, 0x0f, 0x50, 0xa5, 0x28, 0x79, 0xe2, 0x58,
-	0xb9, 0x1c, 0x1d, 0x2b, 0x82, 0x3e, 0x44, 0x70, 0x2b, 0x64, 0xfe, 0x50, 0xa3, 0xa8, 0x18, 0x30,
-	0x90, 0xdf, 0x9d, 0xd0, 0x40, 0x90, 0xf8, 0x01, 0x41, 0x6e, 0xdc, 0x68, 0xf0, 0x5e, 0x74, 0xe7,
-	0x7e, 0x4b, 0xf9, 0x83, 0x69, 0x2d, 0x05, 0xbf, 0x47, 0x08, 0x96, 0xc3, 0xdb, 0x73, 0x39, 0xba,
-	0x7f, 0x51, 0x21, 0x95, 0xc9, 0x6d, 0x04, 0x9b, 0x07, 0x90, 0xba, 0xd0, 0x56, 0xdf, 0x18, 0x93,
-	0x78, 0x0f, 0x28, 0xab, 0x11, 0x81, 0x83, 0x17, 0x70, 0xa8, 0x27, 0x85, 0x5f, 0xc0, 0x8b, 0xd0,
-	0x11, 0x17, 0x30, 0xac, 0xfb, 0x48, 0x1f, 0x43, 0x82, 0x75, 0x9e, 0xd5, 0x50, 0xd3, 0xfe, 0xb6,
-	0xfc, 0xda, 0xc8, 0xed, 0x41, 0x6f, 0xec, 0x3d, 0x0d, 0xf7, 0xd6, 0xdf, 0x1e, 0xe1, 0x6d, 0xf0,
-	0x75, 0xde, 0x79, 0xff, 0xc9, 0x69, 0x16, 0x3d, 0x3d, 0xcd, 0xa2, 0x67, 0xa7, 0x59, 0xf4, 0xfd,
-	0x59, 0x36, 0xf6, 0xf4, 0x2c, 0x1b, 0xfb, 0xeb, 0x2c, 0x1b, 0xfb, 0x7a, 0xcd, 0xb4, 0x9c, 0xfd,
-	0xc3, 0xa6, 0xd2, 0x22, 0x6d, 0xf7, 0xf7, 0x09, 0xf7, 0xcf, 0x06, 0x35, 0xbe, 0x55, 0x4f, 0xf8,
-	0x6f, 0x10, 0xcd, 0x59, 0x36, 0x7a, 0x6c, 0xfd, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x1d, 0x8e,
-	0x60, 0x1b, 0x11, 0x00, 0x00,
+	// 1120 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0x1b, 0x55,
+	0x10, 0xf6, 0xab, 0xdd, 0xfc, 0x18, 0xa7, 0x6e, 0xb2, 0x75, 0x53, 0x7b, 0x49, 0x6c, 0xe3, 0xa6,
+	0x60, 0xdc, 0x64, 0x17, 0x3b, 0x45, 0x20, 0xab, 0x42, 0x24, 0xa9, 0x41, 0x96, 0x6a, 0x14, 0xb6,
+	0x94, 0x5f, 0x07, 0xac, 0xb5, 0xf7, 0xb1, 0xd9, 0x12, 0x7b, 0x57, 0x7e, 0x1b, 0x27, 0xbe, 0xf6,
+	0x84, 0xd4, 0x0b, 0xff, 0x00, 0x12, 0x12, 0x12, 0x07, 0x4e, 0x1c, 0x7a, 0xe1, 0xca, 0xa9, 0xe2,
+	0x42, 0xc5, 0x89, 0x13, 0xaa, 0x92, 0x03, 0x48, 0x1c, 0xf8, 0x17, 0x90, 0xdf, 0xdb, 0x7d, 0xf1,
+	0xc6, 0xbb, 0xf1, 0xda, 0x8a, 0x7a, 0x4a, 0x76, 0xe7, 0x9b, 0x99, 0xef, 0x7b, 0x33, 0x6f, 0x67,
+	0x64, 0x58, 0x6d, 0x99, 0xa4, 0x6d, 0x12, 0x59, 0xef, 0x9a, 0x07, 0x96, 0xdc, 0x2b, 0x35, 0xb1,
+	0xad, 0x96, 0x64, 0xfb, 0x48, 0xb2, 0xba, 0xa6, 0x6d, 0x0a, 0x49, 0x66, 0x96, 0xa8, 0x59, 0x72,
+	0xcc, 0x62, 0x52, 0x37, 0x75, 0x93, 0x02, 0xe4, 0xc1, 0x7f, 0x0c, 0x2b, 0xa6, 0x19, 0xb6, 0xc1,
+	0x0c, 0x8e, 0xa3, 0x63, 0xd2, 0x4d, 0x53, 0xdf, 0xc7, 0x32, 0x7d, 0x6a, 0x1e, 0x7c, 0x25, 0xab,
+	0x9d, 0xbe, 0x63, 0xca, 0xf9, 0x13, 0xe8, 0x5b, 0xd8, 0x75, 0xbe, 0xe1, 0x20, 0xda, 0x44, 0x97,
+	0x7b, 0xa5, 0xc1, 0x1f, 0x66, 0xc8, 0xff, 0x88, 0x20, 0x51, 0x27, 0xfa, 0x4e, 0x17, 0xab, 0x36,
+	0xfe, 0x60, 0xe0, 0x2f, 0x48, 0x70, 0x59, 0xd5, 0xda, 0x46, 0x27, 0x85, 0x72, 0xa8, 0x30, 0xbf,
+	0x9d, 0xfa, 0xe3, 0xe9, 0x86, 0x2b, 0x61, 0x4b, 0xd3, 0xba, 0x98, 0x90, 0x07, 0x76, 0xd7, 0xe8,
+	0xe8, 0x0a, 0x83, 0x09, 0x77, 0x61, 0xb6, 0x8d, 0xdb, 0x4d, 0xdc, 0x25, 0xa9, 0x4b, 0xb9, 0x68,
+	0x21, 0x5e, 0x5e, 0x91, 0xfc, 0x14, 0x4b, 0x75, 0x0a, 0xda, 0x8e, 0x3d, 0xfb, 0x2b, 0x1b, 0x51,
+	0x5c, 0x17, 0x41, 0x84, 0xb9, 0x36, 0xb6, 0x55, 0x4d, 0xb5, 0xd5, 0x54, 0x34, 0x87, 0x0a, 0x0b,
+	0x0a, 0x7f, 0xae, 0xc0, 0xe3, 0xbf, 0x7f, 0x2e, 0xb2, 0x2c, 0xf9, 0x4d, 0x58, 0xf6, 0xf2, 0x54,
+	0x30, 0xb1, 0xcc, 0x0e, 0xc1, 0x42, 0x1a, 0xe6, 0x68, 0xa2, 0x86, 0xa1, 0x51, 0xca, 0x31, 0x65,
+	0x96, 0x3e, 0xd7, 0xb4, 0xfc, 0x2f, 0x08, 0xae, 0xd7, 0x89, 0xfe, 0xd0, 0xd2, 0x5c, 0xaf, 0xba,
+	0x93, 0x76, 0x52, 0x91, 0xc3, 0x49, 0x2e, 0x79, 0x92, 0x08, 0x35, 0x48, 0x30, 0x31, 0x8d, 0x03,
+	0x9a, 0x87, 0xa4, 0xa2, 0xa1, 0x8f, 0xe1, 0x0a, 0xf3, 0x64, 0x04, 0x89, 0x47, 0x70, 0x16, 0x56,
+	0x7d, 0xa9, 0xbb, 0xba, 0xf3, 0x3f, 0x20, 0xb8, 0xe6, 0x45, 0x6c, 0x51, 0xaa, 0x17, 0x28, 0xed,
+	0x2d, 0x98, 0xef, 0xe0, 0xc3, 0x06, 0x0b, 0x17, 0x1d, 0x13, 0x6e, 0xae, 0x83, 0x0f, 0x29, 0x03,
+	0x8f, 0x8c, 0x55, 0x78, 0xc5, 0x87, 0x24, 0x17, 0xf1, 0x04, 0xc1, 0xb2, 0xd7, 0x5e, 0x77, 0xaa,
+	0x7f, 0x91, 0x3a, 0xc2, 0x36, 0x59, 0x0e, 0x32, 0xfe, 0x64, 0x38, 0xdf, 0x17, 0x08, 0x92, 0xde,
+	0x3e, 0xdc, 0x35, 0xf7, 0x8d, 0x56, 0xff, 0x25, 0xb1, 0x15, 0x3e, 0x82, 0xab, 0x1a, 0x6e, 0x19,
+	0xc4, 0x30, 0x3b, 0x0d, 0x8b, 0x66, 0x4e, 0xc5, 0x72, 0xa8, 0x10, 0x2f, 0x27, 0x25, 0xf6, 0x7d,
+	0x90, 0xdc, 0xef, 0x83, 0xb4, 0xd5, 0xe9, 0x6f, 0x0b, 0xbf, 0x3d, 0xdd, 0x48, 0xdc, 0x73, 0x1c,
+	0x18, 0x53, 0x25, 0xa1, 0x79, 0x9e, 0x2b, 0x89, 0x6f, 0xbe, 0xcf, 0x46, 0x86, 0x0e, 0x41, 0x81,
+	0x15, 0x3f, 0x85, 0xfc, 0xbe, 0x95, 0x61, 0x56, 0x65, 0x8a, 0xc6, 0x6a, 0x75, 0x81, 0xf9, 0x5f,
+	0x11, 0xa4, 0xbd, 0x27, 0xcb, 0x82, 0x4e, 0xd7, 0xb1, 0x43, 0x0c, 0x2e, 0x85, 0x64, 0x70, 0x11,
+	0xad, 0x7c, 0x13, 0x5e, 0x0d, 0xd4, 0xc0, 0x1b, 0xe4, 0x5f, 0x04, 0x79, 0x3f, 0x94, 0xb7, 0x08,
+	0x2f, 0x45, 0xb2, 0x4f, 0xaf, 0x44, 0x2f, 0xb8, 0x57, 0xd6, 0xa1, 0x38, 0x5e, 0x2c, 0x3f, 0x9b,
+	0x9f, 0x10, 0xac, 0xf8, 0xc1, 0xa7, 0xbe, 0xf2, 0xd3, 0x9c, 0x4a, 0xd8, 0x6f, 0xc1, 0x6b, 0xb0,
+	0x76, 0x1e, 0x57, 0x2e, 0xea, 0x3f, 0x04, 0x4b, 0xfc, 0xbe, 0xec, 0x76, 0x4d, 0xcb, 0x24, 0xea,
+	0xfe, 0x34, 0x97, 0x44, 0x58, 0x81, 0x79, 0x8b, 0xfa, 0xbb, 0xa3, 0x74, 0x5e, 0x39, 0x7d, 0x71,
+	0xee, 0x57, 0xa1, 0x00, 0xb1, 0x36, 0xd1, 0x49, 0x2a, 0x96, 0x8b, 0x06, 0x95, 0x57, 0xa1, 0x08,
+	0x41, 0x82, 0x18, 0x3e, 0xc2, 0xad, 0xd4, 0xe5, 0x1c, 0x2a, 0x24, 0xca, 0xa2, 0xff, 0x88, 0xaa,
+	0x1e, 0xe1, 0x96, 0x42, 0x71, 0x15, 0xc1, 0x2d, 0xf8, 0x29, 0x93, 0xfc, 0x5d, 0x48, 0x8f, 0x08,
+	0xe6, 0x5f, 0x87, 0x2c, 0xc4, 0x2d, 0xe7, 0xdd, 0xe9, 0x40, 0x06, 0xf7, 0x55, 0x4d, 0xcb, 0x3f,
+	0xa2, 0x53, 0xeb, 0x53, 0xc3, 0xde, 0xd3, 0xba, 0xea, 0x21, 0x3f, 0xb0, 0x71, 0x7e, 0xd3, 0xd4,
+	0xda, 0x19, 0x3e, 0x67, 0x73, 0xf1, 0xd2, 0xfd, 0x83, 0x60, 0xb6, 0x4e, 0xf4, 0x4f, 0x4c, 0x7b,
+	0x3c, 0xef, 0x41, 0x6f, 0xf6, 0x4c, 0x1b, 0x77, 0xc7, 0x66, 0x67, 0x30, 0xe1, 0x0e, 0xcc, 0xb4,
+	0xf6, 0x4c, 0xa3, 0x85, 0x69, 0xb5, 0x12, 0x41, 0xeb, 0xc0, 0x0e, 0xc5, 0x28, 0x0e, 0xd6, 0x53,
+	0xe5, 0xd8, 0x99, 0x2a, 0x4f, 0x5a, 0x3b, 0xd6, 0xcd, 0x94, 0x4d, 0x7e, 0x09, 0xae, 0x3a, 0x4a,
+	0xb9, 0x7a, 0x83, 0x8a, 0x1f, 0xe0, 0xc7, 0x8b, 0x7f, 0x13, 0x66, 0x88, 0xa1, 0x77, 0x42, 0xa8,
+	0x77, 0x70, 0x95, 0xf8, 0x20, 0xb9, 0xf3, 0xe0, 0x64, 0xa7, 0xd4, 0xdc, 0xec, 0xfb, 0x70, 0xa5,
+	0x4e, 0xf4, 0xfb, 0x58, 0xed, 0x39, 0x6b, 0xe7, 0x34, 0x37, 0x26, 0x78, 0x88, 0x56, 0x16, 0x06,
+	0xf9, 0x79, 0x23, 0xdc, 0x80, 0xeb, 0x9e, 0x6c, 0x2e, 0x8d, 0x62, 0x11, 0x62, 0xf4, 0x04, 0x92,
+	0xb0, 0x58, 0xfd, 0xac, 0xba, 0xd3, 0x78, 0xf8, 0xe1, 0x83, 0xdd, 0xea, 0x4e, 0xed, 0xfd, 0x5a,
+	0xf5, 0xde, 0x62, 0x44, 0x58, 0x80, 0x39, 0xfa, 0xf6, 0x63, 0xe5, 0xf3, 0x45, 0x54, 0xfe, 0x3d,
+	0x0e, 0xd1, 0x3a, 0xd1, 0x05, 0x15, 0xe2, 0xc3, 0xfb, 0xf2, 0x5a, 0xc0, 0x9e, 0xe7, 0x99, 0xa1,
+	0xe2, 0x7a, 0x18, 0x14, 0xbf, 0x45, 0x3d, 0x10, 0x7c, 0x96, 0xd6, 0xdb, 0x81, 0x31, 0x46, 0xc1,
+	0xe2, 0xe6, 0x04, 0x60, 0x9e, 0xd7, 0x82, 0xc5, 0x91, 0x7d, 0xf2, 0x8d, 0x30, 0x81, 0x28, 0x54,
+	0x2c, 0x85, 0x86, 0xf2, 0x8c, 0x7d, 0xb8, 0xe6, 0xb7, 0xfc, 0xad, 0x87, 0x63, 0xcf, 0xd0, 0xe2,
+	0x9d, 0x49, 0xd0, 0x3c, 0x35, 0x81, 0xa5, 0xd1, 0x3d, 0xae, 0x18, 0xa6, 0x4e, 0x0c, 0x2b, 0x96,
+	0xc3, 0x63, 0x79, 0xd2, 0xc7, 0x08, 0x96, 0x03, 0xd6, 0x20, 0x39, 0x8c, 0x8a, 0x21, 0x07, 0xf1,
+	0xed, 0x09, 0x1d, 0x38, 0x89, 0xef, 0x10, 0x64, 0xc7, 0x6d, 0x28, 0xef, 0x84, 0x0f, 0xee, 0xf5,
+	0x14, 0xdf, 0x9b, 0xd6, 0x93, 0xf3, 0x7b, 0x82, 0x20, 0x1d, 0xbc, 0x25, 0x94, 0xc3, 0xc7, 0xe7,
+	0x1d, 0x52, 0x99, 0xdc, 0x87, 0xb3, 0x79, 0x04, 0x89, 0x33, 0xd3, 0xfd, 0xf5, 0x31, 0x85, 0x77,
+	0x81, 0xa2, 0x1c, 0x12, 0x38, 0x7c, 0x01, 0x47, 0x46, 0x63, 0xf0, 0x05, 0x3c, 0x0b, 0x15, 0x4b,
+	0xa1, 0xa1, 0x3c, 0xe3, 0x7d, 0x88, 0xd1, 0x01, 0xb8, 0x1a, 0xe8, 0x3a, 0x30, 0x8b, 0xb7, 0xce,
+	0x35, 0x0f, 0x47, 0xa3, 0xdf, 0xd3, 0xe0, 0x68, 0x03, 0xb3, 0x78, 0xeb, 0x5c, 0x33, 0x8f, 0xf6,
+	0x25, 0xc0, 0xd0, 0x84, 0xb8, 0x19, 0xe8, 0x74, 0x0a, 0x12, 0x6f, 0x87, 0x00, 0xb9, 0xf1, 0xb7,
+	0xdf, 0x7d, 0x76, 0x9c, 0x41, 0xcf, 0x8f, 0x33, 0xe8, 0xc5, 0x71, 0x06, 0x7d, 0x7b, 0x92, 0x89,
+	0x3c, 0x3f, 0xc9, 0x44, 0xfe, 0x3c, 0xc9, 0x44, 0xbe, 0x58, 0xd3, 0x0d, 0x7b, 0xef, 0xa0, 0x29,
+	0xb5, 0xcc, 0xb6, 0xf3, 0x33, 0x8c, 0xf3, 0x67, 0x83, 0x68, 0x5f, 0xcb, 0x47, 0xec, 0xa7, 0x96,
+	0xe6, 0x0c, 0xdd, 0xb0, 0x36, 0xff, 0x1f, 0x00, 0xe5, 0xc5, 0x82, 0xb1, 0x02, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1392,6 +1488,8 @@ type MsgClient interface {
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
+	// LeaveGroup allows a group member to leave the group.
+	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
 }
 
 type msgClient struct {
@@ -1510,6 +1608,15 @@ func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error) {
+	out := new(MsgLeaveGroupResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1beta1.Msg/LeaveGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateGroup creates a new group with an admin account address, a list of members and some optional metadata.
@@ -1536,6 +1643,8 @@ type MsgServer interface {
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
+	// LeaveGroup allows a group member to leave the group.
+	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1578,6 +1687,9 @@ func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVote
 func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
+func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1799,6 +1911,24 @@ func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLeaveGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LeaveGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1beta1.Msg/LeaveGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LeaveGroup(ctx, req.(*MsgLeaveGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1851,6 +1981,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Exec",
 			Handler:    _Msg_Exec_Handler,
 		},
+		{
+			MethodName: "LeaveGroup",
+			Handler:    _Msg_LeaveGroup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1beta1/tx.proto",
@@ -2711,6 +2845,64 @@ func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgLeaveGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeaveGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeaveGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeaveGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -3102,6 +3294,31 @@ func (m *MsgExecResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgLeaveGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *MsgLeaveGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5522,6 +5739,157 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLeaveGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLeaveGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLeaveGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLeaveGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13,7 +13,6 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/group/errors"
 	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
-	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
 )
 
 type DecisionPolicyResult struct {
@@ -27,16 +26,21 @@ type DecisionPolicy interface {
 
 	ValidateBasic() error
 	GetTimeout() time.Duration
+	GetMinExecutionPeriod() time.Duration
 	Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error)
 	Validate(g GroupInfo) error
 }
 
 // Implements DecisionPolicy Interface
-var _ DecisionPolicy = &ThresholdDecisionPolicy{}
+var (
+	_ DecisionPolicy = &ThresholdDecisionPolicy{}
+	_ DecisionPolicy = &PercentageDecisionPolicy{}
+	_ DecisionPolicy = &QuorumDecisionPolicy{}
+)
 
 // NewThresholdDecisionPolicy creates a threshold DecisionPolicy
-func NewThresholdDecisionPolicy(threshold string, timeout time.Duration) DecisionPolicy {
-	return &ThresholdDecisionPolicy{threshold, timeout}
+func NewThresholdDecisionPolicy(threshold string, timeout time.Duration, minExecutionPeriod time.Duration) DecisionPolicy {
+	return &ThresholdDecisionPolicy{threshold, timeout, minExecutionPeriod}
 }
 
 func (p ThresholdDecisionPolicy) ValidateBasic() error {
@@ -44,12 +48,7 @@ func (p ThresholdDecisionPolicy) ValidateBasic() error {
 		return sdkerrors.Wrap(err, "threshold")
 	}
 
-	timeout := p.Timeout
-
-	if timeout <= time.Nanosecond {
-		return sdkerrors.Wrap(errors.ErrInvalid, "timeout")
-	}
-	return nil
+	return validateWindows(p.Timeout, p.MinExecutionPeriod)
 }
 
 // Allow allows a proposal to pass when the tally of yes votes equals or exceeds the threshold before the timeout.
@@ -113,7 +112,262 @@ func (p *ThresholdDecisionPolicy) Validate(g GroupInfo) error {
 	return nil
 }
 
-var _ orm.Validateable = GroupPolicyInfo{}
+// NewPercentageDecisionPolicy creates a percentage DecisionPolicy
+func NewPercentageDecisionPolicy(percentage string, timeout time.Duration, minExecutionPeriod time.Duration) DecisionPolicy {
+	return &PercentageDecisionPolicy{percentage, timeout, minExecutionPeriod}
+}
+
+func (p PercentageDecisionPolicy) ValidateBasic() error {
+	if _, err := parseFraction(p.Percentage); err != nil {
+		return sdkerrors.Wrap(err, "percentage")
+	}
+
+	return validateWindows(p.Timeout, p.MinExecutionPeriod)
+}
+
+// Allow allows a proposal to pass when the tally of yes votes equals or exceeds the percentage of the total
+// group weight before the timeout.
+func (p PercentageDecisionPolicy) Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error) {
+	if p.Timeout <= votingDuration {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	percentage, err := parseFraction(p.Percentage)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	yesCount, err := tally.GetYesCount()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	totalPowerDec, err := math.NewNonNegativeDecFromString(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	threshold, err := totalPowerDec.Mul(percentage)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if yesCount.Cmp(threshold) >= 0 {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	undecided, err := tally.undecided(totalPowerDec)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	sum, err := yesCount.Add(undecided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if sum.Cmp(threshold) < 0 {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// Validate returns an error if the group has no weight, in which case any
+// proposal would pass without votes.
+func (p *PercentageDecisionPolicy) Validate(g GroupInfo) error {
+	return validatePositiveWeight(g)
+}
+
+// NewQuorumDecisionPolicy creates a quorum DecisionPolicy
+func NewQuorumDecisionPolicy(quorum, threshold, vetoThreshold string, timeout time.Duration, minExecutionPeriod time.Duration) DecisionPolicy {
+	return &QuorumDecisionPolicy{quorum, threshold, vetoThreshold, timeout, minExecutionPeriod}
+}
+
+func (p QuorumDecisionPolicy) ValidateBasic() error {
+	if _, err := parseFraction(p.Quorum); err != nil {
+		return sdkerrors.Wrap(err, "quorum")
+	}
+	if _, err := parseFraction(p.Threshold); err != nil {
+		return sdkerrors.Wrap(err, "threshold")
+	}
+	if _, err := parseFraction(p.VetoThreshold); err != nil {
+		return sdkerrors.Wrap(err, "veto threshold")
+	}
+
+	return validateWindows(p.Timeout, p.MinExecutionPeriod)
+}
+
+// Allow allows a proposal to pass when, like in x/gov, the votes (including abstain and veto votes) reach the
+// quorum, the veto votes don't exceed the veto threshold and the yes votes exceed the threshold of the yes, no and
+// veto votes. Before the timeout, the result is final only if the remaining votes can't change it. After the
+// timeout, the result is computed from the final tally.
+func (p QuorumDecisionPolicy) Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error) {
+	totalPowerDec, err := math.NewNonNegativeDecFromString(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	yesCount, err := tally.GetYesCount()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	noCount, err := tally.GetNoCount()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	abstainCount, err := tally.GetAbstainCount()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	vetoCount, err := tally.GetVetoCount()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	passes, err := p.passes(yesCount, noCount, abstainCount, vetoCount, totalPowerDec)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if p.Timeout <= votingDuration {
+		return DecisionPolicyResult{Allow: passes, Final: true}, nil
+	}
+
+	undecided, err := tally.undecided(totalPowerDec)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	// The proposal can only be accepted early if it would still pass with all
+	// the undecided weight voting veto, which is the worst case.
+	if passes {
+		vetoCount, err = vetoCount.Add(undecided)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		passes, err = p.passes(yesCount, noCount, abstainCount, vetoCount, totalPowerDec)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		return DecisionPolicyResult{Allow: passes, Final: passes}, nil
+	}
+
+	// The proposal can be rejected early if it wouldn't pass even with all the
+	// undecided weight voting yes, which is the best case.
+	yesCount, err = yesCount.Add(undecided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	passes, err = p.passes(yesCount, noCount, abstainCount, vetoCount, totalPowerDec)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	return DecisionPolicyResult{Allow: false, Final: !passes}, nil
+}
+
+// passes returns whether a proposal with the given vote counts passes.
+func (p QuorumDecisionPolicy) passes(yesCount, noCount, abstainCount, vetoCount, totalPower math.Dec) (bool, error) {
+	quorum, err := parseFraction(p.Quorum)
+	if err != nil {
+		return false, err
+	}
+	threshold, err := parseFraction(p.Threshold)
+	if err != nil {
+		return false, err
+	}
+	vetoThreshold, err := parseFraction(p.VetoThreshold)
+	if err != nil {
+		return false, err
+	}
+
+	nonAbstainCount, err := yesCount.Add(noCount)
+	if err != nil {
+		return false, err
+	}
+	nonAbstainCount, err = nonAbstainCount.Add(vetoCount)
+	if err != nil {
+		return false, err
+	}
+	totalCounts, err := nonAbstainCount.Add(abstainCount)
+	if err != nil {
+		return false, err
+	}
+
+	minCounts, err := totalPower.Mul(quorum)
+	if err != nil {
+		return false, err
+	}
+	if totalCounts.Cmp(minCounts) < 0 {
+		return false, nil
+	}
+
+	maxVetoCount, err := totalCounts.Mul(vetoThreshold)
+	if err != nil {
+		return false, err
+	}
+	if vetoCount.Cmp(maxVetoCount) > 0 {
+		return false, nil
+	}
+
+	if nonAbstainCount.IsZero() {
+		return false, nil
+	}
+	minYesCount, err := nonAbstainCount.Mul(threshold)
+	if err != nil {
+		return false, err
+	}
+	return yesCount.Cmp(minYesCount) > 0, nil
+}
+
+// Validate returns an error if the group has no weight, in which case the
+// quorum would always be reached.
+func (p *QuorumDecisionPolicy) Validate(g GroupInfo) error {
+	return validatePositiveWeight(g)
+}
+
+// parseFraction parses a decimal in the (0, 1] range.
+func parseFraction(s string) (math.Dec, error) {
+	d, err := math.NewPositiveDecFromString(s)
+	if err != nil {
+		return math.Dec{}, err
+	}
+	if d.Cmp(math.NewDecFromInt64(1)) > 0 {
+		return math.Dec{}, errors.ErrInvalidDecString.Wrapf("expected a decimal between 0 and 1, got %s", s)
+	}
+	return d, nil
+}
+
+// validateWindows validates the timeout and the minimum execution period of a
+// decision policy.
+func validateWindows(timeout, minExecutionPeriod time.Duration) error {
+	if timeout <= time.Nanosecond {
+		return sdkerrors.Wrap(errors.ErrInvalid, "timeout")
+	}
+	if minExecutionPeriod < 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "min execution period")
+	}
+	return nil
+}
+
+// ValidateWindows returns an error if the windows of the decision policy are
+// invalid or if its min execution period ends after the max execution period
+// of the module, in which case a passed proposal would be pruned before it
+// could be executed.
+func ValidateWindows(p DecisionPolicy, maxExecutionPeriod time.Duration) error {
+	if p == nil {
+		return sdkerrors.Wrap(errors.ErrEmpty, "nil policy")
+	}
+	if err := validateWindows(p.GetTimeout(), p.GetMinExecutionPeriod()); err != nil {
+		return err
+	}
+	if p.GetMinExecutionPeriod() > p.GetTimeout()+maxExecutionPeriod {
+		return sdkerrors.Wrapf(errors.ErrInvalid, "min execution period must not exceed timeout + max execution period (%s)", maxExecutionPeriod)
+	}
+	return nil
+}
+
+func validatePositiveWeight(g GroupInfo) error {
+	totalWeight, err := math.NewNonNegativeDecFromString(g.TotalWeight)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group total weight")
+	}
+	if totalWeight.IsZero() {
+		return sdkerrors.Wrap(errors.ErrInvalid, "group total weight must be positive")
+	}
+	return nil
+}
 
 // NewGroupPolicyInfo creates a new GroupPolicyInfo instance
 func NewGroupPolicyInfo(address sdk.AccAddress, group uint64, admin sdk.AccAddress, metadata []byte,
@@ -162,10 +416,6 @@ func (g GroupPolicyInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error
 	return unpacker.UnpackAny(g.DecisionPolicy, &decisionPolicy)
 }
 
-func (g GroupInfo) PrimaryKeyFields() []interface{} {
-	return []interface{}{g.GroupId}
-}
-
 func (g GroupInfo) ValidateBasic() error {
 	if g.GroupId == 0 {
 		return sdkerrors.Wrap(errors.ErrEmpty, "group's GroupId")
@@ -185,18 +435,6 @@ func (g GroupInfo) ValidateBasic() error {
 	return nil
 }
 
-func (g GroupPolicyInfo) PrimaryKeyFields() []interface{} {
-	addr, err := sdk.AccAddressFromBech32(g.Address)
-	if err != nil {
-		panic(err)
-	}
-	return []interface{}{addr.Bytes()}
-}
-
-func (g Proposal) PrimaryKeyFields() []interface{} {
-	return []interface{}{g.ProposalId}
-}
-
 func (g GroupPolicyInfo) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(g.Admin)
 	if err != nil {
@@ -224,14 +462,6 @@ func (g GroupPolicyInfo) ValidateBasic() error {
 	return nil
 }
 
-func (g GroupMember) PrimaryKeyFields() []interface{} {
-	addr, err := sdk.AccAddressFromBech32(g.Member.Address)
-	if err != nil {
-		panic(err)
-	}
-	return []interface{}{g.GroupId, addr.Bytes()}
-}
-
 func (g GroupMember) ValidateBasic() error {
 	if g.GroupId == 0 {
 		return sdkerrors.Wrap(errors.ErrEmpty, "group member's group id")
@@ -278,16 +508,6 @@ func (p Proposal) ValidateBasic() error {
 	return nil
 }
 
-func (v Vote) PrimaryKeyFields() []interface{} {
-	addr, err := sdk.AccAddressFromBech32(v.Voter)
-	if err != nil {
-		panic(err)
-	}
-	return []interface{}{v.ProposalId, addr.Bytes()}
-}
-
-var _ orm.Validateable = Vote{}
-
 func (v Vote) ValidateBasic() error {
 
 	_, err := sdk.AccAddressFromBech32(v.Voter)
@@ -422,6 +642,15 @@ func (t *Tally) Add(vote Vote, weight string) error {
 	return nil
 }
 
+// undecided returns the weight of the group members who haven't voted yet.
+func (t Tally) undecided(totalPower math.Dec) (math.Dec, error) {
+	totalCounts, err := t.TotalCounts()
+	if err != nil {
+		return math.Dec{}, err
+	}
+	return math.SubNonNegative(totalPower, totalCounts)
+}
+
 // TotalCounts is the sum of all weights.
 func (t Tally) TotalCounts() (math.Dec, error) {
 	yesCount, err := t.GetYesCount()
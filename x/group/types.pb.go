@@ -109,7 +109,7 @@ func (x Proposal_Status) String() string {
 }
 
 func (Proposal_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{6, 0}
+	return fileDescriptor_e091dfce5c49c8b6, []int{8, 0}
 }
 
 // Result defines types of proposal results.
@@ -145,7 +145,7 @@ func (x Proposal_Result) String() string {
 }
 
 func (Proposal_Result) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{6, 1}
+	return fileDescriptor_e091dfce5c49c8b6, []int{8, 1}
 }
 
 // ExecutorResult defines types of proposal executor results.
@@ -181,7 +181,7 @@ func (x Proposal_ExecutorResult) String() string {
 }
 
 func (Proposal_ExecutorResult) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{6, 2}
+	return fileDescriptor_e091dfce5c49c8b6, []int{8, 2}
 }
 
 // Member represents a group member with an account address,
@@ -311,6 +311,13 @@ type ThresholdDecisionPolicy struct {
 	// timeout is the duration from submission of a proposal to the end of voting period
 	// Within this times votes and exec messages can be submitted.
 	Timeout time.Duration `protobuf:"bytes,2,opt,name=timeout,proto3,stdduration" json:"timeout"`
+	// min_execution_period is the minimum duration after the proposal submission
+	// where members can start sending MsgExec. This means that the window for
+	// sending a MsgExec transaction is:
+	// `[ submission + min_execution_period ; submission + timeout ]`
+	// or, once the proposal was accepted, any time after
+	// `submission + min_execution_period`.
+	MinExecutionPeriod time.Duration `protobuf:"bytes,3,opt,name=min_execution_period,json=minExecutionPeriod,proto3,stdduration" json:"min_execution_period"`
 }
 
 func (m *ThresholdDecisionPolicy) Reset()         { *m = ThresholdDecisionPolicy{} }
@@ -360,6 +367,170 @@ func (m *ThresholdDecisionPolicy) GetTimeout() time.Duration {
 	return 0
 }
 
+func (m *ThresholdDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	if m != nil {
+		return m.MinExecutionPeriod
+	}
+	return 0
+}
+
+// PercentageDecisionPolicy implements the DecisionPolicy interface
+type PercentageDecisionPolicy struct {
+	// percentage is the minimum fraction of the total group weight, between 0 and 1,
+	// that the weighted sum of yes votes must meet or exceed for a proposal to succeed.
+	Percentage string `protobuf:"bytes,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// timeout is the duration from submission of a proposal to the end of voting period
+	// Within this times votes and exec messages can be submitted.
+	Timeout time.Duration `protobuf:"bytes,2,opt,name=timeout,proto3,stdduration" json:"timeout"`
+	// min_execution_period is the minimum duration after the proposal submission
+	// where members can start sending MsgExec.
+	MinExecutionPeriod time.Duration `protobuf:"bytes,3,opt,name=min_execution_period,json=minExecutionPeriod,proto3,stdduration" json:"min_execution_period"`
+}
+
+func (m *PercentageDecisionPolicy) Reset()         { *m = PercentageDecisionPolicy{} }
+func (m *PercentageDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*PercentageDecisionPolicy) ProtoMessage()    {}
+func (*PercentageDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e091dfce5c49c8b6, []int{3}
+}
+func (m *PercentageDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PercentageDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PercentageDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PercentageDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PercentageDecisionPolicy.Merge(m, src)
+}
+func (m *PercentageDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *PercentageDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PercentageDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PercentageDecisionPolicy proto.InternalMessageInfo
+
+func (m *PercentageDecisionPolicy) GetPercentage() string {
+	if m != nil {
+		return m.Percentage
+	}
+	return ""
+}
+
+func (m *PercentageDecisionPolicy) GetTimeout() time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *PercentageDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	if m != nil {
+		return m.MinExecutionPeriod
+	}
+	return 0
+}
+
+// QuorumDecisionPolicy implements the DecisionPolicy interface. Like the x/gov
+// tally, it requires a quorum of the total group weight to vote, counting
+// abstain and veto votes, a threshold of yes votes over the non-abstain votes
+// and no more veto votes than the veto threshold.
+type QuorumDecisionPolicy struct {
+	// quorum is the minimum fraction of the total group weight, between 0 and 1,
+	// that must vote for a proposal to succeed.
+	Quorum string `protobuf:"bytes,1,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// threshold is the fraction of yes votes over the yes, no and veto votes,
+	// between 0 and 1, that must be exceeded for a proposal to succeed.
+	Threshold string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// veto_threshold is the fraction of veto votes over all votes, between 0
+	// and 1, that must not be exceeded for a proposal to succeed.
+	VetoThreshold string `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// timeout is the duration from submission of a proposal to the end of voting period
+	// Within this times votes and exec messages can be submitted.
+	Timeout time.Duration `protobuf:"bytes,4,opt,name=timeout,proto3,stdduration" json:"timeout"`
+	// min_execution_period is the minimum duration after the proposal submission
+	// where members can start sending MsgExec.
+	MinExecutionPeriod time.Duration `protobuf:"bytes,5,opt,name=min_execution_period,json=minExecutionPeriod,proto3,stdduration" json:"min_execution_period"`
+}
+
+func (m *QuorumDecisionPolicy) Reset()         { *m = QuorumDecisionPolicy{} }
+func (m *QuorumDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*QuorumDecisionPolicy) ProtoMessage()    {}
+func (*QuorumDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e091dfce5c49c8b6, []int{4}
+}
+func (m *QuorumDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuorumDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuorumDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuorumDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuorumDecisionPolicy.Merge(m, src)
+}
+func (m *QuorumDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuorumDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuorumDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuorumDecisionPolicy proto.InternalMessageInfo
+
+func (m *QuorumDecisionPolicy) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *QuorumDecisionPolicy) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *QuorumDecisionPolicy) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
+	}
+	return ""
+}
+
+func (m *QuorumDecisionPolicy) GetTimeout() time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *QuorumDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	if m != nil {
+		return m.MinExecutionPeriod
+	}
+	return 0
+}
+
 // GroupInfo represents the high-level on-chain information for a group.
 type GroupInfo struct {
 	// group_id is the unique ID of the group.
@@ -383,7 +554,7 @@ func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
 func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupInfo) ProtoMessage()    {}
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{3}
+	return fileDescriptor_e091dfce5c49c8b6, []int{5}
 }
 func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -466,7 +637,7 @@ func (m *GroupMember) Reset()         { *m = GroupMember{} }
 func (m *GroupMember) String() string { return proto.CompactTextString(m) }
 func (*GroupMember) ProtoMessage()    {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{4}
+	return fileDescriptor_e091dfce5c49c8b6, []int{6}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -532,7 +703,7 @@ func (m *GroupPolicyInfo) Reset()         { *m = GroupPolicyInfo{} }
 func (m *GroupPolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupPolicyInfo) ProtoMessage()    {}
 func (*GroupPolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{5}
+	return fileDescriptor_e091dfce5c49c8b6, []int{7}
 }
 func (m *GroupPolicyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,11 +758,13 @@ type Proposal struct {
 	// result is the final result based on the votes and election rule. Initial value is unfinalized.
 	// The result is persisted so that clients can always rely on this state and not have to replicate the logic.
 	Result Proposal_Result `protobuf:"varint,9,opt,name=result,proto3,enum=cosmos.group.v1beta1.Proposal_Result" json:"result,omitempty"`
-	// vote_state contains the sums of all weighted votes for this proposal.
+	// vote_state contains the sums of all weighted votes for this proposal. The votes are pruned at the end of the
+	// voting period, vote_state then holds the final tally result of the proposal.
 	VoteState Tally `protobuf:"bytes,10,opt,name=vote_state,json=voteState,proto3" json:"vote_state"`
-	// timeout is the timestamp of the block where the proposal execution times out. Header times of the votes and
-	// execution messages must be before this end time to be included in the election. After the timeout timestamp the
-	// proposal can not be executed anymore and should be considered pending delete.
+	// timeout is the timestamp of the block where the proposal voting period ends. Header times of the votes must be
+	// before this end time to be included in the election. At the end of the voting period, the proposal is tallied
+	// and its votes are pruned. The proposal can still be executed during the max execution period of the module
+	// after the timeout timestamp, after which it is pruned.
 	Timeout time.Time `protobuf:"bytes,11,opt,name=timeout,proto3,stdtime" json:"timeout"`
 	// executor_result is the final result based on the votes and election rule. Initial value is NotRun.
 	ExecutorResult Proposal_ExecutorResult `protobuf:"varint,12,opt,name=executor_result,json=executorResult,proto3,enum=cosmos.group.v1beta1.Proposal_ExecutorResult" json:"executor_result,omitempty"`
@@ -603,7 +776,7 @@ func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{6}
+	return fileDescriptor_e091dfce5c49c8b6, []int{8}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -648,7 +821,7 @@ func (m *Tally) Reset()         { *m = Tally{} }
 func (m *Tally) String() string { return proto.CompactTextString(m) }
 func (*Tally) ProtoMessage()    {}
 func (*Tally) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{7}
+	return fileDescriptor_e091dfce5c49c8b6, []int{9}
 }
 func (m *Tally) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,7 +868,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{8}
+	return fileDescriptor_e091dfce5c49c8b6, []int{10}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,6 +940,8 @@ func init() {
 	proto.RegisterType((*Member)(nil), "cosmos.group.v1beta1.Member")
 	proto.RegisterType((*Members)(nil), "cosmos.group.v1beta1.Members")
 	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "cosmos.group.v1beta1.ThresholdDecisionPolicy")
+	proto.RegisterType((*PercentageDecisionPolicy)(nil), "cosmos.group.v1beta1.PercentageDecisionPolicy")
+	proto.RegisterType((*QuorumDecisionPolicy)(nil), "cosmos.group.v1beta1.QuorumDecisionPolicy")
 	proto.RegisterType((*GroupInfo)(nil), "cosmos.group.v1beta1.GroupInfo")
 	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1beta1.GroupMember")
 	proto.RegisterType((*GroupPolicyInfo)(nil), "cosmos.group.v1beta1.GroupPolicyInfo")
@@ -778,92 +953,98 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1beta1/types.proto", fileDescriptor_e091dfce5c49c8b6) }
 
 var fileDescriptor_e091dfce5c49c8b6 = []byte{
-	// 1345 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0x13, 0xc7,
-	0x1b, 0xce, 0x3a, 0x8e, 0xff, 0xbc, 0x4e, 0x1c, 0x6b, 0x94, 0x1f, 0x38, 0x0e, 0x38, 0x8b, 0xf9,
-	0x55, 0x8a, 0x5a, 0xc5, 0x6e, 0xd2, 0x3f, 0x07, 0x54, 0x68, 0xd7, 0x9b, 0x0d, 0xb8, 0x0a, 0x76,
-	0xba, 0xbb, 0x4e, 0x5a, 0x0e, 0xb5, 0xd6, 0xbb, 0x83, 0xb3, 0xad, 0xbd, 0x63, 0xed, 0x8e, 0x03,
-	0xee, 0xb5, 0x17, 0xea, 0x13, 0xc7, 0xf6, 0x60, 0x09, 0xa9, 0x9f, 0xa0, 0x12, 0x1f, 0x02, 0xf5,
-	0x84, 0x7a, 0xaa, 0x38, 0xb4, 0x15, 0x5c, 0x38, 0x57, 0xfd, 0x00, 0xd5, 0xce, 0xcc, 0x12, 0x0c,
-	0xc6, 0x10, 0xd4, 0x53, 0x76, 0x66, 0x9e, 0xe7, 0x9d, 0xf7, 0x7d, 0x9e, 0x37, 0x33, 0x63, 0x90,
-	0x6d, 0x12, 0xf4, 0x48, 0x50, 0xe9, 0xf8, 0x64, 0xd0, 0xaf, 0x1c, 0x6f, 0xb5, 0x31, 0xb5, 0xb6,
-	0x2a, 0x74, 0xd8, 0xc7, 0x41, 0xb9, 0xef, 0x13, 0x4a, 0xd0, 0x0a, 0x47, 0x94, 0x19, 0xa2, 0x2c,
-	0x10, 0x85, 0x95, 0x0e, 0xe9, 0x10, 0x06, 0xa8, 0x84, 0x5f, 0x1c, 0x5b, 0x28, 0x76, 0x08, 0xe9,
-	0x74, 0x71, 0x85, 0x8d, 0xda, 0x83, 0x9b, 0x15, 0x67, 0xe0, 0x5b, 0xd4, 0x25, 0x9e, 0x58, 0x5f,
-	0x7f, 0x71, 0x9d, 0xba, 0x3d, 0x1c, 0x50, 0xab, 0xd7, 0x17, 0x80, 0x55, 0xbe, 0x59, 0x8b, 0x47,
-	0x16, 0x3b, 0x8b, 0xa5, 0x17, 0xb9, 0x96, 0x37, 0xe4, 0x4b, 0xa5, 0x5f, 0x24, 0x48, 0x5c, 0xc7,
-	0xbd, 0x36, 0xf6, 0xd1, 0x36, 0x24, 0x2d, 0xc7, 0xf1, 0x71, 0x10, 0xe4, 0x25, 0x59, 0xda, 0x48,
-	0x57, 0xf3, 0xbf, 0xdd, 0xdf, 0x8c, 0x4a, 0x50, 0xf8, 0x8a, 0x41, 0x7d, 0xd7, 0xeb, 0xe8, 0x11,
-	0x10, 0x9d, 0x81, 0xc4, 0x2d, 0xec, 0x76, 0x8e, 0x68, 0x3e, 0x16, 0x52, 0x74, 0x31, 0x42, 0x05,
-	0x48, 0xf5, 0x30, 0xb5, 0x1c, 0x8b, 0x5a, 0xf9, 0x79, 0x59, 0xda, 0x58, 0xd4, 0x9f, 0x8d, 0xd1,
-	0xa7, 0x90, 0xb2, 0x1c, 0x07, 0x3b, 0x2d, 0x8b, 0xe6, 0xe3, 0xb2, 0xb4, 0x91, 0xd9, 0x2e, 0x94,
-	0x79, 0x82, 0xe5, 0x28, 0xc1, 0xb2, 0x19, 0x15, 0x57, 0x4d, 0x3d, 0xf8, 0x63, 0x7d, 0xee, 0xee,
-	0x9f, 0xeb, 0x12, 0xdb, 0x14, 0x3b, 0x0a, 0x2d, 0x5d, 0x85, 0x24, 0x4f, 0x39, 0x40, 0x9f, 0x40,
-	0xb2, 0xc7, 0x3f, 0xf3, 0x92, 0x3c, 0xbf, 0x91, 0xd9, 0x3e, 0x57, 0x9e, 0xa6, 0x79, 0x99, 0xe3,
-	0xab, 0xf1, 0x30, 0x98, 0x1e, 0x51, 0x4a, 0x23, 0x09, 0xce, 0x9a, 0x47, 0x3e, 0x0e, 0x8e, 0x48,
-	0xd7, 0xd9, 0xc1, 0xb6, 0x1b, 0xb8, 0xc4, 0xdb, 0x27, 0x5d, 0xd7, 0x1e, 0xa2, 0x73, 0x90, 0xa6,
-	0xd1, 0x12, 0xd7, 0x43, 0x3f, 0x99, 0x40, 0x97, 0x21, 0x19, 0xea, 0x4f, 0x06, 0xbc, 0xf0, 0xcc,
-	0xf6, 0xea, 0x4b, 0x25, 0xec, 0x08, 0xff, 0x78, 0x05, 0x3f, 0xb2, 0x0a, 0x04, 0xe7, 0x12, 0xfa,
-	0xf5, 0xfe, 0x66, 0x76, 0x72, 0xc3, 0xd2, 0x3f, 0x12, 0xa4, 0xaf, 0x86, 0x49, 0xd7, 0xbc, 0x9b,
-	0x04, 0xad, 0x42, 0x8a, 0x55, 0xd0, 0x72, 0xf9, 0xee, 0x71, 0x3d, 0xc9, 0xc6, 0x35, 0x07, 0x95,
-	0x61, 0xc1, 0x72, 0x7a, 0xae, 0xc7, 0x25, 0x9f, 0xe1, 0x12, 0x87, 0xcd, 0xf4, 0x22, 0x0f, 0xc9,
-	0x63, 0xec, 0x87, 0x59, 0x30, 0x2b, 0xe2, 0x7a, 0x34, 0x44, 0x17, 0x60, 0x91, 0x12, 0x6a, 0x75,
-	0x5b, 0xc2, 0xdf, 0x05, 0x26, 0x41, 0x86, 0xcd, 0x1d, 0x72, 0x93, 0x55, 0x00, 0xdb, 0xc7, 0x16,
-	0xe5, 0x56, 0x26, 0x4e, 0x61, 0x65, 0x5a, 0xf0, 0x14, 0x5a, 0xfa, 0x1a, 0x32, 0xac, 0x6a, 0xd1,
-	0x84, 0x33, 0xea, 0xfe, 0x10, 0x12, 0xdc, 0x38, 0x21, 0xf9, 0x4c, 0xab, 0x75, 0x81, 0x2d, 0x3d,
-	0x8d, 0xc1, 0x32, 0xdb, 0x80, 0xcb, 0xcc, 0xc4, 0x7d, 0x9b, 0x4e, 0x7f, 0x3e, 0xb1, 0xd8, 0x2b,
-	0x0c, 0x99, 0x3f, 0xbd, 0x21, 0xf1, 0x57, 0x1b, 0xb2, 0x30, 0x69, 0xc8, 0x17, 0xb0, 0xec, 0x88,
-	0x8e, 0x69, 0xf5, 0x59, 0x2d, 0x42, 0xf2, 0x95, 0x97, 0x24, 0x57, 0xbc, 0x61, 0x75, 0x4a, 0x8b,
-	0xe9, 0x59, 0x67, 0xb2, 0xc7, 0x27, 0x0d, 0x4c, 0xbe, 0x95, 0x81, 0x97, 0x52, 0x77, 0xee, 0xad,
-	0xcf, 0x3d, 0xbd, 0xb7, 0x2e, 0x95, 0x1e, 0x65, 0x20, 0xb5, 0xef, 0x93, 0x3e, 0x09, 0xac, 0x2e,
-	0x5a, 0x87, 0x4c, 0x5f, 0x7c, 0x9f, 0x78, 0x09, 0xd1, 0x54, 0xcd, 0x79, 0xde, 0x84, 0xd8, 0x9b,
-	0x9a, 0x30, 0xab, 0x95, 0x3f, 0x86, 0x34, 0x8f, 0x1e, 0x1e, 0x06, 0x71, 0x79, 0x7e, 0x66, 0xc4,
-	0x13, 0x28, 0xba, 0x0a, 0x8b, 0xc1, 0xa0, 0xdd, 0x73, 0xa9, 0x90, 0x61, 0xe1, 0x14, 0x32, 0x64,
-	0x9e, 0x31, 0x15, 0x8a, 0x2e, 0xc2, 0x12, 0xef, 0x90, 0xc8, 0xc0, 0x04, 0xab, 0x79, 0x91, 0x4d,
-	0x1e, 0x08, 0x17, 0xdf, 0x87, 0x15, 0x0e, 0xe2, 0x16, 0x3e, 0xc3, 0x26, 0x19, 0x16, 0x75, 0x4e,
-	0x3a, 0x35, 0x62, 0x5c, 0x86, 0x44, 0x40, 0x2d, 0x3a, 0x08, 0xf2, 0x29, 0x59, 0xda, 0xc8, 0x6e,
-	0xbf, 0x33, 0xbd, 0xed, 0x23, 0xe1, 0xcb, 0x06, 0x03, 0xeb, 0x82, 0x14, 0xd2, 0x7d, 0x1c, 0x0c,
-	0xba, 0x34, 0x9f, 0x7e, 0x23, 0xba, 0xce, 0xc0, 0xba, 0x20, 0xa1, 0xcf, 0x00, 0x8e, 0x09, 0xc5,
-	0xad, 0x30, 0x1a, 0xce, 0x03, 0xd3, 0x66, 0x6d, 0x7a, 0x08, 0xd3, 0xea, 0x76, 0x87, 0xe2, 0x88,
-	0x4d, 0x87, 0xa4, 0x30, 0x13, 0x8c, 0xae, 0x9c, 0x1c, 0x95, 0x99, 0xd3, 0x9c, 0xf6, 0x82, 0x84,
-	0x0e, 0x60, 0x19, 0xdf, 0xc6, 0xf6, 0x80, 0x12, 0xbf, 0x25, 0x2a, 0x59, 0x64, 0x95, 0x6c, 0xbe,
-	0xa6, 0x12, 0x4d, 0xb0, 0x44, 0x45, 0x59, 0x3c, 0x31, 0x46, 0x1b, 0x10, 0xef, 0x05, 0x9d, 0x20,
-	0xbf, 0xc4, 0xee, 0x8d, 0xa9, 0xff, 0x44, 0x3a, 0x43, 0x94, 0xbe, 0x8f, 0x41, 0x82, 0xab, 0x8a,
-	0xb6, 0x00, 0x19, 0xa6, 0x62, 0x36, 0x8d, 0x56, 0xb3, 0x6e, 0xec, 0x6b, 0x6a, 0x6d, 0xb7, 0xa6,
-	0xed, 0xe4, 0xe6, 0x0a, 0xab, 0xa3, 0xb1, 0xfc, 0xbf, 0x68, 0x67, 0x8e, 0xad, 0x79, 0xc7, 0x56,
-	0xd7, 0x75, 0xd0, 0x16, 0xe4, 0x04, 0xc5, 0x68, 0x56, 0xaf, 0xd7, 0x4c, 0x53, 0xdb, 0xc9, 0x49,
-	0x85, 0xb5, 0xd1, 0x58, 0x3e, 0x3b, 0x49, 0x30, 0xa2, 0x5e, 0x42, 0xef, 0xc1, 0x92, 0xa0, 0xa8,
-	0x7b, 0x0d, 0x43, 0xdb, 0xc9, 0xc5, 0x0a, 0xf9, 0xd1, 0x58, 0x5e, 0x99, 0xc4, 0xab, 0x5d, 0x12,
-	0x60, 0x07, 0x6d, 0x42, 0x56, 0x80, 0x95, 0x6a, 0x43, 0x0f, 0xa3, 0xcf, 0x4f, 0x4b, 0x47, 0x69,
-	0x13, 0x3f, 0x8c, 0x7d, 0x92, 0xce, 0x61, 0xcd, 0xbc, 0xb6, 0xa3, 0x2b, 0x87, 0xf5, 0x5c, 0x7c,
-	0x5a, 0x3a, 0x87, 0x2e, 0x3d, 0x72, 0x7c, 0xeb, 0x96, 0x57, 0x88, 0xdf, 0xf9, 0xb9, 0x38, 0x57,
-	0x7a, 0x24, 0x41, 0x42, 0x48, 0xb7, 0x05, 0x48, 0xd7, 0x8c, 0xe6, 0x9e, 0x39, 0x4b, 0x05, 0x8e,
-	0x8d, 0x54, 0xf8, 0xe8, 0x39, 0xca, 0x6e, 0xad, 0xae, 0xec, 0xd5, 0x6e, 0x30, 0x1d, 0xce, 0x8f,
-	0xc6, 0xf2, 0xea, 0x24, 0xa5, 0xe9, 0xdd, 0x74, 0x3d, 0xab, 0xeb, 0x7e, 0x87, 0x1d, 0x54, 0x81,
-	0x65, 0x41, 0x53, 0x54, 0x55, 0xdb, 0x37, 0x99, 0x16, 0x85, 0xd1, 0x58, 0x3e, 0x33, 0xc9, 0x51,
-	0x6c, 0x1b, 0xf7, 0xe9, 0x04, 0x41, 0xd7, 0x3e, 0xd7, 0x54, 0x2e, 0xc7, 0x14, 0x82, 0x8e, 0xbf,
-	0xc1, 0x36, 0xc5, 0x8e, 0x28, 0xee, 0xa7, 0x18, 0x64, 0x27, 0xfb, 0x05, 0x55, 0x61, 0x4d, 0xfb,
-	0x52, 0x53, 0x9b, 0x66, 0x43, 0x6f, 0x4d, 0xad, 0xf6, 0xc2, 0x68, 0x2c, 0x9f, 0x8f, 0xa2, 0x4e,
-	0x92, 0xa3, 0xaa, 0x2f, 0xc3, 0xd9, 0x17, 0x63, 0xd4, 0x1b, 0x66, 0x4b, 0x6f, 0xd6, 0x73, 0x52,
-	0x41, 0x1e, 0x8d, 0xe5, 0x73, 0xd3, 0xf9, 0x75, 0x42, 0xf5, 0x81, 0x87, 0xae, 0xbc, 0x4c, 0x37,
-	0x9a, 0xaa, 0xaa, 0x19, 0x46, 0x2e, 0x36, 0x6b, 0x7b, 0x63, 0x60, 0xdb, 0xe1, 0x71, 0x39, 0x85,
-	0xbf, 0xab, 0xd4, 0xf6, 0x9a, 0xba, 0x96, 0x9b, 0x9f, 0xc5, 0xdf, 0xb5, 0xdc, 0xee, 0xc0, 0xc7,
-	0x5c, 0x9b, 0x4b, 0xf1, 0xf0, 0x80, 0x2f, 0xfd, 0x20, 0xc1, 0x02, 0xfb, 0x0f, 0x47, 0x6b, 0x90,
-	0x1e, 0xe2, 0xa0, 0x65, 0x93, 0x81, 0x47, 0xc5, 0xcb, 0x28, 0x35, 0xc4, 0x81, 0x1a, 0x8e, 0xc3,
-	0x6b, 0xd2, 0x23, 0x62, 0x8d, 0x3f, 0x09, 0x93, 0x1e, 0xe1, 0x4b, 0x17, 0x61, 0xc9, 0x6a, 0x07,
-	0xd4, 0x72, 0x3d, 0xb1, 0xce, 0xae, 0x4b, 0x7d, 0x51, 0x4c, 0x72, 0xd0, 0x79, 0x80, 0x63, 0x4c,
-	0xa3, 0x08, 0x71, 0xfe, 0xee, 0x0a, 0x67, 0xd8, 0xb2, 0xc8, 0xe5, 0x6f, 0x09, 0xe2, 0x07, 0x84,
-	0xe2, 0xd7, 0x5f, 0x32, 0x65, 0x58, 0x08, 0x4f, 0x22, 0xff, 0xf5, 0x6f, 0x25, 0x06, 0x0b, 0xdf,
-	0x18, 0xf6, 0x11, 0x71, 0x6d, 0xcc, 0x92, 0xcb, 0xbe, 0xea, 0x8d, 0xa1, 0x32, 0x8c, 0x2e, 0xb0,
-	0x33, 0x2f, 0xf4, 0xff, 0xea, 0x7a, 0x79, 0xd7, 0x81, 0x04, 0xdf, 0x16, 0x9d, 0x01, 0xa4, 0x5e,
-	0x6b, 0xd4, 0x54, 0x6d, 0xb2, 0x21, 0xd1, 0x12, 0xa4, 0xc5, 0x7c, 0xbd, 0x91, 0x93, 0x50, 0x16,
-	0x40, 0x0c, 0xbf, 0xd2, 0x8c, 0x5c, 0x0c, 0x21, 0xc8, 0x8a, 0xb1, 0x52, 0x35, 0x4c, 0xa5, 0x56,
-	0xcf, 0xcd, 0xa3, 0x65, 0xc8, 0x88, 0xb9, 0x03, 0xcd, 0x6c, 0xe4, 0xe2, 0xd5, 0x2b, 0x0f, 0x1e,
-	0x17, 0xa5, 0x87, 0x8f, 0x8b, 0xd2, 0x5f, 0x8f, 0x8b, 0xd2, 0xdd, 0x27, 0xc5, 0xb9, 0x87, 0x4f,
-	0x8a, 0x73, 0xbf, 0x3f, 0x29, 0xce, 0xdd, 0xf8, 0x7f, 0xc7, 0xa5, 0x47, 0x83, 0x76, 0xd9, 0x26,
-	0x3d, 0xf1, 0xeb, 0x42, 0xfc, 0xd9, 0x0c, 0x9c, 0x6f, 0x2b, 0xb7, 0xf9, 0xcf, 0xa0, 0x76, 0x82,
-	0x15, 0xf4, 0xc1, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6d, 0xca, 0xed, 0x16, 0x1d, 0x0d, 0x00,
-	0x00,
+	// 1451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4d, 0x6f, 0xdb, 0xc6,
+	0x16, 0x35, 0x25, 0x59, 0x1f, 0x57, 0xb6, 0x2c, 0x0c, 0xfc, 0x1c, 0x59, 0x4e, 0x64, 0x46, 0x79,
+	0x01, 0x8c, 0xf7, 0x60, 0xe9, 0xd9, 0xef, 0xbd, 0x2e, 0x82, 0x26, 0xad, 0x24, 0xd3, 0x89, 0x0a,
+	0x47, 0x76, 0x48, 0xca, 0x6e, 0xb3, 0xa8, 0x40, 0x91, 0x13, 0x99, 0xad, 0xc4, 0x51, 0xc9, 0xa1,
+	0x13, 0x77, 0xdb, 0x4d, 0xea, 0x55, 0x96, 0xed, 0x42, 0x40, 0x80, 0xfe, 0x82, 0x02, 0xf9, 0x11,
+	0x41, 0x57, 0x41, 0x57, 0x45, 0x16, 0xfd, 0x48, 0xba, 0xc8, 0xba, 0xe8, 0x0f, 0x28, 0x38, 0x33,
+	0xb4, 0x2d, 0x47, 0x96, 0xe3, 0x20, 0x9b, 0xae, 0xac, 0xb9, 0x73, 0xce, 0xcc, 0x9c, 0x73, 0x2f,
+	0xef, 0x8c, 0x41, 0x36, 0x89, 0xd7, 0x23, 0x5e, 0xb9, 0xe3, 0x12, 0xbf, 0x5f, 0xde, 0x5b, 0x69,
+	0x63, 0x6a, 0xac, 0x94, 0xe9, 0x7e, 0x1f, 0x7b, 0xa5, 0xbe, 0x4b, 0x28, 0x41, 0xb3, 0x1c, 0x51,
+	0x62, 0x88, 0x92, 0x40, 0xe4, 0x67, 0x3b, 0xa4, 0x43, 0x18, 0xa0, 0x1c, 0xfc, 0xe2, 0xd8, 0x7c,
+	0xa1, 0x43, 0x48, 0xa7, 0x8b, 0xcb, 0x6c, 0xd4, 0xf6, 0xef, 0x95, 0x2d, 0xdf, 0x35, 0xa8, 0x4d,
+	0x1c, 0x31, 0xbf, 0x78, 0x72, 0x9e, 0xda, 0x3d, 0xec, 0x51, 0xa3, 0xd7, 0x17, 0x80, 0x79, 0xbe,
+	0x59, 0x8b, 0xaf, 0x2c, 0x76, 0x16, 0x53, 0x27, 0xb9, 0x86, 0xb3, 0xcf, 0xa7, 0x8a, 0xdf, 0x4b,
+	0x10, 0xbf, 0x8d, 0x7b, 0x6d, 0xec, 0xa2, 0x55, 0x48, 0x18, 0x96, 0xe5, 0x62, 0xcf, 0xcb, 0x49,
+	0xb2, 0xb4, 0x94, 0xaa, 0xe6, 0x7e, 0x7c, 0xb2, 0x1c, 0x4a, 0xa8, 0xf0, 0x19, 0x8d, 0xba, 0xb6,
+	0xd3, 0x51, 0x43, 0x20, 0x9a, 0x83, 0xf8, 0x7d, 0x6c, 0x77, 0x76, 0x69, 0x2e, 0x12, 0x50, 0x54,
+	0x31, 0x42, 0x79, 0x48, 0xf6, 0x30, 0x35, 0x2c, 0x83, 0x1a, 0xb9, 0xa8, 0x2c, 0x2d, 0x4d, 0xa9,
+	0x87, 0x63, 0xf4, 0x01, 0x24, 0x0d, 0xcb, 0xc2, 0x56, 0xcb, 0xa0, 0xb9, 0x98, 0x2c, 0x2d, 0xa5,
+	0x57, 0xf3, 0x25, 0x7e, 0xc0, 0x52, 0x78, 0xc0, 0x92, 0x1e, 0x8a, 0xab, 0x26, 0x9f, 0xfe, 0xbc,
+	0x38, 0xf1, 0xe8, 0x97, 0x45, 0x89, 0x6d, 0x8a, 0xad, 0x0a, 0x2d, 0xde, 0x84, 0x04, 0x3f, 0xb2,
+	0x87, 0xde, 0x87, 0x44, 0x8f, 0xff, 0xcc, 0x49, 0x72, 0x74, 0x29, 0xbd, 0x7a, 0xb1, 0x34, 0xca,
+	0xf3, 0x12, 0xc7, 0x57, 0x63, 0xc1, 0x62, 0x6a, 0x48, 0x29, 0xfe, 0x26, 0xc1, 0x05, 0x7d, 0xd7,
+	0xc5, 0xde, 0x2e, 0xe9, 0x5a, 0x6b, 0xd8, 0xb4, 0x3d, 0x9b, 0x38, 0x5b, 0xa4, 0x6b, 0x9b, 0xfb,
+	0xe8, 0x22, 0xa4, 0x68, 0x38, 0xc5, 0xfd, 0x50, 0x8f, 0x02, 0xe8, 0x3a, 0x24, 0x02, 0xff, 0x89,
+	0xcf, 0x85, 0xa7, 0x57, 0xe7, 0x5f, 0x93, 0xb0, 0x26, 0xf2, 0xc7, 0x15, 0x7c, 0xc3, 0x14, 0x08,
+	0x0e, 0x6a, 0xc2, 0x6c, 0xcf, 0x76, 0x5a, 0xf8, 0x01, 0x36, 0xfd, 0x00, 0xd3, 0xea, 0x63, 0xd7,
+	0x26, 0x56, 0x2e, 0xfa, 0xe6, 0x6b, 0xa1, 0x9e, 0xed, 0x28, 0x21, 0x7f, 0x8b, 0xd1, 0xaf, 0xa1,
+	0x1f, 0x9e, 0x2c, 0x67, 0x86, 0x75, 0x14, 0x7f, 0x97, 0x20, 0xb7, 0x85, 0x5d, 0x13, 0x3b, 0xd4,
+	0xe8, 0xe0, 0x13, 0x22, 0x0b, 0x00, 0xfd, 0xc3, 0x39, 0xa1, 0xf2, 0x58, 0xe4, 0x6f, 0x24, 0x73,
+	0x10, 0x81, 0xd9, 0x3b, 0x3e, 0x71, 0xfd, 0xde, 0x09, 0x89, 0x73, 0x10, 0xff, 0x82, 0xc5, 0x85,
+	0x3c, 0x31, 0x1a, 0xce, 0x6f, 0xe4, 0x64, 0x7e, 0xaf, 0x42, 0x66, 0x0f, 0x53, 0xd2, 0x3a, 0x82,
+	0x44, 0x19, 0x64, 0x3a, 0x88, 0xea, 0xa3, 0xca, 0x20, 0xf6, 0x0e, 0xfd, 0x99, 0x7c, 0xf7, 0xfe,
+	0xfc, 0x29, 0x41, 0xea, 0x66, 0xf0, 0x49, 0xd4, 0x9d, 0x7b, 0x04, 0xcd, 0x43, 0x92, 0x7d, 0x1f,
+	0x2d, 0x9b, 0xd7, 0x76, 0x4c, 0x4d, 0xb0, 0x71, 0xdd, 0x42, 0x25, 0x98, 0x34, 0xac, 0x9e, 0xed,
+	0xe4, 0x22, 0x67, 0xf4, 0x00, 0x0e, 0x1b, 0xfb, 0xa5, 0xe7, 0x20, 0xb1, 0x87, 0xdd, 0xe0, 0x14,
+	0xcc, 0x9e, 0x98, 0x1a, 0x0e, 0xd1, 0x65, 0x98, 0xa2, 0x84, 0x1a, 0xdd, 0x96, 0xe8, 0x1e, 0x93,
+	0xcc, 0xdd, 0x34, 0x8b, 0xed, 0xb0, 0x10, 0xaa, 0x01, 0x98, 0x2e, 0x36, 0x28, 0x6f, 0x14, 0xf1,
+	0x73, 0x34, 0x8a, 0x94, 0xe0, 0x55, 0x68, 0xf1, 0x53, 0x48, 0x33, 0xd5, 0xa2, 0xc5, 0x8d, 0xd1,
+	0xfd, 0x3f, 0x88, 0xf3, 0xb6, 0x20, 0x2a, 0x7d, 0x6c, 0x23, 0x51, 0x05, 0xb6, 0xf8, 0x2a, 0x02,
+	0x33, 0x6c, 0x03, 0x6e, 0x33, 0x33, 0xf7, 0x6d, 0xfa, 0xe8, 0xf1, 0x83, 0x45, 0x4e, 0x49, 0x48,
+	0xf4, 0xfc, 0x09, 0x89, 0x9d, 0x9e, 0x90, 0xc9, 0xe1, 0x84, 0xdc, 0x81, 0x19, 0x4b, 0x54, 0x4c,
+	0xab, 0xcf, 0xb4, 0x08, 0xcb, 0x67, 0x5f, 0xb3, 0xbc, 0xe2, 0xec, 0x57, 0x47, 0x94, 0x98, 0x9a,
+	0xb1, 0x86, 0xbf, 0xbc, 0xe1, 0x04, 0x26, 0xde, 0x2a, 0x81, 0xd7, 0x92, 0x0f, 0x1f, 0x2f, 0x4e,
+	0xbc, 0x7a, 0xbc, 0x28, 0x15, 0x9f, 0xa7, 0x21, 0xb9, 0xe5, 0x92, 0x3e, 0xf1, 0x8c, 0x2e, 0x5a,
+	0x84, 0x74, 0x5f, 0xfc, 0x3e, 0xca, 0x25, 0x84, 0xa1, 0xba, 0x75, 0x3c, 0x09, 0x91, 0x37, 0x4d,
+	0xc2, 0xb8, 0x52, 0x7e, 0x0f, 0x52, 0x7c, 0xf5, 0xe0, 0xaa, 0x89, 0xc9, 0xd1, 0xb1, 0x2b, 0x1e,
+	0x41, 0xd1, 0x4d, 0x98, 0xf2, 0xfc, 0x76, 0xcf, 0xa6, 0xc2, 0x86, 0xc9, 0x73, 0xd8, 0x90, 0x3e,
+	0x64, 0x56, 0x28, 0xba, 0x02, 0xd3, 0xbc, 0x42, 0xc2, 0x04, 0xc6, 0x99, 0xe6, 0x29, 0x16, 0xdc,
+	0x16, 0x59, 0xfc, 0x0f, 0xcc, 0x72, 0x10, 0x4f, 0xe1, 0x21, 0x36, 0xc1, 0xb0, 0xa8, 0x73, 0x54,
+	0xa9, 0x21, 0xe3, 0x3a, 0xc4, 0x3d, 0x6a, 0x50, 0xdf, 0xcb, 0x25, 0x65, 0x69, 0x29, 0xb3, 0x7a,
+	0x75, 0x74, 0xd9, 0x87, 0xc6, 0x97, 0x34, 0x06, 0x56, 0x05, 0x29, 0xa0, 0xbb, 0xd8, 0xf3, 0xbb,
+	0x34, 0x97, 0x7a, 0x23, 0xba, 0xca, 0xc0, 0xaa, 0x20, 0xa1, 0x0f, 0x01, 0xf6, 0x08, 0xc5, 0xad,
+	0x60, 0x35, 0x9c, 0x03, 0xe6, 0xcd, 0xc2, 0xe8, 0x25, 0x74, 0xa3, 0xdb, 0xdd, 0x17, 0x17, 0x78,
+	0x2a, 0x20, 0x05, 0x27, 0xc1, 0xe8, 0xc6, 0x51, 0x07, 0x4e, 0x9f, 0xe7, 0x2d, 0x11, 0xb6, 0xe0,
+	0x6d, 0x98, 0xe1, 0xed, 0x97, 0xb8, 0x2d, 0xa1, 0x64, 0x8a, 0x29, 0x59, 0x3e, 0x43, 0x89, 0x22,
+	0x58, 0x42, 0x51, 0x06, 0x0f, 0x8d, 0xd1, 0x12, 0xc4, 0x7a, 0x5e, 0xc7, 0xcb, 0x4d, 0xcb, 0xd1,
+	0xd3, 0x3e, 0x22, 0x95, 0x21, 0x8a, 0x5f, 0x45, 0x20, 0xce, 0x5d, 0x45, 0x2b, 0x80, 0x34, 0xbd,
+	0xa2, 0x37, 0xb5, 0x56, 0xb3, 0xa1, 0x6d, 0x29, 0xb5, 0xfa, 0x7a, 0x5d, 0x59, 0xcb, 0x4e, 0xe4,
+	0xe7, 0x0f, 0x06, 0xf2, 0x3f, 0xc2, 0x9d, 0x39, 0xb6, 0xee, 0xec, 0x19, 0x5d, 0xdb, 0x42, 0x2b,
+	0x90, 0x15, 0x14, 0xad, 0x59, 0xbd, 0x5d, 0xd7, 0x75, 0x65, 0x2d, 0x2b, 0xe5, 0x17, 0x0e, 0x06,
+	0xf2, 0x85, 0x61, 0x82, 0x16, 0xd6, 0x12, 0xfa, 0x37, 0x4c, 0x0b, 0x4a, 0x6d, 0x63, 0x53, 0x53,
+	0xd6, 0xb2, 0x91, 0x7c, 0xee, 0x60, 0x20, 0xcf, 0x0e, 0xe3, 0x6b, 0x5d, 0xe2, 0x61, 0x0b, 0x2d,
+	0x43, 0x46, 0x80, 0x2b, 0xd5, 0x4d, 0x35, 0x58, 0x3d, 0x3a, 0xea, 0x38, 0x95, 0x36, 0x71, 0x29,
+	0x3e, 0x7e, 0x9c, 0x9d, 0xba, 0x7e, 0x6b, 0x4d, 0xad, 0xec, 0x34, 0xb2, 0xb1, 0x51, 0xc7, 0xd9,
+	0xb1, 0xe9, 0xae, 0xe5, 0x1a, 0xf7, 0x9d, 0x7c, 0xec, 0xe1, 0x77, 0x85, 0x89, 0xe2, 0x73, 0x09,
+	0xe2, 0xc2, 0xba, 0x15, 0x40, 0xaa, 0xa2, 0x35, 0x37, 0xf4, 0x71, 0x2e, 0x70, 0x6c, 0xe8, 0xc2,
+	0xff, 0x8f, 0x51, 0xd6, 0xeb, 0x8d, 0xca, 0x46, 0xfd, 0x2e, 0xf3, 0xe1, 0xd2, 0xc1, 0x40, 0x9e,
+	0x1f, 0xa6, 0x34, 0x9d, 0x7b, 0xb6, 0x63, 0x74, 0xed, 0x2f, 0xb1, 0x85, 0xca, 0x30, 0x23, 0x68,
+	0x95, 0x5a, 0x4d, 0xd9, 0xd2, 0x99, 0x17, 0xf9, 0x83, 0x81, 0x3c, 0x37, 0xcc, 0xa9, 0x98, 0x26,
+	0xee, 0xd3, 0x21, 0x82, 0xaa, 0x7c, 0xa4, 0xd4, 0xb8, 0x1d, 0x23, 0x08, 0x2a, 0xfe, 0x0c, 0x9b,
+	0x14, 0x5b, 0x42, 0xdc, 0xb7, 0x11, 0xc8, 0x0c, 0xd7, 0x0b, 0xaa, 0xc2, 0x82, 0xf2, 0xb1, 0x52,
+	0x6b, 0xea, 0x9b, 0x6a, 0x6b, 0xa4, 0xda, 0xcb, 0x07, 0x03, 0xf9, 0x52, 0xb8, 0xea, 0x30, 0x39,
+	0x54, 0x7d, 0x1d, 0x2e, 0x9c, 0x5c, 0xa3, 0xb1, 0xa9, 0xb7, 0xd4, 0x66, 0x23, 0x2b, 0xe5, 0xe5,
+	0x83, 0x81, 0x7c, 0x71, 0x34, 0xbf, 0x41, 0xa8, 0xea, 0x3b, 0xe8, 0xc6, 0xeb, 0x74, 0xad, 0x59,
+	0xab, 0x29, 0x9a, 0x96, 0x8d, 0x8c, 0xdb, 0x5e, 0xf3, 0x4d, 0x33, 0x68, 0x97, 0x23, 0xf8, 0xeb,
+	0x95, 0xfa, 0x46, 0x53, 0x55, 0xb2, 0xd1, 0x71, 0xfc, 0x75, 0xc3, 0xee, 0xfa, 0x2e, 0xe6, 0xde,
+	0x5c, 0x8b, 0x05, 0x0d, 0xbe, 0xf8, 0xb5, 0x04, 0x93, 0xec, 0x0b, 0x47, 0x0b, 0x90, 0xda, 0xc7,
+	0x5e, 0xcb, 0x24, 0xbe, 0x43, 0xc5, 0x93, 0x2d, 0xb9, 0x8f, 0xbd, 0x5a, 0x30, 0x0e, 0xae, 0x49,
+	0x87, 0x88, 0x39, 0xfe, 0x66, 0x4b, 0x38, 0x84, 0x4f, 0x5d, 0x81, 0x69, 0xa3, 0xed, 0x51, 0xc3,
+	0x76, 0xc4, 0x3c, 0x7f, 0xb0, 0x4d, 0x89, 0x20, 0x07, 0x5d, 0x02, 0x60, 0xcf, 0x3a, 0x8e, 0x88,
+	0xf1, 0x57, 0x5f, 0x10, 0x61, 0xd3, 0xe2, 0x2c, 0x7f, 0x48, 0x10, 0xdb, 0x26, 0x14, 0x9f, 0x7d,
+	0xc9, 0x94, 0x60, 0x32, 0xe8, 0x44, 0xee, 0xd9, 0x6f, 0x25, 0x06, 0x0b, 0xde, 0x18, 0xe6, 0x2e,
+	0xb1, 0x4d, 0xcc, 0x0e, 0x97, 0x39, 0xed, 0x8d, 0x51, 0x63, 0x18, 0x55, 0x60, 0xc7, 0x5e, 0xe8,
+	0xef, 0xea, 0x7a, 0xf9, 0x97, 0x05, 0x71, 0xbe, 0x2d, 0x9a, 0x03, 0x54, 0xbb, 0xb5, 0x59, 0xaf,
+	0x29, 0xc3, 0x05, 0x89, 0xa6, 0x21, 0x25, 0xe2, 0x8d, 0xcd, 0xac, 0x84, 0x32, 0x00, 0x62, 0xf8,
+	0x89, 0xa2, 0x65, 0x23, 0x08, 0x41, 0x46, 0x8c, 0x2b, 0x55, 0x4d, 0xaf, 0xd4, 0x1b, 0xd9, 0x28,
+	0x9a, 0x81, 0xb4, 0x88, 0x6d, 0x2b, 0xfa, 0x66, 0x36, 0x56, 0xbd, 0xf1, 0xf4, 0x45, 0x41, 0x7a,
+	0xf6, 0xa2, 0x20, 0xfd, 0xfa, 0xa2, 0x20, 0x3d, 0x7a, 0x59, 0x98, 0x78, 0xf6, 0xb2, 0x30, 0xf1,
+	0xd3, 0xcb, 0xc2, 0xc4, 0xdd, 0x7f, 0x76, 0x6c, 0xba, 0xeb, 0xb7, 0x4b, 0x26, 0xe9, 0x89, 0xff,
+	0x5d, 0xc5, 0x9f, 0x65, 0xcf, 0xfa, 0xbc, 0xfc, 0x80, 0xff, 0x93, 0xdd, 0x8e, 0x33, 0x41, 0xff,
+	0xfd, 0x6b, 0x00, 0x39, 0x07, 0x78, 0x72, 0x7b, 0x0f, 0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1017,19 +1198,133 @@ func (m *ThresholdDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout):])
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintTypes(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTypes(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PercentageDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PercentageDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PercentageDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTypes(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTypes(dAtA, i, uint64(n5))
+	i--
 	dAtA[i] = 0x12
+	if len(m.Percentage) > 0 {
+		i -= len(m.Percentage)
+		copy(dAtA[i:], m.Percentage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Percentage)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuorumDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuorumDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuorumDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x2a
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Threshold) > 0 {
 		i -= len(m.Threshold)
 		copy(dAtA[i:], m.Threshold)
 		i = encodeVarintTypes(dAtA, i, uint64(len(m.Threshold)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Quorum)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
@@ -1055,12 +1350,12 @@ func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err3 != nil {
-		return 0, err3
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n3
-	i = encodeVarintTypes(dAtA, i, uint64(n3))
+	i -= n8
+	i = encodeVarintTypes(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x32
 	if len(m.TotalWeight) > 0 {
@@ -1157,12 +1452,12 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err5 != nil {
-		return 0, err5
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n5
-	i = encodeVarintTypes(dAtA, i, uint64(n5))
+	i -= n10
+	i = encodeVarintTypes(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x3a
 	if m.DecisionPolicy != nil {
@@ -1250,12 +1545,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x60
 	}
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timeout):])
-	if err7 != nil {
-		return 0, err7
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timeout):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n7
-	i = encodeVarintTypes(dAtA, i, uint64(n7))
+	i -= n12
+	i = encodeVarintTypes(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x5a
 	{
@@ -1288,12 +1583,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmittedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmittedAt):])
-	if err9 != nil {
-		return 0, err9
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmittedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmittedAt):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n9
-	i = encodeVarintTypes(dAtA, i, uint64(n9))
+	i -= n14
+	i = encodeVarintTypes(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Proposers) > 0 {
@@ -1398,12 +1693,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmittedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmittedAt):])
-	if err10 != nil {
-		return 0, err10
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmittedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmittedAt):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n15
+	i = encodeVarintTypes(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -1494,6 +1789,50 @@ func (m *ThresholdDecisionPolicy) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout)
 	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *PercentageDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Percentage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *QuorumDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod)
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -2043,6 +2382,399 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinExecutionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PercentageDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PercentageDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Percentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinExecutionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuorumDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuorumDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuorumDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinExecutionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -0,0 +1,222 @@
+package group_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+func TestDecisionPolicyValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		policy group.DecisionPolicy
+		errMsg string
+	}{
+		{
+			"valid threshold policy",
+			group.NewThresholdDecisionPolicy("1", time.Second, time.Second),
+			"",
+		},
+		{
+			"negative min execution period",
+			group.NewThresholdDecisionPolicy("1", time.Second, -time.Second),
+			"min execution period",
+		},
+		{
+			"valid percentage policy",
+			group.NewPercentageDecisionPolicy("0.5", time.Second, 0),
+			"",
+		},
+		{
+			"percentage greater than 1",
+			group.NewPercentageDecisionPolicy("1.5", time.Second, 0),
+			"expected a decimal between 0 and 1",
+		},
+		{
+			"zero percentage",
+			group.NewPercentageDecisionPolicy("0", time.Second, 0),
+			"expected a positive decimal",
+		},
+		{
+			"percentage policy without timeout",
+			group.NewPercentageDecisionPolicy("0.5", 0, 0),
+			"timeout",
+		},
+		{
+			"valid quorum policy",
+			group.NewQuorumDecisionPolicy("0.4", "0.5", "0.334", time.Second, time.Second),
+			"",
+		},
+		{
+			"invalid quorum",
+			group.NewQuorumDecisionPolicy("2", "0.5", "0.334", time.Second, 0),
+			"quorum",
+		},
+		{
+			"invalid threshold",
+			group.NewQuorumDecisionPolicy("0.4", "-0.5", "0.334", time.Second, 0),
+			"threshold",
+		},
+		{
+			"invalid veto threshold",
+			group.NewQuorumDecisionPolicy("0.4", "0.5", "", time.Second, 0),
+			"veto threshold",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.ValidateBasic()
+			if tc.errMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPercentageDecisionPolicyAllow(t *testing.T) {
+	policy := group.NewPercentageDecisionPolicy("0.5", time.Hour, 0)
+
+	testCases := []struct {
+		name           string
+		tally          group.Tally
+		votingDuration time.Duration
+		exp            group.DecisionPolicyResult
+	}{
+		{
+			"yes votes reach the percentage",
+			group.Tally{YesCount: "5", NoCount: "1", AbstainCount: "0", VetoCount: "0"},
+			time.Minute,
+			group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		{
+			"yes votes can still reach the percentage",
+			group.Tally{YesCount: "2", NoCount: "3", AbstainCount: "0", VetoCount: "0"},
+			time.Minute,
+			group.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		{
+			"yes votes can't reach the percentage anymore",
+			group.Tally{YesCount: "2", NoCount: "5", AbstainCount: "1", VetoCount: "0"},
+			time.Minute,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		{
+			"timeout",
+			group.Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			time.Hour,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := policy.Allow(tc.tally, "10", tc.votingDuration)
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, res)
+		})
+	}
+}
+
+func TestQuorumDecisionPolicyAllow(t *testing.T) {
+	policy := group.NewQuorumDecisionPolicy("0.4", "0.5", "0.334", time.Hour, 0)
+
+	testCases := []struct {
+		name           string
+		tally          group.Tally
+		votingDuration time.Duration
+		exp            group.DecisionPolicyResult
+	}{
+		{
+			"passes whatever the remaining votes",
+			group.Tally{YesCount: "8", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			time.Minute,
+			group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		{
+			"remaining votes could veto",
+			group.Tally{YesCount: "4", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			time.Minute,
+			group.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		{
+			"fails whatever the remaining votes",
+			group.Tally{YesCount: "0", NoCount: "6", AbstainCount: "0", VetoCount: "0"},
+			time.Minute,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		{
+			"passes after timeout",
+			group.Tally{YesCount: "3", NoCount: "1", AbstainCount: "1", VetoCount: "0"},
+			time.Hour,
+			group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		{
+			"no quorum after timeout",
+			group.Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			time.Hour,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		{
+			"abstain votes count in the quorum",
+			group.Tally{YesCount: "1", NoCount: "0", AbstainCount: "3", VetoCount: "0"},
+			time.Hour,
+			group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		{
+			"threshold not exceeded after timeout",
+			group.Tally{YesCount: "2", NoCount: "2", AbstainCount: "1", VetoCount: "0"},
+			time.Hour,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		{
+			"vetoed after timeout",
+			group.Tally{YesCount: "4", NoCount: "0", AbstainCount: "0", VetoCount: "3"},
+			time.Hour,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		{
+			"only abstain votes",
+			group.Tally{YesCount: "0", NoCount: "0", AbstainCount: "10", VetoCount: "0"},
+			time.Minute,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := policy.Allow(tc.tally, "10", tc.votingDuration)
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, res)
+		})
+	}
+}
+
+func TestDecisionPolicyValidate(t *testing.T) {
+	emptyGroup := group.GroupInfo{TotalWeight: "0"}
+	g := group.GroupInfo{TotalWeight: "10"}
+
+	require.NoError(t, group.NewThresholdDecisionPolicy("10", time.Second, 0).Validate(g))
+	require.Error(t, group.NewThresholdDecisionPolicy("11", time.Second, 0).Validate(g))
+	require.Error(t, group.NewThresholdDecisionPolicy("1", time.Second, 0).Validate(emptyGroup))
+	require.NoError(t, group.NewPercentageDecisionPolicy("0.5", time.Second, 0).Validate(g))
+	require.Error(t, group.NewPercentageDecisionPolicy("0.5", time.Second, 0).Validate(emptyGroup))
+	require.NoError(t, group.NewQuorumDecisionPolicy("0.4", "0.5", "0.334", time.Second, 0).Validate(g))
+	require.Error(t, group.NewQuorumDecisionPolicy("0.4", "0.5", "0.334", time.Second, 0).Validate(emptyGroup))
+}
+
+func TestValidateWindows(t *testing.T) {
+	maxExecutionPeriod := time.Hour
+
+	require.NoError(t, group.ValidateWindows(group.NewThresholdDecisionPolicy("1", time.Second, 0), maxExecutionPeriod))
+	require.NoError(t, group.ValidateWindows(group.NewThresholdDecisionPolicy("1", time.Second, time.Second+maxExecutionPeriod), maxExecutionPeriod))
+	require.Error(t, group.ValidateWindows(group.NewThresholdDecisionPolicy("1", time.Second, time.Second+maxExecutionPeriod+time.Nanosecond), maxExecutionPeriod))
+	require.Error(t, group.ValidateWindows(group.NewPercentageDecisionPolicy("0.5", 0, 0), maxExecutionPeriod))
+	require.Error(t, group.ValidateWindows(nil, maxExecutionPeriod))
+}
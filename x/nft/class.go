@@ -0,0 +1,73 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateMintPolicy returns whether the issuer and the minters of the class are valid addresses
+func (c Class) ValidateMintPolicy() error {
+	if len(c.Issuer) == 0 {
+		if len(c.Minters) > 0 {
+			return sdkerrors.Wrapf(ErrInvalidClass, "class %s has minters but no issuer", c.Id)
+		}
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Issuer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid issuer address (%s)", c.Issuer)
+	}
+	for _, minter := range c.Minters {
+		if _, err := sdk.AccAddressFromBech32(minter); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", minter)
+		}
+	}
+	return nil
+}
+
+// MaxBasisPoints is the number of basis points of the full sale price of a nft
+const MaxBasisPoints = 10000
+
+// Validate returns whether the royalty has a valid recipient and a share not greater than the sale price
+func (r Royalty) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Recipient); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRoyalty, "invalid recipient address (%s)", r.Recipient)
+	}
+	if r.BasisPoints > MaxBasisPoints {
+		return sdkerrors.Wrapf(ErrInvalidRoyalty, "basis points %d greater than %d", r.BasisPoints, MaxBasisPoints)
+	}
+	return nil
+}
+
+// Amount returns the royalty due on the given sale price, rounded down
+func (r Royalty) Amount(salePrice sdk.Coin) sdk.Coin {
+	amount := salePrice.Amount.MulRaw(int64(r.BasisPoints)).QuoRaw(MaxBasisPoints)
+	return sdk.NewCoin(salePrice.Denom, amount)
+}
+
+// IsIssuer determines whether the given address is the issuer of the class.
+// Classes saved without an issuer can't be managed through the Msg service.
+func (c Class) IsIssuer(addr sdk.AccAddress) bool {
+	return len(c.Issuer) > 0 && c.Issuer == addr.String()
+}
+
+// CanMint determines whether the given address is allowed to mint nfts of the class
+func (c Class) CanMint(addr sdk.AccAddress) bool {
+	if c.IsIssuer(addr) {
+		return true
+	}
+	if len(c.Issuer) == 0 {
+		return false
+	}
+	for _, minter := range c.Minters {
+		if minter == addr.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// CanBurn determines whether the owners of the nfts of the class are allowed to burn them.
+// Like minting and updating, burning requires the class to have an issuer.
+func (c Class) CanBurn() bool {
+	return len(c.Issuer) > 0
+}
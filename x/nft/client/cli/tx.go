@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -13,6 +14,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
+// Flag names and values
+const (
+	FlagName        = "name"
+	FlagSymbol      = "symbol"
+	FlagDescription = "description"
+	FlagURI         = "uri"
+	FlagURIHash     = "uri-hash"
+	FlagMinters     = "minters"
+	FlagRoyalty     = "royalty"
+	FlagSoulbound   = "soulbound"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	nftTxCmd := &cobra.Command{
@@ -26,6 +39,10 @@ func GetTxCmd() *cobra.Command {
 
 	nftTxCmd.AddCommand(
 		NewCmdSend(),
+		NewCmdCreateClass(),
+		NewCmdMint(),
+		NewCmdBurn(),
+		NewCmdUpdateNFT(),
 	)
 
 	return nftTxCmd
@@ -58,3 +75,214 @@ func NewCmdSend() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewCmdCreateClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-class [class-id] --from [issuer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "create a new nft class issued by the sender",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s create-class <class-id> --name <name> --symbol <symbol> --minters <minter1>,<minter2> --from <issuer> --chain-id <chain-id>
+
+Set a royalty of 2.5%% of the sale price of the nfts paid to <recipient> with:
+			$ %s tx %s create-class <class-id> --royalty <recipient>:250 --from <issuer> --chain-id <chain-id>`, version.AppName, nft.ModuleName, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			name, err := cmd.Flags().GetString(FlagName)
+			if err != nil {
+				return err
+			}
+			symbol, err := cmd.Flags().GetString(FlagSymbol)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(FlagDescription)
+			if err != nil {
+				return err
+			}
+			uri, err := cmd.Flags().GetString(FlagURI)
+			if err != nil {
+				return err
+			}
+			uriHash, err := cmd.Flags().GetString(FlagURIHash)
+			if err != nil {
+				return err
+			}
+			minters, err := cmd.Flags().GetStringSlice(FlagMinters)
+			if err != nil {
+				return err
+			}
+			royaltyStr, err := cmd.Flags().GetString(FlagRoyalty)
+			if err != nil {
+				return err
+			}
+			var royalty *nft.Royalty
+			if royaltyStr != "" {
+				royalty, err = parseRoyalty(royaltyStr)
+				if err != nil {
+					return err
+				}
+			}
+			soulbound, err := cmd.Flags().GetBool(FlagSoulbound)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgCreateClass{
+				ClassId:     args[0],
+				Name:        name,
+				Symbol:      symbol,
+				Description: description,
+				Uri:         uri,
+				UriHash:     uriHash,
+				Issuer:      clientCtx.GetFromAddress().String(),
+				Minters:     minters,
+				Royalty:     royalty,
+				Soulbound:   soulbound,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagName, "", "The name of the class")
+	cmd.Flags().String(FlagSymbol, "", "The symbol of the class")
+	cmd.Flags().String(FlagDescription, "", "The description of the class")
+	cmd.Flags().String(FlagURI, "", "The uri of the class metadata")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	cmd.Flags().StringSlice(FlagMinters, []string{}, "The addresses allowed to mint nfts of the class, in addition to the issuer")
+	cmd.Flags().String(FlagRoyalty, "", "The royalty paid on the sales of the nfts of the class, as <recipient>:<basis-points>")
+	cmd.Flags().Bool(FlagSoulbound, false, "Whether the nfts of the class can't be transferred once minted")
+	return cmd
+}
+
+// parseRoyalty parses a royalty given as <recipient>:<basis-points>.
+func parseRoyalty(royaltyStr string) (*nft.Royalty, error) {
+	parts := strings.Split(royaltyStr, ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid royalty %s, expected <recipient>:<basis-points>", royaltyStr)
+	}
+
+	basisPoints, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid royalty basis points %s: %w", parts[1], err)
+	}
+
+	return &nft.Royalty{
+		Recipient:   parts[0],
+		BasisPoints: uint32(basisPoints),
+	}, nil
+}
+
+func NewCmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [class-id] [nft-id] [receiver] --from [minter]",
+		Args:  cobra.ExactArgs(3),
+		Short: "mint a new nft of a class",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s mint <class-id> <nft-id> <receiver> --uri <uri> --from <minter> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uri, err := cmd.Flags().GetString(FlagURI)
+			if err != nil {
+				return err
+			}
+			uriHash, err := cmd.Flags().GetString(FlagURIHash)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgMint{
+				ClassId:  args[0],
+				Id:       args[1],
+				Uri:      uri,
+				UriHash:  uriHash,
+				Minter:   clientCtx.GetFromAddress().String(),
+				Receiver: args[2],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagURI, "", "The uri of the nft metadata")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	return cmd
+}
+
+func NewCmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [class-id] [nft-id] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "burn a nft",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s burn <class-id> <nft-id> --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgBurn{
+				ClassId: args[0],
+				Id:      args[1],
+				Owner:   clientCtx.GetFromAddress().String(),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdUpdateNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-nft [class-id] [nft-id] --from [issuer]",
+		Args:  cobra.ExactArgs(2),
+		Short: "update the metadata of a nft",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s update-nft <class-id> <nft-id> --uri <uri> --uri-hash <uri-hash> --from <issuer> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uri, err := cmd.Flags().GetString(FlagURI)
+			if err != nil {
+				return err
+			}
+			uriHash, err := cmd.Flags().GetString(FlagURIHash)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgUpdateNFT{
+				ClassId: args[0],
+				Id:      args[1],
+				Uri:     uri,
+				UriHash: uriHash,
+				Issuer:  clientCtx.GetFromAddress().String(),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagURI, "", "The uri of the nft metadata")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	return cmd
+}
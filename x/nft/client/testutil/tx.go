@@ -6,11 +6,14 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 
 	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/cosmos-sdk/x/nft/client/cli"
 )
 
 const (
@@ -42,6 +45,8 @@ var (
 		Symbol:      testClassSymbol,
 		Description: testClassDescription,
 		Uri:         testClassURI,
+		Issuer:      Owner,
+		Minters:     []string{},
 	}
 
 	ExpNFT = nft.NFT{
@@ -142,6 +147,128 @@ func (s *IntegrationTestSuite) TestCLITxSend() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestCLITxCreateClass() {
+	val := s.network.Validators[0]
+	args := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, OwnerName),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+	testCases := []struct {
+		name         string
+		args         []string
+		expectedCode uint32
+		expectErr    bool
+	}{
+		{
+			"invalid class id",
+			[]string{"1kitty"},
+			0,
+			true,
+		},
+		{
+			"invalid minter",
+			[]string{"doggy", fmt.Sprintf("--%s=%s", cli.FlagMinters, "invalid")},
+			0,
+			true,
+		},
+		{
+			"class already exists",
+			[]string{testClassID},
+			nft.ErrClassExists.ABCICode(),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			clientCtx := val.ClientCtx
+			out, err := ExecCreateClass(
+				val,
+				append(tc.args, args...),
+			)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				var txResp sdk.TxResponse
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestCLITxMintUpdateBurn() {
+	val := s.network.Validators[0]
+	args := []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+	fromOwner := fmt.Sprintf("--%s=%s", flags.FlagFrom, OwnerName)
+	fromVal := fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address)
+	nftID := "kitty2"
+
+	testCases := []struct {
+		name         string
+		exec         func(val *network.Validator, args []string) (testutil.BufferWriter, error)
+		args         []string
+		expectedCode uint32
+	}{
+		{
+			"mint by a non minter",
+			ExecMint,
+			[]string{testClassID, nftID, val.Address.String(), fromVal},
+			sdkerrors.ErrUnauthorized.ABCICode(),
+		},
+		{
+			"mint by the issuer",
+			ExecMint,
+			[]string{testClassID, nftID, val.Address.String(), fmt.Sprintf("--%s=%s", cli.FlagURI, testURI), fromOwner},
+			0,
+		},
+		{
+			"update by a non issuer",
+			ExecUpdateNFT,
+			[]string{testClassID, nftID, fmt.Sprintf("--%s=%s", cli.FlagURI, "new uri"), fromVal},
+			sdkerrors.ErrUnauthorized.ABCICode(),
+		},
+		{
+			"update by the issuer",
+			ExecUpdateNFT,
+			[]string{testClassID, nftID, fmt.Sprintf("--%s=%s", cli.FlagURI, "new uri"), fromOwner},
+			0,
+		},
+		{
+			"burn by a non owner",
+			ExecBurn,
+			[]string{testClassID, nftID, fromOwner},
+			sdkerrors.ErrUnauthorized.ABCICode(),
+		},
+		{
+			"burn by the owner",
+			ExecBurn,
+			[]string{testClassID, nftID, fromVal},
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			clientCtx := val.ClientCtx
+			out, err := tc.exec(val, append(tc.args, args...))
+			var txResp sdk.TxResponse
+			s.Require().NoError(err)
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+			s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) initAccount() {
 	val := s.network.Validators[0]
 	ctx := val.ClientCtx
@@ -13,4 +13,7 @@ var (
 	ErrNFTNotExists   = sdkerrors.Register(ModuleName, 6, "nft does not exist")
 	ErrInvalidID      = sdkerrors.Register(ModuleName, 7, "invalid id")
 	ErrInvalidClassID = sdkerrors.Register(ModuleName, 8, "invalid class id")
+	ErrInvalidClass   = sdkerrors.Register(ModuleName, 9, "invalid nft class")
+	ErrInvalidRoyalty = sdkerrors.Register(ModuleName, 10, "invalid royalty")
+	ErrSoulbound      = sdkerrors.Register(ModuleName, 11, "nft is not transferable")
 )
@@ -16,3 +16,10 @@ type AccountKeeper interface {
 	GetModuleAddress(name string) sdk.AccAddress
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 }
+
+// TransferHooks defines the hooks called by the nft keeper when a nft is transferred.
+// BeforeTransfer can veto the transfer by returning an error.
+type TransferHooks interface {
+	BeforeTransfer(ctx sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error
+	AfterTransfer(ctx sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error
+}
@@ -10,6 +10,14 @@ func ValidateGenesis(data GenesisState) error {
 		if err := ValidateClassID(class.Id); err != nil {
 			return err
 		}
+		if err := class.ValidateMintPolicy(); err != nil {
+			return err
+		}
+		if class.Royalty != nil {
+			if err := class.Royalty.Validate(); err != nil {
+				return err
+			}
+		}
 	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
@@ -0,0 +1,33 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ TransferHooks = MultiTransferHooks{}
+
+// MultiTransferHooks combines multiple transfer hooks, all hook functions are run in array sequence
+// and the first error aborts the transfer
+type MultiTransferHooks []TransferHooks
+
+func NewMultiTransferHooks(hooks ...TransferHooks) MultiTransferHooks {
+	return hooks
+}
+
+func (h MultiTransferHooks) BeforeTransfer(ctx sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].BeforeTransfer(ctx, classID, nftID, sender, receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiTransferHooks) AfterTransfer(ctx sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].AfterTransfer(ctx, classID, nftID, sender, receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -65,3 +65,21 @@ func (k Keeper) HasClass(ctx sdk.Context, classID string) bool {
 	store := ctx.KVStore(k.storeKey)
 	return store.Has(classStoreKey(classID))
 }
+
+// RoyaltyInfo returns the royalty recipient of the specified class and the amount it is due
+// for a sale of one of its nfts at salePrice. The recipient is nil if the class has no royalty.
+func (k Keeper) RoyaltyInfo(ctx sdk.Context, classID string, salePrice sdk.Coin) (sdk.AccAddress, sdk.Coin, error) {
+	class, has := k.GetClass(ctx, classID)
+	if !has {
+		return nil, sdk.Coin{}, sdkerrors.Wrap(nft.ErrClassNotExists, classID)
+	}
+	if class.Royalty == nil {
+		return nil, sdk.NewCoin(salePrice.Denom, sdk.ZeroInt()), nil
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(class.Royalty.Recipient)
+	if err != nil {
+		return nil, sdk.Coin{}, err
+	}
+	return recipient, class.Royalty.Amount(salePrice), nil
+}
@@ -11,6 +11,7 @@ type Keeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
 	bk       nft.BankKeeper
+	hooks    nft.TransferHooks
 }
 
 // NewKeeper creates a new nft Keeper instance
@@ -28,3 +29,14 @@ func NewKeeper(key storetypes.StoreKey,
 		bk:       bk,
 	}
 }
+
+// SetHooks sets the nft transfer hooks
+func (k *Keeper) SetHooks(th nft.TransferHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set nft transfer hooks twice")
+	}
+
+	k.hooks = th
+
+	return k
+}
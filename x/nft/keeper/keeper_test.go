@@ -10,7 +10,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/nft"
+	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
 )
 
 const (
@@ -298,6 +300,102 @@ func (s *TestSuite) TestTransfer() {
 	s.Require().EqualValues([]nft.NFT{expNFT}, actNFTs)
 }
 
+func (s *TestSuite) TestTransferSoulbound() {
+	class := nft.Class{
+		Id:        testClassID,
+		Name:      testClassName,
+		Soulbound: true,
+	}
+	err := s.app.NFTKeeper.SaveClass(s.ctx, class)
+	s.Require().NoError(err)
+
+	expNFT := nft.NFT{
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     testURI,
+	}
+	err = s.app.NFTKeeper.Mint(s.ctx, expNFT, s.addrs[0])
+	s.Require().NoError(err)
+
+	err = s.app.NFTKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[1])
+	s.Require().ErrorIs(err, nft.ErrSoulbound)
+
+	owner := s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID)
+	s.Require().Equal(s.addrs[0], owner)
+
+	// soulbound nfts can still be burnt
+	err = s.app.NFTKeeper.Burn(s.ctx, testClassID, testID)
+	s.Require().NoError(err)
+}
+
+// mockTransferHooks records the transfers and vetoes the ones to the blocked address.
+type mockTransferHooks struct {
+	blocked   sdk.AccAddress
+	transfers []string
+}
+
+func (h *mockTransferHooks) BeforeTransfer(_ sdk.Context, _, nftID string, _, receiver sdk.AccAddress) error {
+	if receiver.Equals(h.blocked) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s can't receive nfts", receiver)
+	}
+	return nil
+}
+
+func (h *mockTransferHooks) AfterTransfer(_ sdk.Context, _, nftID string, sender, receiver sdk.AccAddress) error {
+	h.transfers = append(h.transfers, nftID)
+	return nil
+}
+
+func (s *TestSuite) TestTransferHooks() {
+	hooks := &mockTransferHooks{blocked: s.addrs[2]}
+	k := nftkeeper.NewKeeper(s.app.GetKey(nftkeeper.StoreKey), s.app.AppCodec(), s.app.AccountKeeper, s.app.BankKeeper)
+	k.SetHooks(nft.NewMultiTransferHooks(hooks))
+
+	err := k.SaveClass(s.ctx, nft.Class{Id: testClassID})
+	s.Require().NoError(err)
+	err = k.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0])
+	s.Require().NoError(err)
+
+	err = k.Transfer(s.ctx, testClassID, testID, s.addrs[2])
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	s.Require().Equal(s.addrs[0], k.GetOwner(s.ctx, testClassID, testID))
+	s.Require().Empty(hooks.transfers)
+
+	err = k.Transfer(s.ctx, testClassID, testID, s.addrs[1])
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[1], k.GetOwner(s.ctx, testClassID, testID))
+	s.Require().Equal([]string{testID}, hooks.transfers)
+
+	s.Require().Panics(func() { k.SetHooks(nft.NewMultiTransferHooks()) })
+}
+
+func (s *TestSuite) TestRoyaltyInfo() {
+	class := nft.Class{
+		Id: testClassID,
+		Royalty: &nft.Royalty{
+			Recipient:   s.addrs[1].String(),
+			BasisPoints: 250,
+		},
+	}
+	err := s.app.NFTKeeper.SaveClass(s.ctx, class)
+	s.Require().NoError(err)
+	err = s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID + "2"})
+	s.Require().NoError(err)
+
+	recipient, amount, err := s.app.NFTKeeper.RoyaltyInfo(s.ctx, testClassID, sdk.NewInt64Coin("stake", 1999))
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[1], recipient)
+	s.Require().Equal(sdk.NewInt64Coin("stake", 49), amount)
+
+	recipient, amount, err = s.app.NFTKeeper.RoyaltyInfo(s.ctx, testClassID+"2", sdk.NewInt64Coin("stake", 1999))
+	s.Require().NoError(err)
+	s.Require().Nil(recipient)
+	s.Require().True(amount.IsZero())
+
+	_, _, err = s.app.NFTKeeper.RoyaltyInfo(s.ctx, "doggy", sdk.NewInt64Coin("stake", 1999))
+	s.Require().ErrorIs(err, nft.ErrClassNotExists)
+}
+
 func (s *TestSuite) TestExportGenesis() {
 	class := nft.Class{
 		Id:          testClassID,
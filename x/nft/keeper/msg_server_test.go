@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
+)
+
+func (s *TestSuite) TestMsgCreateClass() {
+	msgServer := keeper.NewMsgServerImpl(s.app.NFTKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	msg := &nft.MsgCreateClass{
+		ClassId:     testClassID,
+		Name:        testClassName,
+		Symbol:      testClassSymbol,
+		Description: testClassDescription,
+		Uri:         testClassURI,
+		UriHash:     testClassURIHash,
+		Issuer:      s.addrs[0].String(),
+		Minters:     []string{s.addrs[1].String()},
+	}
+	_, err := msgServer.CreateClass(goCtx, msg)
+	s.Require().NoError(err)
+
+	class, has := s.app.NFTKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(s.addrs[0].String(), class.Issuer)
+	s.Require().Equal([]string{s.addrs[1].String()}, class.Minters)
+
+	_, err = msgServer.CreateClass(goCtx, msg)
+	s.Require().ErrorIs(err, nft.ErrClassExists)
+
+	msg.ClassId = testClassID + "2"
+	msg.Royalty = &nft.Royalty{Recipient: s.addrs[1].String(), BasisPoints: nft.MaxBasisPoints + 1}
+	_, err = msgServer.CreateClass(goCtx, msg)
+	s.Require().ErrorIs(err, nft.ErrInvalidRoyalty)
+}
+
+func (s *TestSuite) TestMsgSendSoulbound() {
+	msgServer := keeper.NewMsgServerImpl(s.app.NFTKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	err := s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, Soulbound: true})
+	s.Require().NoError(err)
+	err = s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0])
+	s.Require().NoError(err)
+
+	_, err = msgServer.Send(goCtx, &nft.MsgSend{ClassId: testClassID, Id: testID, Sender: s.addrs[0].String(), Receiver: s.addrs[1].String()})
+	s.Require().ErrorIs(err, nft.ErrSoulbound)
+}
+
+func (s *TestSuite) TestMsgMint() {
+	msgServer := keeper.NewMsgServerImpl(s.app.NFTKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	s.createIssuedClass()
+	// classes saved by other modules have no issuer
+	err := s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: "kitten"})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		msg    *nft.MsgMint
+		expErr error
+	}{
+		{
+			"class does not exist",
+			&nft.MsgMint{ClassId: "doggy", Id: testID, Minter: s.addrs[0].String(), Receiver: s.addrs[2].String()},
+			nft.ErrClassNotExists,
+		},
+		{
+			"class without issuer",
+			&nft.MsgMint{ClassId: "kitten", Id: testID, Minter: s.addrs[0].String(), Receiver: s.addrs[2].String()},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"not allowed to mint",
+			&nft.MsgMint{ClassId: testClassID, Id: testID, Minter: s.addrs[2].String(), Receiver: s.addrs[2].String()},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"mint by the issuer",
+			&nft.MsgMint{ClassId: testClassID, Id: testID, Uri: testURI, Minter: s.addrs[0].String(), Receiver: s.addrs[2].String()},
+			nil,
+		},
+		{
+			"mint by a minter",
+			&nft.MsgMint{ClassId: testClassID, Id: testID + "2", Uri: testURI, Minter: s.addrs[1].String(), Receiver: s.addrs[2].String()},
+			nil,
+		},
+		{
+			"nft already exists",
+			&nft.MsgMint{ClassId: testClassID, Id: testID, Minter: s.addrs[0].String(), Receiver: s.addrs[2].String()},
+			nft.ErrNFTExists,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := msgServer.Mint(goCtx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+			owner := s.app.NFTKeeper.GetOwner(s.ctx, tc.msg.ClassId, tc.msg.Id)
+			s.Require().True(s.addrs[2].Equals(owner))
+		})
+	}
+	s.Require().EqualValues(uint64(2), s.app.NFTKeeper.GetTotalSupply(s.ctx, testClassID))
+}
+
+func (s *TestSuite) TestMsgBurn() {
+	msgServer := keeper.NewMsgServerImpl(s.app.NFTKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	s.createIssuedClass()
+	err := s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID, Uri: testURI}, s.addrs[2])
+	s.Require().NoError(err)
+
+	// classes saved by other modules have no issuer
+	err = s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: "kitten"})
+	s.Require().NoError(err)
+	err = s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: "kitten", Id: testID}, s.addrs[2])
+	s.Require().NoError(err)
+
+	_, err = msgServer.Burn(goCtx, &nft.MsgBurn{ClassId: "doggy", Id: testID, Owner: s.addrs[2].String()})
+	s.Require().ErrorIs(err, nft.ErrClassNotExists)
+
+	// the owner can't burn a nft of a class without issuer
+	_, err = msgServer.Burn(goCtx, &nft.MsgBurn{ClassId: "kitten", Id: testID, Owner: s.addrs[2].String()})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	s.Require().True(s.app.NFTKeeper.HasNFT(s.ctx, "kitten", testID))
+
+	// the issuer can't burn a nft it doesn't own
+	_, err = msgServer.Burn(goCtx, &nft.MsgBurn{ClassId: testClassID, Id: testID, Owner: s.addrs[0].String()})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.Burn(goCtx, &nft.MsgBurn{ClassId: testClassID, Id: testID, Owner: s.addrs[2].String()})
+	s.Require().NoError(err)
+	s.Require().False(s.app.NFTKeeper.HasNFT(s.ctx, testClassID, testID))
+	s.Require().EqualValues(uint64(0), s.app.NFTKeeper.GetTotalSupply(s.ctx, testClassID))
+}
+
+func (s *TestSuite) TestMsgUpdateNFT() {
+	msgServer := keeper.NewMsgServerImpl(s.app.NFTKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	s.createIssuedClass()
+	err := s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID, Uri: testURI}, s.addrs[2])
+	s.Require().NoError(err)
+
+	msg := &nft.MsgUpdateNFT{
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     testURI + "2",
+		UriHash: testURIHash,
+	}
+
+	// neither minters nor owners can update a nft
+	msg.Issuer = s.addrs[1].String()
+	_, err = msgServer.UpdateNFT(goCtx, msg)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	msg.Issuer = s.addrs[2].String()
+	_, err = msgServer.UpdateNFT(goCtx, msg)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	msg.Issuer = s.addrs[0].String()
+	_, err = msgServer.UpdateNFT(goCtx, msg)
+	s.Require().NoError(err)
+
+	token, has := s.app.NFTKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().Equal(testURI+"2", token.Uri)
+	s.Require().Equal(testURIHash, token.UriHash)
+
+	msg.Id = testID + "2"
+	_, err = msgServer.UpdateNFT(goCtx, msg)
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+}
+
+// createIssuedClass saves a class issued by addrs[0] with addrs[1] as minter.
+func (s *TestSuite) createIssuedClass() {
+	class := nft.Class{
+		Id:      testClassID,
+		Name:    testClassName,
+		Issuer:  s.addrs[0].String(),
+		Minters: []string{s.addrs[1].String()},
+	}
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, class))
+}
@@ -58,22 +58,39 @@ func (k Keeper) Update(ctx sdk.Context, token nft.NFT) error {
 }
 
 // Transfer defines a method for sending a nft from one account to another account.
+// Nfts of soulbound classes can't be transferred, and the registered TransferHooks
+// can veto the transfer.
 // Note: When the upper module uses this method, it needs to authenticate nft
 func (k Keeper) Transfer(ctx sdk.Context,
 	classID string,
 	nftID string,
 	receiver sdk.AccAddress) error {
-	if !k.HasClass(ctx, classID) {
+	class, has := k.GetClass(ctx, classID)
+	if !has {
 		return sdkerrors.Wrap(nft.ErrClassNotExists, classID)
 	}
 
+	if class.Soulbound {
+		return sdkerrors.Wrapf(nft.ErrSoulbound, "class %s is soulbound", classID)
+	}
+
 	if !k.HasNFT(ctx, classID, nftID) {
 		return sdkerrors.Wrap(nft.ErrNFTNotExists, nftID)
 	}
 
 	owner := k.GetOwner(ctx, classID, nftID)
+	if k.hooks != nil {
+		if err := k.hooks.BeforeTransfer(ctx, classID, nftID, owner, receiver); err != nil {
+			return err
+		}
+	}
+
 	k.deleteOwner(ctx, classID, nftID, owner)
 	k.setOwner(ctx, classID, nftID, receiver)
+
+	if k.hooks != nil {
+		return k.hooks.AfterTransfer(ctx, classID, nftID, owner, receiver)
+	}
 	return nil
 }
 
@@ -19,16 +19,28 @@ import (
 
 const (
 	// Simulation operation weights constants
-	OpWeightMsgSend = "op_weight_msg_send"
+	OpWeightMsgSend        = "op_weight_msg_send"
+	OpWeightMsgCreateClass = "op_weight_msg_create_class"
+	OpWeightMsgMint        = "op_weight_msg_mint"
+	OpWeightMsgBurn        = "op_weight_msg_burn"
+	OpWeightMsgUpdateNFT   = "op_weight_msg_update_nft"
 )
 
 const (
 	// nft operations weights
-	WeightSend = 100
+	WeightSend        = 100
+	WeightCreateClass = 20
+	WeightMint        = 50
+	WeightBurn        = 20
+	WeightUpdateNFT   = 20
 )
 
 var (
-	TypeMsgSend = sdk.MsgTypeURL(&nft.MsgSend{})
+	TypeMsgSend        = sdk.MsgTypeURL(&nft.MsgSend{})
+	TypeMsgCreateClass = sdk.MsgTypeURL(&nft.MsgCreateClass{})
+	TypeMsgMint        = sdk.MsgTypeURL(&nft.MsgMint{})
+	TypeMsgBurn        = sdk.MsgTypeURL(&nft.MsgBurn{})
+	TypeMsgUpdateNFT   = sdk.MsgTypeURL(&nft.MsgUpdateNFT{})
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -41,7 +53,11 @@ func WeightedOperations(
 	k keeper.Keeper) simulation.WeightedOperations {
 
 	var (
-		weightMsgSend int
+		weightMsgSend        int
+		weightMsgCreateClass int
+		weightMsgMint        int
+		weightMsgBurn        int
+		weightMsgUpdateNFT   int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgSend, &weightMsgSend, nil,
@@ -50,10 +66,51 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateClass, &weightMsgCreateClass, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateClass = WeightCreateClass
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgMint, &weightMsgMint, nil,
+		func(_ *rand.Rand) {
+			weightMsgMint = WeightMint
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurn, &weightMsgBurn, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurn = WeightBurn
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateNFT, &weightMsgUpdateNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateNFT = WeightUpdateNFT
+		},
+	)
+
+	protoCdc := codec.NewProtoCodec(registry)
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgSend,
-			SimulateMsgSend(codec.NewProtoCodec(registry), ak, bk, k),
+			SimulateMsgSend(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateClass,
+			SimulateMsgCreateClass(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMint,
+			SimulateMsgMint(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBurn,
+			SimulateMsgBurn(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateNFT,
+			SimulateMsgUpdateNFT(protoCdc, ak, bk, k),
 		),
 	}
 }
@@ -91,6 +148,10 @@ func SimulateMsgSend(
 			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSend, err.Error()), nil, err
 		}
 
+		if class, _ := k.GetClass(ctx, n.ClassId); class.Soulbound {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSend, "nft is soulbound"), nil, nil
+		}
+
 		msg := &nft.MsgSend{
 			ClassId:  n.ClassId,
 			Id:       n.Id,
@@ -122,6 +183,176 @@ func SimulateMsgSend(
 	}
 }
 
+// SimulateMsgCreateClass generates a MsgCreateClass with random values.
+func SimulateMsgCreateClass(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		issuer, _ := simtypes.RandomAcc(r, accs)
+		minter, _ := simtypes.RandomAcc(r, accs)
+
+		classID := simtypes.RandStringOfLength(r, 10)
+		if k.HasClass(ctx, classID) {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgCreateClass, "class already exists"), nil, nil
+		}
+
+		recipient, _ := simtypes.RandomAcc(r, accs)
+		msg := &nft.MsgCreateClass{
+			ClassId:     classID,
+			Name:        simtypes.RandStringOfLength(r, 10),
+			Symbol:      simtypes.RandStringOfLength(r, 10),
+			Description: simtypes.RandStringOfLength(r, 10),
+			Uri:         simtypes.RandStringOfLength(r, 10),
+			Issuer:      issuer.Address.String(),
+			Minters:     []string{minter.Address.String()},
+			Royalty: &nft.Royalty{
+				Recipient:   recipient.Address.String(),
+				BasisPoints: uint32(r.Intn(nft.MaxBasisPoints + 1)),
+			},
+			Soulbound: r.Intn(10) == 0,
+		}
+
+		return genAndDeliverTx(r, app, ctx, cdc, ak, bk, msg, TypeMsgCreateClass, issuer)
+	}
+}
+
+// SimulateMsgMint generates a MsgMint with random values.
+func SimulateMsgMint(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		receiver, _ := simtypes.RandomAcc(r, accs)
+
+		type mintable struct {
+			class  *nft.Class
+			minter simtypes.Account
+		}
+		var candidates []mintable
+		for _, c := range k.GetClasses(ctx) {
+			for _, acc := range accs {
+				if c.CanMint(acc.Address) {
+					candidates = append(candidates, mintable{c, acc})
+				}
+			}
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgMint, "no class mintable by the accounts"), nil, nil
+		}
+		candidate := candidates[r.Intn(len(candidates))]
+		class, minter := candidate.class, candidate.minter
+
+		nftID := simtypes.RandStringOfLength(r, 10)
+		if k.HasNFT(ctx, class.Id, nftID) {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgMint, "nft already exists"), nil, nil
+		}
+
+		msg := &nft.MsgMint{
+			ClassId:  class.Id,
+			Id:       nftID,
+			Uri:      simtypes.RandStringOfLength(r, 10),
+			Minter:   minter.Address.String(),
+			Receiver: receiver.Address.String(),
+		}
+
+		return genAndDeliverTx(r, app, ctx, cdc, ak, bk, msg, TypeMsgMint, minter)
+	}
+}
+
+// SimulateMsgBurn generates a MsgBurn with random values.
+func SimulateMsgBurn(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, _ := simtypes.RandomAcc(r, accs)
+
+		var nfts []nft.NFT
+		for _, c := range k.GetClasses(ctx) {
+			if c.CanBurn() {
+				nfts = append(nfts, k.GetNFTsOfClassByOwner(ctx, c.Id, owner.Address)...)
+			}
+		}
+		if len(nfts) == 0 {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgBurn, "no burnable nft owned by the account"), nil, nil
+		}
+		n := nfts[r.Intn(len(nfts))]
+
+		msg := &nft.MsgBurn{
+			ClassId: n.ClassId,
+			Id:      n.Id,
+			Owner:   owner.Address.String(),
+		}
+
+		return genAndDeliverTx(r, app, ctx, cdc, ak, bk, msg, TypeMsgBurn, owner)
+	}
+}
+
+// SimulateMsgUpdateNFT generates a MsgUpdateNFT with random values.
+func SimulateMsgUpdateNFT(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		issuer, _ := simtypes.RandomAcc(r, accs)
+
+		var nfts []nft.NFT
+		for _, c := range k.GetClasses(ctx) {
+			if c.IsIssuer(issuer.Address) {
+				nfts = append(nfts, k.GetNFTsOfClass(ctx, c.Id)...)
+			}
+		}
+		if len(nfts) == 0 {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgUpdateNFT, "no nft issued by the account"), nil, nil
+		}
+		n := nfts[r.Intn(len(nfts))]
+
+		msg := &nft.MsgUpdateNFT{
+			ClassId: n.ClassId,
+			Id:      n.Id,
+			Uri:     simtypes.RandStringOfLength(r, 10),
+			UriHash: simtypes.RandStringOfLength(r, 10),
+			Issuer:  issuer.Address.String(),
+		}
+
+		return genAndDeliverTx(r, app, ctx, cdc, ak, bk, msg, TypeMsgUpdateNFT, issuer)
+	}
+}
+
+func genAndDeliverTx(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper, bk nft.BankKeeper, msg sdk.Msg, msgType string, signer simtypes.Account,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:             r,
+		App:           app,
+		TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:           cdc,
+		Msg:           msg,
+		MsgType:       msgType,
+		Context:       ctx,
+		SimAccount:    signer,
+		AccountKeeper: ak,
+		Bankkeeper:    bk,
+		ModuleName:    nft.ModuleName,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
 func randNFT(ctx sdk.Context, r *rand.Rand, k keeper.Keeper, minter sdk.AccAddress) (nft.NFT, error) {
 	c, err := randClass(ctx, r, k)
 	if err != nil {
@@ -53,6 +53,11 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 		opMsgName  string
 	}{
 		{simulation.WeightSend, simulation.TypeMsgSend, simulation.TypeMsgSend},
+		{simulation.WeightCreateClass, simulation.TypeMsgCreateClass, simulation.TypeMsgCreateClass},
+		{simulation.WeightMint, simulation.TypeMsgMint, simulation.TypeMsgMint},
+		{simulation.WeightBurn, simulation.TypeMsgBurn, simulation.TypeMsgBurn},
+		// no nft has been minted in a class issued by the random account yet
+		{simulation.WeightUpdateNFT, nft.ModuleName, simulation.TypeMsgUpdateNFT},
 	}
 
 	for i, w := range weightedOps {
@@ -110,6 +115,63 @@ func (suite *SimTestSuite) TestSimulateMsgSend() {
 
 }
 
+func (suite *SimTestSuite) TestSimulateMsgCreateClass() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+
+	// begin a new block
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+
+	// execute operation
+	registry := suite.app.InterfaceRegistry()
+	op := simulation.SimulateMsgCreateClass(codec.NewProtoCodec(registry), suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg nft.MsgCreateClass
+	suite.app.AppCodec().UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Len(futureOperations, 0)
+
+	class, has := suite.app.NFTKeeper.GetClass(suite.ctx, msg.ClassId)
+	suite.Require().True(has)
+	suite.Require().Equal(msg.Issuer, class.Issuer)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgMint() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 1)
+	err := suite.app.NFTKeeper.SaveClass(suite.ctx, nft.Class{Id: "kitty", Issuer: accounts[0].Address.String()})
+	suite.Require().NoError(err)
+
+	// begin a new block
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+
+	// execute operation
+	registry := suite.app.InterfaceRegistry()
+	op := simulation.SimulateMsgMint(codec.NewProtoCodec(registry), suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg nft.MsgMint
+	suite.app.AppCodec().UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Len(futureOperations, 0)
+	suite.Require().True(suite.app.NFTKeeper.HasNFT(suite.ctx, "kitty", msg.Id))
+}
+
 func TestSimTestSuite(t *testing.T) {
 	suite.Run(t, new(SimTestSuite))
 }
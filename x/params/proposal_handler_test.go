@@ -29,6 +29,10 @@ func (suite *HandlerTestSuite) SetupTest() {
 	suite.app = simapp.Setup(suite.T(), false)
 	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{})
 	suite.govHandler = params.NewParamChangeProposalHandler(suite.app.ParamsKeeper)
+
+	// the gov params live in the gov store, the legacy gov subspace is only populated
+	// before their migration
+	suite.app.GetSubspace(govtypes.ModuleName).Set(suite.ctx, govv1beta2.ParamStoreKeyDepositParams, govv1beta2.DefaultDepositParams())
 }
 
 func TestHandlerTestSuite(t *testing.T) {
@@ -69,11 +73,13 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 				Value:    `{"min_deposit": [{"denom": "uatom","amount": "64000000"}], "max_deposit_period": "172800000000000"}`,
 			}),
 			func() {
-				depositParams := suite.app.GovKeeper.GetDepositParams(suite.ctx)
+				var depositParams govv1beta2.DepositParams
+				suite.app.GetSubspace(govtypes.ModuleName).Get(suite.ctx, govv1beta2.ParamStoreKeyDepositParams, &depositParams)
 				defaultPeriod := govv1beta2.DefaultPeriod
 				suite.Require().Equal(govv1beta2.DepositParams{
-					MinDeposit:       sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(64000000))),
-					MaxDepositPeriod: &defaultPeriod,
+					MinDeposit:          sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(64000000))),
+					MaxDepositPeriod:    &defaultPeriod,
+					ProposalCancelRatio: govv1beta2.DefaultProposalCancelRatio.String(),
 				}, depositParams)
 			},
 			false,
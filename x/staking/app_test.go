@@ -76,6 +76,10 @@ func TestStakingMsgs(t *testing.T) {
 	require.NoError(t, err)
 	simapp.CheckBalance(t, app, addr1, sdk.Coins{genCoin.Sub(bondCoin)})
 
+	// the validator is only created at the end of the epoch
+	checkValidator(t, app, sdk.ValAddress(addr1), false)
+	simapp.EndEpoch(app)
+
 	header = tmproto.Header{Height: app.LastBlockHeight() + 1}
 	app.BeginBlock(abci.RequestBeginBlock{Header: header})
 
@@ -94,6 +98,7 @@ func TestStakingMsgs(t *testing.T) {
 	header = tmproto.Header{Height: app.LastBlockHeight() + 1}
 	_, _, err = simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, []sdk.Msg{editValidatorMsg}, "", []uint64{0}, []uint64{1}, true, true, priv1)
 	require.NoError(t, err)
+	simapp.EndEpoch(app)
 
 	validator = checkValidator(t, app, sdk.ValAddress(addr1), true)
 	require.Equal(t, description, validator.Description)
@@ -106,6 +111,9 @@ func TestStakingMsgs(t *testing.T) {
 	_, _, err = simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, []sdk.Msg{delegateMsg}, "", []uint64{1}, []uint64{0}, true, true, priv2)
 	require.NoError(t, err)
 
+	simapp.CheckBalance(t, app, addr2, sdk.Coins{genCoin.Sub(bondCoin)})
+	checkDelegation(t, app, addr2, sdk.ValAddress(addr1), false, sdk.Dec{})
+	simapp.EndEpoch(app)
 	simapp.CheckBalance(t, app, addr2, sdk.Coins{genCoin.Sub(bondCoin)})
 	checkDelegation(t, app, addr2, sdk.ValAddress(addr1), true, bondTokens.ToDec())
 
@@ -114,6 +122,7 @@ func TestStakingMsgs(t *testing.T) {
 	header = tmproto.Header{Height: app.LastBlockHeight() + 1}
 	_, _, err = simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, []sdk.Msg{beginUnbondingMsg}, "", []uint64{1}, []uint64{1}, true, true, priv2)
 	require.NoError(t, err)
+	simapp.EndEpoch(app)
 
 	// delegation should exist anymore
 	checkDelegation(t, app, addr2, sdk.ValAddress(addr1), false, sdk.Dec{})
@@ -3,6 +3,7 @@ package testutil
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -75,7 +76,8 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	_, err = MsgUnbondExec(val.ClientCtx, val.Address, val.ValAddress, unbond)
 	s.Require().NoError(err)
 
-	_, err = s.network.WaitForHeight(1)
+	// the redelegation and the unbonding are buffered until the end of the epoch
+	_, err = s.network.WaitForEpochEnd()
 	s.Require().NoError(err)
 }
 
@@ -1297,6 +1299,103 @@ func (s *IntegrationTestSuite) TestNewUnbondCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewCancelUnbondingDelegationCmd() {
+	val := s.network.Validators[0]
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryUnbondingDelegation(), []string{
+		val.Address.String(),
+		val.ValAddress.String(),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+
+	var ubd types.UnbondingDelegation
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &ubd))
+	s.Require().NotEmpty(ubd.Entries)
+	creationHeight := strconv.FormatInt(ubd.Entries[0].CreationHeight, 10)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+		respType     proto.Message
+	}{
+		{
+			"Without creation height",
+			[]string{
+				val.ValAddress.String(),
+				sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5)).String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, 0, nil,
+		},
+		{
+			"Invalid creation height",
+			[]string{
+				val.ValAddress.String(),
+				sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5)).String(),
+				"abc",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, 0, nil,
+		},
+		{
+			"unbonding delegation entry not found at creation height",
+			[]string{
+				val.ValAddress.String(),
+				sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5)).String(),
+				"10000",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			// the message is buffered, the entry is only looked up at the end of the epoch
+			false, 0, &sdk.TxResponse{},
+		},
+		{
+			"valid transaction of cancel unbonding delegation",
+			[]string{
+				val.ValAddress.String(),
+				sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5)).String(),
+				creationHeight,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, 0, &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewCancelUnbondingDelegation()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err, out.String())
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
 // TestBlockResults tests that the validator updates correctly show when
 // calling the /block_results RPC endpoint.
 // ref: https://github.com/cosmos/cosmos-sdk/issues/7401.
@@ -1323,8 +1422,6 @@ func (s *IntegrationTestSuite) TestBlockResults() {
 	require.NoError(err)
 
 	// Use CLI to create a delegation from the new account to validator `val`.
-	delHeight, err := s.network.LatestHeight()
-	require.NoError(err)
 	cmd := cli.NewDelegateCmd()
 	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, []string{
 		val.ValAddress.String(),
@@ -1336,37 +1433,24 @@ func (s *IntegrationTestSuite) TestBlockResults() {
 	})
 	require.NoError(err)
 
+	// The delegation is buffered until the end of the epoch, so the validator
+	// update shows in the results of the last block of the epoch.
+	epochEndHeight, err := s.network.WaitForEpochEnd()
+	require.NoError(err)
+
 	// Create a HTTP rpc client.
 	rpcClient, err := http.New(val.RPCAddress)
 	require.NoError(err)
 
-	// Loop until we find a block result with the correct validator updates.
-	// By experience, it happens around 2 blocks after `delHeight`.
-	for {
-		latestHeight, err := s.network.LatestHeight()
-		require.NoError(err)
-
-		// Wait maximum 10 blocks, or else fail test.
-		if latestHeight > delHeight+10 {
-			s.Fail("timeout reached")
-		}
-
-		res, err := rpcClient.BlockResults(context.Background(), &latestHeight)
-		require.NoError(err)
-
-		if len(res.ValidatorUpdates) > 0 {
-			valUpdate := res.ValidatorUpdates[0]
-			require.Equal(
-				valUpdate.GetPubKey().Sum.(*crypto.PublicKey_Ed25519).Ed25519,
-				val.PubKey.Bytes(),
-			)
-
-			// We got our validator update, test passed.
-			break
-		}
+	res, err := rpcClient.BlockResults(context.Background(), &epochEndHeight)
+	require.NoError(err)
+	require.NotEmpty(res.ValidatorUpdates)
 
-		s.network.WaitForNextBlock()
-	}
+	valUpdate := res.ValidatorUpdates[0]
+	require.Equal(
+		valUpdate.GetPubKey().Sum.(*crypto.PublicKey_Ed25519).Ed25519,
+		val.PubKey.Bytes(),
+	)
 }
 
 // https://github.com/cosmos/cosmos-sdk/issues/10660
@@ -1387,6 +1471,10 @@ func (s *IntegrationTestSuite) TestEditValidatorMoniker() {
 	})
 	require.NoError(err)
 
+	// the edit is buffered until the end of the epoch
+	_, err = s.network.WaitForEpochEnd()
+	require.NoError(err)
+
 	queryCmd := cli.GetCmdQueryValidator()
 	res, err := clitestutil.ExecTestCLICmd(
 		val.ClientCtx, queryCmd,
@@ -1407,6 +1495,9 @@ func (s *IntegrationTestSuite) TestEditValidatorMoniker() {
 	})
 	require.NoError(err)
 
+	_, err = s.network.WaitForEpochEnd()
+	require.NoError(err)
+
 	res, err = clitestutil.ExecTestCLICmd(
 		val.ClientCtx, queryCmd,
 		[]string{val.ValAddress.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
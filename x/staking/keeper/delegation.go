@@ -814,6 +814,80 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 	return balances, nil
 }
 
+// CancelUnbondingDelegation delegates back to the validator an amount of the
+// unbonding delegation entry created at the given height. The amount is taken
+// from the current balance of the entry, which already accounts for the
+// slashes applied to it, and the entry is removed once it is fully canceled.
+func (k Keeper) CancelUnbondingDelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64, amount sdk.Int,
+) error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	// In some situations, the exchange rate becomes invalid, e.g. if
+	// Validator loses all tokens due to slashing. In this case,
+	// make all future delegations invalid.
+	if validator.InvalidExRate() {
+		return types.ErrDelegatorShareExRateInvalid
+	}
+
+	if validator.IsJailed() {
+		return types.ErrValidatorJailed
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.ErrNoUnbondingDelegation
+	}
+
+	entryIndex := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight {
+			entryIndex = i
+			break
+		}
+	}
+	if entryIndex == -1 {
+		return sdkerrors.ErrNotFound.Wrapf("unbonding delegation entry is not found at block height %d", creationHeight)
+	}
+
+	entry := ubd.Entries[entryIndex]
+	if entry.IsMature(ctx.BlockHeader().Time) {
+		return sdkerrors.ErrInvalidRequest.Wrap("unbonding delegation is already processed")
+	}
+
+	if entry.Balance.LT(amount) {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"amount %s is greater than the unbonding delegation entry balance %s", amount, entry.Balance,
+		)
+	}
+
+	// the tokens of the entry are in the not bonded pool
+	if _, err := k.Delegate(ctx, delAddr, amount, types.Unbonding, validator, false); err != nil {
+		return err
+	}
+
+	remaining := entry.Balance.Sub(amount)
+	if remaining.IsZero() {
+		ubd.RemoveEntry(int64(entryIndex))
+	} else {
+		entry.Balance = remaining
+		entry.InitialBalance = entry.InitialBalance.Sub(amount)
+		ubd.Entries[entryIndex] = entry
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return nil
+}
+
 // begin unbonding / redelegation; create a redelegation record
 func (k Keeper) BeginRedelegation(
 	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec,
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
@@ -608,6 +609,116 @@ func TestUnbondingAllDelegationFromValidator(t *testing.T) {
 }
 
 // Make sure that that the retrieving the delegations doesn't affect the state
+func TestCancelUnbondingDelegation(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	delCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, delTokens))
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(0))
+	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
+
+	// add bonded tokens to pool for delegations
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, notBondedPool.GetName(), delCoins))
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+
+	// create a bonded validator and a delegation to it
+	validator := teststaking.NewValidator(t, addrVals[0], PKs[0])
+	app.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
+
+	validator, issuedShares := validator.AddTokensFromDel(delTokens)
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	require.True(t, validator.IsBonded())
+
+	delegation := types.NewDelegation(addrDels[0], addrVals[0], issuedShares)
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+
+	// unbond some of the delegation at height 10
+	blockHeight := int64(10)
+	ctx = ctx.WithBlockHeight(blockHeight).WithBlockTime(time.Unix(333, 0))
+	unbondTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
+	_, err := app.StakingKeeper.Undelegate(ctx, addrDels[0], addrVals[0], unbondTokens.ToDec())
+	require.NoError(t, err)
+
+	notBondedBalance := app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom)
+	bondedBalance := app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom)
+
+	// the entry must exist at the creation height
+	err = app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], blockHeight+1, unbondTokens)
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	// the amount cannot exceed the entry balance
+	err = app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], blockHeight, unbondTokens.AddRaw(1))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// cancel part of the entry
+	cancelTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+	require.NoError(t, app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], blockHeight, cancelTokens))
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, unbondTokens.Sub(cancelTokens), ubd.Entries[0].Balance)
+	require.Equal(t, unbondTokens.Sub(cancelTokens), ubd.Entries[0].InitialBalance)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, delTokens.Sub(unbondTokens).Add(cancelTokens), delegation.Shares.RoundInt())
+
+	// the canceled tokens are moved back to the bonded pool
+	require.Equal(t, notBondedBalance.SubAmount(cancelTokens), app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom))
+	require.Equal(t, bondedBalance.AddAmount(cancelTokens), app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom))
+
+	// cancel the rest of the entry, removing the unbonding delegation
+	require.NoError(t, app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], blockHeight, unbondTokens.Sub(cancelTokens)))
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.False(t, found)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, delTokens, delegation.Shares.RoundInt())
+
+	err = app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], blockHeight, cancelTokens)
+	require.ErrorIs(t, err, types.ErrNoUnbondingDelegation)
+
+	// unbond again and slash half of the entry
+	_, err = app.StakingKeeper.Undelegate(ctx, addrDels[0], addrVals[0], unbondTokens.ToDec())
+	require.NoError(t, err)
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	app.StakingKeeper.SlashUnbondingDelegation(ctx, ubd, blockHeight, sdk.NewDecWithPrec(5, 1))
+	slashedTokens := unbondTokens.QuoRaw(2)
+
+	// only the remaining balance of a slashed entry can be canceled
+	err = app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], blockHeight, unbondTokens)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// a mature entry cannot be canceled
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	matureCtx := ctx.WithBlockTime(ubd.Entries[0].CompletionTime)
+	err = app.StakingKeeper.CancelUnbondingDelegation(matureCtx, addrDels[0], addrVals[0], blockHeight, slashedTokens)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// an entry cannot be canceled while the validator is jailed
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	cacheCtx, _ := ctx.CacheContext()
+	app.StakingKeeper.Jail(cacheCtx, consAddr)
+	err = app.StakingKeeper.CancelUnbondingDelegation(cacheCtx, addrDels[0], addrVals[0], blockHeight, slashedTokens)
+	require.ErrorIs(t, err, types.ErrValidatorJailed)
+
+	require.NoError(t, app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], blockHeight, slashedTokens))
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.False(t, found)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, delTokens.Sub(slashedTokens), delegation.Shares.RoundInt())
+}
+
 func TestGetRedelegationsFromSrcValidator(t *testing.T) {
 	_, app, ctx := createTestInput(t)
 
@@ -39,6 +39,8 @@ const (
 	AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE AuthorizationType = 2
 	// AUTHORIZATION_TYPE_REDELEGATE defines an authorization type for Msg/BeginRedelegate
 	AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE AuthorizationType = 3
+	// AUTHORIZATION_TYPE_CANCEL_UNBONDING_DELEGATION defines an authorization type for Msg/CancelUnbondingDelegation
+	AuthorizationType_AUTHORIZATION_TYPE_CANCEL_UNBONDING_DELEGATION AuthorizationType = 4
 )
 
 var AuthorizationType_name = map[int32]string{
@@ -46,13 +48,15 @@ var AuthorizationType_name = map[int32]string{
 	1: "AUTHORIZATION_TYPE_DELEGATE",
 	2: "AUTHORIZATION_TYPE_UNDELEGATE",
 	3: "AUTHORIZATION_TYPE_REDELEGATE",
+	4: "AUTHORIZATION_TYPE_CANCEL_UNBONDING_DELEGATION",
 }
 
 var AuthorizationType_value = map[string]int32{
-	"AUTHORIZATION_TYPE_UNSPECIFIED": 0,
-	"AUTHORIZATION_TYPE_DELEGATE":    1,
-	"AUTHORIZATION_TYPE_UNDELEGATE":  2,
-	"AUTHORIZATION_TYPE_REDELEGATE":  3,
+	"AUTHORIZATION_TYPE_UNSPECIFIED":                 0,
+	"AUTHORIZATION_TYPE_DELEGATE":                    1,
+	"AUTHORIZATION_TYPE_UNDELEGATE":                  2,
+	"AUTHORIZATION_TYPE_REDELEGATE":                  3,
+	"AUTHORIZATION_TYPE_CANCEL_UNBONDING_DELEGATION": 4,
 }
 
 func (x AuthorizationType) String() string {
@@ -228,37 +232,38 @@ func init() {
 }
 
 var fileDescriptor_d6d8cdbc6f4432f0 = []byte{
-	// 476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4d, 0x6e, 0xd3, 0x40,
-	0x18, 0x86, 0xed, 0x06, 0x01, 0xf9, 0xf8, 0x51, 0x33, 0xaa, 0x50, 0x1a, 0x84, 0x5b, 0xb2, 0x21,
-	0xfc, 0x64, 0xac, 0x06, 0xb1, 0x61, 0x85, 0xd3, 0xba, 0x34, 0x52, 0xd5, 0x56, 0x8e, 0x5b, 0x41,
-	0x37, 0xd6, 0x24, 0x1e, 0x39, 0xa3, 0xc4, 0x9e, 0xc8, 0x33, 0x29, 0x49, 0x4f, 0xc1, 0x09, 0x38,
-	0x00, 0xeb, 0x1e, 0x02, 0xb1, 0xaa, 0x58, 0xb1, 0x03, 0x25, 0x12, 0xe7, 0x40, 0xb6, 0xa7, 0x86,
-	0xd2, 0x84, 0x4d, 0x57, 0xb6, 0xfc, 0x3d, 0x7e, 0xde, 0x77, 0xac, 0xcf, 0x50, 0xed, 0x72, 0x11,
-	0x72, 0x61, 0x0a, 0x49, 0xfa, 0x2c, 0x0a, 0xcc, 0x93, 0x8d, 0x0e, 0x95, 0x64, 0xc3, 0x24, 0x23,
-	0xd9, 0x3b, 0xc5, 0xc3, 0x98, 0x4b, 0x8e, 0x1e, 0x64, 0x0c, 0x56, 0x0c, 0x56, 0x4c, 0x65, 0x25,
-	0xe0, 0x01, 0x4f, 0x11, 0x33, 0xb9, 0xcb, 0xe8, 0xca, 0x6a, 0x46, 0x7b, 0xd9, 0x40, 0xbd, 0x9a,
-	0x8d, 0x0c, 0x15, 0xd6, 0x21, 0x82, 0xe6, 0x49, 0x5d, 0xce, 0xa2, 0x6c, 0x5e, 0xfd, 0x55, 0x00,
-	0xd4, 0x96, 0xa4, 0x4f, 0xad, 0x91, 0xec, 0xf1, 0x98, 0x9d, 0x12, 0xc9, 0x78, 0x84, 0x28, 0x40,
-	0x48, 0xc6, 0x9e, 0xe4, 0x7d, 0x1a, 0x89, 0xb2, 0xbe, 0xae, 0xd7, 0xee, 0x34, 0x56, 0xb1, 0x32,
-	0x27, 0xae, 0x8b, 0x46, 0x78, 0x93, 0xb3, 0xa8, 0xf9, 0xfc, 0xf3, 0x8f, 0xb5, 0x27, 0x01, 0x93,
-	0xbd, 0x51, 0x07, 0x77, 0x79, 0xa8, 0x2a, 0xa8, 0x4b, 0x5d, 0xf8, 0x7d, 0x53, 0x4e, 0x86, 0x54,
-	0xa4, 0xb0, 0x53, 0x0c, 0xc9, 0xd8, 0x4d, 0xc5, 0xe8, 0x08, 0x80, 0x0c, 0x06, 0xfc, 0x83, 0x37,
-	0x60, 0x42, 0x96, 0x97, 0xd2, 0x98, 0x57, 0x78, 0xfe, 0xd9, 0xf1, 0xd5, 0x9a, 0xf8, 0x88, 0x0c,
-	0x98, 0x4f, 0x24, 0x8f, 0xc5, 0x8e, 0xe6, 0x14, 0x53, 0xd5, 0x2e, 0x13, 0x12, 0xb9, 0x50, 0xf4,
-	0x69, 0x34, 0xc9, 0xb4, 0x85, 0xeb, 0x69, 0x6f, 0x27, 0xa6, 0xd4, 0xfa, 0x0e, 0x10, 0xf9, 0x9b,
-	0xf3, 0x92, 0x43, 0x95, 0x6f, 0xac, 0xeb, 0xb5, 0xfb, 0x8d, 0xa7, 0x8b, 0xf4, 0x97, 0xcc, 0xee,
-	0x64, 0x48, 0x9d, 0x12, 0xf9, 0xf7, 0x51, 0xe5, 0x0d, 0xc0, 0x9f, 0x4c, 0xd4, 0x80, 0x5b, 0xc4,
-	0xf7, 0x63, 0x2a, 0x92, 0x2f, 0x5f, 0xa8, 0x15, 0x9b, 0xe5, 0x6f, 0x67, 0xf5, 0x15, 0xe5, 0xb7,
-	0xb2, 0x49, 0x5b, 0xc6, 0x2c, 0x0a, 0x9c, 0x0b, 0xf0, 0x75, 0xe9, 0xeb, 0x59, 0xfd, 0xde, 0xa5,
-	0xac, 0xe6, 0x5d, 0x80, 0x93, 0x5c, 0xfa, 0xec, 0x93, 0x0e, 0xa5, 0x2b, 0x5d, 0x50, 0x15, 0x0c,
-	0xeb, 0xd0, 0xdd, 0xd9, 0x77, 0x5a, 0xc7, 0x96, 0xdb, 0xda, 0xdf, 0xf3, 0xdc, 0xf7, 0x07, 0xb6,
-	0x77, 0xb8, 0xd7, 0x3e, 0xb0, 0x37, 0x5b, 0xdb, 0x2d, 0x7b, 0x6b, 0x59, 0x43, 0x6b, 0xf0, 0x70,
-	0x0e, 0xb3, 0x65, 0xef, 0xda, 0x6f, 0x2d, 0xd7, 0x5e, 0xd6, 0xd1, 0x63, 0x78, 0x34, 0x57, 0x92,
-	0x23, 0x4b, 0x0b, 0x10, 0xc7, 0xce, 0x91, 0x42, 0x73, 0xfb, 0xcb, 0xd4, 0xd0, 0xcf, 0xa7, 0x86,
-	0xfe, 0x73, 0x6a, 0xe8, 0x1f, 0x67, 0x86, 0x76, 0x3e, 0x33, 0xb4, 0xef, 0x33, 0x43, 0x3b, 0x7e,
-	0xf1, 0xdf, 0xcd, 0x1a, 0xe7, 0x3f, 0x52, 0xba, 0x63, 0x9d, 0x9b, 0xe9, 0x62, 0xbf, 0xfc, 0x1d,
-	0x00, 0x00, 0xff, 0xff, 0xbd, 0xd5, 0x4d, 0xf3, 0x67, 0x03, 0x00, 0x00,
+	// 495 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4d, 0x6f, 0xd3, 0x30,
+	0x1c, 0xc6, 0x93, 0x75, 0x02, 0x6a, 0x5e, 0xd4, 0x5a, 0x13, 0xea, 0x8a, 0xc8, 0x46, 0x2f, 0x94,
+	0x97, 0x3a, 0x5a, 0x11, 0x17, 0x4e, 0x24, 0x6d, 0xb6, 0x45, 0xaa, 0xd2, 0x29, 0x4d, 0x27, 0xd8,
+	0x25, 0x72, 0x1b, 0x2b, 0xb5, 0xda, 0xc6, 0x55, 0xec, 0x8e, 0x76, 0x9f, 0x82, 0xcf, 0xc1, 0x79,
+	0x1f, 0x02, 0x71, 0x9a, 0x76, 0xe2, 0x06, 0x6a, 0x25, 0x3e, 0x07, 0xca, 0x4b, 0x03, 0x63, 0x1d,
+	0x97, 0x9d, 0x12, 0xe5, 0xff, 0xcb, 0xef, 0x79, 0x6c, 0xd9, 0xa0, 0xd2, 0x67, 0x7c, 0xcc, 0xb8,
+	0xca, 0x05, 0x1e, 0xd2, 0xc0, 0x57, 0x4f, 0xf7, 0x7a, 0x44, 0xe0, 0x3d, 0x15, 0x4f, 0xc5, 0xe0,
+	0x0c, 0x4d, 0x42, 0x26, 0x18, 0x7c, 0x9c, 0x30, 0x28, 0x65, 0x50, 0xca, 0x94, 0xb7, 0x7c, 0xe6,
+	0xb3, 0x18, 0x51, 0xa3, 0xb7, 0x84, 0x2e, 0x6f, 0x27, 0xb4, 0x9b, 0x0c, 0xd2, 0x5f, 0x93, 0x91,
+	0x92, 0x86, 0xf5, 0x30, 0x27, 0x59, 0x52, 0x9f, 0xd1, 0x20, 0x99, 0x57, 0x7e, 0xe5, 0x00, 0xec,
+	0x08, 0x3c, 0x24, 0xda, 0x54, 0x0c, 0x58, 0x48, 0xcf, 0xb0, 0xa0, 0x2c, 0x80, 0x04, 0x80, 0x31,
+	0x9e, 0xb9, 0x82, 0x0d, 0x49, 0xc0, 0x4b, 0xf2, 0xae, 0x5c, 0xbd, 0x5f, 0xdf, 0x46, 0xa9, 0x39,
+	0x72, 0xad, 0x1a, 0xa1, 0x06, 0xa3, 0x81, 0xfe, 0xea, 0xcb, 0x8f, 0x9d, 0xe7, 0x3e, 0x15, 0x83,
+	0x69, 0x0f, 0xf5, 0xd9, 0x38, 0xad, 0x90, 0x3e, 0x6a, 0xdc, 0x1b, 0xaa, 0x62, 0x3e, 0x21, 0x3c,
+	0x86, 0xed, 0xfc, 0x18, 0xcf, 0x9c, 0x58, 0x0c, 0x8f, 0x01, 0xc0, 0xa3, 0x11, 0xfb, 0xe4, 0x8e,
+	0x28, 0x17, 0xa5, 0x8d, 0x38, 0xe6, 0x2d, 0x5a, 0xbf, 0x76, 0x74, 0xbd, 0x26, 0x3a, 0xc6, 0x23,
+	0xea, 0x61, 0xc1, 0x42, 0x7e, 0x28, 0xd9, 0xf9, 0x58, 0xd5, 0xa2, 0x5c, 0x40, 0x07, 0xe4, 0x3d,
+	0x12, 0xcc, 0x13, 0x6d, 0xee, 0x76, 0xda, 0x7b, 0x91, 0x29, 0xb6, 0x7e, 0x00, 0x10, 0xff, 0xcd,
+	0xb9, 0xd1, 0xa2, 0x4a, 0x9b, 0xbb, 0x72, 0xf5, 0x51, 0xfd, 0xc5, 0x4d, 0xfa, 0x2b, 0x66, 0x67,
+	0x3e, 0x21, 0x76, 0x11, 0xff, 0xfb, 0xa9, 0xfc, 0x1e, 0x80, 0x3f, 0x99, 0xb0, 0x0e, 0xee, 0x62,
+	0xcf, 0x0b, 0x09, 0x8f, 0x76, 0x3e, 0x57, 0xcd, 0xeb, 0xa5, 0xcb, 0xf3, 0xda, 0x56, 0xea, 0xd7,
+	0x92, 0x49, 0x47, 0x84, 0x34, 0xf0, 0xed, 0x15, 0xf8, 0xae, 0xf8, 0xed, 0xbc, 0xf6, 0xf0, 0x4a,
+	0x96, 0xfe, 0x00, 0x80, 0xd3, 0x4c, 0xfa, 0xf2, 0x52, 0x06, 0xc5, 0x6b, 0x5d, 0x60, 0x05, 0x28,
+	0x5a, 0xd7, 0x39, 0x6c, 0xdb, 0xe6, 0x89, 0xe6, 0x98, 0x6d, 0xcb, 0x75, 0x3e, 0x1e, 0x19, 0x6e,
+	0xd7, 0xea, 0x1c, 0x19, 0x0d, 0x73, 0xdf, 0x34, 0x9a, 0x05, 0x09, 0xee, 0x80, 0x27, 0x6b, 0x98,
+	0xa6, 0xd1, 0x32, 0x0e, 0x34, 0xc7, 0x28, 0xc8, 0xf0, 0x19, 0x78, 0xba, 0x56, 0x92, 0x21, 0x1b,
+	0x37, 0x20, 0xb6, 0x91, 0x21, 0x39, 0x58, 0x07, 0x68, 0x0d, 0xd2, 0xd0, 0xac, 0x86, 0xd1, 0x72,
+	0xbb, 0x96, 0xde, 0xb6, 0x9a, 0xa6, 0x75, 0xb0, 0xca, 0x35, 0xdb, 0x56, 0x61, 0x53, 0xdf, 0xff,
+	0xba, 0x50, 0xe4, 0x8b, 0x85, 0x22, 0xff, 0x5c, 0x28, 0xf2, 0xe7, 0xa5, 0x22, 0x5d, 0x2c, 0x15,
+	0xe9, 0xfb, 0x52, 0x91, 0x4e, 0x5e, 0xff, 0xf7, 0x34, 0xce, 0xb2, 0xcb, 0x17, 0x9f, 0xcb, 0xde,
+	0x9d, 0xf8, 0x32, 0xbc, 0xf9, 0x3d, 0x00, 0x23, 0x47, 0x8f, 0xad, 0x9b, 0x03, 0x00, 0x00,
 }
 
 func (m *StakeAuthorization) Marshal() (dAtA []byte, err error) {
@@ -0,0 +1,24 @@
+package tokenfactory
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate performs a basic validation of the authority metadata.
+func (m DenomAuthorityMetadata) Validate() error {
+	if m.Admin == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAdmin, "invalid admin address %s: %s", m.Admin, err)
+	}
+
+	return nil
+}
+
+// IsAdmin returns true if addr is the admin of the denom.
+func (m DenomAuthorityMetadata) IsAdmin(addr sdk.AccAddress) bool {
+	return m.Admin != "" && m.Admin == addr.String()
+}
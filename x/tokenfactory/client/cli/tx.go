@@ -0,0 +1,234 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+// Flag names and values
+const (
+	FlagMintTo = "mint-to"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	tokenfactoryTxCmd := &cobra.Command{
+		Use:                        tokenfactory.ModuleName,
+		Short:                      "tokenfactory transactions subcommands",
+		Long:                       "Provides the creation and administration of factory/{creator}/{subdenom} denoms",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	tokenfactoryTxCmd.AddCommand(
+		NewCmdCreateDenom(),
+		NewCmdMint(),
+		NewCmdBurn(),
+		NewCmdChangeAdmin(),
+		NewCmdSetDenomMetadata(),
+		NewCmdForceTransfer(),
+	)
+
+	return tokenfactoryTxCmd
+}
+
+func NewCmdCreateDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-denom [subdenom] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "create a new factory/{sender}/{subdenom} denom administered by the sender, for the denom creation fee",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s create-denom <subdenom> --from <sender> --chain-id <chain-id>`, version.AppName, tokenfactory.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := tokenfactory.NewMsgCreateDenom(clientCtx.GetFromAddress().String(), args[0])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [amount] --from [admin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "mint coins of a factory denom, to the admin or to the --mint-to address",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s mint 1000factory/<creator>/<subdenom> --mint-to <address> --from <admin> --chain-id <chain-id>`, version.AppName, tokenfactory.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			mintTo, err := cmd.Flags().GetString(FlagMintTo)
+			if err != nil {
+				return err
+			}
+
+			msg := tokenfactory.NewMsgMint(clientCtx.GetFromAddress().String(), amount, mintTo)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMintTo, "", "The address receiving the minted coins, the admin by default")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [amount] --from [admin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "burn coins of a factory denom from the admin account",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s burn 1000factory/<creator>/<subdenom> --from <admin> --chain-id <chain-id>`, version.AppName, tokenfactory.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := tokenfactory.NewMsgBurn(clientCtx.GetFromAddress().String(), amount)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdChangeAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-admin [denom] [new-admin] --from [admin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "change the admin of a factory denom, an empty new admin renouncing its administration",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s change-admin factory/<creator>/<subdenom> <new-admin> --from <admin> --chain-id <chain-id>
+
+Renounce the administration of the denom for good with:
+			$ %s tx %s change-admin factory/<creator>/<subdenom> "" --from <admin> --chain-id <chain-id>`,
+			version.AppName, tokenfactory.ModuleName, version.AppName, tokenfactory.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := tokenfactory.NewMsgChangeAdmin(clientCtx.GetFromAddress().String(), args[0], args[1])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdSetDenomMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [metadata-file] --from [admin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "set the bank metadata of a factory denom from a JSON file",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s set-denom-metadata metadata.json --from <admin> --chain-id <chain-id>
+
+Where metadata.json contains:
+{
+  "description": "The token of the project",
+  "denom_units": [
+    {"denom": "factory/<creator>/<subdenom>", "exponent": 0},
+    {"denom": "token", "exponent": 6}
+  ],
+  "base": "factory/<creator>/<subdenom>",
+  "display": "token",
+  "name": "Token",
+  "symbol": "TKN"
+}`, version.AppName, tokenfactory.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var metadata banktypes.Metadata
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &metadata); err != nil {
+				return err
+			}
+
+			msg := &tokenfactory.MsgSetDenomMetadata{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Metadata: metadata,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdForceTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "force-transfer [amount] [transfer-from] [transfer-to] --from [admin]",
+		Args:  cobra.ExactArgs(3),
+		Short: "transfer coins of a factory denom between two accounts, without the consent of the sending account",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s force-transfer 1000factory/<creator>/<subdenom> <transfer-from> <transfer-to> --from <admin> --chain-id <chain-id>`, version.AppName, tokenfactory.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := tokenfactory.NewMsgForceTransfer(clientCtx.GetFromAddress().String(), amount, args[1], args[2])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,84 @@
+package tokenfactory
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// ModuleDenomPrefix is the prefix of all the denoms created by the
+	// tokenfactory module.
+	ModuleDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of a subdenom.
+	MaxSubdenomLength = 44
+
+	// MaxCreatorLength is the maximum length of the bech32 address of a
+	// creator, so that any factory denom fits in the 128 characters allowed
+	// for a denom.
+	MaxCreatorLength = 128 - len(ModuleDenomPrefix) - MaxSubdenomLength - 2
+)
+
+// GetTokenDenom returns the factory/{creator}/{subdenom} denom created by the
+// given creator with the given subdenom.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if subdenom == "" {
+		return "", sdkerrors.Wrap(ErrInvalidSubdenom, "subdenom cannot be empty")
+	}
+
+	if len(subdenom) > MaxSubdenomLength {
+		return "", sdkerrors.Wrapf(ErrInvalidSubdenom, "subdenom too long, max length is %d bytes", MaxSubdenomLength)
+	}
+
+	if len(creator) > MaxCreatorLength {
+		return "", sdkerrors.Wrapf(ErrInvalidCreator, "creator too long, max length is %d bytes", MaxCreatorLength)
+	}
+
+	if strings.Contains(creator, "/") {
+		return "", sdkerrors.Wrapf(ErrInvalidCreator, "creator cannot contain '/': %s", creator)
+	}
+
+	denom := strings.Join([]string{ModuleDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructDenom returns the creator and the subdenom of a denom created by
+// the tokenfactory module. It returns an error if the denom is not a valid
+// factory/{creator}/{subdenom} denom.
+func DeconstructDenom(denom string) (creator, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) < 3 {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenom, "not enough parts in denom %s", denom)
+	}
+
+	if parts[0] != ModuleDenomPrefix {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenom, "denom %s does not start with the %s prefix", denom, ModuleDenomPrefix)
+	}
+
+	creator = parts[1]
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenom, "invalid creator address %s: %s", creator, err)
+	}
+
+	// the subdenom may itself contain slashes
+	subdenom = parts[2]
+	if subdenom == "" {
+		return "", "", sdkerrors.Wrap(ErrInvalidSubdenom, "subdenom cannot be empty")
+	}
+
+	if len(subdenom) > MaxSubdenomLength {
+		return "", "", sdkerrors.Wrapf(ErrInvalidSubdenom, "subdenom too long, max length is %d bytes", MaxSubdenomLength)
+	}
+
+	return creator, subdenom, nil
+}
@@ -0,0 +1,76 @@
+package tokenfactory_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+var creator = sdk.AccAddress([]byte("creator_____________")).String()
+
+func TestGetTokenDenom(t *testing.T) {
+	testCases := []struct {
+		name     string
+		creator  string
+		subdenom string
+		expDenom string
+		expErr   error
+	}{
+		{"valid", creator, "bitcoin", "factory/" + creator + "/bitcoin", nil},
+		{"subdenom with slashes", creator, "bitcoin/1", "factory/" + creator + "/bitcoin/1", nil},
+		{"empty subdenom", creator, "", "", tokenfactory.ErrInvalidSubdenom},
+		{"subdenom too long", creator, strings.Repeat("a", tokenfactory.MaxSubdenomLength+1), "", tokenfactory.ErrInvalidSubdenom},
+		{"creator too long", strings.Repeat("a", tokenfactory.MaxCreatorLength+1), "bitcoin", "", tokenfactory.ErrInvalidCreator},
+		{"creator with slash", "cosmos/creator", "bitcoin", "", tokenfactory.ErrInvalidCreator},
+		{"invalid characters", creator, "bit coin", "", tokenfactory.ErrInvalidDenom},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			denom, err := tokenfactory.GetTokenDenom(tc.creator, tc.subdenom)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expDenom, denom)
+		})
+	}
+}
+
+func TestDeconstructDenom(t *testing.T) {
+	testCases := []struct {
+		name        string
+		denom       string
+		expCreator  string
+		expSubdenom string
+		expErr      error
+	}{
+		{"valid", "factory/" + creator + "/bitcoin", creator, "bitcoin", nil},
+		{"subdenom with slashes", "factory/" + creator + "/bitcoin/1", creator, "bitcoin/1", nil},
+		{"not a factory denom", "stake", "", "", tokenfactory.ErrInvalidDenom},
+		{"missing subdenom", "factory/" + creator, "", "", tokenfactory.ErrInvalidDenom},
+		{"empty subdenom", "factory/" + creator + "/", "", "", tokenfactory.ErrInvalidSubdenom},
+		{"wrong prefix", "ibc/" + creator + "/bitcoin", "", "", tokenfactory.ErrInvalidDenom},
+		{"invalid creator", "factory/creator/bitcoin", "", "", tokenfactory.ErrInvalidDenom},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			creator, subdenom, err := tokenfactory.DeconstructDenom(tc.denom)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expCreator, creator)
+			require.Equal(t, tc.expSubdenom, subdenom)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package tokenfactory
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/tokenfactory module sentinel errors
+var (
+	ErrInvalidDenom          = sdkerrors.Register(ModuleName, 2, "invalid denom")
+	ErrInvalidSubdenom       = sdkerrors.Register(ModuleName, 3, "invalid subdenom")
+	ErrInvalidCreator        = sdkerrors.Register(ModuleName, 4, "invalid creator")
+	ErrDenomExists           = sdkerrors.Register(ModuleName, 5, "denom already exists")
+	ErrDenomNotExists        = sdkerrors.Register(ModuleName, 6, "denom does not exist")
+	ErrUnauthorized          = sdkerrors.Register(ModuleName, 7, "unauthorized account")
+	ErrInvalidMetadata       = sdkerrors.Register(ModuleName, 8, "invalid denom metadata")
+	ErrInvalidAdmin          = sdkerrors.Register(ModuleName, 9, "invalid admin")
+	ErrModuleAccount         = sdkerrors.Register(ModuleName, 10, "cannot force transfer from a module account")
+	ErrForceTransferDisabled = sdkerrors.Register(ModuleName, 11, "force transfers are disabled")
+)
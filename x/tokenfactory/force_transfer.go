@@ -0,0 +1,22 @@
+package tokenfactory
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type forceTransferKey struct{}
+
+// WithForceTransfer returns a context marking the transfers made with it as
+// forced by the admin of a factory denom. Force transfers go through the bank
+// send restrictions like any other transfer, which can use IsForceTransfer to
+// let them through.
+func WithForceTransfer(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(forceTransferKey{}, true)
+}
+
+// IsForceTransfer returns true if the transfers made with ctx are forced by
+// the admin of a factory denom.
+func IsForceTransfer(ctx sdk.Context) bool {
+	forced, _ := ctx.Value(forceTransferKey{}).(bool)
+	return forced
+}
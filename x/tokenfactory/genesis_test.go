@@ -0,0 +1,50 @@
+package tokenfactory_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	denom := tokenfactory.GenesisDenom{
+		Denom:             "factory/" + creator + "/bitcoin",
+		AuthorityMetadata: tokenfactory.DenomAuthorityMetadata{Admin: creator},
+	}
+
+	testCases := []struct {
+		name     string
+		genesis  *tokenfactory.GenesisState
+		expValid bool
+	}{
+		{"default", tokenfactory.DefaultGenesisState(), true},
+		{"valid denoms", tokenfactory.NewGenesisState(tokenfactory.DefaultParams(), []tokenfactory.GenesisDenom{
+			denom,
+			{Denom: "factory/" + creator + "/atom"},
+		}), true},
+		{"invalid params", tokenfactory.NewGenesisState(tokenfactory.Params{
+			DenomCreationFee: sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}},
+		}, nil), false},
+		{"duplicate denom", tokenfactory.NewGenesisState(tokenfactory.DefaultParams(), []tokenfactory.GenesisDenom{denom, denom}), false},
+		{"non factory denom", tokenfactory.NewGenesisState(tokenfactory.DefaultParams(), []tokenfactory.GenesisDenom{{Denom: "stake"}}), false},
+		{"invalid admin", tokenfactory.NewGenesisState(tokenfactory.DefaultParams(), []tokenfactory.GenesisDenom{{
+			Denom:             denom.Denom,
+			AuthorityMetadata: tokenfactory.DenomAuthorityMetadata{Admin: "admin"},
+		}}), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tokenfactory.ValidateGenesis(*tc.genesis)
+			if tc.expValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
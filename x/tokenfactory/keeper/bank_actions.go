@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+// MintTo mints amount and sends it to the mintTo address, which can't be a
+// blocked address.
+func (k Keeper) MintTo(ctx sdk.Context, amount sdk.Coin, mintTo sdk.AccAddress) error {
+	if k.bankKeeper.BlockedAddr(mintTo) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", mintTo)
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.MintCoins(ctx, tokenfactory.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, tokenfactory.ModuleName, mintTo, coins)
+}
+
+// BurnFrom burns amount from the burnFrom address.
+func (k Keeper) BurnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom sdk.AccAddress) error {
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, burnFrom, tokenfactory.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, tokenfactory.ModuleName, coins)
+}
+
+// ForceTransfer transfers amount from the from address to the to address,
+// without the consent of the from address. The transfer goes through the bank
+// send restrictions and hooks with a context marked by
+// tokenfactory.WithForceTransfer. Module accounts can't be force transferred
+// from, and blocked addresses can't receive force transfers.
+func (k Keeper) ForceTransfer(ctx sdk.Context, amount sdk.Coin, from, to sdk.AccAddress) error {
+	if _, ok := k.accountKeeper.GetAccount(ctx, from).(authtypes.ModuleAccountI); ok {
+		return sdkerrors.Wrap(tokenfactory.ErrModuleAccount, from.String())
+	}
+
+	if k.bankKeeper.BlockedAddr(to) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", to)
+	}
+
+	return k.bankKeeper.SendCoins(tokenfactory.WithForceTransfer(ctx), from, to, sdk.NewCoins(amount))
+}
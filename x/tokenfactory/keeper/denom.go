@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+// CreateDenom creates the factory/{creator}/{subdenom} denom, administered by
+// the creator, and charges the creator the denom creation fee. The bank
+// metadata of the denom is initialized with a single unit, the denom itself.
+func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	denom, err := tokenfactory.GetTokenDenom(creator.String(), subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	if k.HasDenom(ctx, denom) || k.bankKeeper.HasDenomMetaData(ctx, denom) {
+		return "", sdkerrors.Wrap(tokenfactory.ErrDenomExists, denom)
+	}
+
+	if fee := k.GetParams(ctx).DenomCreationFee; !fee.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, fee, creator); err != nil {
+			return "", err
+		}
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:       denom,
+		Display:    denom,
+		Name:       denom,
+		Symbol:     subdenom,
+	})
+
+	if err := k.saveDenom(ctx, denom, tokenfactory.DenomAuthorityMetadata{Admin: creator.String()}); err != nil {
+		return "", err
+	}
+
+	return denom, nil
+}
+
+// saveDenom stores the authority metadata of a new denom and indexes it by
+// its creator.
+func (k Keeper) saveDenom(ctx sdk.Context, denom string, metadata tokenfactory.DenomAuthorityMetadata) error {
+	creator, _, err := tokenfactory.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	creatorAddr, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return err
+	}
+
+	if err := k.SetAuthorityMetadata(ctx, denom, metadata); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(creatorDenomStoreKey(creatorAddr, denom), Placeholder)
+	return nil
+}
+
+// HasDenom returns true if the denom was created by the tokenfactory module.
+func (k Keeper) HasDenom(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(denomAuthorityMetadataStoreKey(denom))
+}
+
+// GetAuthorityMetadata returns the authority metadata of a denom created by
+// the tokenfactory module.
+func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (tokenfactory.DenomAuthorityMetadata, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(denomAuthorityMetadataStoreKey(denom))
+	if bz == nil {
+		return tokenfactory.DenomAuthorityMetadata{}, false
+	}
+
+	var metadata tokenfactory.DenomAuthorityMetadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+	return metadata, true
+}
+
+// SetAuthorityMetadata sets the authority metadata of a denom.
+func (k Keeper) SetAuthorityMetadata(ctx sdk.Context, denom string, metadata tokenfactory.DenomAuthorityMetadata) error {
+	if err := metadata.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(denomAuthorityMetadataStoreKey(denom), k.cdc.MustMarshal(&metadata))
+	return nil
+}
+
+// IterateAuthorityMetadata iterates over the denoms created by the
+// tokenfactory module along with their authority metadata, until cb returns
+// true.
+func (k Keeper) IterateAuthorityMetadata(ctx sdk.Context, cb func(denom string, metadata tokenfactory.DenomAuthorityMetadata) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), DenomAuthorityMetadataKey)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata tokenfactory.DenomAuthorityMetadata
+		k.cdc.MustUnmarshal(iterator.Value(), &metadata)
+
+		if cb(string(iterator.Key()), metadata) {
+			break
+		}
+	}
+}
+
+// GetDenomsFromCreator returns the denoms created by creator.
+func (k Keeper) GetDenomsFromCreator(ctx sdk.Context, creator sdk.AccAddress) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), prefixCreatorDenomStoreKey(creator))
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var denoms []string
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()))
+	}
+
+	return denoms
+}
+
+// getAdminAuthorization returns the authority metadata of denom, or an error
+// if the denom was not created by the tokenfactory module or if addr is not
+// its admin.
+func (k Keeper) getAdminAuthorization(ctx sdk.Context, addr sdk.AccAddress, denom string) (tokenfactory.DenomAuthorityMetadata, error) {
+	metadata, found := k.GetAuthorityMetadata(ctx, denom)
+	if !found {
+		return tokenfactory.DenomAuthorityMetadata{}, sdkerrors.Wrap(tokenfactory.ErrDenomNotExists, denom)
+	}
+
+	if !metadata.IsAdmin(addr) {
+		return tokenfactory.DenomAuthorityMetadata{}, sdkerrors.Wrapf(tokenfactory.ErrUnauthorized, "%s is not the admin of %s", addr, denom)
+	}
+
+	return metadata, nil
+}
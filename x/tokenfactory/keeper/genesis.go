@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+// InitGenesis initializes the tokenfactory module's state from a given
+// genesis state. The bank metadata of the denoms is part of the bank genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *tokenfactory.GenesisState) {
+	k.SetParams(ctx, data.Params)
+
+	for _, denom := range data.FactoryDenoms {
+		if err := k.saveDenom(ctx, denom.Denom, denom.AuthorityMetadata); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *tokenfactory.GenesisState {
+	var denoms []tokenfactory.GenesisDenom
+	k.IterateAuthorityMetadata(ctx, func(denom string, metadata tokenfactory.DenomAuthorityMetadata) bool {
+		denoms = append(denoms, tokenfactory.GenesisDenom{
+			Denom:             denom,
+			AuthorityMetadata: metadata,
+		})
+		return false
+	})
+
+	return tokenfactory.NewGenesisState(k.GetParams(ctx), denoms)
+}
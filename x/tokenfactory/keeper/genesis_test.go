@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+func (s *TestSuite) TestExportAndInitGenesis() {
+	k := s.app.TokenFactoryKeeper
+	denom := s.createDenom()
+	renounced, err := k.CreateDenom(s.ctx, s.addrs[1], testSubdenom)
+	s.Require().NoError(err)
+	s.Require().NoError(k.SetAuthorityMetadata(s.ctx, renounced, tokenfactory.DenomAuthorityMetadata{}))
+
+	genesis := k.ExportGenesis(s.ctx)
+	s.Require().Len(genesis.FactoryDenoms, 2)
+	s.Require().NoError(tokenfactory.ValidateGenesis(*genesis))
+
+	// round trip through JSON into a fresh app
+	cdc := s.app.AppCodec()
+	bz := cdc.MustMarshalJSON(genesis)
+	var imported tokenfactory.GenesisState
+	cdc.MustUnmarshalJSON(bz, &imported)
+
+	s.SetupTest()
+	k = s.app.TokenFactoryKeeper
+	k.InitGenesis(s.ctx, &imported)
+	s.Require().Equal(bz, cdc.MustMarshalJSON(k.ExportGenesis(s.ctx)))
+
+	metadata, found := k.GetAuthorityMetadata(s.ctx, denom)
+	s.Require().True(found)
+	s.Require().Equal(s.addrs[0].String(), metadata.Admin)
+	s.Require().Equal([]string{renounced}, k.GetDenomsFromCreator(s.ctx, s.addrs[1]))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, tokenfactory.DefaultDenomCreationFeeAmount)), imported.Params.DenomCreationFee)
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+var _ tokenfactory.QueryServer = Keeper{}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(goCtx context.Context, req *tokenfactory.QueryParamsRequest) (*tokenfactory.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &tokenfactory.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// DenomAuthorityMetadata implements the Query/DenomAuthorityMetadata gRPC method
+func (k Keeper) DenomAuthorityMetadata(goCtx context.Context, req *tokenfactory.QueryDenomAuthorityMetadataRequest) (*tokenfactory.QueryDenomAuthorityMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	denom, err := tokenfactory.GetTokenDenom(req.Creator, req.Subdenom)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	metadata, found := k.GetAuthorityMetadata(ctx, denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "denom %s does not exist", denom)
+	}
+
+	return &tokenfactory.QueryDenomAuthorityMetadataResponse{AuthorityMetadata: metadata}, nil
+}
+
+// DenomsFromCreator implements the Query/DenomsFromCreator gRPC method
+func (k Keeper) DenomsFromCreator(goCtx context.Context, req *tokenfactory.QueryDenomsFromCreatorRequest) (*tokenfactory.QueryDenomsFromCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	creator, err := sdk.AccAddressFromBech32(req.Creator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), prefixCreatorDenomStoreKey(creator))
+
+	var denoms []string
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		denoms = append(denoms, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &tokenfactory.QueryDenomsFromCreatorResponse{Denoms: denoms, Pagination: pageRes}, nil
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+func (s *TestSuite) TestQueryParams() {
+	res, err := s.queryClient.Params(gocontext.Background(), &tokenfactory.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(s.app.TokenFactoryKeeper.GetParams(s.ctx), res.Params)
+}
+
+func (s *TestSuite) TestQueryDenomAuthorityMetadata() {
+	denom := s.createDenom()
+
+	testCases := []struct {
+		msg      string
+		req      *tokenfactory.QueryDenomAuthorityMetadataRequest
+		expError string
+	}{
+		{
+			"fail empty subdenom",
+			&tokenfactory.QueryDenomAuthorityMetadataRequest{Creator: s.addrs[0].String()},
+			"invalid subdenom",
+		},
+		{
+			"fail denom not exists",
+			&tokenfactory.QueryDenomAuthorityMetadataRequest{Creator: s.addrs[1].String(), Subdenom: testSubdenom},
+			"does not exist",
+		},
+		{
+			"success",
+			&tokenfactory.QueryDenomAuthorityMetadataRequest{Creator: s.addrs[0].String(), Subdenom: testSubdenom},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			res, err := s.queryClient.DenomAuthorityMetadata(gocontext.Background(), tc.req)
+			if tc.expError != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expError)
+				return
+			}
+			s.Require().NoError(err)
+			expected, _ := s.app.TokenFactoryKeeper.GetAuthorityMetadata(s.ctx, denom)
+			s.Require().Equal(expected, res.AuthorityMetadata)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryDenomsFromCreator() {
+	k := s.app.TokenFactoryKeeper
+	for _, subdenom := range []string{"atom", "bitcoin", "cosmos"} {
+		_, err := k.CreateDenom(s.ctx, s.addrs[0], subdenom)
+		s.Require().NoError(err)
+	}
+
+	_, err := s.queryClient.DenomsFromCreator(gocontext.Background(), &tokenfactory.QueryDenomsFromCreatorRequest{Creator: "creator"})
+	s.Require().Error(err)
+
+	res, err := s.queryClient.DenomsFromCreator(gocontext.Background(), &tokenfactory.QueryDenomsFromCreatorRequest{Creator: s.addrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Denoms)
+
+	res, err = s.queryClient.DenomsFromCreator(gocontext.Background(), &tokenfactory.QueryDenomsFromCreatorRequest{
+		Creator:    s.addrs[0].String(),
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(k.GetDenomsFromCreator(s.ctx, s.addrs[0])[:2], res.Denoms)
+	s.Require().Equal(uint64(3), res.Pagination.Total)
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+// Keeper of the tokenfactory store
+type Keeper struct {
+	storeKey   storetypes.StoreKey
+	cdc        codec.BinaryCodec
+	paramSpace paramtypes.Subspace
+
+	accountKeeper tokenfactory.AccountKeeper
+	bankKeeper    tokenfactory.BankKeeper
+	distrKeeper   tokenfactory.DistrKeeper
+}
+
+// NewKeeper creates a new tokenfactory Keeper instance. The denom creation
+// fees are sent to the community pool through the distrKeeper.
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	ak tokenfactory.AccountKeeper, bk tokenfactory.BankKeeper, dk tokenfactory.DistrKeeper,
+) Keeper {
+	// ensure the tokenfactory module account is set
+	if addr := ak.GetModuleAddress(tokenfactory.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", tokenfactory.ModuleName))
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(tokenfactory.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		paramSpace:    paramSpace,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		distrKeeper:   dk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", tokenfactory.ModuleName))
+}
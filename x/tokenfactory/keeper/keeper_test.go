@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmtime "github.com/tendermint/tendermint/libs/time"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+const testSubdenom = "bitcoin"
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	queryClient tokenfactory.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: tmtime.Now()})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	tokenfactory.RegisterQueryServer(queryHelper, app.TokenFactoryKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.queryClient = tokenfactory.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) TestCreateDenom() {
+	k := s.app.TokenFactoryKeeper
+	fee := k.GetParams(s.ctx).DenomCreationFee
+	balance := s.app.BankKeeper.GetAllBalances(s.ctx, s.addrs[0])
+	communityPool := s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx)
+
+	denom, err := k.CreateDenom(s.ctx, s.addrs[0], testSubdenom)
+	s.Require().NoError(err)
+	s.Require().Equal("factory/"+s.addrs[0].String()+"/"+testSubdenom, denom)
+
+	s.T().Log("verify the creation fee is sent to the community pool")
+	s.Require().Equal(balance.Sub(fee), s.app.BankKeeper.GetAllBalances(s.ctx, s.addrs[0]))
+	s.Require().Equal(communityPool.Add(sdk.NewDecCoinsFromCoins(fee...)...), s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx))
+
+	s.T().Log("verify the creator is the admin of the denom")
+	metadata, found := k.GetAuthorityMetadata(s.ctx, denom)
+	s.Require().True(found)
+	s.Require().Equal(s.addrs[0].String(), metadata.Admin)
+	s.Require().Equal([]string{denom}, k.GetDenomsFromCreator(s.ctx, s.addrs[0]))
+
+	s.T().Log("verify the bank metadata of the denom is initialized")
+	bankMetadata, found := s.app.BankKeeper.GetDenomMetaData(s.ctx, denom)
+	s.Require().True(found)
+	s.Require().Equal(denom, bankMetadata.Base)
+	s.Require().Equal(testSubdenom, bankMetadata.Symbol)
+
+	_, err = k.CreateDenom(s.ctx, s.addrs[0], testSubdenom)
+	s.Require().ErrorIs(err, tokenfactory.ErrDenomExists)
+
+	_, err = k.CreateDenom(s.ctx, s.addrs[0], "")
+	s.Require().ErrorIs(err, tokenfactory.ErrInvalidSubdenom)
+}
+
+func (s *TestSuite) TestCreateDenomInsufficientFee() {
+	k := s.app.TokenFactoryKeeper
+	poor := sdk.AccAddress([]byte("poor________________"))
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, poor))
+
+	_, err := k.CreateDenom(s.ctx, poor, testSubdenom)
+	s.Require().Error(err)
+	s.Require().False(k.HasDenom(s.ctx, "factory/"+poor.String()+"/"+testSubdenom))
+
+	s.T().Log("verify denoms can be created for free when the fee is zero")
+	k.SetParams(s.ctx, tokenfactory.NewParams(nil, false))
+	_, err = k.CreateDenom(s.ctx, poor, testSubdenom)
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestGetDenomsFromCreator() {
+	k := s.app.TokenFactoryKeeper
+
+	s.Require().Empty(k.GetDenomsFromCreator(s.ctx, s.addrs[0]))
+
+	var expected []string
+	for _, subdenom := range []string{"atom", "bitcoin", "cosmos"} {
+		denom, err := k.CreateDenom(s.ctx, s.addrs[0], subdenom)
+		s.Require().NoError(err)
+		expected = append(expected, denom)
+	}
+	_, err := k.CreateDenom(s.ctx, s.addrs[1], testSubdenom)
+	s.Require().NoError(err)
+
+	s.Require().Equal(expected, k.GetDenomsFromCreator(s.ctx, s.addrs[0]))
+}
+
+func (s *TestSuite) createDenom() string {
+	denom, err := s.app.TokenFactoryKeeper.CreateDenom(s.ctx, s.addrs[0], testSubdenom)
+	s.Require().NoError(err)
+	return denom
+}
+
+func (s *TestSuite) enableForceTransfer() {
+	params := s.app.TokenFactoryKeeper.GetParams(s.ctx)
+	params.EnableForceTransfer = true
+	s.app.TokenFactoryKeeper.SetParams(s.ctx, params)
+}
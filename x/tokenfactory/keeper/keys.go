@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/internal/conv"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+var (
+	DenomAuthorityMetadataKey = []byte{0x01}
+	CreatorDenomKey           = []byte{0x02}
+
+	Placeholder = []byte{0x01}
+)
+
+// StoreKey is the store key string for tokenfactory
+const StoreKey = tokenfactory.ModuleName
+
+// denomAuthorityMetadataStoreKey returns the byte representation of the
+// authority metadata key of a denom
+// Items are stored with the following key: values
+// 0x01<denom>
+func denomAuthorityMetadataStoreKey(denom string) []byte {
+	denomBz := conv.UnsafeStrToBytes(denom)
+
+	key := make([]byte, len(DenomAuthorityMetadataKey)+len(denomBz))
+	copy(key, DenomAuthorityMetadataKey)
+	copy(key[len(DenomAuthorityMetadataKey):], denomBz)
+	return key
+}
+
+// creatorDenomStoreKey returns the byte representation of the key indexing a
+// denom by its creator
+// Items are stored with the following key: values
+// 0x02<creator(len prefixed)><denom>
+func creatorDenomStoreKey(creator sdk.AccAddress, denom string) []byte {
+	prefix := prefixCreatorDenomStoreKey(creator)
+	denomBz := conv.UnsafeStrToBytes(denom)
+
+	key := make([]byte, len(prefix)+len(denomBz))
+	copy(key, prefix)
+	copy(key[len(prefix):], denomBz)
+	return key
+}
+
+// prefixCreatorDenomStoreKey returns the prefix of the result of the method
+// creatorDenomStoreKey
+// Items are stored with the following key: values
+// 0x02<creator(len prefixed)>
+func prefixCreatorDenomStoreKey(creator sdk.AccAddress) []byte {
+	creator = address.MustLengthPrefix(creator)
+
+	key := make([]byte, len(CreatorDenomKey)+len(creator))
+	copy(key, CreatorDenomKey)
+	copy(key[len(CreatorDenomKey):], creator)
+	return key
+}
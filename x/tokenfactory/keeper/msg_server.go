@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the tokenfactory MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) tokenfactory.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ tokenfactory.MsgServer = msgServer{}
+
+// CreateDenom implements CreateDenom method of the types.MsgServer.
+func (k msgServer) CreateDenom(goCtx context.Context, msg *tokenfactory.MsgCreateDenom) (*tokenfactory.MsgCreateDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	denom, err := k.Keeper.CreateDenom(ctx, sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&tokenfactory.EventCreateDenom{
+		Denom:   denom,
+		Creator: msg.Sender,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &tokenfactory.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+// Mint implements Mint method of the types.MsgServer.
+func (k msgServer) Mint(goCtx context.Context, msg *tokenfactory.MsgMint) (*tokenfactory.MsgMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := k.getAdminAuthorization(ctx, sender, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	mintTo := sender
+	if msg.MintToAddress != "" {
+		mintTo, err = sdk.AccAddressFromBech32(msg.MintToAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.MintTo(ctx, msg.Amount, mintTo); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&tokenfactory.EventMint{
+		Amount:        msg.Amount,
+		MintToAddress: mintTo.String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &tokenfactory.MsgMintResponse{}, nil
+}
+
+// Burn implements Burn method of the types.MsgServer.
+func (k msgServer) Burn(goCtx context.Context, msg *tokenfactory.MsgBurn) (*tokenfactory.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := k.getAdminAuthorization(ctx, sender, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	if err := k.BurnFrom(ctx, msg.Amount, sender); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&tokenfactory.EventBurn{
+		Amount:          msg.Amount,
+		BurnFromAddress: msg.Sender,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &tokenfactory.MsgBurnResponse{}, nil
+}
+
+// ChangeAdmin implements ChangeAdmin method of the types.MsgServer.
+func (k msgServer) ChangeAdmin(goCtx context.Context, msg *tokenfactory.MsgChangeAdmin) (*tokenfactory.MsgChangeAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := k.getAdminAuthorization(ctx, sender, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata.Admin = msg.NewAdmin
+	if err := k.SetAuthorityMetadata(ctx, msg.Denom, metadata); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&tokenfactory.EventChangeAdmin{
+		Denom:    msg.Denom,
+		NewAdmin: msg.NewAdmin,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &tokenfactory.MsgChangeAdminResponse{}, nil
+}
+
+// SetDenomMetadata implements SetDenomMetadata method of the types.MsgServer.
+func (k msgServer) SetDenomMetadata(goCtx context.Context, msg *tokenfactory.MsgSetDenomMetadata) (*tokenfactory.MsgSetDenomMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := k.getAdminAuthorization(ctx, sender, msg.Metadata.Base); err != nil {
+		return nil, err
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, msg.Metadata)
+
+	if err := ctx.EventManager().EmitTypedEvent(&tokenfactory.EventSetDenomMetadata{
+		Denom: msg.Metadata.Base,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &tokenfactory.MsgSetDenomMetadataResponse{}, nil
+}
+
+// ForceTransfer implements ForceTransfer method of the types.MsgServer.
+func (k msgServer) ForceTransfer(goCtx context.Context, msg *tokenfactory.MsgForceTransfer) (*tokenfactory.MsgForceTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.GetParams(ctx).EnableForceTransfer {
+		return nil, tokenfactory.ErrForceTransferDisabled
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := k.getAdminAuthorization(ctx, sender, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.TransferFromAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := sdk.AccAddressFromBech32(msg.TransferToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.ForceTransfer(ctx, msg.Amount, from, to); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&tokenfactory.EventForceTransfer{
+		Amount:              msg.Amount,
+		TransferFromAddress: msg.TransferFromAddress,
+		TransferToAddress:   msg.TransferToAddress,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &tokenfactory.MsgForceTransferResponse{}, nil
+}
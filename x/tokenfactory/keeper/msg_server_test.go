@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+)
+
+func (s *TestSuite) TestMsgCreateDenom() {
+	msgServer := keeper.NewMsgServerImpl(s.app.TokenFactoryKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	res, err := msgServer.CreateDenom(goCtx, tokenfactory.NewMsgCreateDenom(s.addrs[0].String(), testSubdenom))
+	s.Require().NoError(err)
+	s.Require().True(s.app.TokenFactoryKeeper.HasDenom(s.ctx, res.NewTokenDenom))
+
+	_, err = msgServer.CreateDenom(goCtx, tokenfactory.NewMsgCreateDenom(s.addrs[0].String(), testSubdenom))
+	s.Require().ErrorIs(err, tokenfactory.ErrDenomExists)
+}
+
+func (s *TestSuite) TestMsgMintAndBurn() {
+	msgServer := keeper.NewMsgServerImpl(s.app.TokenFactoryKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	denom := s.createDenom()
+
+	_, err := msgServer.Mint(goCtx, tokenfactory.NewMsgMint(s.addrs[0].String(), sdk.NewInt64Coin(denom, 100), ""))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(denom, 100), s.app.BankKeeper.GetBalance(s.ctx, s.addrs[0], denom))
+
+	_, err = msgServer.Mint(goCtx, tokenfactory.NewMsgMint(s.addrs[0].String(), sdk.NewInt64Coin(denom, 50), s.addrs[1].String()))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(denom, 50), s.app.BankKeeper.GetBalance(s.ctx, s.addrs[1], denom))
+	s.Require().Equal(sdk.NewInt64Coin(denom, 150), s.app.BankKeeper.GetSupply(s.ctx, denom))
+
+	_, err = msgServer.Burn(goCtx, tokenfactory.NewMsgBurn(s.addrs[0].String(), sdk.NewInt64Coin(denom, 40)))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(denom, 60), s.app.BankKeeper.GetBalance(s.ctx, s.addrs[0], denom))
+	s.Require().Equal(sdk.NewInt64Coin(denom, 110), s.app.BankKeeper.GetSupply(s.ctx, denom))
+
+	s.T().Log("verify only the admin can mint and burn")
+	_, err = msgServer.Mint(goCtx, tokenfactory.NewMsgMint(s.addrs[1].String(), sdk.NewInt64Coin(denom, 100), ""))
+	s.Require().ErrorIs(err, tokenfactory.ErrUnauthorized)
+	_, err = msgServer.Burn(goCtx, tokenfactory.NewMsgBurn(s.addrs[1].String(), sdk.NewInt64Coin(denom, 10)))
+	s.Require().ErrorIs(err, tokenfactory.ErrUnauthorized)
+
+	s.T().Log("verify only factory denoms can be minted")
+	_, err = msgServer.Mint(goCtx, tokenfactory.NewMsgMint(s.addrs[0].String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), ""))
+	s.Require().ErrorIs(err, tokenfactory.ErrDenomNotExists)
+
+	s.T().Log("verify blocked addresses can't be minted to")
+	feeCollector := s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	_, err = msgServer.Mint(goCtx, tokenfactory.NewMsgMint(s.addrs[0].String(), sdk.NewInt64Coin(denom, 100), feeCollector.String()))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	s.T().Log("verify the admin can't burn more than its balance")
+	_, err = msgServer.Burn(goCtx, tokenfactory.NewMsgBurn(s.addrs[0].String(), sdk.NewInt64Coin(denom, 100)))
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+}
+
+func (s *TestSuite) TestMsgChangeAdmin() {
+	msgServer := keeper.NewMsgServerImpl(s.app.TokenFactoryKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	denom := s.createDenom()
+
+	_, err := msgServer.ChangeAdmin(goCtx, tokenfactory.NewMsgChangeAdmin(s.addrs[1].String(), denom, s.addrs[1].String()))
+	s.Require().ErrorIs(err, tokenfactory.ErrUnauthorized)
+
+	_, err = msgServer.ChangeAdmin(goCtx, tokenfactory.NewMsgChangeAdmin(s.addrs[0].String(), denom, s.addrs[1].String()))
+	s.Require().NoError(err)
+	metadata, _ := s.app.TokenFactoryKeeper.GetAuthorityMetadata(s.ctx, denom)
+	s.Require().Equal(s.addrs[1].String(), metadata.Admin)
+
+	_, err = msgServer.Mint(goCtx, tokenfactory.NewMsgMint(s.addrs[0].String(), sdk.NewInt64Coin(denom, 100), ""))
+	s.Require().ErrorIs(err, tokenfactory.ErrUnauthorized)
+	_, err = msgServer.Mint(goCtx, tokenfactory.NewMsgMint(s.addrs[1].String(), sdk.NewInt64Coin(denom, 100), ""))
+	s.Require().NoError(err)
+
+	s.T().Log("verify the admin can renounce the administration of the denom")
+	_, err = msgServer.ChangeAdmin(goCtx, tokenfactory.NewMsgChangeAdmin(s.addrs[1].String(), denom, ""))
+	s.Require().NoError(err)
+	metadata, _ = s.app.TokenFactoryKeeper.GetAuthorityMetadata(s.ctx, denom)
+	s.Require().Empty(metadata.Admin)
+
+	_, err = msgServer.Mint(goCtx, tokenfactory.NewMsgMint(s.addrs[1].String(), sdk.NewInt64Coin(denom, 100), ""))
+	s.Require().ErrorIs(err, tokenfactory.ErrUnauthorized)
+	_, err = msgServer.ChangeAdmin(goCtx, tokenfactory.NewMsgChangeAdmin(s.addrs[1].String(), denom, s.addrs[1].String()))
+	s.Require().ErrorIs(err, tokenfactory.ErrUnauthorized)
+}
+
+func (s *TestSuite) TestMsgSetDenomMetadata() {
+	msgServer := keeper.NewMsgServerImpl(s.app.TokenFactoryKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	denom := s.createDenom()
+
+	metadata := banktypes.Metadata{
+		Description: "Wrapped bitcoin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "wbtc", Exponent: 8},
+		},
+		Base:    denom,
+		Display: "wbtc",
+		Name:    "Wrapped Bitcoin",
+		Symbol:  "WBTC",
+	}
+
+	_, err := msgServer.SetDenomMetadata(goCtx, &tokenfactory.MsgSetDenomMetadata{Sender: s.addrs[1].String(), Metadata: metadata})
+	s.Require().ErrorIs(err, tokenfactory.ErrUnauthorized)
+
+	_, err = msgServer.SetDenomMetadata(goCtx, &tokenfactory.MsgSetDenomMetadata{Sender: s.addrs[0].String(), Metadata: metadata})
+	s.Require().NoError(err)
+
+	actual, found := s.app.BankKeeper.GetDenomMetaData(s.ctx, denom)
+	s.Require().True(found)
+	s.Require().Equal(metadata, actual)
+}
+
+func (s *TestSuite) TestMsgForceTransfer() {
+	msgServer := keeper.NewMsgServerImpl(s.app.TokenFactoryKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	denom := s.createDenom()
+
+	_, err := msgServer.Mint(goCtx, tokenfactory.NewMsgMint(s.addrs[0].String(), sdk.NewInt64Coin(denom, 100), s.addrs[1].String()))
+	s.Require().NoError(err)
+
+	s.T().Log("verify force transfers are rejected when disabled")
+	s.Require().False(s.app.TokenFactoryKeeper.GetParams(s.ctx).EnableForceTransfer)
+	_, err = msgServer.ForceTransfer(goCtx, tokenfactory.NewMsgForceTransfer(s.addrs[0].String(), sdk.NewInt64Coin(denom, 30), s.addrs[1].String(), s.addrs[2].String()))
+	s.Require().ErrorIs(err, tokenfactory.ErrForceTransferDisabled)
+	s.Require().Equal(sdk.NewInt64Coin(denom, 100), s.app.BankKeeper.GetBalance(s.ctx, s.addrs[1], denom))
+
+	s.enableForceTransfer()
+
+	_, err = msgServer.ForceTransfer(goCtx, tokenfactory.NewMsgForceTransfer(s.addrs[1].String(), sdk.NewInt64Coin(denom, 10), s.addrs[1].String(), s.addrs[2].String()))
+	s.Require().ErrorIs(err, tokenfactory.ErrUnauthorized)
+
+	_, err = msgServer.ForceTransfer(goCtx, tokenfactory.NewMsgForceTransfer(s.addrs[0].String(), sdk.NewInt64Coin(denom, 30), s.addrs[1].String(), s.addrs[2].String()))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(denom, 70), s.app.BankKeeper.GetBalance(s.ctx, s.addrs[1], denom))
+	s.Require().Equal(sdk.NewInt64Coin(denom, 30), s.app.BankKeeper.GetBalance(s.ctx, s.addrs[2], denom))
+
+	s.T().Log("verify module accounts can't be force transferred from")
+	distrAddr := s.app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	_, err = msgServer.ForceTransfer(goCtx, tokenfactory.NewMsgForceTransfer(s.addrs[0].String(), sdk.NewInt64Coin(denom, 10), distrAddr.String(), s.addrs[2].String()))
+	s.Require().ErrorIs(err, tokenfactory.ErrModuleAccount)
+
+	s.T().Log("verify blocked addresses can't be force transferred to")
+	feeCollector := s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	_, err = msgServer.ForceTransfer(goCtx, tokenfactory.NewMsgForceTransfer(s.addrs[0].String(), sdk.NewInt64Coin(denom, 10), s.addrs[1].String(), feeCollector.String()))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func (s *TestSuite) TestMsgForceTransferSendRestriction() {
+	msgServer := keeper.NewMsgServerImpl(s.app.TokenFactoryKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	denom := s.createDenom()
+
+	_, err := msgServer.Mint(goCtx, tokenfactory.NewMsgMint(s.addrs[0].String(), sdk.NewInt64Coin(denom, 100), s.addrs[1].String()))
+	s.Require().NoError(err)
+	s.enableForceTransfer()
+
+	// freeze the factory denom, except for force transfers
+	s.app.BankKeeper.AppendSendRestriction(func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+		if amt.AmountOf(denom).IsPositive() && !tokenfactory.IsForceTransfer(ctx) {
+			return sdkerrors.ErrUnauthorized.Wrapf("%s is frozen", denom)
+		}
+		return nil
+	})
+	defer s.app.BankKeeper.ClearSendRestriction()
+
+	err = s.app.BankKeeper.SendCoins(s.ctx, s.addrs[1], s.addrs[2], sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.ForceTransfer(goCtx, tokenfactory.NewMsgForceTransfer(s.addrs[0].String(), sdk.NewInt64Coin(denom, 10), s.addrs[1].String(), s.addrs[2].String()))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(denom, 10), s.app.BankKeeper.GetBalance(s.ctx, s.addrs[2], denom))
+}
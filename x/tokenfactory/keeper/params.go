@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+// GetParams returns the total set of tokenfactory parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params tokenfactory.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the tokenfactory parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params tokenfactory.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
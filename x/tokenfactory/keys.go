@@ -0,0 +1,12 @@
+package tokenfactory
+
+const (
+	// ModuleName is the name of the tokenfactory module
+	ModuleName = "tokenfactory"
+
+	// StoreKey is the default store key for tokenfactory
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for tokenfactory
+	RouterKey = ModuleName
+)
@@ -0,0 +1,74 @@
+package tokenfactory_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+func TestMsgsValidateBasic(t *testing.T) {
+	other := sdk.AccAddress([]byte("other_______________")).String()
+	denom := "factory/" + creator + "/bitcoin"
+	coin := sdk.NewInt64Coin(denom, 10)
+
+	testCases := []struct {
+		name   string
+		msg    sdk.Msg
+		expErr error
+	}{
+		{"create denom", tokenfactory.NewMsgCreateDenom(creator, "bitcoin"), nil},
+		{"create denom invalid sender", tokenfactory.NewMsgCreateDenom("creator", "bitcoin"), sdkerrors.ErrInvalidAddress},
+		{"create denom empty subdenom", tokenfactory.NewMsgCreateDenom(creator, ""), tokenfactory.ErrInvalidSubdenom},
+		{"mint", tokenfactory.NewMsgMint(creator, coin, ""), nil},
+		{"mint to address", tokenfactory.NewMsgMint(creator, coin, other), nil},
+		{"mint invalid mint to address", tokenfactory.NewMsgMint(creator, coin, "other"), sdkerrors.ErrInvalidAddress},
+		{"mint zero", tokenfactory.NewMsgMint(creator, sdk.NewInt64Coin(denom, 0), ""), sdkerrors.ErrInvalidCoins},
+		{"mint non factory denom", tokenfactory.NewMsgMint(creator, sdk.NewInt64Coin("stake", 10), ""), tokenfactory.ErrInvalidDenom},
+		{"burn", tokenfactory.NewMsgBurn(creator, coin), nil},
+		{"burn invalid sender", tokenfactory.NewMsgBurn("creator", coin), sdkerrors.ErrInvalidAddress},
+		{"change admin", tokenfactory.NewMsgChangeAdmin(creator, denom, other), nil},
+		{"renounce admin", tokenfactory.NewMsgChangeAdmin(creator, denom, ""), nil},
+		{"change admin invalid new admin", tokenfactory.NewMsgChangeAdmin(creator, denom, "other"), sdkerrors.ErrInvalidAddress},
+		{"change admin non factory denom", tokenfactory.NewMsgChangeAdmin(creator, "stake", other), tokenfactory.ErrInvalidDenom},
+		{
+			"set denom metadata",
+			&tokenfactory.MsgSetDenomMetadata{
+				Sender: creator,
+				Metadata: banktypes.Metadata{
+					DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+					Base:       denom,
+					Display:    denom,
+					Name:       "bitcoin",
+					Symbol:     "BTC",
+				},
+			},
+			nil,
+		},
+		{
+			"set invalid denom metadata",
+			&tokenfactory.MsgSetDenomMetadata{Sender: creator, Metadata: banktypes.Metadata{Base: denom}},
+			tokenfactory.ErrInvalidMetadata,
+		},
+		{"force transfer", tokenfactory.NewMsgForceTransfer(creator, coin, other, creator), nil},
+		{"force transfer invalid from address", tokenfactory.NewMsgForceTransfer(creator, coin, "other", creator), sdkerrors.ErrInvalidAddress},
+		{"force transfer invalid to address", tokenfactory.NewMsgForceTransfer(creator, coin, other, "creator"), sdkerrors.ErrInvalidAddress},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, creator, tc.msg.GetSigners()[0].String())
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package tokenfactory
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// DefaultDenomCreationFeeAmount is the default amount of the bond denom
+// charged to create a denom.
+const DefaultDenomCreationFeeAmount = 10_000_000
+
+// Parameter store keys
+var (
+	KeyDenomCreationFee    = []byte("DenomCreationFee")
+	KeyEnableForceTransfer = []byte("EnableForceTransfer")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the parameter key table of the tokenfactory module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(denomCreationFee sdk.Coins, enableForceTransfer bool) Params {
+	return Params{
+		DenomCreationFee:    denomCreationFee,
+		EnableForceTransfer: enableForceTransfer,
+	}
+}
+
+// DefaultParams returns the default parameters of the tokenfactory module.
+// Force transfers are disabled by default.
+func DefaultParams() Params {
+	return NewParams(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, DefaultDenomCreationFeeAmount)), false)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDenomCreationFee, &p.DenomCreationFee, validateDenomCreationFee),
+		paramtypes.NewParamSetPair(KeyEnableForceTransfer, &p.EnableForceTransfer, validateEnableForceTransfer),
+	}
+}
+
+// Validate performs a basic validation of the parameters.
+func (p Params) Validate() error {
+	if err := validateDenomCreationFee(p.DenomCreationFee); err != nil {
+		return err
+	}
+
+	return validateEnableForceTransfer(p.EnableForceTransfer)
+}
+
+func validateDenomCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee %s: %w", v, err)
+	}
+
+	return nil
+}
+
+func validateEnableForceTransfer(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+// Simulation parameter constants
+const (
+	DenomCreationFee    = "denom_creation_fee"
+	EnableForceTransfer = "enable_force_transfer"
+)
+
+// GenDenomCreationFee randomized DenomCreationFee, possibly free.
+func GenDenomCreationFee(r *rand.Rand) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(r.Intn(1_000_000))))
+}
+
+// GenEnableForceTransfer randomized EnableForceTransfer.
+func GenEnableForceTransfer(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// RandomizedGenState generates a random GenesisState for tokenfactory.
+func RandomizedGenState(simState *module.SimulationState) {
+	var denomCreationFee sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DenomCreationFee, &denomCreationFee, simState.Rand,
+		func(r *rand.Rand) { denomCreationFee = GenDenomCreationFee(r) },
+	)
+
+	var enableForceTransfer bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EnableForceTransfer, &enableForceTransfer, simState.Rand,
+		func(r *rand.Rand) { enableForceTransfer = GenEnableForceTransfer(r) },
+	)
+
+	tokenfactoryGenesis := tokenfactory.NewGenesisState(tokenfactory.NewParams(denomCreationFee, enableForceTransfer), nil)
+	simState.GenState[tokenfactory.ModuleName] = simState.Cdc.MustMarshalJSON(tokenfactoryGenesis)
+}
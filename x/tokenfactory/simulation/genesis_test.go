@@ -0,0 +1,42 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/simulation"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	app := simapp.Setup(t, false)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          app.AppCodec(),
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(r, 3),
+		InitialStake: 1000,
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState)
+	var tokenfactoryGenesis tokenfactory.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[tokenfactory.ModuleName], &tokenfactoryGenesis)
+
+	require.NoError(t, tokenfactory.ValidateGenesis(tokenfactoryGenesis))
+	require.Len(t, tokenfactoryGenesis.Params.DenomCreationFee, 1)
+	require.Equal(t, sdk.DefaultBondDenom, tokenfactoryGenesis.Params.DenomCreationFee[0].Denom)
+	require.Empty(t, tokenfactoryGenesis.FactoryDenoms)
+}
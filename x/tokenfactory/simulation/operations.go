@@ -0,0 +1,376 @@
+package simulation
+
+import (
+	"math/rand"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+)
+
+const (
+	// Simulation operation weights constants
+	OpWeightMsgCreateDenom      = "op_weight_msg_create_denom"
+	OpWeightMsgMint             = "op_weight_msg_mint"
+	OpWeightMsgBurn             = "op_weight_msg_burn"
+	OpWeightMsgChangeAdmin      = "op_weight_msg_change_admin"
+	OpWeightMsgSetDenomMetadata = "op_weight_msg_set_denom_metadata"
+	OpWeightMsgForceTransfer    = "op_weight_msg_force_transfer"
+)
+
+const (
+	// tokenfactory operations weights
+	WeightCreateDenom      = 20
+	WeightMint             = 50
+	WeightBurn             = 30
+	WeightChangeAdmin      = 10
+	WeightSetDenomMetadata = 10
+	WeightForceTransfer    = 20
+)
+
+var (
+	TypeMsgCreateDenom      = sdk.MsgTypeURL(&tokenfactory.MsgCreateDenom{})
+	TypeMsgMint             = sdk.MsgTypeURL(&tokenfactory.MsgMint{})
+	TypeMsgBurn             = sdk.MsgTypeURL(&tokenfactory.MsgBurn{})
+	TypeMsgChangeAdmin      = sdk.MsgTypeURL(&tokenfactory.MsgChangeAdmin{})
+	TypeMsgSetDenomMetadata = sdk.MsgTypeURL(&tokenfactory.MsgSetDenomMetadata{})
+	TypeMsgForceTransfer    = sdk.MsgTypeURL(&tokenfactory.MsgForceTransfer{})
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	registry cdctypes.InterfaceRegistry,
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	ak tokenfactory.AccountKeeper,
+	bk tokenfactory.BankKeeper,
+	k keeper.Keeper) simulation.WeightedOperations {
+
+	var (
+		weightMsgCreateDenom      int
+		weightMsgMint             int
+		weightMsgBurn             int
+		weightMsgChangeAdmin      int
+		weightMsgSetDenomMetadata int
+		weightMsgForceTransfer    int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateDenom, &weightMsgCreateDenom, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateDenom = WeightCreateDenom
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgMint, &weightMsgMint, nil,
+		func(_ *rand.Rand) {
+			weightMsgMint = WeightMint
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurn, &weightMsgBurn, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurn = WeightBurn
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgChangeAdmin, &weightMsgChangeAdmin, nil,
+		func(_ *rand.Rand) {
+			weightMsgChangeAdmin = WeightChangeAdmin
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetDenomMetadata, &weightMsgSetDenomMetadata, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetDenomMetadata = WeightSetDenomMetadata
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgForceTransfer, &weightMsgForceTransfer, nil,
+		func(_ *rand.Rand) {
+			weightMsgForceTransfer = WeightForceTransfer
+		},
+	)
+
+	protoCdc := codec.NewProtoCodec(registry)
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateDenom,
+			SimulateMsgCreateDenom(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMint,
+			SimulateMsgMint(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBurn,
+			SimulateMsgBurn(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgChangeAdmin,
+			SimulateMsgChangeAdmin(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetDenomMetadata,
+			SimulateMsgSetDenomMetadata(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgForceTransfer,
+			SimulateMsgForceTransfer(protoCdc, ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgCreateDenom generates a MsgCreateDenom with random values.
+func SimulateMsgCreateDenom(
+	cdc *codec.ProtoCodec,
+	ak tokenfactory.AccountKeeper,
+	bk tokenfactory.BankKeeper,
+	k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		sender, _ := simtypes.RandomAcc(r, accs)
+
+		subdenom := strings.ToLower(simtypes.RandStringOfLength(r, 10))
+		denom, err := tokenfactory.GetTokenDenom(sender.Address.String(), subdenom)
+		if err != nil {
+			return simtypes.NoOpMsg(tokenfactory.ModuleName, TypeMsgCreateDenom, err.Error()), nil, err
+		}
+
+		if k.HasDenom(ctx, denom) {
+			return simtypes.NoOpMsg(tokenfactory.ModuleName, TypeMsgCreateDenom, "denom already exists"), nil, nil
+		}
+
+		creationFee := k.GetParams(ctx).DenomCreationFee
+		if !bk.SpendableCoins(ctx, sender.Address).IsAllGTE(creationFee) {
+			return simtypes.NoOpMsg(tokenfactory.ModuleName, TypeMsgCreateDenom, "not enough funds for the denom creation fee"), nil, nil
+		}
+
+		msg := tokenfactory.NewMsgCreateDenom(sender.Address.String(), subdenom)
+
+		return genAndDeliverTx(r, app, ctx, cdc, ak, bk, msg, TypeMsgCreateDenom, sender, creationFee)
+	}
+}
+
+// SimulateMsgMint generates a MsgMint with random values.
+func SimulateMsgMint(
+	cdc *codec.ProtoCodec,
+	ak tokenfactory.AccountKeeper,
+	bk tokenfactory.BankKeeper,
+	k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, admin, found := randAdministeredDenom(ctx, r, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(tokenfactory.ModuleName, TypeMsgMint, "no denom administered by an account"), nil, nil
+		}
+
+		mintTo, _ := simtypes.RandomAcc(r, accs)
+
+		msg := tokenfactory.NewMsgMint(
+			admin.Address.String(),
+			sdk.NewInt64Coin(denom, int64(simtypes.RandIntBetween(r, 1, 1_000_000_000))),
+			mintTo.Address.String(),
+		)
+
+		return genAndDeliverTx(r, app, ctx, cdc, ak, bk, msg, TypeMsgMint, admin, nil)
+	}
+}
+
+// SimulateMsgBurn generates a MsgBurn with random values.
+func SimulateMsgBurn(
+	cdc *codec.ProtoCodec,
+	ak tokenfactory.AccountKeeper,
+	bk tokenfactory.BankKeeper,
+	k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, admin, found := randAdministeredDenom(ctx, r, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(tokenfactory.ModuleName, TypeMsgBurn, "no denom administered by an account"), nil, nil
+		}
+
+		spendable := bk.SpendableCoins(ctx, admin.Address).AmountOf(denom)
+		if !spendable.IsPositive() {
+			return simtypes.NoOpMsg(tokenfactory.ModuleName, TypeMsgBurn, "admin has no coins to burn"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(tokenfactory.ModuleName, TypeMsgBurn, err.Error()), nil, err
+		}
+
+		burn := sdk.NewCoin(denom, amount)
+		msg := tokenfactory.NewMsgBurn(admin.Address.String(), burn)
+
+		return genAndDeliverTx(r, app, ctx, cdc, ak, bk, msg, TypeMsgBurn, admin, sdk.NewCoins(burn))
+	}
+}
+
+// SimulateMsgChangeAdmin generates a MsgChangeAdmin with random values.
+func SimulateMsgChangeAdmin(
+	cdc *codec.ProtoCodec,
+	ak tokenfactory.AccountKeeper,
+	bk tokenfactory.BankKeeper,
+	k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, admin, found := randAdministeredDenom(ctx, r, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(tokenfactory.ModuleName, TypeMsgChangeAdmin, "no denom administered by an account"), nil, nil
+		}
+
+		newAdmin, _ := simtypes.RandomAcc(r, accs)
+
+		msg := tokenfactory.NewMsgChangeAdmin(admin.Address.String(), denom, newAdmin.Address.String())
+
+		return genAndDeliverTx(r, app, ctx, cdc, ak, bk, msg, TypeMsgChangeAdmin, admin, nil)
+	}
+}
+
+// SimulateMsgSetDenomMetadata generates a MsgSetDenomMetadata with random
+// values.
+func SimulateMsgSetDenomMetadata(
+	cdc *codec.ProtoCodec,
+	ak tokenfactory.AccountKeeper,
+	bk tokenfactory.BankKeeper,
+	k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, admin, found := randAdministeredDenom(ctx, r, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(tokenfactory.ModuleName, TypeMsgSetDenomMetadata, "no denom administered by an account"), nil, nil
+		}
+
+		display := strings.ToLower(simtypes.RandStringOfLength(r, 10))
+		msg := &tokenfactory.MsgSetDenomMetadata{
+			Sender: admin.Address.String(),
+			Metadata: banktypes.Metadata{
+				Description: simtypes.RandStringOfLength(r, 20),
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: denom, Exponent: 0},
+					{Denom: display, Exponent: uint32(simtypes.RandIntBetween(r, 1, 19))},
+				},
+				Base:    denom,
+				Display: display,
+				Name:    simtypes.RandStringOfLength(r, 10),
+				Symbol:  strings.ToUpper(simtypes.RandStringOfLength(r, 4)),
+			},
+		}
+
+		return genAndDeliverTx(r, app, ctx, cdc, ak, bk, msg, TypeMsgSetDenomMetadata, admin, nil)
+	}
+}
+
+// SimulateMsgForceTransfer generates a MsgForceTransfer with random values.
+func SimulateMsgForceTransfer(
+	cdc *codec.ProtoCodec,
+	ak tokenfactory.AccountKeeper,
+	bk tokenfactory.BankKeeper,
+	k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !k.GetParams(ctx).EnableForceTransfer {
+			return simtypes.NoOpMsg(tokenfactory.ModuleName, TypeMsgForceTransfer, "force transfers are disabled"), nil, nil
+		}
+
+		denom, admin, found := randAdministeredDenom(ctx, r, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(tokenfactory.ModuleName, TypeMsgForceTransfer, "no denom administered by an account"), nil, nil
+		}
+
+		from, _ := simtypes.RandomAcc(r, accs)
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		spendable := bk.SpendableCoins(ctx, from.Address).AmountOf(denom)
+		if !spendable.IsPositive() {
+			return simtypes.NoOpMsg(tokenfactory.ModuleName, TypeMsgForceTransfer, "account has no coins to transfer"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(tokenfactory.ModuleName, TypeMsgForceTransfer, err.Error()), nil, err
+		}
+
+		transfer := sdk.NewCoin(denom, amount)
+		msg := tokenfactory.NewMsgForceTransfer(admin.Address.String(), transfer, from.Address.String(), to.Address.String())
+
+		// the admin pays the fees, so it can't spend the coins taken from its
+		// own account on them
+		var coinsSpent sdk.Coins
+		if from.Address.Equals(admin.Address) {
+			coinsSpent = sdk.NewCoins(transfer)
+		}
+
+		return genAndDeliverTx(r, app, ctx, cdc, ak, bk, msg, TypeMsgForceTransfer, admin, coinsSpent)
+	}
+}
+
+func genAndDeliverTx(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, cdc *codec.ProtoCodec,
+	ak tokenfactory.AccountKeeper, bk tokenfactory.BankKeeper, msg sdk.Msg, msgType string, signer simtypes.Account,
+	coinsSpent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             cdc,
+		Msg:             msg,
+		MsgType:         msgType,
+		CoinsSpentInMsg: coinsSpent,
+		Context:         ctx,
+		SimAccount:      signer,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      tokenfactory.ModuleName,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+// randAdministeredDenom returns a random factory denom administered by one of
+// the simulation accounts, along with its admin.
+func randAdministeredDenom(ctx sdk.Context, r *rand.Rand, k keeper.Keeper, accs []simtypes.Account) (string, simtypes.Account, bool) {
+	var (
+		denoms []string
+		admins []simtypes.Account
+	)
+	k.IterateAuthorityMetadata(ctx, func(denom string, metadata tokenfactory.DenomAuthorityMetadata) bool {
+		if metadata.Admin == "" {
+			return false
+		}
+
+		adminAddr, err := sdk.AccAddressFromBech32(metadata.Admin)
+		if err != nil {
+			return false
+		}
+
+		admin, found := simtypes.FindAccount(accs, adminAddr)
+		if found {
+			denoms = append(denoms, denom)
+			admins = append(admins, admin)
+		}
+		return false
+	})
+
+	if len(denoms) == 0 {
+		return "", simtypes.Account{}, false
+	}
+
+	i := r.Intn(len(denoms))
+	return denoms[i], admins[i], true
+}
@@ -0,0 +1,182 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/simulation"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *SimTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(suite.T(), checkTx)
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{})
+}
+
+func (suite *SimTestSuite) TestWeightedOperations() {
+	weightedOps := simulation.WeightedOperations(
+		suite.app.InterfaceRegistry(),
+		make(simtypes.AppParams),
+		suite.app.AppCodec(),
+		suite.app.AccountKeeper,
+		suite.app.BankKeeper, suite.app.TokenFactoryKeeper,
+	)
+
+	// setup 3 accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accs := suite.getTestingAccounts(r, 3)
+
+	expected := []struct {
+		weight     int
+		opMsgRoute string
+		opMsgName  string
+	}{
+		{simulation.WeightCreateDenom, simulation.TypeMsgCreateDenom, simulation.TypeMsgCreateDenom},
+		{simulation.WeightMint, simulation.TypeMsgMint, simulation.TypeMsgMint},
+		// the coins were minted to another account than the admin
+		{simulation.WeightBurn, tokenfactory.ModuleName, simulation.TypeMsgBurn},
+		{simulation.WeightChangeAdmin, simulation.TypeMsgChangeAdmin, simulation.TypeMsgChangeAdmin},
+		{simulation.WeightSetDenomMetadata, simulation.TypeMsgSetDenomMetadata, simulation.TypeMsgSetDenomMetadata},
+		// the random account holds no coins of the denom
+		{simulation.WeightForceTransfer, tokenfactory.ModuleName, simulation.TypeMsgForceTransfer},
+	}
+
+	for i, w := range weightedOps {
+		operationMsg, _, _ := w.Op()(r, suite.app.BaseApp, suite.ctx, accs, "")
+		// the following checks are very much dependent from the ordering of the output given
+		// by WeightedOperations. if the ordering in WeightedOperations changes some tests
+		// will fail
+		suite.Require().Equal(expected[i].weight, w.Weight(), "weight should be the same")
+		suite.Require().Equal(expected[i].opMsgRoute, operationMsg.Route, "route should be the same")
+		suite.Require().Equal(expected[i].opMsgName, operationMsg.Name, "operation Msg name should be the same")
+	}
+}
+
+func (suite *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	initAmt := suite.app.StakingKeeper.TokensFromConsensusPower(suite.ctx, 200000)
+	initCoins := sdk.NewCoins(sdk.NewCoin("stake", initAmt))
+
+	// add coins to the accounts
+	for _, account := range accounts {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, account.Address)
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, suite.ctx, account.Address, initCoins))
+	}
+
+	return accounts
+}
+
+func (suite *SimTestSuite) beginBlock() {
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+}
+
+func (suite *SimTestSuite) TestSimulateMsgCreateDenom() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+
+	suite.beginBlock()
+
+	// execute operation
+	registry := suite.app.InterfaceRegistry()
+	op := simulation.SimulateMsgCreateDenom(codec.NewProtoCodec(registry), suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.TokenFactoryKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg tokenfactory.MsgCreateDenom
+	suite.app.AppCodec().UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Len(futureOperations, 0)
+
+	denom, err := tokenfactory.GetTokenDenom(msg.Sender, msg.Subdenom)
+	suite.Require().NoError(err)
+	metadata, found := suite.app.TokenFactoryKeeper.GetAuthorityMetadata(suite.ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(msg.Sender, metadata.Admin)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgMint() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 1)
+	denom, err := suite.app.TokenFactoryKeeper.CreateDenom(suite.ctx, accounts[0].Address, "bitcoin")
+	suite.Require().NoError(err)
+
+	suite.beginBlock()
+
+	// execute operation
+	registry := suite.app.InterfaceRegistry()
+	op := simulation.SimulateMsgMint(codec.NewProtoCodec(registry), suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.TokenFactoryKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg tokenfactory.MsgMint
+	suite.app.AppCodec().UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Len(futureOperations, 0)
+	suite.Require().Equal(msg.Amount, suite.app.BankKeeper.GetBalance(suite.ctx, accounts[0].Address, denom))
+}
+
+func (suite *SimTestSuite) TestSimulateMsgForceTransfer() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 1)
+	denom, err := suite.app.TokenFactoryKeeper.CreateDenom(suite.ctx, accounts[0].Address, "bitcoin")
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.app.TokenFactoryKeeper.MintTo(suite.ctx, sdk.NewInt64Coin(denom, 1000), accounts[0].Address))
+
+	suite.beginBlock()
+
+	registry := suite.app.InterfaceRegistry()
+	op := simulation.SimulateMsgForceTransfer(codec.NewProtoCodec(registry), suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.TokenFactoryKeeper)
+
+	// force transfers are disabled by default
+	operationMsg, _, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+	suite.Require().False(operationMsg.OK)
+
+	params := suite.app.TokenFactoryKeeper.GetParams(suite.ctx)
+	params.EnableForceTransfer = true
+	suite.app.TokenFactoryKeeper.SetParams(suite.ctx, params)
+
+	// execute operation
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg tokenfactory.MsgForceTransfer
+	suite.app.AppCodec().UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Len(futureOperations, 0)
+	suite.Require().Equal(denom, msg.Amount.Denom)
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
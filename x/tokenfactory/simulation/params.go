@@ -0,0 +1,36 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+const (
+	keyDenomCreationFee    = "DenomCreationFee"
+	keyEnableForceTransfer = "EnableForceTransfer"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(tokenfactory.ModuleName, keyDenomCreationFee,
+			func(r *rand.Rand) string {
+				bz, _ := json.Marshal(GenDenomCreationFee(r))
+				return string(bz)
+			},
+		),
+		simulation.NewSimParamChange(tokenfactory.ModuleName, keyEnableForceTransfer,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%v", GenEnableForceTransfer(r))
+			},
+		),
+	}
+}